@@ -0,0 +1,72 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const defaultBaseURL = "http://localhost:8080"
+
+// Config — настройки prctl. Порядок приоритета: флаги, переменные окружения, файл конфигурации.
+type Config struct {
+	BaseURL string `yaml:"base_url"`
+	Token   string `yaml:"token"`
+	Actor   string `yaml:"actor"`
+	Output  string `yaml:"output"`
+}
+
+// configPath возвращает путь к файлу конфигурации и признак того, что он задан явно.
+func configPath(flagPath string) (string, bool) {
+	if flagPath != "" {
+		return flagPath, true
+	}
+	if env := os.Getenv("PRCTL_CONFIG"); env != "" {
+		return env, true
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", false
+	}
+	return filepath.Join(dir, "prctl", "config.yaml"), false
+}
+
+// loadConfig читает файл конфигурации и применяет переменные окружения.
+// Отсутствие файла по умолчанию не ошибка, отсутствие явно указанного — ошибка.
+func loadConfig(flagPath string) (*Config, error) {
+	cfg := &Config{}
+	path, explicit := configPath(flagPath)
+	if path != "" {
+		raw, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			if err := yaml.Unmarshal(raw, cfg); err != nil {
+				return nil, fmt.Errorf("parse config %s: %w", path, err)
+			}
+		case errors.Is(err, fs.ErrNotExist) && !explicit:
+		default:
+			return nil, fmt.Errorf("read config: %w", err)
+		}
+	}
+	for env, field := range map[string]*string{
+		"PRCTL_URL":    &cfg.BaseURL,
+		"PRCTL_TOKEN":  &cfg.Token,
+		"PRCTL_ACTOR":  &cfg.Actor,
+		"PRCTL_OUTPUT": &cfg.Output,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*field = v
+		}
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = defaultBaseURL
+	}
+	if cfg.Output == "" {
+		cfg.Output = outputTable
+	}
+	return cfg, nil
+}
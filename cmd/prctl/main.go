@@ -0,0 +1,188 @@
+// prctl — консольный клиент администратора сервиса назначения ревьюверов.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"avito-test-pr-service/pkg/client"
+)
+
+const usage = `Usage: prctl [global flags] <command> <subcommand> [flags]
+
+Commands:
+  team add      --name NAME (--member ID:USERNAME[:inactive]... | --file FILE)
+  team get      NAME
+  team list
+  team sync     --name NAME (--member ... | --file FILE) [--dry-run]
+  user set-active USER_ID true|false
+  user list
+  pr create     --id ID --name TITLE --author USER_ID [--changed-file PATH]... [--label LABEL]...
+  pr get        PR_ID
+  pr reassign   --id PR_ID --old USER_ID [--new USER_ID] [--reason REASON]
+  pr merge      PR_ID
+  pr list       [--author ID] [--team NAME] [--status OPEN|MERGED|CLOSED] [--reviewer ID] [--limit N] [--offset N]
+  stats         [--team NAME] [--author ID]
+
+Global flags:
+`
+
+// errUsage — ошибка в аргументах командной строки (код выхода 2).
+var errUsage = errors.New("usage")
+
+type command func(ctx context.Context, a *app, args []string) error
+
+var commands = map[string]map[string]command{
+	"team": {"add": teamAdd, "get": teamGet, "list": teamList, "sync": teamSync},
+	"user": {"set-active": userSetActive, "list": userList},
+	"pr":   {"create": prCreate, "get": prGet, "reassign": prReassign, "merge": prMerge, "list": prList},
+}
+
+type app struct {
+	client *client.Client
+	out    io.Writer
+	errOut io.Writer
+	format string
+}
+
+func (a *app) render(data any, tbl *table) error { return render(a.out, a.format, data, tbl) }
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("prctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	configFile := fs.String("config", "", "config file (default $PRCTL_CONFIG or ~/.config/prctl/config.yaml)")
+	baseURL := fs.String("url", "", "service base URL (overrides config and $PRCTL_URL)")
+	token := fs.String("token", "", "bearer token (overrides config and $PRCTL_TOKEN)")
+	actor := fs.String("actor", "", "X-Actor-ID for mutating requests (overrides config and $PRCTL_ACTOR)")
+	output := fs.String("o", "", "output format: table, json or yaml")
+	fs.Usage = func() {
+		_, _ = fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	cfg, err := loadConfig(*configFile)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "prctl:", err)
+		return 1
+	}
+	for _, o := range []struct{ value, field *string }{
+		{baseURL, &cfg.BaseURL}, {token, &cfg.Token}, {actor, &cfg.Actor}, {output, &cfg.Output},
+	} {
+		if *o.value != "" {
+			*o.field = *o.value
+		}
+	}
+	if err := validOutput(cfg.Output); err != nil {
+		_, _ = fmt.Fprintln(stderr, "prctl:", err)
+		return 2
+	}
+
+	cmd, rest, err := lookup(fs.Args())
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "prctl:", err)
+		fs.Usage()
+		return 2
+	}
+
+	a := &app{
+		client: client.New(cfg.BaseURL, client.WithToken(cfg.Token), client.WithActor(cfg.Actor)),
+		out:    stdout,
+		errOut: stderr,
+		format: cfg.Output,
+	}
+	if err := cmd(ctx, a, rest); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		_, _ = fmt.Fprintln(stderr, "prctl:", err)
+		if errors.Is(err, errUsage) {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+func lookup(args []string) (command, []string, error) {
+	if len(args) == 0 {
+		return nil, nil, errors.New("command is required")
+	}
+	if args[0] == "stats" {
+		return stats, args[1:], nil
+	}
+	group, ok := commands[args[0]]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown command %q", args[0])
+	}
+	if len(args) < 2 {
+		return nil, nil, fmt.Errorf("%s: subcommand is required (%s)", args[0], subcommands(group))
+	}
+	cmd, ok := group[args[1]]
+	if !ok {
+		return nil, nil, fmt.Errorf("%s: unknown subcommand %q (%s)", args[0], args[1], subcommands(group))
+	}
+	return cmd, args[2:], nil
+}
+
+func subcommands(group map[string]command) string {
+	names := make([]string, 0, len(group))
+	for name := range group {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func (a *app) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.errOut)
+	return fs
+}
+
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return fmt.Errorf("%w: %s", errUsage, err.Error())
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: %s: unexpected arguments %v", errUsage, fs.Name(), fs.Args())
+	}
+	return nil
+}
+
+// positional проверяет число позиционных аргументов подкоманды.
+func positional(name string, args []string, names ...string) error {
+	if len(args) != len(names) {
+		return fmt.Errorf("%w: %s expects %s", errUsage, name, strings.Join(names, " "))
+	}
+	return nil
+}
+
+// stringList — повторяемый строковый флаг.
+type stringList []string
+
+func (s *stringList) String() string { return strings.Join(*s, ",") }
+
+func (s *stringList) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
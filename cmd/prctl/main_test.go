@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/team/add":
+			require.Equal(t, "Bearer file-token", r.Header.Get("Authorization"))
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{"team": body})
+		case "/team/get":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NOT_FOUND","message":"team not found"}}`))
+		case "/pullRequest/list":
+			// 501 PR: первая страница полная, вторая содержит один закрытый PR.
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			require.Equal(t, "500", r.URL.Query().Get("limit"))
+			n := 500
+			if offset > 0 {
+				n = 1
+			}
+			prs := make([]map[string]any, 0, n)
+			for i := 0; i < n; i++ {
+				status, reviewers := "MERGED", []string{}
+				switch {
+				case offset+i < 3:
+					status, reviewers = "OPEN", []string{"u2", "u3"}
+				case offset+i == 500:
+					status, reviewers = "CLOSED", []string{"u2"}
+				}
+				prs = append(prs, map[string]any{
+					"pull_request_id": fmt.Sprintf("pr-%d", offset+i), "author_id": "u1",
+					"status": status, "assigned_reviewers": reviewers,
+				})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"pull_requests": prs, "total": 501, "limit": 500, "offset": offset})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func writeConfig(t *testing.T, srv *httptest.Server) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("base_url: "+srv.URL+"\ntoken: file-token\noutput: json\n"), 0o600))
+	return path
+}
+
+func TestRun_TeamAdd(t *testing.T) {
+	srv := newFakeServer(t)
+	cfg := writeConfig(t, srv)
+	var stdout, stderr bytes.Buffer
+
+	code := run(context.Background(), []string{"--config", cfg, "-o", "table", "team", "add", "--name", "core", "--member", "u1:Alice", "--member", "u2:Bob:inactive"}, &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+	require.Equal(t, "TEAM  USER_ID  USERNAME  ACTIVE\ncore  u1       Alice     true\ncore  u2       Bob       false\n", stdout.String())
+
+	stdout.Reset()
+	code = run(context.Background(), []string{"--config", cfg, "-o", "yaml", "team", "add", "--name", "core", "--member", "u1:Alice"}, &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+	require.Contains(t, stdout.String(), "team_name: core\nmembers:\n  - user_id: u1\n")
+}
+
+func TestRun_Errors(t *testing.T) {
+	srv := newFakeServer(t)
+	cfg := writeConfig(t, srv)
+
+	for _, tc := range []struct {
+		args []string
+		code int
+		msg  string
+	}{
+		{[]string{"team", "get", "missing"}, 1, "404 NOT_FOUND: team not found"},
+		{[]string{"team", "get"}, 2, "team get expects NAME"},
+		{[]string{"team", "add", "--member", "u1"}, 2, "--name is required"},
+		{[]string{"team", "add", "--name", "core", "--member", "u1"}, 2, "must be ID:USERNAME"},
+		{[]string{"user", "set-active", "u1", "maybe"}, 2, "state must be true or false"},
+		{[]string{"pr", "merge-all"}, 2, `unknown subcommand "merge-all"`},
+		{[]string{"-o", "xml", "team", "list"}, 2, "unknown output format"},
+		{[]string{"--config", filepath.Join(t.TempDir(), "missing.yaml"), "team", "list"}, 1, "read config"},
+	} {
+		var stdout, stderr bytes.Buffer
+		code := run(context.Background(), append([]string{"--config", cfg}, tc.args...), &stdout, &stderr)
+		require.Equal(t, tc.code, code, tc.args)
+		require.Contains(t, stderr.String(), tc.msg, tc.args)
+	}
+}
+
+func TestRun_StatsPagesThroughList(t *testing.T) {
+	srv := newFakeServer(t)
+	var stdout, stderr bytes.Buffer
+
+	code := run(context.Background(), []string{"--config", writeConfig(t, srv), "stats"}, &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+	var s Stats
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &s))
+	require.Equal(t, 501, s.Total)
+	require.Equal(t, 3, s.Open)
+	require.Equal(t, 497, s.Merged)
+	require.Equal(t, 1, s.Closed)
+	require.Equal(t, []Count{{ID: "u2", Count: 3}, {ID: "u3", Count: 3}}, s.OpenReviews)
+	require.Equal(t, []Count{{ID: "u1", Count: 501}}, s.ByAuthor)
+}
+
+func TestLoadConfig_EnvOverridesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("base_url: http://file\ntoken: file-token\n"), 0o600))
+	t.Setenv("PRCTL_CONFIG", path)
+	t.Setenv("PRCTL_TOKEN", "env-token")
+
+	cfg, err := loadConfig("")
+	require.NoError(t, err)
+	require.Equal(t, "http://file", cfg.BaseURL)
+	require.Equal(t, "env-token", cfg.Token)
+	require.Equal(t, outputTable, cfg.Output)
+
+	t.Setenv("PRCTL_CONFIG", "")
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", "")
+	cfg, err = loadConfig("")
+	require.NoError(t, err)
+	require.Equal(t, defaultBaseURL, cfg.BaseURL)
+}
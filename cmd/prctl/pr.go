@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"avito-test-pr-service/pkg/client"
+)
+
+func prTable(prs ...client.PullRequest) *table {
+	tbl := &table{headers: []string{"PR_ID", "NAME", "AUTHOR", "STATUS", "REVIEWERS"}}
+	for _, pr := range prs {
+		tbl.add(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, joinOrDash(pr.AssignedReviewers))
+	}
+	return tbl
+}
+
+func prCreate(ctx context.Context, a *app, args []string) error {
+	var (
+		req           client.CreatePRRequest
+		files, labels stringList
+	)
+	fs := a.flags("pr create")
+	fs.StringVar(&req.PullRequestID, "id", "", "pull request id")
+	fs.StringVar(&req.PullRequestName, "name", "", "pull request title")
+	fs.StringVar(&req.AuthorID, "author", "", "author user id")
+	fs.Var(&files, "changed-file", "changed file path, repeatable")
+	fs.Var(&labels, "label", "label, repeatable")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if req.PullRequestID == "" || req.PullRequestName == "" || req.AuthorID == "" {
+		return fmt.Errorf("%w: pr create requires --id, --name and --author", errUsage)
+	}
+	req.ChangedFiles, req.Labels = files, labels
+	pr, err := a.client.CreatePR(ctx, req)
+	if err != nil {
+		return err
+	}
+	return a.render(pr, prTable(*pr))
+}
+
+func prGet(ctx context.Context, a *app, args []string) error {
+	if err := positional("pr get", args, "PR_ID"); err != nil {
+		return err
+	}
+	pr, err := a.client.GetPR(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return a.render(pr, prTable(*pr))
+}
+
+func prReassign(ctx context.Context, a *app, args []string) error {
+	var req client.ReassignRequest
+	fs := a.flags("pr reassign")
+	fs.StringVar(&req.PullRequestID, "id", "", "pull request id")
+	fs.StringVar(&req.OldUserID, "old", "", "reviewer to replace")
+	fs.StringVar(&req.NewUserID, "new", "", "explicit replacement (default: chosen by the team strategy)")
+	fs.StringVar(&req.Reason, "reason", "", "reassignment reason recorded in history")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if req.PullRequestID == "" || req.OldUserID == "" {
+		return fmt.Errorf("%w: pr reassign requires --id and --old", errUsage)
+	}
+	res, err := a.client.ReassignPR(ctx, req)
+	if err != nil {
+		return err
+	}
+	tbl := prTable(res.PR)
+	tbl.headers = append(tbl.headers, "REPLACED_BY")
+	tbl.rows[0] = append(tbl.rows[0], res.ReplacedBy)
+	return a.render(res, tbl)
+}
+
+func prMerge(ctx context.Context, a *app, args []string) error {
+	if err := positional("pr merge", args, "PR_ID"); err != nil {
+		return err
+	}
+	pr, err := a.client.MergePR(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return a.render(pr, prTable(*pr))
+}
+
+func prList(ctx context.Context, a *app, args []string) error {
+	var params client.ListPRsParams
+	fs := a.flags("pr list")
+	fs.StringVar(&params.AuthorID, "author", "", "filter by author id")
+	fs.StringVar(&params.TeamName, "team", "", "filter by author team")
+	fs.StringVar(&params.Status, "status", "", "filter by status: OPEN, MERGED or CLOSED")
+	fs.StringVar(&params.ReviewerID, "reviewer", "", "filter by assigned reviewer id")
+	fs.IntVar(&params.Limit, "limit", 0, "page size (server default when 0)")
+	fs.IntVar(&params.Offset, "offset", 0, "page offset")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	page, err := a.client.ListPRs(ctx, params)
+	if err != nil {
+		return err
+	}
+	tbl := prTable(page.PullRequests...)
+	if a.format == outputTable && page.Total > page.Offset+len(page.PullRequests) {
+		_, _ = fmt.Fprintf(a.errOut, "showing %d-%d of %d\n", page.Offset+1, page.Offset+len(page.PullRequests), page.Total)
+	}
+	return a.render(page, tbl)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"avito-test-pr-service/pkg/client"
+)
+
+// statsPageSize — максимальный limit, который принимает /pullRequest/list.
+const statsPageSize = 500
+
+type Count struct {
+	ID    string `json:"id" yaml:"id"`
+	Count int    `json:"count" yaml:"count"`
+}
+
+// Stats — сводка по PR, собранная на клиенте постраничным обходом /pullRequest/list.
+type Stats struct {
+	Total       int     `json:"total" yaml:"total"`
+	Open        int     `json:"open" yaml:"open"`
+	Merged      int     `json:"merged" yaml:"merged"`
+	Closed      int     `json:"closed" yaml:"closed"`
+	OpenReviews []Count `json:"open_reviews_by_reviewer" yaml:"open_reviews_by_reviewer"`
+	ByAuthor    []Count `json:"prs_by_author" yaml:"prs_by_author"`
+}
+
+func stats(ctx context.Context, a *app, args []string) error {
+	var params client.ListPRsParams
+	fs := a.flags("stats")
+	fs.StringVar(&params.TeamName, "team", "", "only PRs authored by members of the team")
+	fs.StringVar(&params.AuthorID, "author", "", "only PRs of the author")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	s, err := collectStats(ctx, a.client, params)
+	if err != nil {
+		return err
+	}
+	tbl := &table{headers: []string{"METRIC", "ID", "VALUE"}}
+	tbl.add("total", "", strconv.Itoa(s.Total))
+	tbl.add("open", "", strconv.Itoa(s.Open))
+	tbl.add("merged", "", strconv.Itoa(s.Merged))
+	tbl.add("closed", "", strconv.Itoa(s.Closed))
+	for _, c := range s.OpenReviews {
+		tbl.add("open_reviews", c.ID, strconv.Itoa(c.Count))
+	}
+	for _, c := range s.ByAuthor {
+		tbl.add("prs_by_author", c.ID, strconv.Itoa(c.Count))
+	}
+	return a.render(s, tbl)
+}
+
+func collectStats(ctx context.Context, c *client.Client, params client.ListPRsParams) (*Stats, error) {
+	s := &Stats{}
+	reviews := make(map[string]int)
+	authors := make(map[string]int)
+	params.Limit = statsPageSize
+	for params.Offset = 0; ; params.Offset += statsPageSize {
+		page, err := c.ListPRs(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range page.PullRequests {
+			s.Total++
+			authors[pr.AuthorID]++
+			switch pr.Status {
+			case client.StatusOpen:
+				s.Open++
+				for _, id := range pr.AssignedReviewers {
+					reviews[id]++
+				}
+			case client.StatusMerged:
+				s.Merged++
+			case client.StatusClosed:
+				s.Closed++
+			}
+		}
+		if len(page.PullRequests) < statsPageSize || params.Offset+len(page.PullRequests) >= page.Total {
+			break
+		}
+	}
+	s.OpenReviews, s.ByAuthor = sortedCounts(reviews), sortedCounts(authors)
+	return s, nil
+}
+
+// sortedCounts упорядочивает по убыванию счётчика, при равенстве — по id.
+func sortedCounts(m map[string]int) []Count {
+	out := make([]Count, 0, len(m))
+	for id, n := range m {
+		out = append(out, Count{ID: id, Count: n})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
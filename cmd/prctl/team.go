@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"avito-test-pr-service/pkg/client"
+
+	"gopkg.in/yaml.v3"
+)
+
+// teamFlags — общие флаги team add и team sync.
+type teamFlags struct {
+	name    string
+	file    string
+	members stringList
+}
+
+func (a *app) teamFlagSet(name string, tf *teamFlags) *flag.FlagSet {
+	fs := a.flags(name)
+	fs.StringVar(&tf.name, "name", "", "team name")
+	fs.StringVar(&tf.file, "file", "", "YAML or JSON file with team_name and members")
+	fs.Var(&tf.members, "member", "member as ID:USERNAME[:inactive], repeatable")
+	return fs
+}
+
+// team собирает команду из файла или флагов --member; --name переопределяет имя из файла.
+func (tf *teamFlags) team() (client.Team, error) {
+	var team client.Team
+	if tf.file != "" {
+		if len(tf.members) > 0 {
+			return team, fmt.Errorf("%w: --file and --member are mutually exclusive", errUsage)
+		}
+		raw, err := os.ReadFile(tf.file)
+		if err != nil {
+			return team, err
+		}
+		// JSON — подмножество YAML, поэтому один разборщик покрывает оба формата.
+		if err := yaml.Unmarshal(raw, &team); err != nil {
+			return team, fmt.Errorf("parse %s: %w", tf.file, err)
+		}
+	}
+	if tf.name != "" {
+		team.TeamName = tf.name
+	}
+	if team.TeamName == "" {
+		return team, fmt.Errorf("%w: --name is required", errUsage)
+	}
+	for _, spec := range tf.members {
+		m, err := parseMember(spec)
+		if err != nil {
+			return team, err
+		}
+		team.Members = append(team.Members, m)
+	}
+	if team.Members == nil {
+		team.Members = []client.TeamMember{}
+	}
+	return team, nil
+}
+
+func parseMember(spec string) (client.TeamMember, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return client.TeamMember{}, fmt.Errorf("%w: member %q must be ID:USERNAME[:active|inactive]", errUsage, spec)
+	}
+	m := client.TeamMember{UserID: parts[0], Username: parts[1], IsActive: true}
+	if len(parts) == 3 {
+		switch parts[2] {
+		case "active":
+		case "inactive":
+			m.IsActive = false
+		default:
+			return client.TeamMember{}, fmt.Errorf("%w: member %q: state must be active or inactive", errUsage, spec)
+		}
+	}
+	return m, nil
+}
+
+func teamTable(team *client.Team) *table {
+	tbl := &table{headers: []string{"TEAM", "USER_ID", "USERNAME", "ACTIVE"}}
+	for _, m := range team.Members {
+		tbl.add(team.TeamName, m.UserID, m.Username, strconv.FormatBool(m.IsActive))
+	}
+	return tbl
+}
+
+func teamAdd(ctx context.Context, a *app, args []string) error {
+	var tf teamFlags
+	if err := parseFlags(a.teamFlagSet("team add", &tf), args); err != nil {
+		return err
+	}
+	team, err := tf.team()
+	if err != nil {
+		return err
+	}
+	created, err := a.client.AddTeam(ctx, team)
+	if err != nil {
+		return err
+	}
+	return a.render(created, teamTable(created))
+}
+
+func teamGet(ctx context.Context, a *app, args []string) error {
+	if err := positional("team get", args, "NAME"); err != nil {
+		return err
+	}
+	team, err := a.client.GetTeam(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return a.render(team, teamTable(team))
+}
+
+func teamList(ctx context.Context, a *app, args []string) error {
+	if err := positional("team list", args); err != nil {
+		return err
+	}
+	teams, err := a.client.ListTeams(ctx)
+	if err != nil {
+		return err
+	}
+	tbl := &table{headers: []string{"TEAM", "STRATEGY"}}
+	for _, t := range teams {
+		tbl.add(t.TeamName, t.SelectionStrategy)
+	}
+	return a.render(teams, tbl)
+}
+
+func teamSync(ctx context.Context, a *app, args []string) error {
+	var tf teamFlags
+	fs := a.teamFlagSet("team sync", &tf)
+	dryRun := fs.Bool("dry-run", false, "only show the diff")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	team, err := tf.team()
+	if err != nil {
+		return err
+	}
+	// Пустой состав почти всегда означает забытый --member, а применение снимет всех участников.
+	if len(team.Members) == 0 && !*dryRun {
+		return errors.New("refusing to sync team to an empty member list; run with --dry-run to preview")
+	}
+	res, err := a.client.SyncTeam(ctx, team, *dryRun)
+	if err != nil {
+		return err
+	}
+	return a.render(res, syncTable(res))
+}
+
+// syncTable — построчный список изменений, в том же порядке, что и поля diff.
+func syncTable(res *client.SyncResult) *table {
+	tbl := &table{headers: []string{"CHANGE", "TARGET", "DETAILS"}}
+	d := res.Diff
+	for _, u := range d.CreateUsers {
+		tbl.add("create_user", u.UserID, u.Username)
+	}
+	for _, r := range d.RenameUsers {
+		tbl.add("rename_user", r.UserID, r.From+" -> "+r.To)
+	}
+	for _, id := range d.ActivateUsers {
+		tbl.add("activate_user", id, "")
+	}
+	for _, id := range d.DeactivateUsers {
+		tbl.add("deactivate_user", id, "")
+	}
+	for _, m := range d.AddMemberships {
+		tbl.add("add_member", m.UserID, m.TeamName)
+	}
+	for _, m := range d.RemoveMemberships {
+		tbl.add("remove_member", m.UserID, m.TeamName)
+	}
+	for _, r := range res.Releases {
+		details := r.Outcome
+		if r.ReplacedBy != "" {
+			details += " -> " + r.ReplacedBy
+		}
+		tbl.add("release_review", r.PullRequestID, r.UserID+" "+details)
+	}
+	return tbl
+}
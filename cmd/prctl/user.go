@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"avito-test-pr-service/pkg/client"
+)
+
+func userTable(users ...client.User) *table {
+	tbl := &table{headers: []string{"USER_ID", "USERNAME", "TEAM", "ACTIVE"}}
+	for _, u := range users {
+		team := u.TeamName
+		if team == "" {
+			team = "-"
+		}
+		tbl.add(u.UserID, u.Username, team, strconv.FormatBool(u.IsActive))
+	}
+	return tbl
+}
+
+func userSetActive(ctx context.Context, a *app, args []string) error {
+	if err := positional("user set-active", args, "USER_ID", "true|false"); err != nil {
+		return err
+	}
+	active, err := strconv.ParseBool(args[1])
+	if err != nil {
+		return fmt.Errorf("%w: user set-active: state must be true or false", errUsage)
+	}
+	user, err := a.client.SetUserActive(ctx, args[0], active)
+	if err != nil {
+		return err
+	}
+	return a.render(user, userTable(*user))
+}
+
+func userList(ctx context.Context, a *app, args []string) error {
+	if err := positional("user list", args); err != nil {
+		return err
+	}
+	users, err := a.client.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+	return a.render(users, userTable(users...))
+}
@@ -1,17 +1,32 @@
 package main
 
 import (
+	auditapp "avito-test-pr-service/internal/application/audit"
+	feedapp "avito-test-pr-service/internal/application/feed"
+	notificationapp "avito-test-pr-service/internal/application/notification"
 	"avito-test-pr-service/internal/application/pr"
 	teamapp "avito-test-pr-service/internal/application/team"
 	userapp "avito-test-pr-service/internal/application/user"
+	vcsapp "avito-test-pr-service/internal/application/vcs"
+	"avito-test-pr-service/internal/domain/models"
+	uow_port "avito-test-pr-service/internal/domain/ports/output/uow"
+	vcs_port "avito-test-pr-service/internal/domain/ports/output/vcs"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/internal/infrastructure/chat"
 	"avito-test-pr-service/internal/infrastructure/config"
+	"avito-test-pr-service/internal/infrastructure/feedbroker"
+	grpcserver "avito-test-pr-service/internal/infrastructure/grpc"
 	httpserver "avito-test-pr-service/internal/infrastructure/http"
 	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/mail"
 	pg_uow "avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
 	"avito-test-pr-service/internal/infrastructure/reviewerselector"
+	"avito-test-pr-service/internal/infrastructure/vcs/github"
+	"avito-test-pr-service/internal/infrastructure/vcs/gitlab"
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -34,13 +49,7 @@ func main() {
 	ctx := context.Background()
 	log := logger.New(cfg.Env)
 
-	poolConfig, err := pgxpool.ParseConfig(dsn)
-	if err != nil {
-		log.Error("Failed to parse postgres pool config", slog.String("error", err.Error()))
-		os.Exit(1)
-	}
-
-	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	pool, err := newPool(ctx, dsn, cfg.Database.MaxConns, cfg.Database.MinConns)
 	if err != nil {
 		log.Error("Failed to create postgres pool", slog.String("error", err.Error()))
 		os.Exit(1)
@@ -48,19 +57,55 @@ func main() {
 	defer pool.Close()
 
 	uow := pg_uow.NewPostgresUOW(pool, log)
-	selector := reviewerselector.NewRandomReviewerSelector()
+	if replica := cfg.Database.Replica; replica.DSN != "" {
+		replicaPool, err := newPool(ctx, replica.DSN, replica.MaxConns, replica.MinConns)
+		if err != nil {
+			log.Error("Failed to create replica pool", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		defer replicaPool.Close()
+		uow = pg_uow.NewPostgresUOWWithReplica(pool, pg_uow.ReplicaOptions{Pool: replicaPool, MaxStaleness: replica.MaxStaleness}, log)
+		log.Info("Read replica enabled", slog.Duration("max_staleness", replica.MaxStaleness))
+	}
+	selectors := services.NewSelectorRegistry(reviewerselector.StrategyCodeOwners)
+	selectors.Register(reviewerselector.StrategyRandom, reviewerselector.RandomFactory)
+	selectors.Register(reviewerselector.StrategyWeighted, reviewerselector.WeightedFactory)
+	selectors.Register(reviewerselector.StrategyRoundRobin, reviewerselector.RoundRobinFactory)
+	selectors.Register(reviewerselector.StrategyCodeOwners, reviewerselector.CodeOwnersFactory(selectors))
+
+	broker := feedbroker.NewBroker(feedbroker.DefaultBuffer)
+	publishers := feedbroker.Fanout{broker}
+	reviewSync := newReviewSync(uow, cfg.Integrations, log)
+	if reviewSync != nil {
+		publishers = append(publishers, reviewSync)
+	}
+	notifier, err := newNotifier(uow, cfg.Notifications, log)
+	if err != nil {
+		log.Error("Failed to configure notifications", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if notifier != nil {
+		publishers = append(publishers, notifier)
+	}
 
 	userService := userapp.NewService(uow, log)
-	teamService := teamapp.NewService(uow, log)
-	prService := pr.NewService(uow, selector, log)
+	teamService := teamapp.NewService(uow, selectors, pr.NewReviewReleaser(selectors, publishers, log), log)
+	prService := pr.NewService(uow, selectors, publishers, log)
+	auditService := auditapp.NewService(uow, log)
+	feedService := feedapp.NewService(uow, broker, log)
+	vcsService := vcsapp.NewService(uow, prService, log)
+	notificationService := notificationapp.NewService(uow, log)
 
 	addr := fmt.Sprintf("%s:%d", cfg.HTTPServer.Address, cfg.HTTPServer.Port)
-	server := httpserver.NewServer(addr, log, prService, teamService, userService)
+	server := httpserver.NewServer(addr, log, prService, teamService, userService, auditService, feedService, vcsService, notificationService)
+
+	grpcAddr := fmt.Sprintf("%s:%d", cfg.GRPCServer.Address, cfg.GRPCServer.Port)
+	grpcServer := grpcserver.NewServer(grpcAddr, log, prService, teamService, userService)
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
-	done := make(chan bool, 1)
+	done := make(chan bool, 2)
 
 	go func() {
 		if err := server.Run(cfg); err != nil {
@@ -69,16 +114,114 @@ func main() {
 		done <- true
 	}()
 
+	go func() {
+		if err := grpcServer.Run(cfg); err != nil {
+			log.Error("gRPC server error", slog.String("error", err.Error()))
+		}
+		done <- true
+	}()
+
 	<-quit
-	log.Info("Shutting down HTTP server...")
+	log.Info("Shutting down HTTP and gRPC servers...")
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	// Потоки SSE не бывают простаивающими, поэтому закрываем их до Shutdown.
+	broker.Close()
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		log.Error("HTTP server shutdown error", slog.String("error", err.Error()))
 	}
+	if err := grpcServer.Shutdown(shutdownCtx); err != nil {
+		log.Error("gRPC server shutdown error", slog.String("error", err.Error()))
+	}
 
 	<-done
+	<-done
+	// После остановки серверов новых событий нет; дожидаемся уже поставленных в очередь вызовов VCS и сообщений в чат.
+	if reviewSync != nil {
+		reviewSync.Close()
+	}
+	if notifier != nil {
+		notifier.Close()
+	}
 	log.Info("Server exited")
 }
+
+// newPool создаёт пул соединений; нулевые размеры оставляют значения pgx по умолчанию.
+func newPool(ctx context.Context, dsn string, maxConns, minConns int32) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("parse pool config: %w", err)
+	}
+	if maxConns > 0 {
+		poolConfig.MaxConns = maxConns
+	}
+	if minConns > 0 {
+		poolConfig.MinConns = minConns
+	}
+	return pgxpool.NewWithConfig(ctx, poolConfig)
+}
+
+// newReviewSync включает синхронизацию ревьюверов для VCS, у которых задан токен API; без токенов возвращает nil.
+func newReviewSync(uow uow_port.UnitOfWork, cfg config.Integrations, log *logger.Logger) *vcsapp.ReviewSync {
+	requesters := make(map[models.VCSProvider]vcs_port.ReviewRequester)
+	client := &http.Client{Timeout: 15 * time.Second}
+	if cfg.GitHub.Token != "" {
+		requesters[models.VCSProviderGitHub] = github.NewReviewRequester(cfg.GitHub.APIURL, cfg.GitHub.Token, client)
+	}
+	if cfg.GitLab.Token != "" {
+		requesters[models.VCSProviderGitLab] = gitlab.NewReviewRequester(cfg.GitLab.APIURL, cfg.GitLab.Token, client)
+	}
+	if len(requesters) == 0 {
+		return nil
+	}
+	opts := vcsapp.DefaultReviewSyncOptions()
+	opts.MaxAttempts = cfg.ReviewSync.MaxAttempts
+	opts.Backoff = cfg.ReviewSync.Backoff
+	opts.MaxBackoff = cfg.ReviewSync.MaxBackoff
+	log.Info("Review sync enabled", slog.Int("providers", len(requesters)))
+	return vcsapp.NewReviewSync(uow, requesters, opts, log)
+}
+
+// newNotifier включает уведомления в чат, если задан адрес входящего вебхука, и на почту, если задан
+// SMTP-сервер; без обоих возвращает nil.
+func newNotifier(uow uow_port.UnitOfWork, cfg config.Notifications, log *logger.Logger) (*notificationapp.Notifier, error) {
+	if cfg.WebhookURL == "" && cfg.SMTP.Host == "" {
+		return nil, nil
+	}
+	opts := notificationapp.DefaultNotifierOptions()
+	at, err := time.Parse("15:04", cfg.DigestAt)
+	if err != nil {
+		return nil, fmt.Errorf("notifications.digest_at: %w", err)
+	}
+	opts.DigestAt = time.Duration(at.Hour())*time.Hour + time.Duration(at.Minute())*time.Minute
+	if opts.DigestLocation, err = time.LoadLocation(cfg.Timezone); err != nil {
+		return nil, fmt.Errorf("notifications.timezone: %w", err)
+	}
+	opts.ReviewSLA = cfg.ReviewSLA
+
+	var channels notificationapp.NotifierChannels
+	if cfg.WebhookURL != "" {
+		if channels.Templates, err = notificationapp.NewTemplates(cfg.Templates); err != nil {
+			return nil, err
+		}
+		channels.Chat = chat.NewWebhookSender(cfg.WebhookURL, cfg.Username, &http.Client{Timeout: 15 * time.Second})
+		log.Info("Chat notifications enabled")
+	}
+	if cfg.SMTP.Host != "" {
+		if channels.MailTemplates, err = notificationapp.NewEmailTemplates(cfg.EmailTemplatesDir); err != nil {
+			return nil, err
+		}
+		channels.Mail = mail.NewSMTPSender(mail.SMTPOptions{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+		})
+		log.Info("Email notifications enabled", slog.String("smtp_host", cfg.SMTP.Host))
+	}
+	log.Info("Review digests scheduled", slog.String("digest_at", cfg.DigestAt), slog.String("timezone", cfg.Timezone))
+	return notificationapp.NewNotifier(uow, channels, opts, log), nil
+}
@@ -0,0 +1,36 @@
+package audit
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	audit_port "avito-test-pr-service/internal/domain/ports/output/audit"
+	"avito-test-pr-service/internal/utils"
+	"context"
+	"encoding/json"
+)
+
+// Record пишет запись аудита через repo той же транзакции, что и само изменение.
+// Инициатор и request id берутся из ctx; before/after сохраняются снимками JSON (nil — состояния нет).
+func Record(ctx context.Context, repo audit_port.AuditRepository, action models.AuditAction, entityType models.AuditEntityType, entityID string, before, after any) error {
+	entry := &models.AuditEntry{
+		Actor:      utils.ActorFromContext(ctx),
+		RequestID:  utils.RequestIDFromContext(ctx),
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+	}
+	var err error
+	if entry.Before, err = snapshot(before); err != nil {
+		return err
+	}
+	if entry.After, err = snapshot(after); err != nil {
+		return err
+	}
+	return repo.Append(ctx, entry)
+}
+
+func snapshot(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
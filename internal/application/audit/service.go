@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/domain/ports/input"
+	ports "avito-test-pr-service/internal/domain/ports/output"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/utils"
+	"context"
+)
+
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 1000
+)
+
+type Service struct {
+	uow uow.UnitOfWork
+	log ports.Logger
+}
+
+func NewService(uow uow.UnitOfWork, log ports.Logger) input.AuditInputPort {
+	return &Service{uow: uow, log: log}
+}
+
+func (s *Service) ListEntries(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	if filter.Limit < 0 || filter.Offset < 0 || filter.Limit > MaxListLimit {
+		return nil, utils.ErrInvalidArgument
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return nil, utils.ErrInvalidArgument
+	}
+	if filter.Limit == 0 {
+		filter.Limit = DefaultListLimit
+	}
+	var res []*models.AuditEntry
+	err := s.uow.Do(ctx, uow.TxOptions{ReadOnly: true}, func(tx uow.Transaction) error {
+		var err error
+		res, err = tx.AuditRepository().List(ctx, filter)
+		return err
+	})
+	if err != nil {
+		s.log.Error("ListAuditEntries repo failed", "err", err, "entity_type", filter.EntityType, "entity_id", filter.EntityID)
+		return nil, err
+	}
+	return res, nil
+}
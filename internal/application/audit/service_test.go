@@ -0,0 +1,111 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	app "avito-test-pr-service/internal/application/audit"
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/utils"
+	"avito-test-pr-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord(t *testing.T) {
+	ctx := utils.WithRequestID(utils.WithActor(context.Background(), "alice"), "req-1")
+	repo := mocks.NewAuditRepository(t)
+	repo.EXPECT().Append(ctx, mock.MatchedBy(func(e *models.AuditEntry) bool {
+		var after models.User
+		if err := json.Unmarshal(e.After, &after); err != nil {
+			return false
+		}
+		return e.Actor == "alice" && e.RequestID == "req-1" &&
+			e.Action == models.AuditActionUserCreate && e.EntityType == models.AuditEntityUser && e.EntityID == "u1" &&
+			e.Before == nil && after.ID == "u1" && after.Name == "bob"
+	})).Return(nil)
+
+	err := app.Record(ctx, repo, models.AuditActionUserCreate, models.AuditEntityUser, "u1", nil, &models.User{ID: "u1", Name: "bob"})
+	require.NoError(t, err)
+}
+
+func TestRecord_RepoError(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewAuditRepository(t)
+	repo.EXPECT().Append(ctx, mock.Anything).Return(errors.New("db down"))
+
+	err := app.Record(ctx, repo, models.AuditActionPRMerge, models.AuditEntityPR, "pr-1", nil, nil)
+	require.EqualError(t, err, "db down")
+}
+
+func TestAuditService_ListEntries(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		filter  models.AuditFilter
+		setup   func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.AuditRepository)
+		wantErr error
+	}{
+		{
+			name:   "default limit applied",
+			filter: models.AuditFilter{EntityType: models.AuditEntityPR, EntityID: "pr-1"},
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.AuditRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().AuditRepository().Return(repo)
+				repo.EXPECT().List(ctx, mock.MatchedBy(func(f models.AuditFilter) bool {
+					return f.Limit == app.DefaultListLimit && f.EntityID == "pr-1"
+				})).Return([]*models.AuditEntry{{ID: 1}}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+		},
+		{
+			name:    "limit too large",
+			filter:  models.AuditFilter{Limit: app.MaxListLimit + 1},
+			setup:   func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.AuditRepository) {},
+			wantErr: utils.ErrInvalidArgument,
+		},
+		{
+			name:    "inverted time range",
+			filter:  models.AuditFilter{From: &now, To: &earlier},
+			setup:   func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.AuditRepository) {},
+			wantErr: utils.ErrInvalidArgument,
+		},
+		{
+			name:   "repo error",
+			filter: models.AuditFilter{Actor: "alice"},
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.AuditRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().AuditRepository().Return(repo)
+				repo.EXPECT().List(ctx, mock.Anything).Return(nil, utils.ErrInternal)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockRepo := mocks.NewAuditRepository(t)
+			tt.setup(mockUOW, mockTx, mockRepo)
+			svc := app.NewService(mockUOW, logger.New("dev"))
+			res, err := svc.ListEntries(ctx, tt.filter)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+		})
+	}
+}
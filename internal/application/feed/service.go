@@ -0,0 +1,58 @@
+package feed
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/domain/ports/input"
+	ports "avito-test-pr-service/internal/domain/ports/output"
+	feed_port "avito-test-pr-service/internal/domain/ports/output/feed"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/utils"
+	"context"
+)
+
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 1000
+)
+
+// Service — лента событий ревьювера: сохранённые события читаются из базы,
+// новые приходят через брокер, в который их публикует pr.Service.
+type Service struct {
+	uow    uow.UnitOfWork
+	broker feed_port.Broker
+	log    ports.Logger
+}
+
+func NewService(uow uow.UnitOfWork, broker feed_port.Broker, log ports.Logger) input.ReviewFeedInputPort {
+	return &Service{uow: uow, broker: broker, log: log}
+}
+
+// ListEvents возвращает события пользователя с ID больше afterID; для неизвестного пользователя — ErrUserNotFound.
+func (s *Service) ListEvents(ctx context.Context, userID string, afterID int64, limit int) ([]*models.ReviewFeedEvent, error) {
+	if userID == "" || afterID < 0 || limit < 0 || limit > MaxListLimit {
+		return nil, utils.ErrInvalidArgument
+	}
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	var res []*models.ReviewFeedEvent
+	err := s.uow.Do(ctx, uow.TxOptions{ReadOnly: true}, func(tx uow.Transaction) error {
+		if _, err := tx.UserRepository().GetUserByID(ctx, userID); err != nil {
+			return err
+		}
+		var err error
+		res, err = tx.PRRepository().ListFeedEvents(ctx, userID, afterID, limit)
+		if err != nil {
+			s.log.Error("ListFeedEvents repo failed", "err", err, "user_id", userID)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *Service) Subscribe(userID string) (<-chan *models.ReviewFeedEvent, func()) {
+	return s.broker.Subscribe(userID)
+}
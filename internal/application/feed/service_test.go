@@ -0,0 +1,74 @@
+package feed_test
+
+import (
+	"context"
+	"testing"
+
+	app "avito-test-pr-service/internal/application/feed"
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/utils"
+	"avito-test-pr-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedService_ListEvents(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		afterID int64
+		limit   int
+		setup   func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository)
+		wantLen int
+		wantErr error
+	}{
+		{
+			name: "default limit applied", afterID: 7,
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+				tx.EXPECT().UserRepository().Return(userRepo)
+				userRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{ID: "u1"}, nil)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().ListFeedEvents(ctx, "u1", int64(7), app.DefaultListLimit).
+					Return([]*models.ReviewFeedEvent{{ID: 8, UserID: "u1", Type: models.ReviewFeedAssigned}}, nil)
+			},
+			wantLen: 1,
+		},
+		{
+			name: "unknown user", limit: 10,
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+				tx.EXPECT().UserRepository().Return(userRepo)
+				userRepo.EXPECT().GetUserByID(ctx, "u1").Return(nil, utils.ErrUserNotFound)
+			},
+			wantErr: utils.ErrUserNotFound,
+		},
+		{name: "negative after id", afterID: -1, wantErr: utils.ErrInvalidArgument},
+		{name: "limit above max", limit: app.MaxListLimit + 1, wantErr: utils.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockPRRepo := mocks.NewPRRepository(t)
+			if tt.setup != nil {
+				tt.setup(mockUOW, mockTx, mockUserRepo, mockPRRepo)
+			}
+			svc := app.NewService(mockUOW, mocks.NewFeedBroker(t), logger.New("dev"))
+			res, err := svc.ListEvents(ctx, "u1", tt.afterID, tt.limit)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res, tt.wantLen)
+		})
+	}
+}
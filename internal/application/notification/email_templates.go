@@ -0,0 +1,137 @@
+package notification
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+const (
+	emailTextFile = "email.txt.tmpl"
+	emailHTMLFile = "email.html.tmpl"
+)
+
+//go:embed templates/*.tmpl
+var defaultEmailTemplates embed.FS
+
+// EmailDigestData — данные шаблона почтового дайджеста: открытые ревью пользователя от самого старого.
+type EmailDigestData struct {
+	User    *models.User
+	Reviews []DigestReview
+	// SLA — срок ревью; нулевой, если SLA не задан.
+	SLA time.Duration
+}
+
+// DigestReview — открытый PR в дайджесте: сколько он ждёт ревью и укладывается ли в SLA.
+type DigestReview struct {
+	PR     *models.PullRequest
+	Age    time.Duration
+	Status models.SLAStatus
+}
+
+// EmailTemplates — шаблоны писем: текстовая версия с темами (email.txt.tmpl) и HTML-версия (email.html.tmpl).
+// В каждом файле шаблон на вид уведомления и digest; темы — subject.<вид> в текстовом файле.
+type EmailTemplates struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// NewEmailTemplates загружает шаблоны писем из dir, а при пустом dir — встроенные.
+func NewEmailTemplates(dir string) (*EmailTemplates, error) {
+	var fsys fs.FS = defaultEmailTemplates
+	root := "templates"
+	if dir != "" {
+		fsys, root = os.DirFS(dir), "."
+	}
+	funcs := map[string]any{"age": humanAge}
+	text, err := texttemplate.New(emailTextFile).Funcs(funcs).Option("missingkey=error").ParseFS(fsys, path.Join(root, emailTextFile))
+	if err != nil {
+		return nil, fmt.Errorf("email template: %w", err)
+	}
+	html, err := htmltemplate.New(emailHTMLFile).Funcs(funcs).Option("missingkey=error").ParseFS(fsys, path.Join(root, emailHTMLFile))
+	if err != nil {
+		return nil, fmt.Errorf("email template: %w", err)
+	}
+	t := &EmailTemplates{text: text, html: html}
+
+	names := []string{DigestTemplate}
+	for _, k := range models.NotificationKinds {
+		names = append(names, string(k))
+	}
+	for _, name := range names {
+		if t.text.Lookup(name) == nil || t.text.Lookup("subject."+name) == nil || t.html.Lookup(name) == nil {
+			return nil, fmt.Errorf("email template %q is not defined", name)
+		}
+	}
+	// Как и у шаблонов чата, ошибки в именах полей проверяются на пробных данных при запуске.
+	for _, e := range []*models.ReviewFeedEvent{
+		{Type: models.ReviewFeedAssigned, Reason: models.AssignmentReasonInitial},
+		{Type: models.ReviewFeedUnassigned, Reason: models.AssignmentReasonManualReassign},
+		{Type: models.ReviewFeedMerged},
+		{Type: models.ReviewFeedUnassigned, Reason: models.AssignmentReasonSLAEscalation},
+	} {
+		e.UserID, e.PRID, e.PRTitle, e.Actor = "u1", "pr-1", "Sample", "u2"
+		if _, err := t.Render(e); err != nil {
+			return nil, fmt.Errorf("email template: %w", err)
+		}
+	}
+	sample := &EmailDigestData{
+		User:    &models.User{ID: "u1", Name: "Sample"},
+		Reviews: []DigestReview{{PR: &models.PullRequest{ID: "pr-1", Title: "Sample"}, Age: time.Hour, Status: models.SLAStatusOK}},
+		SLA:     48 * time.Hour,
+	}
+	if _, err := t.RenderDigest(sample); err != nil {
+		return nil, fmt.Errorf("email template: %w", err)
+	}
+	return t, nil
+}
+
+// Render возвращает письмо о событии ленты без адресата.
+func (t *EmailTemplates) Render(e *models.ReviewFeedEvent) (*models.EmailMessage, error) {
+	return t.render(string(models.NotificationKindOf(e)), e)
+}
+
+// RenderDigest возвращает письмо с дайджестом открытых ревью без адресата.
+func (t *EmailTemplates) RenderDigest(data *EmailDigestData) (*models.EmailMessage, error) {
+	return t.render(DigestTemplate, data)
+}
+
+func (t *EmailTemplates) render(name string, data any) (*models.EmailMessage, error) {
+	var subject, text, html strings.Builder
+	if err := t.text.ExecuteTemplate(&subject, "subject."+name, data); err != nil {
+		return nil, err
+	}
+	if err := t.text.ExecuteTemplate(&text, name, data); err != nil {
+		return nil, err
+	}
+	if err := t.html.ExecuteTemplate(&html, name, data); err != nil {
+		return nil, err
+	}
+	return &models.EmailMessage{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    strings.TrimSpace(html.String()) + "\n",
+	}, nil
+}
+
+// humanAge округляет длительность до минут, часов или дней с часами: 40m, 5h, 2d 3h.
+func humanAge(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d/time.Minute))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	}
+	days, hours := int(d/(24*time.Hour)), int(d%(24*time.Hour)/time.Hour)
+	if hours == 0 {
+		return fmt.Sprintf("%dd", days)
+	}
+	return fmt.Sprintf("%dd %dh", days, hours)
+}
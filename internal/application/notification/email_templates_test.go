@@ -0,0 +1,59 @@
+package notification_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	app "avito-test-pr-service/internal/application/notification"
+	"avito-test-pr-service/internal/domain/models"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailTemplates_Render(t *testing.T) {
+	tpl, err := app.NewEmailTemplates("")
+	require.NoError(t, err)
+
+	msg, err := tpl.Render(&models.ReviewFeedEvent{Type: models.ReviewFeedAssigned, PRID: "pr-1", PRTitle: "Fix <script> injection", Actor: "u1"})
+	require.NoError(t, err)
+	require.Equal(t, "Review requested: Fix <script> injection", msg.Subject)
+	require.Equal(t, "You were assigned to review \"Fix <script> injection\" (pr-1) by u1.\n", msg.Text)
+	require.Contains(t, msg.HTML, "<b>Fix &lt;script&gt; injection</b> (<code>pr-1</code>) by u1.")
+}
+
+func TestEmailTemplates_RenderDigest(t *testing.T) {
+	tpl, err := app.NewEmailTemplates("")
+	require.NoError(t, err)
+
+	msg, err := tpl.RenderDigest(&app.EmailDigestData{
+		User: &models.User{ID: "u2", Name: "Bob"},
+		Reviews: []app.DigestReview{
+			{PR: &models.PullRequest{ID: "pr-1", Title: "Add search"}, Age: 50 * time.Hour, Status: models.SLAStatusBreached},
+			{PR: &models.PullRequest{ID: "pr-2", Title: "Fix cache"}, Age: 40 * time.Minute, Status: models.SLAStatusOK},
+		},
+		SLA: 48 * time.Hour,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Review digest: 2 open review(s)", msg.Subject)
+	require.Equal(t, "Hi Bob,\n\nyou have 2 open review(s), oldest first (review SLA 2d):\n\n"+
+		"- Add search (pr-1): waiting 2d 2h [breached]\n"+
+		"- Fix cache (pr-2): waiting 40m [ok]\n", msg.Text)
+	require.Contains(t, msg.HTML, `<td style="color: #c00;">breached</td>`)
+	require.Contains(t, msg.HTML, `<td>2d 2h</td>`)
+}
+
+func TestNewEmailTemplates_Dir(t *testing.T) {
+	dir := t.TempDir()
+	defaults, err := os.ReadFile("templates/email.html.tmpl")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "email.html.tmpl"), defaults, 0o600))
+
+	_, err = app.NewEmailTemplates(dir)
+	require.Error(t, err, "text templates are missing")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "email.txt.tmpl"), []byte(`{{define "assigned"}}{{.PRTitle}}{{end}}`), 0o600))
+	_, err = app.NewEmailTemplates(dir)
+	require.ErrorContains(t, err, "is not defined")
+}
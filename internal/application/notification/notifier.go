@@ -0,0 +1,443 @@
+package notification
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	ports "avito-test-pr-service/internal/domain/ports/output"
+	notify_port "avito-test-pr-service/internal/domain/ports/output/notify"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// NotifierOptions — параметры доставки уведомлений.
+type NotifierOptions struct {
+	QueueSize   int
+	SendTimeout time.Duration
+	// DigestAt — время отправки ежедневного дайджеста от полуночи в DigestLocation.
+	DigestAt       time.Duration
+	DigestLocation *time.Location
+	// ReviewSLA — срок ревью для статуса в почтовом дайджесте; нулевой — без SLA.
+	ReviewSLA time.Duration
+}
+
+func DefaultNotifierOptions() NotifierOptions {
+	return NotifierOptions{
+		QueueSize:      1024,
+		SendTimeout:    10 * time.Second,
+		DigestAt:       9 * time.Hour,
+		DigestLocation: time.UTC,
+		ReviewSLA:      48 * time.Hour,
+	}
+}
+
+// NotifierChannels — каналы доставки уведомлений; канал без отправителя отключён.
+type NotifierChannels struct {
+	Chat          notify_port.ChatSender
+	Templates     *Templates
+	Mail          notify_port.MailSender
+	MailTemplates *EmailTemplates
+}
+
+// Notifier получает события ленты ревьюверов после коммита (как feed.Publisher) и по настройкам
+// получателя отправляет сообщение в чат и письмо сразу или откладывает до ежедневного дайджеста.
+// Дайджест в чат собирается из отложенных событий, дайджест на почту — список открытых ревью
+// пользователя на момент отправки. Доставка асинхронная и на операции сервиса не влияет:
+// неудачная отправка только логируется, неотправленный дайджест в чат остаётся до следующего раза.
+type Notifier struct {
+	uow      uow.UnitOfWork
+	channels NotifierChannels
+	opts     NotifierOptions
+	log      ports.Logger
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan []*models.ReviewFeedEvent
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+func NewNotifier(uow uow.UnitOfWork, channels NotifierChannels, opts NotifierOptions, log ports.Logger) *Notifier {
+	def := DefaultNotifierOptions()
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = def.QueueSize
+	}
+	if opts.SendTimeout <= 0 {
+		opts.SendTimeout = def.SendTimeout
+	}
+	if opts.DigestLocation == nil {
+		opts.DigestLocation = def.DigestLocation
+	}
+	n := &Notifier{
+		uow:      uow,
+		channels: channels,
+		opts:     opts,
+		log:      log,
+		queue:    make(chan []*models.ReviewFeedEvent, opts.QueueSize),
+		done:     make(chan struct{}),
+	}
+	n.wg.Add(2)
+	go n.work()
+	go n.runDigests()
+	return n
+}
+
+// Publish ставит события в очередь, не блокируя вызывающего; при переполненной очереди
+// события отбрасываются с предупреждением.
+func (n *Notifier) Publish(events []*models.ReviewFeedEvent) {
+	if len(events) == 0 {
+		return
+	}
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.closed {
+		return
+	}
+	select {
+	case n.queue <- events:
+	default:
+		n.log.Warn("Notification queue is full, events dropped", "events", len(events))
+	}
+}
+
+// Close прекращает приём событий, доставляет уже поставленные в очередь и ждёт фоновых задач.
+func (n *Notifier) Close() {
+	n.mu.Lock()
+	if n.closed {
+		n.mu.Unlock()
+		return
+	}
+	n.closed = true
+	close(n.done)
+	close(n.queue)
+	n.mu.Unlock()
+	n.wg.Wait()
+}
+
+func (n *Notifier) work() {
+	defer n.wg.Done()
+	for events := range n.queue {
+		n.deliver(events)
+	}
+}
+
+// deliver откладывает события получателей с дайджестом в чат и сразу отправляет остальное.
+func (n *Notifier) deliver(events []*models.ReviewFeedEvent) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.opts.SendTimeout)
+	defer cancel()
+	r, err := n.route(ctx, events)
+	if err != nil {
+		n.log.Error("Notification routing failed", "err", err, "events", len(events))
+		return
+	}
+	for _, e := range r.chat {
+		text, err := n.channels.Templates.Render(e)
+		if err != nil {
+			n.log.Error("Notification render failed", "err", err, "user_id", e.UserID, "pr_id", e.PRID)
+			continue
+		}
+		n.send(r.prefs[e.UserID], text)
+	}
+	for _, e := range r.mail {
+		msg, err := n.channels.MailTemplates.Render(e)
+		if err != nil {
+			n.log.Error("Email render failed", "err", err, "user_id", e.UserID, "pr_id", e.PRID)
+			continue
+		}
+		msg.To = r.emails[e.UserID]
+		n.sendMail(e.UserID, msg)
+	}
+}
+
+// routing — события к немедленной отправке по каналам с настройками и адресами получателей.
+type routing struct {
+	prefs  map[string]*models.NotificationPreferences
+	emails map[string]string
+	chat   []*models.ReviewFeedEvent
+	mail   []*models.ReviewFeedEvent
+}
+
+func (n *Notifier) route(ctx context.Context, events []*models.ReviewFeedEvent) (*routing, error) {
+	var r *routing
+	err := n.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		userIDs := make([]string, 0, len(events))
+		for _, e := range events {
+			userIDs = append(userIDs, e.UserID)
+		}
+		prefs, err := tx.UserRepository().GetNotificationPreferences(ctx, userIDs)
+		if err != nil {
+			return err
+		}
+		r = &routing{prefs: prefs, emails: map[string]string{}}
+		var digest, mail []*models.ReviewFeedEvent
+		var mailUserIDs []string
+		for _, e := range events {
+			p := prefs[e.UserID]
+			if p == nil {
+				p = models.DefaultNotificationPreferences(e.UserID)
+				prefs[e.UserID] = p
+			}
+			if !p.Wants(models.NotificationKindOf(e)) {
+				continue
+			}
+			if n.channels.Chat != nil {
+				switch p.ChatDelivery() {
+				case models.NotificationDeliveryImmediate:
+					r.chat = append(r.chat, e)
+				case models.NotificationDeliveryDigest:
+					digest = append(digest, e)
+				}
+			}
+			if n.channels.Mail != nil && p.EmailDelivery == models.NotificationDeliveryImmediate {
+				mail = append(mail, e)
+				mailUserIDs = append(mailUserIDs, e.UserID)
+			}
+		}
+		if len(mail) > 0 {
+			users, err := tx.UserRepository().ListUsersByIDs(ctx, mailUserIDs)
+			if err != nil {
+				return err
+			}
+			for _, u := range users {
+				if u.Email != "" {
+					r.emails[u.ID] = u.Email
+				}
+			}
+			for _, e := range mail {
+				if r.emails[e.UserID] != "" {
+					r.mail = append(r.mail, e)
+				}
+			}
+		}
+		return tx.PRRepository().AddDigestEvents(ctx, digest)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (n *Notifier) send(p *models.NotificationPreferences, text string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), n.opts.SendTimeout)
+	defer cancel()
+	if err := n.channels.Chat.Send(ctx, &models.ChatMessage{Channel: "@" + p.ChatHandle, Text: text}); err != nil {
+		n.log.Error("Notification send failed", "err", err, "user_id", p.UserID)
+		return false
+	}
+	return true
+}
+
+func (n *Notifier) sendMail(userID string, msg *models.EmailMessage) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), n.opts.SendTimeout)
+	defer cancel()
+	if err := n.channels.Mail.Send(ctx, msg); err != nil {
+		n.log.Error("Email send failed", "err", err, "user_id", userID)
+		return false
+	}
+	return true
+}
+
+// SendDigests отправляет дайджесты по всем включённым каналам и возвращает их число.
+func (n *Notifier) SendDigests(ctx context.Context) (int, error) {
+	var sent int
+	if n.channels.Chat != nil {
+		chat, err := n.sendChatDigests(ctx)
+		if err != nil {
+			return sent, err
+		}
+		sent += chat
+	}
+	if n.channels.Mail != nil {
+		mail, err := n.sendEmailDigests(ctx)
+		if err != nil {
+			return sent, err
+		}
+		sent += mail
+	}
+	return sent, nil
+}
+
+// SendDailyDigests отправляет дайджесты за день запуска at, если их ещё не отправила другая реплика:
+// день отмечается в БД до отправки, поэтому при нескольких репликах дайджест уходит один раз.
+// claimed = false, если день уже отмечен.
+func (n *Notifier) SendDailyDigests(ctx context.Context, at time.Time) (sent int, claimed bool, err error) {
+	day := at.In(n.opts.DigestLocation).Format(time.DateOnly)
+	err = n.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		var err error
+		claimed, err = tx.PRRepository().ClaimDigestDay(ctx, day)
+		return err
+	})
+	if err != nil {
+		n.log.Error("SendDigests claim failed", "err", err, "day", day)
+		return 0, false, err
+	}
+	if !claimed {
+		return 0, false, nil
+	}
+	sent, err = n.SendDigests(ctx)
+	return sent, true, err
+}
+
+// sendChatDigests отправляет каждому пользователю накопленные события одним сообщением.
+// События пользователя, отключившего уведомления или оставшегося без адресата в чате, удаляются
+// без отправки; при ошибке отправки события остаются до следующего раза.
+func (n *Notifier) sendChatDigests(ctx context.Context) (int, error) {
+	events, prefs, err := n.loadDigests(ctx)
+	if err != nil {
+		n.log.Error("SendDigests load failed", "err", err)
+		return 0, err
+	}
+	var sent int
+	for len(events) > 0 {
+		userID := events[0].UserID
+		i := 1
+		for i < len(events) && events[i].UserID == userID {
+			i++
+		}
+		batch := events[:i]
+		events = events[i:]
+
+		p := prefs[userID]
+		if p != nil && p.ChatDelivery() != models.NotificationDeliveryOff {
+			text, err := n.channels.Templates.RenderDigest(userID, batch)
+			if err != nil {
+				n.log.Error("Digest render failed", "err", err, "user_id", userID)
+				continue
+			}
+			if !n.send(p, text) {
+				continue
+			}
+			sent++
+		}
+		if err := n.clearDigest(ctx, userID, batch[len(batch)-1].ID); err != nil {
+			n.log.Error("Digest clear failed", "err", err, "user_id", userID)
+		}
+	}
+	return sent, nil
+}
+
+func (n *Notifier) loadDigests(ctx context.Context) ([]*models.ReviewFeedEvent, map[string]*models.NotificationPreferences, error) {
+	var (
+		events []*models.ReviewFeedEvent
+		prefs  map[string]*models.NotificationPreferences
+	)
+	err := n.uow.Do(ctx, uow.TxOptions{ReadOnly: true}, func(tx uow.Transaction) error {
+		var err error
+		events, err = tx.PRRepository().ListDigestEvents(ctx)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+		var userIDs []string
+		for i, e := range events {
+			if i == 0 || events[i-1].UserID != e.UserID {
+				userIDs = append(userIDs, e.UserID)
+			}
+		}
+		prefs, err = tx.UserRepository().GetNotificationPreferences(ctx, userIDs)
+		return err
+	})
+	if err != nil || len(events) == 0 {
+		return nil, nil, err
+	}
+	return events, prefs, nil
+}
+
+// sendEmailDigests отправляет выбравшим дайджест на почту список их открытых ревью от самого старого
+// со статусом SLA. Пользователям без открытых ревью письмо не отправляется.
+func (n *Notifier) sendEmailDigests(ctx context.Context) (int, error) {
+	digests, err := n.loadEmailDigests(ctx, time.Now())
+	if err != nil {
+		n.log.Error("SendDigests email load failed", "err", err)
+		return 0, err
+	}
+	var sent int
+	for _, d := range digests {
+		msg, err := n.channels.MailTemplates.RenderDigest(d)
+		if err != nil {
+			n.log.Error("Email digest render failed", "err", err, "user_id", d.User.ID)
+			continue
+		}
+		msg.To = d.User.Email
+		if n.sendMail(d.User.ID, msg) {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+func (n *Notifier) loadEmailDigests(ctx context.Context, now time.Time) ([]*EmailDigestData, error) {
+	var res []*EmailDigestData
+	err := n.uow.Do(ctx, uow.TxOptions{ReadOnly: true}, func(tx uow.Transaction) error {
+		users, err := tx.UserRepository().ListEmailDigestRecipients(ctx)
+		if err != nil {
+			return err
+		}
+		open := models.PRStatusOPEN
+		res = nil
+		for _, u := range users {
+			prs, err := tx.PRRepository().ListPRsByReviewer(ctx, u.ID, &open)
+			if err != nil {
+				return err
+			}
+			if len(prs) == 0 {
+				continue
+			}
+			sort.SliceStable(prs, func(i, j int) bool { return prs[i].CreatedAt.Before(prs[j].CreatedAt) })
+			d := &EmailDigestData{User: u, Reviews: make([]DigestReview, 0, len(prs)), SLA: n.opts.ReviewSLA}
+			for _, pr := range prs {
+				age := now.Sub(pr.CreatedAt)
+				d.Reviews = append(d.Reviews, DigestReview{PR: pr, Age: age, Status: models.SLAStatusOf(age, n.opts.ReviewSLA)})
+			}
+			res = append(res, d)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (n *Notifier) clearDigest(ctx context.Context, userID string, throughID int64) error {
+	return n.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		return tx.PRRepository().DeleteDigestEvents(ctx, userID, throughID)
+	})
+}
+
+func (n *Notifier) runDigests() {
+	defer n.wg.Done()
+	for {
+		now := time.Now()
+		at := NextDigest(now, n.opts.DigestAt, n.opts.DigestLocation)
+		timer := time.NewTimer(at.Sub(now))
+		select {
+		case <-n.done:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		sent, claimed, err := n.SendDailyDigests(ctx, at)
+		switch {
+		case err == nil && claimed:
+			n.log.Info("Review digests sent", "digests", sent)
+		case err == nil:
+			n.log.Info("Review digests already sent by another instance", "at", at)
+		}
+		cancel()
+	}
+}
+
+// NextDigest — ближайший после now момент отправки дайджеста: at от полуночи в loc.
+func NextDigest(now time.Time, at time.Duration, loc *time.Location) time.Time {
+	local := now.In(loc)
+	y, m, d := local.Date()
+	// Время задаётся по часам в loc, а не сдвигом от полуночи, чтобы переход на летнее время его не смещал.
+	sec := int(at / time.Second)
+	next := time.Date(y, m, d, 0, 0, sec, 0, loc)
+	if !next.After(local) {
+		next = time.Date(y, m, d+1, 0, 0, sec, 0, loc)
+	}
+	return next
+}
@@ -0,0 +1,229 @@
+package notification_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	app "avito-test-pr-service/internal/application/notification"
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newNotifier(t *testing.T, u *mocks.UnitOfWork, sender *mocks.ChatSender) *app.Notifier {
+	templates, err := app.NewTemplates(nil)
+	require.NoError(t, err)
+	return app.NewNotifier(u, app.NotifierChannels{Chat: sender, Templates: templates}, app.NotifierOptions{SendTimeout: time.Second}, logger.New("dev"))
+}
+
+func newMailNotifier(t *testing.T, u *mocks.UnitOfWork, sender *mocks.MailSender) *app.Notifier {
+	templates, err := app.NewEmailTemplates("")
+	require.NoError(t, err)
+	opts := app.NotifierOptions{SendTimeout: time.Second, ReviewSLA: 48 * time.Hour}
+	return app.NewNotifier(u, app.NotifierChannels{Mail: sender, MailTemplates: templates}, opts, logger.New("dev"))
+}
+
+func expectTx(t *testing.T, u *mocks.UnitOfWork) (*mocks.PRRepository, *mocks.UserRepository) {
+	tx := mocks.NewTransaction(t)
+	prRepo := mocks.NewPRRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	u.EXPECT().Do(mock.Anything, mock.Anything, mock.Anything).RunInTx(tx)
+	tx.EXPECT().PRRepository().Return(prRepo)
+	tx.EXPECT().UserRepository().Return(userRepo).Maybe()
+	tx.EXPECT().Commit(mock.Anything).Return(nil).Maybe()
+	tx.EXPECT().Rollback(mock.Anything).Return(nil).Maybe()
+	return prRepo, userRepo
+}
+
+func TestNotifier_RoutesByPreferences(t *testing.T) {
+	mockUOW := mocks.NewUnitOfWork(t)
+	sender := mocks.NewChatSender(t)
+	prRepo, userRepo := expectTx(t, mockUOW)
+
+	events := []*models.ReviewFeedEvent{
+		{ID: 1, UserID: "u2", Type: models.ReviewFeedAssigned, PRID: "pr-1", PRTitle: "Add search", Actor: "u1"},
+		{ID: 2, UserID: "u3", Type: models.ReviewFeedAssigned, PRID: "pr-1", PRTitle: "Add search"},
+		{ID: 3, UserID: "u4", Type: models.ReviewFeedAssigned, PRID: "pr-1", PRTitle: "Add search"},
+		{ID: 4, UserID: "u5", Type: models.ReviewFeedAssigned, PRID: "pr-1", PRTitle: "Add search"},
+		{ID: 5, UserID: "u6", Type: models.ReviewFeedUnassigned, Reason: models.AssignmentReasonSLAEscalation, PRID: "pr-1", PRTitle: "Add search"},
+	}
+	// u4 получает только merged, у u5 нет настроек.
+	userRepo.EXPECT().GetNotificationPreferences(mock.Anything, []string{"u2", "u3", "u4", "u5", "u6"}).Return(map[string]*models.NotificationPreferences{
+		"u2": {UserID: "u2", ChatHandle: "bob", Delivery: models.NotificationDeliveryImmediate, Kinds: models.NotificationKinds},
+		"u3": {UserID: "u3", ChatHandle: "carol", Delivery: models.NotificationDeliveryDigest, Kinds: models.NotificationKinds},
+		"u4": {UserID: "u4", ChatHandle: "dave", Delivery: models.NotificationDeliveryImmediate, Kinds: []models.NotificationKind{models.NotificationMerged}},
+		"u6": {UserID: "u6", ChatHandle: "erin", Delivery: models.NotificationDeliveryImmediate, Kinds: models.NotificationKinds},
+	}, nil)
+	prRepo.EXPECT().AddDigestEvents(mock.Anything, []*models.ReviewFeedEvent{events[1]}).Return(nil)
+
+	var sent []models.ChatMessage
+	sender.EXPECT().Send(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, msg *models.ChatMessage) error {
+		sent = append(sent, *msg)
+		return nil
+	})
+
+	n := newNotifier(t, mockUOW, sender)
+	n.Publish(events)
+	n.Close()
+	n.Publish(events)
+
+	require.Equal(t, []models.ChatMessage{
+		{Channel: "@bob", Text: "You were assigned to review *Add search* (`pr-1`) by u1."},
+		{Channel: "@erin", Text: "Review SLA for *Add search* (`pr-1`) was breached, the review was handed to another reviewer."},
+	}, sent)
+}
+
+func TestNotifier_SendDigests(t *testing.T) {
+	mockUOW := mocks.NewUnitOfWork(t)
+	sender := mocks.NewChatSender(t)
+	prRepo, userRepo := expectTx(t, mockUOW)
+
+	prRepo.EXPECT().ListDigestEvents(mock.Anything).Return([]*models.ReviewFeedEvent{
+		{ID: 3, UserID: "u2", Type: models.ReviewFeedAssigned, PRID: "pr-1", PRTitle: "Add search"},
+		{ID: 7, UserID: "u2", Type: models.ReviewFeedMerged, PRID: "pr-1", PRTitle: "Add search"},
+		{ID: 4, UserID: "u3", Type: models.ReviewFeedAssigned, PRID: "pr-2", PRTitle: "Fix cache"},
+		{ID: 5, UserID: "u4", Type: models.ReviewFeedAssigned, PRID: "pr-2", PRTitle: "Fix cache"},
+	}, nil)
+	userRepo.EXPECT().GetNotificationPreferences(mock.Anything, []string{"u2", "u3", "u4"}).Return(map[string]*models.NotificationPreferences{
+		"u2": {UserID: "u2", ChatHandle: "bob", Delivery: models.NotificationDeliveryDigest, Kinds: models.NotificationKinds},
+		"u3": {UserID: "u3", ChatHandle: "carol", Delivery: models.NotificationDeliveryOff},
+		"u4": {UserID: "u4", ChatHandle: "dave", Delivery: models.NotificationDeliveryDigest, Kinds: models.NotificationKinds},
+	}, nil)
+	sender.EXPECT().Send(mock.Anything, &models.ChatMessage{
+		Channel: "@bob",
+		Text:    "Review digest: 2 update(s)\n• You were assigned to review *Add search* (`pr-1`).\n• *Add search* (`pr-1`) was merged.",
+	}).Return(nil)
+	sender.EXPECT().Send(mock.Anything, mock.MatchedBy(func(m *models.ChatMessage) bool { return m.Channel == "@dave" })).Return(errors.New("timeout"))
+	// Отправленный дайджест и события отключившего уведомления u3 удаляются; неотправленный остаётся.
+	prRepo.EXPECT().DeleteDigestEvents(mock.Anything, "u2", int64(7)).Return(nil)
+	prRepo.EXPECT().DeleteDigestEvents(mock.Anything, "u3", int64(4)).Return(nil)
+
+	n := newNotifier(t, mockUOW, sender)
+	defer n.Close()
+	sent, err := n.SendDigests(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+}
+
+func TestNotifier_SendDailyDigestsOncePerDay(t *testing.T) {
+	mockUOW := mocks.NewUnitOfWork(t)
+	sender := mocks.NewChatSender(t)
+	prRepo, _ := expectTx(t, mockUOW)
+
+	// День считается в DigestLocation (UTC), а не в поясе at.
+	at := time.Date(2026, 10, 19, 1, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	prRepo.EXPECT().ClaimDigestDay(mock.Anything, "2026-10-18").Return(true, nil).Once()
+	prRepo.EXPECT().ListDigestEvents(mock.Anything).Return(nil, nil).Once()
+	prRepo.EXPECT().ClaimDigestDay(mock.Anything, "2026-10-18").Return(false, nil).Once()
+
+	n := newNotifier(t, mockUOW, sender)
+	defer n.Close()
+	sent, claimed, err := n.SendDailyDigests(context.Background(), at)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	require.Zero(t, sent)
+
+	// Другая реплика уже отметила день: дайджест не собирается.
+	sent, claimed, err = n.SendDailyDigests(context.Background(), at)
+	require.NoError(t, err)
+	require.False(t, claimed)
+	require.Zero(t, sent)
+}
+
+func TestNotifier_EmailImmediate(t *testing.T) {
+	mockUOW := mocks.NewUnitOfWork(t)
+	sender := mocks.NewMailSender(t)
+	prRepo, userRepo := expectTx(t, mockUOW)
+
+	events := []*models.ReviewFeedEvent{
+		{ID: 1, UserID: "u2", Type: models.ReviewFeedAssigned, PRID: "pr-1", PRTitle: "Add search"},
+		{ID: 2, UserID: "u3", Type: models.ReviewFeedAssigned, PRID: "pr-1", PRTitle: "Add search"},
+		{ID: 3, UserID: "u4", Type: models.ReviewFeedMerged, PRID: "pr-2", PRTitle: "Fix cache"},
+		{ID: 4, UserID: "u5", Type: models.ReviewFeedMerged, PRID: "pr-2", PRTitle: "Fix cache"},
+	}
+	// u3 получает письма дайджестом, у u4 нет настроек, у u5 не указана почта.
+	userRepo.EXPECT().GetNotificationPreferences(mock.Anything, []string{"u2", "u3", "u4", "u5"}).Return(map[string]*models.NotificationPreferences{
+		"u2": {UserID: "u2", Delivery: models.NotificationDeliveryOff, EmailDelivery: models.NotificationDeliveryImmediate, Kinds: models.NotificationKinds},
+		"u3": {UserID: "u3", EmailDelivery: models.NotificationDeliveryDigest, Kinds: models.NotificationKinds},
+	}, nil)
+	userRepo.EXPECT().ListUsersByIDs(mock.Anything, []string{"u2", "u4", "u5"}).Return([]*models.User{
+		{ID: "u2", Email: "bob@example.com"},
+		{ID: "u4", Email: "dave@example.com"},
+		{ID: "u5"},
+	}, nil)
+	prRepo.EXPECT().AddDigestEvents(mock.Anything, []*models.ReviewFeedEvent(nil)).Return(nil)
+
+	var sent []string
+	sender.EXPECT().Send(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, msg *models.EmailMessage) error {
+		sent = append(sent, msg.To+": "+msg.Subject)
+		return nil
+	})
+
+	n := newMailNotifier(t, mockUOW, sender)
+	n.Publish(events)
+	n.Close()
+
+	require.Equal(t, []string{"bob@example.com: Review requested: Add search", "dave@example.com: Merged: Fix cache"}, sent)
+}
+
+func TestNotifier_EmailDigests(t *testing.T) {
+	mockUOW := mocks.NewUnitOfWork(t)
+	sender := mocks.NewMailSender(t)
+	prRepo, userRepo := expectTx(t, mockUOW)
+
+	now := time.Now()
+	userRepo.EXPECT().ListEmailDigestRecipients(mock.Anything).Return([]*models.User{
+		{ID: "u2", Name: "Bob", Email: "bob@example.com"},
+		{ID: "u3", Name: "Carol", Email: "carol@example.com"},
+	}, nil)
+	open := models.PRStatusOPEN
+	prRepo.EXPECT().ListPRsByReviewer(mock.Anything, "u2", &open).Return([]*models.PullRequest{
+		{ID: "pr-2", Title: "Fix cache", CreatedAt: now.Add(-40 * time.Hour)},
+		{ID: "pr-3", Title: "Bump deps", CreatedAt: now.Add(-30 * time.Minute)},
+		{ID: "pr-1", Title: "Add search", CreatedAt: now.Add(-50 * time.Hour)},
+	}, nil)
+	prRepo.EXPECT().ListPRsByReviewer(mock.Anything, "u3", &open).Return(nil, nil)
+
+	var got *models.EmailMessage
+	sender.EXPECT().Send(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, msg *models.EmailMessage) error {
+		got = msg
+		return nil
+	}).Once()
+
+	n := newMailNotifier(t, mockUOW, sender)
+	defer n.Close()
+	sent, err := n.SendDigests(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+	require.Equal(t, "bob@example.com", got.To)
+	require.Equal(t, "Hi Bob,\n\nyou have 3 open review(s), oldest first (review SLA 2d):\n\n"+
+		"- Add search (pr-1): waiting 2d 2h [breached]\n"+
+		"- Fix cache (pr-2): waiting 1d 16h [due_soon]\n"+
+		"- Bump deps (pr-3): waiting 30m [ok]\n", got.Text)
+}
+
+func TestNextDigest(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*60*60)
+	tests := []struct {
+		name string
+		now  time.Time
+		at   time.Duration
+		loc  *time.Location
+		want time.Time
+	}{
+		{"later today", time.Date(2026, 3, 10, 7, 0, 0, 0, time.UTC), 9 * time.Hour, time.UTC, time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)},
+		{"exactly now moves to tomorrow", time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC), 9 * time.Hour, time.UTC, time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)},
+		{"month end", time.Date(2026, 3, 31, 18, 0, 0, 0, time.UTC), 9*time.Hour + 30*time.Minute, time.UTC, time.Date(2026, 4, 1, 9, 30, 0, 0, time.UTC)},
+		{"other zone", time.Date(2026, 3, 10, 7, 0, 0, 0, time.UTC), 9 * time.Hour, msk, time.Date(2026, 3, 11, 9, 0, 0, 0, msk)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.True(t, tt.want.Equal(app.NextDigest(tt.now, tt.at, tt.loc)), "got %v", app.NextDigest(tt.now, tt.at, tt.loc))
+		})
+	}
+}
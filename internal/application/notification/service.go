@@ -0,0 +1,125 @@
+package notification
+
+import (
+	"avito-test-pr-service/internal/application/audit"
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/domain/ports/input"
+	ports "avito-test-pr-service/internal/domain/ports/output"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/utils"
+	"context"
+	"regexp"
+	"strings"
+)
+
+var chatHandlePattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// Service хранит настройки уведомлений пользователей; доставку по ним выполняет Notifier.
+type Service struct {
+	uow uow.UnitOfWork
+	log ports.Logger
+}
+
+func NewService(uow uow.UnitOfWork, log ports.Logger) input.NotificationInputPort {
+	return &Service{uow: uow, log: log}
+}
+
+// GetPreferences возвращает настройки пользователя, а если он их не задавал — настройки по умолчанию.
+func (s *Service) GetPreferences(ctx context.Context, userID string) (*models.NotificationPreferences, error) {
+	if userID == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	var prefs *models.NotificationPreferences
+	err := s.uow.Do(ctx, uow.TxOptions{ReadOnly: true}, func(tx uow.Transaction) error {
+		var err error
+		prefs, err = s.load(ctx, tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
+
+// SetPreferences заменяет настройки пользователя целиком. Пустые Delivery и EmailDelivery — immediate,
+// пустой список видов — все виды; ChatHandle хранится без ведущего «@».
+func (s *Service) SetPreferences(ctx context.Context, prefs *models.NotificationPreferences) (*models.NotificationPreferences, error) {
+	if prefs == nil || prefs.UserID == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	normalized, err := normalize(prefs)
+	if err != nil {
+		return nil, err
+	}
+	err = s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		before, err := s.load(ctx, tx, prefs.UserID)
+		if err != nil {
+			return err
+		}
+		if err := tx.UserRepository().SetNotificationPreferences(ctx, normalized); err != nil {
+			s.log.Error("SetNotificationPreferences repo failed", "err", err, "user_id", prefs.UserID)
+			return err
+		}
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionUserNotifyPrefs, models.AuditEntityUser, prefs.UserID, before, normalized); err != nil {
+			s.log.Error("SetNotificationPreferences audit failed", "err", err, "user_id", prefs.UserID)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+func (s *Service) load(ctx context.Context, tx uow.Transaction, userID string) (*models.NotificationPreferences, error) {
+	repo := tx.UserRepository()
+	if _, err := repo.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	stored, err := repo.GetNotificationPreferences(ctx, []string{userID})
+	if err != nil {
+		s.log.Error("GetNotificationPreferences repo failed", "err", err, "user_id", userID)
+		return nil, err
+	}
+	if p, ok := stored[userID]; ok {
+		return p, nil
+	}
+	return models.DefaultNotificationPreferences(userID), nil
+}
+
+func normalize(prefs *models.NotificationPreferences) (*models.NotificationPreferences, error) {
+	res := &models.NotificationPreferences{
+		UserID:        prefs.UserID,
+		ChatHandle:    strings.TrimPrefix(strings.TrimSpace(prefs.ChatHandle), "@"),
+		Delivery:      prefs.Delivery,
+		EmailDelivery: prefs.EmailDelivery,
+	}
+	if res.ChatHandle != "" && !chatHandlePattern.MatchString(res.ChatHandle) {
+		return nil, utils.ErrInvalidChatHandle
+	}
+	if res.Delivery == "" {
+		res.Delivery = models.NotificationDeliveryImmediate
+	}
+	if res.EmailDelivery == "" {
+		res.EmailDelivery = models.NotificationDeliveryImmediate
+	}
+	if !res.Delivery.IsValid() || !res.EmailDelivery.IsValid() {
+		return nil, utils.ErrInvalidDelivery
+	}
+	if len(prefs.Kinds) == 0 {
+		res.Kinds = models.NotificationKinds
+		return res, nil
+	}
+	seen := make(map[models.NotificationKind]bool, len(prefs.Kinds))
+	for _, k := range prefs.Kinds {
+		if !k.IsValid() {
+			return nil, utils.ErrInvalidNotificationKind
+		}
+		if !seen[k] {
+			seen[k] = true
+			res.Kinds = append(res.Kinds, k)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,104 @@
+package notification_test
+
+import (
+	"context"
+	"testing"
+
+	app "avito-test-pr-service/internal/application/notification"
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/utils"
+	"avito-test-pr-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationService_GetPreferences(t *testing.T) {
+	ctx := context.Background()
+	stored := &models.NotificationPreferences{UserID: "u2", ChatHandle: "bob", Delivery: models.NotificationDeliveryDigest, Kinds: []models.NotificationKind{models.NotificationMerged}}
+	tests := []struct {
+		name    string
+		userID  string
+		stored  map[string]*models.NotificationPreferences
+		userErr error
+		want    *models.NotificationPreferences
+		wantErr error
+	}{
+		{name: "stored", userID: "u2", stored: map[string]*models.NotificationPreferences{"u2": stored}, want: stored},
+		{name: "defaults", userID: "u3", stored: map[string]*models.NotificationPreferences{}, want: models.DefaultNotificationPreferences("u3")},
+		{name: "unknown user", userID: "ghost", userErr: utils.ErrUserNotFound, wantErr: utils.ErrUserNotFound},
+		{name: "empty id", wantErr: utils.ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			if tt.userID != "" {
+				tx := mocks.NewTransaction(t)
+				repo := mocks.NewUserRepository(t)
+				mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().UserRepository().Return(repo)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+				repo.EXPECT().GetUserByID(ctx, tt.userID).Return(&models.User{ID: tt.userID}, tt.userErr)
+				if tt.userErr == nil {
+					repo.EXPECT().GetNotificationPreferences(ctx, []string{tt.userID}).Return(tt.stored, nil)
+				}
+			}
+			got, err := app.NewService(mockUOW, logger.New("dev")).GetPreferences(ctx, tt.userID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNotificationService_SetPreferences(t *testing.T) {
+	ctx := context.Background()
+	t.Run("normalizes and audits", func(t *testing.T) {
+		mockUOW := mocks.NewUnitOfWork(t)
+		tx := mocks.NewTransaction(t)
+		repo := mocks.NewUserRepository(t)
+		auditRepo := mocks.NewAuditRepository(t)
+		mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+		tx.EXPECT().UserRepository().Return(repo)
+		tx.EXPECT().AuditRepository().Return(auditRepo)
+		tx.EXPECT().Commit(ctx).Return(nil)
+		repo.EXPECT().GetUserByID(ctx, "u2").Return(&models.User{ID: "u2"}, nil)
+		repo.EXPECT().GetNotificationPreferences(ctx, []string{"u2"}).Return(map[string]*models.NotificationPreferences{}, nil)
+		want := &models.NotificationPreferences{
+			UserID: "u2", ChatHandle: "bob.reviews", Delivery: models.NotificationDeliveryImmediate, EmailDelivery: models.NotificationDeliveryDigest,
+			Kinds: []models.NotificationKind{models.NotificationAssigned, models.NotificationSLABreached},
+		}
+		repo.EXPECT().SetNotificationPreferences(ctx, want).Return(nil)
+		auditRepo.EXPECT().Append(ctx, mock.MatchedBy(func(e *models.AuditEntry) bool {
+			return e.Action == models.AuditActionUserNotifyPrefs && e.EntityID == "u2"
+		})).Return(nil)
+
+		got, err := app.NewService(mockUOW, logger.New("dev")).SetPreferences(ctx, &models.NotificationPreferences{
+			UserID: "u2", ChatHandle: " @bob.reviews ", EmailDelivery: models.NotificationDeliveryDigest,
+			Kinds: []models.NotificationKind{models.NotificationAssigned, models.NotificationSLABreached, models.NotificationAssigned},
+		})
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+
+	for _, tt := range []struct {
+		name    string
+		prefs   *models.NotificationPreferences
+		wantErr error
+	}{
+		{"nil", nil, utils.ErrInvalidArgument},
+		{"bad handle", &models.NotificationPreferences{UserID: "u2", ChatHandle: "bob reviews"}, utils.ErrInvalidChatHandle},
+		{"bad delivery", &models.NotificationPreferences{UserID: "u2", Delivery: "hourly"}, utils.ErrInvalidDelivery},
+		{"bad email delivery", &models.NotificationPreferences{UserID: "u2", EmailDelivery: "weekly"}, utils.ErrInvalidDelivery},
+		{"bad kind", &models.NotificationPreferences{UserID: "u2", Kinds: []models.NotificationKind{"commented"}}, utils.ErrInvalidNotificationKind},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := app.NewService(mocks.NewUnitOfWork(t), logger.New("dev")).SetPreferences(ctx, tt.prefs)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
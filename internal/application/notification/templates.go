@@ -0,0 +1,103 @@
+package notification
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// DigestTemplate — ключ шаблона ежедневного дайджеста среди шаблонов уведомлений.
+const DigestTemplate = "digest"
+
+// DefaultTemplates — шаблоны text/template по умолчанию. Шаблон события получает models.ReviewFeedEvent,
+// шаблон дайджеста — DigestData. Разметка — общее подмножество Slack и Mattermost.
+var DefaultTemplates = map[string]string{
+	string(models.NotificationAssigned):       "You were assigned to review *{{.PRTitle}}* (`{{.PRID}}`){{if .Actor}} by {{.Actor}}{{end}}.",
+	string(models.NotificationReassignedAway): "You no longer review *{{.PRTitle}}* (`{{.PRID}}`){{if .Reason}}: {{.Reason}}{{end}}.",
+	string(models.NotificationMerged):         "*{{.PRTitle}}* (`{{.PRID}}`) was merged.",
+	string(models.NotificationSLABreached):    "Review SLA for *{{.PRTitle}}* (`{{.PRID}}`) was breached, the review was handed to another reviewer.",
+	DigestTemplate:                            "Review digest: {{len .Lines}} update(s)\n{{range .Lines}}• {{.}}\n{{end}}",
+}
+
+// DigestData — данные шаблона дайджеста: события пользователя, уже отрендеренные шаблонами своих видов.
+type DigestData struct {
+	UserID string
+	Lines  []string
+}
+
+// Templates — разобранные шаблоны сообщений по видам уведомлений и шаблон дайджеста.
+type Templates struct {
+	kinds  map[models.NotificationKind]*template.Template
+	digest *template.Template
+}
+
+// NewTemplates разбирает шаблоны по умолчанию, заменяя переопределённые. Неизвестный ключ
+// или ошибка разбора — ошибка конфигурации.
+func NewTemplates(overrides map[string]string) (*Templates, error) {
+	sources := make(map[string]string, len(DefaultTemplates))
+	for k, v := range DefaultTemplates {
+		sources[k] = v
+	}
+	for k, v := range overrides {
+		if _, ok := sources[k]; !ok {
+			return nil, fmt.Errorf("unknown notification template %q", k)
+		}
+		if strings.TrimSpace(v) != "" {
+			sources[k] = v
+		}
+	}
+	t := &Templates{kinds: make(map[models.NotificationKind]*template.Template, len(models.NotificationKinds))}
+	for name, src := range sources {
+		tpl, err := template.New(name).Option("missingkey=error").Parse(src)
+		if err != nil {
+			return nil, fmt.Errorf("notification template %q: %w", name, err)
+		}
+		if name == DigestTemplate {
+			t.digest = tpl
+			continue
+		}
+		t.kinds[models.NotificationKind(name)] = tpl
+	}
+	// Опечатки в именах полей text/template находит только при выполнении, поэтому шаблоны
+	// проверяются на пробных событиях всех видов сразу, а не при первой отправке.
+	samples := []*models.ReviewFeedEvent{
+		{Type: models.ReviewFeedAssigned, Reason: models.AssignmentReasonInitial},
+		{Type: models.ReviewFeedUnassigned, Reason: models.AssignmentReasonManualReassign},
+		{Type: models.ReviewFeedMerged},
+		{Type: models.ReviewFeedUnassigned, Reason: models.AssignmentReasonSLAEscalation},
+	}
+	for _, e := range samples {
+		e.UserID, e.PRID, e.PRTitle, e.Actor = "u1", "pr-1", "Sample", "u2"
+	}
+	if _, err := t.RenderDigest("u1", samples); err != nil {
+		return nil, fmt.Errorf("notification template: %w", err)
+	}
+	return t, nil
+}
+
+// Render возвращает текст уведомления о событии ленты.
+func (t *Templates) Render(e *models.ReviewFeedEvent) (string, error) {
+	return execute(t.kinds[models.NotificationKindOf(e)], e)
+}
+
+// RenderDigest собирает события пользователя в одно сообщение.
+func (t *Templates) RenderDigest(userID string, events []*models.ReviewFeedEvent) (string, error) {
+	data := DigestData{UserID: userID, Lines: make([]string, 0, len(events))}
+	for _, e := range events {
+		line, err := t.Render(e)
+		if err != nil {
+			return "", err
+		}
+		data.Lines = append(data.Lines, line)
+	}
+	return execute(t.digest, data)
+}
+
+func execute(tpl *template.Template, data any) (string, error) {
+	var b strings.Builder
+	if err := tpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
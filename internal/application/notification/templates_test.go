@@ -0,0 +1,42 @@
+package notification_test
+
+import (
+	"testing"
+
+	app "avito-test-pr-service/internal/application/notification"
+	"avito-test-pr-service/internal/domain/models"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplates_Render(t *testing.T) {
+	tpl, err := app.NewTemplates(map[string]string{"merged": "{{.PRID}} merged by {{.Actor}}"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		event *models.ReviewFeedEvent
+		want  string
+	}{
+		{&models.ReviewFeedEvent{Type: models.ReviewFeedAssigned, PRID: "pr-1", PRTitle: "Add search"}, "You were assigned to review *Add search* (`pr-1`)."},
+		{&models.ReviewFeedEvent{Type: models.ReviewFeedUnassigned, Reason: models.AssignmentReasonDeactivation, PRID: "pr-1", PRTitle: "Add search"}, "You no longer review *Add search* (`pr-1`): deactivation."},
+		{&models.ReviewFeedEvent{Type: models.ReviewFeedMerged, PRID: "pr-1", Actor: "u1"}, "pr-1 merged by u1"},
+	}
+	for _, tt := range tests {
+		got, err := tpl.Render(tt.event)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got)
+	}
+}
+
+func TestNewTemplates_Invalid(t *testing.T) {
+	for name, overrides := range map[string]map[string]string{
+		"unknown key":   {"commented": "x"},
+		"syntax error":  {"assigned": "{{.PRTitle"},
+		"unknown field": {"digest": "{{.Events}}"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := app.NewTemplates(overrides)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package pr
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	pr_port "avito-test-pr-service/internal/domain/ports/output/pr"
+	"avito-test-pr-service/internal/utils"
+	"context"
+)
+
+// feedEvents — события ленты ревьюверов одной операции. Они сохраняются в той же транзакции,
+// что и изменение, а подписчикам рассылаются только после коммита.
+type feedEvents []*models.ReviewFeedEvent
+
+func (e *feedEvents) add(ctx context.Context, pr *models.PullRequest, userID string, typ models.ReviewFeedEventType, reason models.AssignmentReason) {
+	*e = append(*e, &models.ReviewFeedEvent{
+		UserID:  userID,
+		Type:    typ,
+		PRID:    pr.ID,
+		PRTitle: pr.Title,
+		Reason:  reason,
+		Actor:   utils.ActorFromContext(ctx),
+	})
+}
+
+func (s *Service) saveFeed(ctx context.Context, prRepo pr_port.PRRepository, events feedEvents) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return prRepo.AppendFeedEvents(ctx, events)
+}
+
+func (s *Service) publishFeed(events feedEvents) {
+	if len(events) == 0 {
+		return
+	}
+	s.feed.Publish(events)
+}
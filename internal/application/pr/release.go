@@ -0,0 +1,71 @@
+package pr
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	ports "avito-test-pr-service/internal/domain/ports/output"
+	feed_port "avito-test-pr-service/internal/domain/ports/output/feed"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/internal/utils"
+	"context"
+	"errors"
+)
+
+// NewReviewReleaser возвращает снятие ревьюверов, которое выполняется в транзакциях других сервисов.
+func NewReviewReleaser(selectors services.SelectorResolver, feed feed_port.Publisher, log ports.Logger) services.ReviewReleaser {
+	return &Service{selectors: selectors, feed: feed, log: log}
+}
+
+// ReleaseTeamReviews снимает ревьювера, ушедшего из команды, с открытых PR её участников authorIDs
+// в транзакции вызывающего; PR авторов из других команд не затрагиваются.
+func (s *Service) ReleaseTeamReviews(ctx context.Context, tx uow.Transaction, reviewerID string, authorIDs []string, reason models.AssignmentReason) ([]models.ReviewRelease, func(), error) {
+	open := models.PRStatusOPEN
+	prs, err := tx.PRRepository().ListPRsByReviewer(ctx, reviewerID, &open)
+	if err != nil {
+		s.log.Error("ReleaseTeamReviews list failed", "err", err, "reviewer_id", reviewerID)
+		return nil, nil, err
+	}
+	var (
+		res    []models.ReviewRelease
+		events feedEvents
+	)
+	for _, pr := range prs {
+		if !utils.ContainsString(authorIDs, pr.AuthorID) {
+			continue
+		}
+		release, err := s.releaseInTx(ctx, tx, pr, reviewerID, reason, &events)
+		if err != nil {
+			s.log.Error("ReleaseTeamReviews failed", "err", err, "pr_id", pr.ID, "reviewer_id", reviewerID)
+			return nil, nil, err
+		}
+		if release != nil {
+			res = append(res, *release)
+		}
+	}
+	return res, func() { s.publishFeed(events) }, nil
+}
+
+// releaseInTx переназначает ревьювера PR как reassign без new_user_id, а если замены нет — снимает его.
+// Для PR, смерженного, закрытого или уже без этого ревьювера, возвращает nil.
+func (s *Service) releaseInTx(ctx context.Context, tx uow.Transaction, pr *models.PullRequest, reviewerID string, reason models.AssignmentReason, events *feedEvents) (*models.ReviewRelease, error) {
+	release := &models.ReviewRelease{PRID: pr.ID, ReviewerID: reviewerID, Outcome: models.ReleaseOutcomeReassigned}
+	updated, err := s.reassignInTx(ctx, tx, pr.ID, reviewerID, "", reason, nil, events)
+	if errors.Is(err, utils.ErrNoReplacementCandidates) || errors.Is(err, utils.ErrConstraintsUnsatisfied) || errors.Is(err, utils.ErrUserNoTeam) {
+		release.Outcome = models.ReleaseOutcomeUnassigned
+		_, err = s.removeInTx(ctx, tx, pr.ID, reviewerID, reason, nil, events)
+	}
+	switch {
+	case errors.Is(err, utils.ErrAlreadyMerged), errors.Is(err, utils.ErrPRClosed), errors.Is(err, utils.ErrReviewerNotAssigned), errors.Is(err, utils.ErrPRNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	if release.Outcome == models.ReleaseOutcomeReassigned {
+		for _, id := range updated.ReviewerIDs {
+			if !utils.ContainsString(pr.ReviewerIDs, id) {
+				release.ReplacedBy = id
+			}
+		}
+	}
+	return release, nil
+}
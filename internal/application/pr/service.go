@@ -1,42 +1,137 @@
 package pr
 
 import (
+	"avito-test-pr-service/internal/application/audit"
 	"avito-test-pr-service/internal/domain/models"
 	"avito-test-pr-service/internal/domain/ports/input"
 	ports "avito-test-pr-service/internal/domain/ports/output"
+	feed_port "avito-test-pr-service/internal/domain/ports/output/feed"
+	pr_port "avito-test-pr-service/internal/domain/ports/output/pr"
 	uow "avito-test-pr-service/internal/domain/ports/output/uow"
 	"avito-test-pr-service/internal/domain/services"
 	"avito-test-pr-service/internal/utils"
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 500
+	MaxBatchSize     = 1000
+
+	reviewersPerPR = 2
+)
+
+var (
+	// balanced — транзакции, выбирающие ревьюверов по нагрузке: под READ COMMITTED параллельные
+	// назначения в одной команде видят одну и ту же нагрузку и нарушают равномерность.
+	balanced = uow.TxOptions{Isolation: uow.Serializable}
+	readOnly = uow.TxOptions{ReadOnly: true}
+	// replicaRead — списки, которые можно отдать с реплики с допустимым отставанием. Ответы с ETag
+	// читаются с основного сервера: по устаревшей версии изменение получило бы 412.
+	replicaRead = uow.TxOptions{ReadOnly: true, Replica: true}
 )
 
 type Service struct {
-	uow      uow.UnitOfWork
-	selector services.ReviewerSelector
-	log      ports.Logger
+	uow       uow.UnitOfWork
+	selectors services.SelectorResolver
+	feed      feed_port.Publisher
+	log       ports.Logger
+}
+
+func NewService(uow uow.UnitOfWork, selectors services.SelectorResolver, feed feed_port.Publisher, log ports.Logger) input.PRInputPort {
+	return &Service{uow: uow, selectors: selectors, feed: feed, log: log}
 }
 
-func NewService(uow uow.UnitOfWork, selector services.ReviewerSelector, log ports.Logger) input.PRInputPort {
-	return &Service{uow: uow, selector: selector, log: log}
+// selectorForTeam возвращает стратегию выбора ревьюверов, настроенную у команды автора.
+func (s *Service) selectorForTeam(ctx context.Context, tx uow.Transaction, teamID uuid.UUID) (services.ReviewerSelector, error) {
+	team, err := tx.TeamRepository().GetTeamByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return s.selectors.Resolve(team.SelectionStrategy, team.SelectionParams)
+}
+
+// constraintsFor возвращает ограничения подбора ревьюверов команды, применимые к автору.
+func (s *Service) constraintsFor(ctx context.Context, tx uow.Transaction, teamID uuid.UUID, authorID string) (services.ConstraintPlan, error) {
+	constraints, err := tx.TeamRepository().ListReviewerConstraints(ctx, teamID)
+	if err != nil {
+		return services.ConstraintPlan{}, err
+	}
+	return services.PlanConstraints(constraints, authorID), nil
 }
 
-func (s *Service) CreatePR(ctx context.Context, prID string, authorID string, title string) (*models.PullRequest, error) {
+func (s *Service) CreatePR(ctx context.Context, prID string, authorID string, title string, changedFiles []string, labels []string) (*models.PullRequest, error) {
 	if authorID == "" || title == "" || prID == "" {
 		return nil, utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
+	labels, err := services.NormalizeTags(labels)
 	if err != nil {
-		s.log.Error("CreatePR begin tx failed", "err", err, "author_id", authorID, "pr_id", prID)
 		return nil, err
 	}
-	var commit bool
-	defer func() {
-		if !commit {
-			_ = tx.Rollback(ctx)
+	var (
+		res    *models.PullRequest
+		events feedEvents
+	)
+	err = s.uow.Do(ctx, balanced, func(tx uow.Transaction) error {
+		events = nil
+		draft, err := s.draftPR(ctx, tx, &models.BatchPRItem{ID: prID, Title: title, AuthorID: authorID, ChangedFiles: changedFiles, Labels: labels}, nil)
+		if err != nil {
+			return err
+		}
+		pr := draft.pr
+		prRepo := tx.PRRepository()
+		if err := prRepo.CreatePR(ctx, pr); err != nil {
+			s.log.Error("CreatePR repo failed", "err", err, "author_id", authorID, "pr_id", prID)
+			return err
+		}
+		for _, reviewerID := range pr.ReviewerIDs {
+			if err := s.recordReviewerEvent(ctx, prRepo, pr.ID, reviewerID, models.ReviewerEventAssigned, models.AssignmentReasonInitial); err != nil {
+				s.log.Error("CreatePR history failed", "err", err, "pr_id", pr.ID, "reviewer_id", reviewerID)
+				return err
+			}
+			events.add(ctx, pr, reviewerID, models.ReviewFeedAssigned, models.AssignmentReasonInitial)
+		}
+		if err := s.saveFeed(ctx, prRepo, events); err != nil {
+			s.log.Error("CreatePR feed failed", "err", err, "pr_id", pr.ID)
+			return err
 		}
-	}()
+		if err := prRepo.AppendSelectionExplanation(ctx, draft.explanation); err != nil {
+			s.log.Error("CreatePR explanation failed", "err", err, "pr_id", pr.ID)
+			return err
+		}
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionPRCreate, models.AuditEntityPR, pr.ID, nil, pr); err != nil {
+			s.log.Error("CreatePR audit failed", "err", err, "pr_id", pr.ID)
+			return err
+		}
+		res = pr
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishFeed(events)
+	return res, nil
+}
 
+// prDraft — PR, готовый к вставке: ревьюверы выбраны, объяснение выбора (если был автоматический выбор) подготовлено.
+type prDraft struct {
+	pr          *models.PullRequest
+	explanation *models.SelectionExplanation
+}
+
+// draftPR проверяет автора и выбирает ревьюверов так же, как при обычном создании PR.
+// Если item.ReviewerIDs задан (не nil), ревьюверы не выбираются стратегией, а проверяются:
+// активные участники команды автора, не автор, без повторов, не больше двух и с соблюдением ограничений команды.
+// Метки item.Labels должны быть уже нормализованы. pending — ревью, уже назначенные предыдущими PR пачки
+// и ещё не записанные в БД: выбор учитывает их так же, как последовательные CreatePR видели бы друг друга.
+func (s *Service) draftPR(ctx context.Context, tx uow.Transaction, item *models.BatchPRItem, pending map[string]int) (*prDraft, error) {
+	authorID, prID := item.AuthorID, item.ID
 	userRepo := tx.UserRepository()
 	if _, err := userRepo.GetUserByID(ctx, authorID); err != nil {
 		s.log.Error("CreatePR author fetch failed", "err", err, "author_id", authorID)
@@ -47,54 +142,242 @@ func (s *Service) CreatePR(ctx context.Context, prID string, authorID string, ti
 		s.log.Error("CreatePR get team failed", "err", err, "author_id", authorID)
 		return nil, err
 	}
-	candidates, err := userRepo.ListActiveMembersByTeamID(ctx, teamID)
+	members, err := userRepo.ListMembersByTeamID(ctx, teamID)
 	if err != nil {
 		s.log.Error("CreatePR list candidates failed", "err", err, "author_id", authorID, "team_id", teamID)
 		return nil, err
 	}
-	filtered := utils.FilterStrings(candidates, map[string]struct{}{authorID: {}})
-	selected := s.selector.Select(filtered, 2)
-	if selected == nil {
-		selected = []string{}
+	plan, err := s.constraintsFor(ctx, tx, teamID, authorID)
+	if err != nil {
+		s.log.Error("CreatePR load constraints failed", "err", err, "team_id", teamID)
+		return nil, err
 	}
-	prRepo := tx.PRRepository()
-	pr := &models.PullRequest{ID: prID, Title: title, AuthorID: authorID, Status: models.PRStatusOPEN, ReviewerIDs: selected}
-	if err := prRepo.CreatePR(ctx, pr); err != nil {
-		s.log.Error("CreatePR repo failed", "err", err, "author_id", authorID, "pr_id", prID)
+	changedFiles := utils.FilterStrings(item.ChangedFiles, map[string]struct{}{"": {}})
+	labels := item.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	pr := &models.PullRequest{ID: prID, Title: item.Title, AuthorID: authorID, Status: models.PRStatusOPEN, ChangedFiles: changedFiles, Labels: labels}
+
+	if item.ReviewerIDs != nil {
+		if err := checkPresetReviewers(pr, activeIDs(members), plan, item.ReviewerIDs); err != nil {
+			return nil, err
+		}
+		pr.ReviewerIDs = append([]string{}, item.ReviewerIDs...)
+		return &prDraft{pr: pr}, nil
+	}
+
+	pool, excluded := splitCandidates(members, authorID, nil, plan)
+	selector, err := s.selectorForTeam(ctx, tx, teamID)
+	if err != nil {
+		s.log.Error("CreatePR resolve selector failed", "err", err, "pr_id", prID, "team_id", teamID)
 		return nil, err
 	}
-	if err := tx.Commit(ctx); err != nil {
-		s.log.Error("CreatePR commit failed", "err", err, "pr_id", pr.ID)
+	selection, err := services.SelectWithConstraints(ctx, tx, selector, services.SelectionRequest{
+		TeamID:      teamID,
+		PRID:        prID,
+		AuthorID:    authorID,
+		Candidates:  pool,
+		Count:       reviewersPerPR,
+		FilePaths:   changedFiles,
+		Labels:      labels,
+		PendingLoad: pending,
+	}, plan, nil)
+	if err != nil {
+		s.log.Error("CreatePR select reviewers failed", "err", err, "pr_id", prID, "team_id", teamID)
 		return nil, err
 	}
-	commit = true
-	return pr, nil
+	pr.ReviewerIDs = selection.ReviewerIDs
+	if pr.ReviewerIDs == nil {
+		pr.ReviewerIDs = []string{}
+	}
+	return &prDraft{
+		pr:          pr,
+		explanation: newExplanation(prID, models.AssignmentReasonInitial, reviewersPerPR, pool, excluded, selection),
+	}, nil
 }
 
-func (s *Service) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (*models.PullRequest, error) {
+// BatchCreatePRs создаёт PR пачкой в одной транзакции. Каждый элемент проходит ту же проверку автора
+// и выбор ревьюверов, что и CreatePR; ошибки предметной области отдельных элементов не прерывают пачку
+// и возвращаются в результатах, а прочие ошибки откатывают всю пачку (конфликт сериализации — с повтором).
+// PR, ревьюверы и история назначений вставляются пакетно, а не по одному запросу на ревьювера.
+func (s *Service) BatchCreatePRs(ctx context.Context, items []*models.BatchPRItem) ([]*models.BatchPRResult, error) {
+	if len(items) == 0 {
+		return nil, utils.ErrInvalidArgument
+	}
+	if len(items) > MaxBatchSize {
+		return nil, utils.ErrBatchTooLarge
+	}
+	var (
+		res    []*models.BatchPRResult
+		events feedEvents
+	)
+	err := s.uow.Do(ctx, balanced, func(tx uow.Transaction) error {
+		events = nil
+		prRepo := tx.PRRepository()
+		ids := make([]string, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		existing, err := prRepo.ExistingPRIDs(ctx, ids)
+		if err != nil {
+			s.log.Error("BatchCreatePRs existing ids failed", "err", err)
+			return err
+		}
+
+		results := make([]*models.BatchPRResult, len(items))
+		drafts := make([]*prDraft, 0, len(items))
+		draftIdx := make(map[string]int, len(items))
+		pending := make(map[string]int)
+		for i, item := range items {
+			results[i] = &models.BatchPRResult{PullRequestID: item.ID}
+			if _, ok := existing[item.ID]; ok {
+				results[i].Status = models.BatchStatusExists
+				continue
+			}
+			if _, ok := draftIdx[item.ID]; ok {
+				results[i].Status = models.BatchStatusExists
+				continue
+			}
+			if err := s.validateBatchItem(item); err != nil {
+				results[i].Status, results[i].Err = models.BatchStatusError, err
+				continue
+			}
+			draft, err := s.draftPR(ctx, tx, item, pending)
+			if err != nil {
+				// ошибка БД прерывает транзакцию, поэтому в результат элемента попадают только ошибки предметной области
+				if !utils.IsDomainError(err) {
+					return err
+				}
+				results[i].Status, results[i].Err = models.BatchStatusError, err
+				continue
+			}
+			draftIdx[item.ID] = i
+			drafts = append(drafts, draft)
+			for _, id := range draft.pr.ReviewerIDs {
+				pending[id]++
+			}
+		}
+
+		prs := make([]*models.PullRequest, 0, len(drafts))
+		for _, d := range drafts {
+			prs = append(prs, d.pr)
+		}
+		created, err := prRepo.CreatePRs(ctx, prs)
+		if err != nil {
+			s.log.Error("BatchCreatePRs insert failed", "err", err, "count", len(prs))
+			return err
+		}
+
+		var history []*models.ReviewerHistoryEntry
+		actor := utils.ActorFromContext(ctx)
+		for _, d := range drafts {
+			result := results[draftIdx[d.pr.ID]]
+			if _, ok := created[d.pr.ID]; !ok {
+				// PR с тем же id успели создать параллельно
+				result.Status = models.BatchStatusExists
+				continue
+			}
+			result.Status, result.PR = models.BatchStatusCreated, d.pr
+			for _, reviewerID := range d.pr.ReviewerIDs {
+				history = append(history, &models.ReviewerHistoryEntry{
+					PRID:       d.pr.ID,
+					ReviewerID: reviewerID,
+					Event:      models.ReviewerEventAssigned,
+					Reason:     models.AssignmentReasonInitial,
+					Actor:      actor,
+				})
+				events.add(ctx, d.pr, reviewerID, models.ReviewFeedAssigned, models.AssignmentReasonInitial)
+			}
+		}
+		if err := prRepo.AppendReviewerHistoryBatch(ctx, history); err != nil {
+			s.log.Error("BatchCreatePRs history failed", "err", err)
+			return err
+		}
+		if err := s.saveFeed(ctx, prRepo, events); err != nil {
+			s.log.Error("BatchCreatePRs feed failed", "err", err)
+			return err
+		}
+		for _, d := range drafts {
+			if _, ok := created[d.pr.ID]; !ok {
+				continue
+			}
+			if d.explanation != nil {
+				if err := prRepo.AppendSelectionExplanation(ctx, d.explanation); err != nil {
+					s.log.Error("BatchCreatePRs explanation failed", "err", err, "pr_id", d.pr.ID)
+					return err
+				}
+			}
+			if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionPRCreate, models.AuditEntityPR, d.pr.ID, nil, d.pr); err != nil {
+				s.log.Error("BatchCreatePRs audit failed", "err", err, "pr_id", d.pr.ID)
+				return err
+			}
+		}
+		res = results
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishFeed(events)
+	return res, nil
+}
+
+// validateBatchItem повторяет проверки аргументов CreatePR и нормализует метки элемента.
+func (s *Service) validateBatchItem(item *models.BatchPRItem) error {
+	if item.AuthorID == "" || item.Title == "" || item.ID == "" {
+		return utils.ErrInvalidArgument
+	}
+	labels, err := services.NormalizeTags(item.Labels)
+	if err != nil {
+		return err
+	}
+	item.Labels = labels
+	return nil
+}
+
+// ReassignReviewer заменяет oldReviewerID на newReviewerID, если он задан,
+// иначе на случайного активного участника команды автора. ifMatch сверяется с версией PR.
+func (s *Service) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason models.AssignmentReason, ifMatch *utils.IfMatch) (*models.PullRequest, error) {
 	if prID == "" || oldReviewerID == "" {
 		return nil, utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
+	if reason == "" {
+		reason = models.AssignmentReasonManualReassign
+	}
+	if !models.IsValidReassignReason(reason) {
+		return nil, utils.ErrInvalidReason
+	}
+	var (
+		res    *models.PullRequest
+		events feedEvents
+	)
+	err := s.uow.Do(ctx, balanced, func(tx uow.Transaction) error {
+		events = nil
+		var err error
+		res, err = s.reassignInTx(ctx, tx, prID, oldReviewerID, newReviewerID, reason, ifMatch, &events)
+		return err
+	})
 	if err != nil {
-		s.log.Error("Reassign begin tx failed", "err", err, "pr_id", prID)
 		return nil, err
 	}
-	var commit bool
-	defer func() {
-		if !commit {
-			_ = tx.Rollback(ctx)
-		}
-	}()
+	s.publishFeed(events)
+	return res, nil
+}
 
+// reassignInTx — ReassignReviewer в транзакции tx; события ленты добавляются в events.
+func (s *Service) reassignInTx(ctx context.Context, tx uow.Transaction, prID, oldReviewerID, newReviewerID string, reason models.AssignmentReason, ifMatch *utils.IfMatch, events *feedEvents) (*models.PullRequest, error) {
 	prRepo := tx.PRRepository()
 	pr, err := prRepo.LockPRByID(ctx, prID)
 	if err != nil {
 		s.log.Error("Reassign lock failed", "err", err, "pr_id", prID)
 		return nil, err
 	}
-	if pr.Status == models.PRStatusMERGED {
-		return nil, utils.ErrAlreadyMerged
+	if err := ifMatch.Check(pr.Version); err != nil {
+		return nil, err
+	}
+	if err := requireOpen(pr); err != nil {
+		return nil, err
 	}
 	if !utils.ContainsString(pr.ReviewerIDs, oldReviewerID) {
 		return nil, utils.ErrReviewerNotAssigned
@@ -105,91 +388,291 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID string, oldReviewer
 	if err != nil {
 		return nil, err
 	}
-	members, err := userRepo.ListActiveMembersByTeamID(ctx, teamID)
+	members, err := userRepo.ListMembersByTeamID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
-	ex := make(map[string]struct{}, len(pr.ReviewerIDs)+1)
-	ex[pr.AuthorID] = struct{}{}
-	for _, id := range pr.ReviewerIDs {
-		ex[id] = struct{}{}
-	}
-	pool := utils.FilterStrings(members, ex)
-	if len(pool) == 0 {
-		return nil, utils.ErrNoReplacementCandidates
+	plan, err := s.constraintsFor(ctx, tx, teamID, pr.AuthorID)
+	if err != nil {
+		return nil, err
 	}
-	picked := s.selector.Select(pool, 1)
-	if len(picked) == 0 {
-		return nil, utils.ErrNoReplacementCandidates
+	remaining := utils.FilterStrings(pr.ReviewerIDs, map[string]struct{}{oldReviewerID: {}})
+	replacement := newReviewerID
+	var explanation *models.SelectionExplanation
+	if replacement != "" {
+		if err := checkManualReviewer(pr, activeIDs(members), replacement); err != nil {
+			return nil, err
+		}
+		if err := checkManualConstraints(plan, append(remaining, replacement), replacement); err != nil {
+			return nil, err
+		}
+	} else {
+		pool, excluded := splitCandidates(members, pr.AuthorID, pr.ReviewerIDs, plan)
+		if len(pool) == 0 {
+			if len(plan.Unsatisfied(remaining)) > 0 {
+				return nil, utils.ErrConstraintsUnsatisfied
+			}
+			return nil, utils.ErrNoReplacementCandidates
+		}
+		selector, err := s.selectorForTeam(ctx, tx, teamID)
+		if err != nil {
+			s.log.Error("Reassign resolve selector failed", "err", err, "pr_id", prID, "team_id", teamID)
+			return nil, err
+		}
+		selection, err := services.SelectWithConstraints(ctx, tx, selector, services.SelectionRequest{
+			TeamID:     teamID,
+			PRID:       prID,
+			AuthorID:   pr.AuthorID,
+			Candidates: pool,
+			Count:      1,
+			FilePaths:  pr.ChangedFiles,
+			Labels:     pr.Labels,
+		}, plan, remaining)
+		if err != nil {
+			s.log.Error("Reassign select reviewer failed", "err", err, "pr_id", prID, "team_id", teamID)
+			return nil, err
+		}
+		if len(selection.ReviewerIDs) == 0 {
+			return nil, utils.ErrNoReplacementCandidates
+		}
+		replacement = selection.ReviewerIDs[0]
+		explanation = newExplanation(prID, reason, 1, pool, excluded, selection)
 	}
-	newReviewerID := picked[0]
 	if err := prRepo.RemoveReviewer(ctx, prID, oldReviewerID); err != nil {
 		return nil, err
 	}
-	if err := prRepo.AddReviewer(ctx, prID, newReviewerID); err != nil {
+	if err := s.recordReviewerEvent(ctx, prRepo, prID, oldReviewerID, models.ReviewerEventUnassigned, reason); err != nil {
+		s.log.Error("Reassign history failed", "err", err, "pr_id", prID, "reviewer_id", oldReviewerID)
+		return nil, err
+	}
+	if err := prRepo.AddReviewer(ctx, prID, replacement); err != nil {
+		return nil, err
+	}
+	if err := s.recordReviewerEvent(ctx, prRepo, prID, replacement, models.ReviewerEventAssigned, reason); err != nil {
+		s.log.Error("Reassign history failed", "err", err, "pr_id", prID, "reviewer_id", replacement)
 		return nil, err
 	}
+	if explanation != nil {
+		if err := prRepo.AppendSelectionExplanation(ctx, explanation); err != nil {
+			s.log.Error("Reassign explanation failed", "err", err, "pr_id", prID)
+			return nil, err
+		}
+	}
+	var feed feedEvents
+	feed.add(ctx, pr, oldReviewerID, models.ReviewFeedUnassigned, reason)
+	feed.add(ctx, pr, replacement, models.ReviewFeedAssigned, reason)
+	if err := s.saveFeed(ctx, prRepo, feed); err != nil {
+		s.log.Error("Reassign feed failed", "err", err, "pr_id", prID)
+		return nil, err
+	}
+	*events = append(*events, feed...)
 	updatedPR, err := prRepo.GetPRByID(ctx, prID)
 	if err != nil {
 		return nil, err
 	}
-	if err := tx.Commit(ctx); err != nil {
+	if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionPRReassign, models.AuditEntityPR, prID, pr, updatedPR); err != nil {
+		s.log.Error("Reassign audit failed", "err", err, "pr_id", prID)
 		return nil, err
 	}
-	commit = true
 	return updatedPR, nil
 }
 
-func (s *Service) MergePR(ctx context.Context, prID string) (*models.PullRequest, error) {
-	if prID == "" {
+func (s *Service) AddReviewer(ctx context.Context, prID string, reviewerID string, ifMatch *utils.IfMatch) (*models.PullRequest, error) {
+	if prID == "" || reviewerID == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	var (
+		res    *models.PullRequest
+		events feedEvents
+	)
+	err := s.uow.Do(ctx, balanced, func(tx uow.Transaction) error {
+		events = nil
+		prRepo := tx.PRRepository()
+		pr, err := prRepo.LockPRByID(ctx, prID)
+		if err != nil {
+			return err
+		}
+		if err := ifMatch.Check(pr.Version); err != nil {
+			return err
+		}
+		if err := requireOpen(pr); err != nil {
+			return err
+		}
+		userRepo := tx.UserRepository()
+		teamID, err := userRepo.GetTeamIDByUserID(ctx, pr.AuthorID)
+		if err != nil {
+			return err
+		}
+		members, err := userRepo.ListActiveMembersByTeamID(ctx, teamID)
+		if err != nil {
+			return err
+		}
+		if err := checkManualReviewer(pr, members, reviewerID); err != nil {
+			return err
+		}
+		plan, err := s.constraintsFor(ctx, tx, teamID, pr.AuthorID)
+		if err != nil {
+			return err
+		}
+		if err := checkManualConstraints(plan, append(append([]string{}, pr.ReviewerIDs...), reviewerID), reviewerID); err != nil {
+			return err
+		}
+		if err := prRepo.AddReviewer(ctx, prID, reviewerID); err != nil {
+			return err
+		}
+		if err := s.recordReviewerEvent(ctx, prRepo, prID, reviewerID, models.ReviewerEventAssigned, models.AssignmentReasonManual); err != nil {
+			s.log.Error("AddReviewer history failed", "err", err, "pr_id", prID, "reviewer_id", reviewerID)
+			return err
+		}
+		events.add(ctx, pr, reviewerID, models.ReviewFeedAssigned, models.AssignmentReasonManual)
+		if err := s.saveFeed(ctx, prRepo, events); err != nil {
+			s.log.Error("AddReviewer feed failed", "err", err, "pr_id", prID)
+			return err
+		}
+		updatedPR, err := prRepo.GetPRByID(ctx, prID)
+		if err != nil {
+			return err
+		}
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionPRReviewerAdd, models.AuditEntityPR, prID, pr, updatedPR); err != nil {
+			s.log.Error("AddReviewer audit failed", "err", err, "pr_id", prID)
+			return err
+		}
+		res = updatedPR
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishFeed(events)
+	return res, nil
+}
+
+func (s *Service) RemoveReviewer(ctx context.Context, prID string, reviewerID string, ifMatch *utils.IfMatch) (*models.PullRequest, error) {
+	if prID == "" || reviewerID == "" {
 		return nil, utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
+	var (
+		res    *models.PullRequest
+		events feedEvents
+	)
+	err := s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		events = nil
+		var err error
+		res, err = s.removeInTx(ctx, tx, prID, reviewerID, models.AssignmentReasonManual, ifMatch, &events)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	var commit bool
-	defer func() {
-		if !commit {
-			_ = tx.Rollback(ctx)
-		}
-	}()
+	s.publishFeed(events)
+	return res, nil
+}
+
+// removeInTx — RemoveReviewer в транзакции tx; события ленты добавляются в events.
+func (s *Service) removeInTx(ctx context.Context, tx uow.Transaction, prID, reviewerID string, reason models.AssignmentReason, ifMatch *utils.IfMatch, events *feedEvents) (*models.PullRequest, error) {
 	prRepo := tx.PRRepository()
 	pr, err := prRepo.LockPRByID(ctx, prID)
 	if err != nil {
 		return nil, err
 	}
-	if pr.Status == models.PRStatusMERGED {
-		if err := tx.Commit(ctx); err != nil {
-			return nil, err
-		}
-		commit = true
-		return pr, nil
+	if err := ifMatch.Check(pr.Version); err != nil {
+		return nil, err
 	}
-	now := time.Now().UTC()
-	if err := prRepo.UpdateStatus(ctx, prID, models.PRStatusMERGED, &now); err != nil {
+	if err := requireOpen(pr); err != nil {
 		return nil, err
 	}
-	pr.Status = models.PRStatusMERGED
-	pr.MergedAt = &now
-	if err := tx.Commit(ctx); err != nil {
+	if !utils.ContainsString(pr.ReviewerIDs, reviewerID) {
+		return nil, utils.ErrReviewerNotAssigned
+	}
+	if err := prRepo.RemoveReviewer(ctx, prID, reviewerID); err != nil {
 		return nil, err
 	}
-	commit = true
-	return pr, nil
+	if err := s.recordReviewerEvent(ctx, prRepo, prID, reviewerID, models.ReviewerEventUnassigned, reason); err != nil {
+		s.log.Error("RemoveReviewer history failed", "err", err, "pr_id", prID, "reviewer_id", reviewerID)
+		return nil, err
+	}
+	var feed feedEvents
+	feed.add(ctx, pr, reviewerID, models.ReviewFeedUnassigned, reason)
+	if err := s.saveFeed(ctx, prRepo, feed); err != nil {
+		s.log.Error("RemoveReviewer feed failed", "err", err, "pr_id", prID)
+		return nil, err
+	}
+	*events = append(*events, feed...)
+	updatedPR, err := prRepo.GetPRByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionPRReviewerRemove, models.AuditEntityPR, prID, pr, updatedPR); err != nil {
+		s.log.Error("RemoveReviewer audit failed", "err", err, "pr_id", prID)
+		return nil, err
+	}
+	return updatedPR, nil
 }
 
-func (s *Service) GetPR(ctx context.Context, prID string) (*models.PullRequest, error) {
+func (s *Service) MergePR(ctx context.Context, prID string, ifMatch *utils.IfMatch) (*models.PullRequest, error) {
 	if prID == "" {
 		return nil, utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
+	var (
+		res    *models.PullRequest
+		events feedEvents
+	)
+	err := s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		events = nil
+		prRepo := tx.PRRepository()
+		pr, err := prRepo.LockPRByID(ctx, prID)
+		if err != nil {
+			return err
+		}
+		if err := ifMatch.Check(pr.Version); err != nil {
+			return err
+		}
+		if pr.Status == models.PRStatusMERGED {
+			res = pr
+			return nil
+		}
+		if pr.Status == models.PRStatusCLOSED {
+			return utils.ErrPRClosed
+		}
+		before := *pr
+		now := time.Now().UTC()
+		if err := prRepo.UpdateStatus(ctx, prID, models.PRStatusMERGED, &now); err != nil {
+			return err
+		}
+		pr.Status = models.PRStatusMERGED
+		pr.MergedAt = &now
+		pr.Version++
+		for _, reviewerID := range pr.ReviewerIDs {
+			events.add(ctx, pr, reviewerID, models.ReviewFeedMerged, "")
+		}
+		if err := s.saveFeed(ctx, prRepo, events); err != nil {
+			s.log.Error("MergePR feed failed", "err", err, "pr_id", prID)
+			return err
+		}
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionPRMerge, models.AuditEntityPR, prID, before, pr); err != nil {
+			s.log.Error("MergePR audit failed", "err", err, "pr_id", prID)
+			return err
+		}
+		res = pr
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = tx.Rollback(ctx) }()
-	prRepo := tx.PRRepository()
-	pr, err := prRepo.GetPRByID(ctx, prID)
+	s.publishFeed(events)
+	return res, nil
+}
+
+func (s *Service) GetPR(ctx context.Context, prID string) (*models.PullRequest, error) {
+	if prID == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	var pr *models.PullRequest
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		var err error
+		pr, err = tx.PRRepository().GetPRByID(ctx, prID)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -200,13 +683,197 @@ func (s *Service) ListPRsByAssignee(ctx context.Context, reviewerID string, stat
 	if reviewerID == "" {
 		return nil, utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
+	var res []*models.PullRequest
+	err := s.uow.Do(ctx, replicaRead, func(tx uow.Transaction) error {
+		var err error
+		res, err = tx.PRRepository().ListPRsByReviewer(ctx, reviewerID, status)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = tx.Rollback(ctx) }()
-	prRepo := tx.PRRepository()
-	res, err := prRepo.ListPRsByReviewer(ctx, reviewerID, status)
+	return res, nil
+}
+
+func (s *Service) ListPRs(ctx context.Context, filter models.PRFilter) (*models.PRPage, error) {
+	if filter.Limit < 0 || filter.Offset < 0 || filter.Limit > MaxListLimit {
+		return nil, utils.ErrInvalidArgument
+	}
+	if filter.Status != nil && !filter.Status.IsValid() {
+		return nil, utils.ErrInvalidArgument
+	}
+	if filter.Limit == 0 {
+		filter.Limit = DefaultListLimit
+	}
+	page := &models.PRPage{Limit: filter.Limit, Offset: filter.Offset}
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		if filter.TeamName != "" {
+			if _, err := tx.TeamRepository().GetTeamByName(ctx, filter.TeamName); err != nil {
+				return err
+			}
+		}
+		var err error
+		page.PullRequests, page.Total, err = tx.PRRepository().ListPRs(ctx, filter)
+		if err != nil {
+			s.log.Error("ListPRs repo failed", "err", err)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (s *Service) GetReviewerHistory(ctx context.Context, prID string) ([]*models.ReviewerHistoryEntry, error) {
+	if prID == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	var res []*models.ReviewerHistoryEntry
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		prRepo := tx.PRRepository()
+		if _, err := prRepo.GetPRByID(ctx, prID); err != nil {
+			return err
+		}
+		var err error
+		res, err = prRepo.ListReviewerHistory(ctx, prID)
+		if err != nil {
+			s.log.Error("GetReviewerHistory repo failed", "err", err, "pr_id", prID)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *Service) recordReviewerEvent(ctx context.Context, prRepo pr_port.PRRepository, prID, reviewerID string, event models.ReviewerEvent, reason models.AssignmentReason) error {
+	return prRepo.AppendReviewerHistory(ctx, &models.ReviewerHistoryEntry{
+		PRID:       prID,
+		ReviewerID: reviewerID,
+		Event:      event,
+		Reason:     reason,
+		Actor:      utils.ActorFromContext(ctx),
+	})
+}
+
+// checkManualReviewer проверяет, что явно указанный ревьювер — активный участник
+// команды автора, не сам автор и ещё не назначен на PR.
+func checkManualReviewer(pr *models.PullRequest, activeMembers []string, reviewerID string) error {
+	if utils.ContainsString(pr.ReviewerIDs, reviewerID) {
+		return utils.ErrReviewerAlreadyAssigned
+	}
+	if reviewerID == pr.AuthorID || !utils.ContainsString(activeMembers, reviewerID) {
+		return utils.ErrReviewerNotEligible
+	}
+	return nil
+}
+
+// splitCandidates делит участников команды на пул кандидатов и исключённых с указанием причины.
+func splitCandidates(members []*models.User, authorID string, assigned []string, plan services.ConstraintPlan) ([]string, []models.ExcludedCandidate) {
+	sorted := make([]*models.User, len(members))
+	copy(sorted, members)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	pool := make([]string, 0, len(sorted))
+	excluded := make([]models.ExcludedCandidate, 0)
+	for _, u := range sorted {
+		switch {
+		case u.ID == authorID:
+			excluded = append(excluded, models.ExcludedCandidate{UserID: u.ID, Reason: models.ExclusionReasonAuthor})
+		case !u.IsActive:
+			excluded = append(excluded, models.ExcludedCandidate{UserID: u.ID, Reason: models.ExclusionReasonInactive})
+		case utils.ContainsString(assigned, u.ID):
+			excluded = append(excluded, models.ExcludedCandidate{UserID: u.ID, Reason: models.ExclusionReasonAlreadyAssigned})
+		case !plan.Allows(u.ID):
+			excluded = append(excluded, models.ExcludedCandidate{UserID: u.ID, Reason: models.ExclusionReasonConstraint})
+		default:
+			pool = append(pool, u.ID)
+		}
+	}
+	return pool, excluded
+}
+
+// checkManualConstraints проверяет явно выбранного ревьювера против ограничений команды:
+// он не должен быть в never_pair с автором, а итоговый состав reviewers — покрывать группы must_include_one_of.
+func checkManualConstraints(plan services.ConstraintPlan, reviewers []string, reviewerID string) error {
+	if !plan.Allows(reviewerID) {
+		return fmt.Errorf("%w: %s must not review this author", utils.ErrConstraintsUnsatisfied, reviewerID)
+	}
+	if groups := plan.Unsatisfied(reviewers); len(groups) > 0 {
+		return fmt.Errorf("%w: none of [%s] would remain assigned", utils.ErrConstraintsUnsatisfied, strings.Join(groups[0], ", "))
+	}
+	return nil
+}
+
+// checkPresetReviewers проверяет заранее заданных ревьюверов при создании PR.
+func checkPresetReviewers(pr *models.PullRequest, activeMembers []string, plan services.ConstraintPlan, reviewerIDs []string) error {
+	if len(reviewerIDs) > reviewersPerPR {
+		return utils.ErrTooManyReviewers
+	}
+	assigned := &models.PullRequest{AuthorID: pr.AuthorID}
+	for _, id := range reviewerIDs {
+		if err := checkManualReviewer(assigned, activeMembers, id); err != nil {
+			return fmt.Errorf("%w: %s", err, id)
+		}
+		if !plan.Allows(id) {
+			return fmt.Errorf("%w: %s must not review this author", utils.ErrConstraintsUnsatisfied, id)
+		}
+		assigned.ReviewerIDs = append(assigned.ReviewerIDs, id)
+	}
+	if groups := plan.Unsatisfied(reviewerIDs); len(groups) > 0 {
+		return fmt.Errorf("%w: none of [%s] is assigned", utils.ErrConstraintsUnsatisfied, strings.Join(groups[0], ", "))
+	}
+	return nil
+}
+
+func activeIDs(members []*models.User) []string {
+	ids := make([]string, 0, len(members))
+	for _, u := range members {
+		if u.IsActive {
+			ids = append(ids, u.ID)
+		}
+	}
+	return ids
+}
+
+func newExplanation(prID string, reason models.AssignmentReason, slots int, pool []string, excluded []models.ExcludedCandidate, sel *services.Selection) *models.SelectionExplanation {
+	selected := sel.ReviewerIDs
+	if selected == nil {
+		selected = []string{}
+	}
+	scores := sel.Scores
+	if scores == nil {
+		scores = []models.CandidateScore{}
+	}
+	return &models.SelectionExplanation{
+		PRID:     prID,
+		Reason:   reason,
+		Strategy: sel.Strategy,
+		Slots:    slots,
+		Pool:     pool,
+		Excluded: excluded,
+		Scores:   scores,
+		Selected: selected,
+	}
+}
+
+// ExplainSelection возвращает сохранённые объяснения выбора ревьюверов по PR в хронологическом порядке.
+func (s *Service) ExplainSelection(ctx context.Context, prID string) ([]*models.SelectionExplanation, error) {
+	if prID == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	var res []*models.SelectionExplanation
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		prRepo := tx.PRRepository()
+		if _, err := prRepo.GetPRByID(ctx, prID); err != nil {
+			return err
+		}
+		var err error
+		res, err = prRepo.ListSelectionExplanations(ctx, prID)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -2,11 +2,16 @@ package pr_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"testing"
 
 	app "avito-test-pr-service/internal/application/pr"
 	"avito-test-pr-service/internal/domain/models"
+	uowport "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/domain/services"
 	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/reviewerselector"
 	"avito-test-pr-service/internal/utils"
 	"avito-test-pr-service/mocks"
 
@@ -32,16 +37,25 @@ func TestPRService_CreatePR(t *testing.T) {
 			name:  "happy two reviewers",
 			title: "feat",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, sel *mocks.ReviewerSelector) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetUserByID(ctx, authorID).Return(&models.User{ID: authorID, Name: "a"}, nil)
 				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
-				userRepo.EXPECT().ListActiveMembersByTeamID(ctx, teamID).Return([]string{authorID, c1, c2, c3}, nil)
+				userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, c1, c2, c3), nil)
 				tx.EXPECT().PRRepository().Return(prRepo)
-				sel.EXPECT().Select(mock.MatchedBy(func(ids []string) bool { return len(ids) == 3 }), 2).Return([]string{c1, c2})
+				sel.EXPECT().Select(ctx, tx, mock.MatchedBy(func(req services.SelectionRequest) bool {
+					return len(req.Candidates) == 3 && req.Count == 2 && req.TeamID == teamID && req.AuthorID == authorID
+				})).Return(&services.Selection{ReviewerIDs: []string{c1, c2}}, nil)
 				prRepo.EXPECT().CreatePR(ctx, mock.MatchedBy(func(pr *models.PullRequest) bool {
 					return pr.ID == prID && pr.AuthorID == authorID && pr.Title == "feat" && len(pr.ReviewerIDs) == 2 && pr.ReviewerIDs[0] == c1 && pr.ReviewerIDs[1] == c2
 				})).Return(nil)
+				prRepo.EXPECT().AppendReviewerHistory(ctx, mock.MatchedBy(func(e *models.ReviewerHistoryEntry) bool {
+					return e.PRID == prID && e.Event == models.ReviewerEventAssigned && e.Reason == models.AssignmentReasonInitial
+				})).Return(nil).Times(2)
+				prRepo.EXPECT().AppendFeedEvents(ctx, mock.MatchedBy(func(events []*models.ReviewFeedEvent) bool {
+					return len(events) == 2 && events[0].UserID == c1 && events[1].UserID == c2 &&
+						events[0].Type == models.ReviewFeedAssigned && events[0].PRID == prID && events[0].PRTitle == "feat"
+				})).Return(nil)
 				tx.EXPECT().Commit(ctx).Return(nil)
 			},
 		},
@@ -49,16 +63,18 @@ func TestPRService_CreatePR(t *testing.T) {
 			name:  "one candidate -> one reviewer",
 			title: "fix",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, sel *mocks.ReviewerSelector) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetUserByID(ctx, authorID).Return(&models.User{ID: authorID}, nil)
 				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
-				userRepo.EXPECT().ListActiveMembersByTeamID(ctx, teamID).Return([]string{authorID, c1}, nil)
+				userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, c1), nil)
 				tx.EXPECT().PRRepository().Return(prRepo)
-				sel.EXPECT().Select(mock.Anything, 2).Return([]string{c1})
+				sel.EXPECT().Select(ctx, tx, mock.MatchedBy(func(req services.SelectionRequest) bool { return req.Count == 2 })).Return(&services.Selection{ReviewerIDs: []string{c1}}, nil)
 				prRepo.EXPECT().CreatePR(ctx, mock.MatchedBy(func(pr *models.PullRequest) bool {
 					return pr.ID == prID && len(pr.ReviewerIDs) == 1 && pr.ReviewerIDs[0] == c1
 				})).Return(nil)
+				prRepo.EXPECT().AppendReviewerHistory(ctx, mock.MatchedBy(func(e *models.ReviewerHistoryEntry) bool { return e.ReviewerID == c1 })).Return(nil)
+				prRepo.EXPECT().AppendFeedEvents(ctx, mock.MatchedBy(func(events []*models.ReviewFeedEvent) bool { return len(events) == 1 })).Return(nil)
 				tx.EXPECT().Commit(ctx).Return(nil)
 			},
 		},
@@ -66,13 +82,13 @@ func TestPRService_CreatePR(t *testing.T) {
 			name:  "no candidates",
 			title: "chore",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, sel *mocks.ReviewerSelector) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetUserByID(ctx, authorID).Return(&models.User{ID: authorID}, nil)
 				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
-				userRepo.EXPECT().ListActiveMembersByTeamID(ctx, teamID).Return([]string{authorID}, nil)
+				userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID), nil)
 				tx.EXPECT().PRRepository().Return(prRepo)
-				sel.EXPECT().Select([]string{}, 2).Return(nil)
+				sel.EXPECT().Select(ctx, tx, mock.MatchedBy(func(req services.SelectionRequest) bool { return len(req.Candidates) == 0 && req.Count == 2 })).Return(&services.Selection{}, nil)
 				prRepo.EXPECT().CreatePR(ctx, mock.MatchedBy(func(pr *models.PullRequest) bool { return pr.ID == prID && len(pr.ReviewerIDs) == 0 })).Return(nil)
 				tx.EXPECT().Commit(ctx).Return(nil)
 			},
@@ -81,7 +97,7 @@ func TestPRService_CreatePR(t *testing.T) {
 			name:  "author not found",
 			title: "feat",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, sel *mocks.ReviewerSelector) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetUserByID(ctx, authorID).Return(nil, utils.ErrUserNotFound)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -92,7 +108,7 @@ func TestPRService_CreatePR(t *testing.T) {
 			name:  "author no team",
 			title: "feat",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, sel *mocks.ReviewerSelector) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetUserByID(ctx, authorID).Return(&models.User{ID: authorID}, nil)
 				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(uuid.Nil, utils.ErrUserNoTeam)
@@ -106,8 +122,16 @@ func TestPRService_CreatePR(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUOW := mocks.NewUnitOfWork(t)
 			mockTx := mocks.NewTransaction(t)
+			mockTeamRepo := mocks.NewTeamRepository(t)
+			mockTx.EXPECT().TeamRepository().Maybe().Return(mockTeamRepo)
+			mockTeamRepo.EXPECT().GetTeamByID(ctx, mock.Anything).Maybe().Return(&models.Team{}, nil)
+			mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, mock.Anything).Maybe().Return(nil, nil)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
 			mockUserRepo := mocks.NewUserRepository(t)
 			mockPRRepo := mocks.NewPRRepository(t)
+			mockPRRepo.EXPECT().AppendSelectionExplanation(ctx, mock.Anything).Maybe().Return(nil)
 			mockSel := mocks.NewReviewerSelector(t)
 			log := logger.New("dev")
 			if tt.setup != nil {
@@ -115,8 +139,8 @@ func TestPRService_CreatePR(t *testing.T) {
 			}
 			mockTx.EXPECT().PRRepository().Maybe().Return(mockPRRepo)
 			mockTx.EXPECT().UserRepository().Maybe().Return(mockUserRepo)
-			svc := app.NewService(mockUOW, mockSel, log)
-			pr, err := svc.CreatePR(ctx, prID, authorID, tt.title)
+			svc := app.NewService(mockUOW, services.SingleSelector(mockSel), newFeed(t), log)
+			pr, err := svc.CreatePR(ctx, prID, authorID, tt.title, nil, nil)
 			if tt.wantErr != nil {
 				require.Error(t, err)
 				require.ErrorIs(t, err, tt.wantErr)
@@ -129,6 +153,135 @@ func TestPRService_CreatePR(t *testing.T) {
 	}
 }
 
+func TestPRService_CreatePR_ChangedFiles(t *testing.T) {
+	ctx := context.Background()
+	authorID := "user-author"
+	teamID := uuid.New()
+	files := []string{"internal/db/conn.go", "", "README.md"}
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockTx.EXPECT().TeamRepository().Maybe().Return(mockTeamRepo)
+	mockTeamRepo.EXPECT().GetTeamByID(ctx, mock.Anything).Maybe().Return(&models.Team{}, nil)
+	mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, mock.Anything).Maybe().Return(nil, nil)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockPRRepo.EXPECT().AppendSelectionExplanation(ctx, mock.Anything).Maybe().Return(nil)
+	mockSel := mocks.NewReviewerSelector(t)
+
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+	mockUserRepo.EXPECT().GetUserByID(ctx, authorID).Return(&models.User{ID: authorID}, nil)
+	mockUserRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+	mockUserRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, "u1"), nil)
+	mockSel.EXPECT().Select(ctx, mockTx, mock.MatchedBy(func(req services.SelectionRequest) bool {
+		return len(req.FilePaths) == 2 && req.FilePaths[0] == "internal/db/conn.go" && req.FilePaths[1] == "README.md"
+	})).Return(&services.Selection{ReviewerIDs: []string{"u1"}}, nil)
+	mockPRRepo.EXPECT().CreatePR(ctx, mock.MatchedBy(func(pr *models.PullRequest) bool { return len(pr.ChangedFiles) == 2 })).Return(nil)
+	mockPRRepo.EXPECT().AppendReviewerHistory(ctx, mock.Anything).Return(nil)
+	mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Return(nil)
+	mockPRRepo.EXPECT().AppendFeedEvents(ctx, mock.Anything).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	svc := app.NewService(mockUOW, services.SingleSelector(mockSel), newFeed(t), logger.New("dev"))
+	pr, err := svc.CreatePR(ctx, "pr-files", authorID, "feat", files, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"internal/db/conn.go", "README.md"}, pr.ChangedFiles)
+}
+
+func TestPRService_CreatePR_Labels(t *testing.T) {
+	ctx := context.Background()
+	authorID := "user-author"
+	teamID := uuid.New()
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockTx.EXPECT().TeamRepository().Maybe().Return(mockTeamRepo)
+	mockTeamRepo.EXPECT().GetTeamByID(ctx, mock.Anything).Maybe().Return(&models.Team{}, nil)
+	mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, mock.Anything).Maybe().Return(nil, nil)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockPRRepo.EXPECT().AppendSelectionExplanation(ctx, mock.Anything).Maybe().Return(nil)
+	mockSel := mocks.NewReviewerSelector(t)
+
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+	mockUserRepo.EXPECT().GetUserByID(ctx, authorID).Return(&models.User{ID: authorID}, nil)
+	mockUserRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+	mockUserRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, "u1"), nil)
+	mockSel.EXPECT().Select(ctx, mockTx, mock.MatchedBy(func(req services.SelectionRequest) bool {
+		return len(req.Labels) == 2 && req.Labels[0] == "go" && req.Labels[1] == "postgres"
+	})).Return(&services.Selection{ReviewerIDs: []string{"u1"}}, nil)
+	mockPRRepo.EXPECT().CreatePR(ctx, mock.MatchedBy(func(pr *models.PullRequest) bool { return len(pr.Labels) == 2 })).Return(nil)
+	mockPRRepo.EXPECT().AppendReviewerHistory(ctx, mock.Anything).Return(nil)
+	mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Return(nil)
+	mockPRRepo.EXPECT().AppendFeedEvents(ctx, mock.Anything).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	svc := app.NewService(mockUOW, services.SingleSelector(mockSel), newFeed(t), logger.New("dev"))
+	pr, err := svc.CreatePR(ctx, "pr-labels", authorID, "feat", nil, []string{"Postgres", "go", "GO"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"go", "postgres"}, pr.Labels)
+
+	_, err = svc.CreatePR(ctx, "pr-bad", authorID, "feat", nil, []string{"bad label"})
+	require.ErrorIs(t, err, utils.ErrInvalidTag)
+}
+
+func TestPRService_CreatePR_ResolvesTeamStrategy(t *testing.T) {
+	ctx := context.Background()
+	authorID := "user-author"
+	teamID := uuid.New()
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockPRRepo.EXPECT().AppendSelectionExplanation(ctx, mock.Anything).Maybe().Return(nil)
+	defaultSel := mocks.NewReviewerSelector(t)
+	teamSel := mocks.NewReviewerSelector(t)
+
+	var gotParams json.RawMessage
+	reg := services.NewSelectorRegistry("default")
+	reg.Register("default", func(json.RawMessage) (services.ReviewerSelector, error) { return defaultSel, nil })
+	reg.Register("team", func(p json.RawMessage) (services.ReviewerSelector, error) {
+		gotParams = p
+		return teamSel, nil
+	})
+
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockTx.EXPECT().TeamRepository().Return(mockTeamRepo)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+	mockUserRepo.EXPECT().GetUserByID(ctx, authorID).Return(&models.User{ID: authorID}, nil)
+	mockUserRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+	mockUserRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, "u1"), nil)
+	mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, teamID).Return(nil, nil)
+	mockTeamRepo.EXPECT().GetTeamByID(ctx, teamID).Return(&models.Team{ID: teamID, SelectionStrategy: "team", SelectionParams: json.RawMessage(`{"k":1}`)}, nil)
+	teamSel.EXPECT().Select(ctx, mockTx, mock.Anything).Return(&services.Selection{ReviewerIDs: []string{"u1"}}, nil)
+	mockPRRepo.EXPECT().CreatePR(ctx, mock.Anything).Return(nil)
+	mockPRRepo.EXPECT().AppendReviewerHistory(ctx, mock.Anything).Return(nil)
+	mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Return(nil)
+	mockPRRepo.EXPECT().AppendFeedEvents(ctx, mock.Anything).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	svc := app.NewService(mockUOW, reg, newFeed(t), logger.New("dev"))
+	pr, err := svc.CreatePR(ctx, "pr-strategy", authorID, "feat", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"u1"}, pr.ReviewerIDs)
+	require.JSONEq(t, `{"k":1}`, string(gotParams))
+}
+
 func TestPRService_ReassignReviewer(t *testing.T) {
 	ctx := context.Background()
 	prID := "pr-2"
@@ -145,33 +298,55 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 		{
 			name: "happy replace",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, sel *mocks.ReviewerSelector) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().PRRepository().Return(prRepo)
 				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: models.PRStatusOPEN, ReviewerIDs: []string{oldID}}, nil)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
-				userRepo.EXPECT().ListActiveMembersByTeamID(ctx, teamID).Return([]string{authorID, oldID, newID}, nil)
-				sel.EXPECT().Select(mock.MatchedBy(func(ids []string) bool { return len(ids) == 1 && ids[0] == newID }), 1).Return([]string{newID})
+				userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, oldID, newID), nil)
+				sel.EXPECT().Select(ctx, tx, mock.MatchedBy(func(req services.SelectionRequest) bool {
+					return len(req.Candidates) == 1 && req.Candidates[0] == newID && req.Count == 1
+				})).Return(&services.Selection{ReviewerIDs: []string{newID}}, nil)
 				prRepo.EXPECT().RemoveReviewer(ctx, prID, oldID).Return(nil)
+				prRepo.EXPECT().AppendReviewerHistory(ctx, mock.MatchedBy(func(e *models.ReviewerHistoryEntry) bool {
+					return e.ReviewerID == oldID && e.Event == models.ReviewerEventUnassigned && e.Reason == models.AssignmentReasonManualReassign
+				})).Return(nil)
 				prRepo.EXPECT().AddReviewer(ctx, prID, newID).Return(nil)
+				prRepo.EXPECT().AppendReviewerHistory(ctx, mock.MatchedBy(func(e *models.ReviewerHistoryEntry) bool {
+					return e.ReviewerID == newID && e.Event == models.ReviewerEventAssigned && e.Reason == models.AssignmentReasonManualReassign
+				})).Return(nil)
 				prRepo.EXPECT().GetPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: models.PRStatusOPEN, ReviewerIDs: []string{newID}}, nil)
+				prRepo.EXPECT().AppendFeedEvents(ctx, mock.MatchedBy(func(events []*models.ReviewFeedEvent) bool {
+					return len(events) == 2 && events[0].UserID == oldID && events[0].Type == models.ReviewFeedUnassigned &&
+						events[1].UserID == newID && events[1].Type == models.ReviewFeedAssigned
+				})).Return(nil)
 				tx.EXPECT().Commit(ctx).Return(nil)
 			},
 		},
 		{
 			name: "merged -> error",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, sel *mocks.ReviewerSelector) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().PRRepository().Return(prRepo)
 				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusMERGED}, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil)
 			},
 			wantErr: utils.ErrAlreadyMerged,
 		},
+		{
+			name: "closed -> error",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, sel *mocks.ReviewerSelector) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusCLOSED, ReviewerIDs: []string{oldID}}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrPRClosed,
+		},
 		{
 			name: "old not assigned",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, sel *mocks.ReviewerSelector) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().PRRepository().Return(prRepo)
 				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusOPEN, ReviewerIDs: []string{}}, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -181,12 +356,12 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 		{
 			name: "no candidates",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, sel *mocks.ReviewerSelector) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().PRRepository().Return(prRepo)
 				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: models.PRStatusOPEN, ReviewerIDs: []string{oldID}}, nil)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
-				userRepo.EXPECT().ListActiveMembersByTeamID(ctx, teamID).Return([]string{authorID, oldID}, nil)
+				userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, oldID), nil)
 				tx.EXPECT().Rollback(ctx).Return(nil)
 			},
 			wantErr: utils.ErrNoReplacementCandidates,
@@ -197,15 +372,23 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUOW := mocks.NewUnitOfWork(t)
 			mockTx := mocks.NewTransaction(t)
+			mockTeamRepo := mocks.NewTeamRepository(t)
+			mockTx.EXPECT().TeamRepository().Maybe().Return(mockTeamRepo)
+			mockTeamRepo.EXPECT().GetTeamByID(ctx, mock.Anything).Maybe().Return(&models.Team{}, nil)
+			mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, mock.Anything).Maybe().Return(nil, nil)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
 			mockUserRepo := mocks.NewUserRepository(t)
 			mockPRRepo := mocks.NewPRRepository(t)
+			mockPRRepo.EXPECT().AppendSelectionExplanation(ctx, mock.Anything).Maybe().Return(nil)
 			mockSel := mocks.NewReviewerSelector(t)
 			log := logger.New("dev")
 			if tt.setup != nil {
 				tt.setup(mockUOW, mockTx, mockUserRepo, mockPRRepo, mockSel)
 			}
-			svc := app.NewService(mockUOW, mockSel, log)
-			pr, err := svc.ReassignReviewer(ctx, prID, oldID)
+			svc := app.NewService(mockUOW, services.SingleSelector(mockSel), newFeed(t), log)
+			pr, err := svc.ReassignReviewer(ctx, prID, oldID, "", "", nil)
 			if tt.wantErr != nil {
 				require.Error(t, err)
 				require.ErrorIs(t, err, tt.wantErr)
@@ -229,35 +412,51 @@ func TestPRService_MergePR(t *testing.T) {
 		{
 			name: "open->merged",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().PRRepository().Return(prRepo)
-				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusOPEN}, nil)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusOPEN, ReviewerIDs: []string{"u1"}}, nil)
 				prRepo.EXPECT().UpdateStatus(ctx, prID, models.PRStatusMERGED, mock.Anything).Return(nil)
+				prRepo.EXPECT().AppendFeedEvents(ctx, mock.MatchedBy(func(events []*models.ReviewFeedEvent) bool {
+					return len(events) == 1 && events[0].UserID == "u1" && events[0].Type == models.ReviewFeedMerged
+				})).Return(nil)
 				tx.EXPECT().Commit(ctx).Return(nil)
 			},
 		},
 		{
 			name: "already merged idempotent",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().PRRepository().Return(prRepo)
 				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusMERGED}, nil)
 				tx.EXPECT().Commit(ctx).Return(nil)
 			},
 		},
+		{
+			name: "closed -> error",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusCLOSED}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrPRClosed,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUOW := mocks.NewUnitOfWork(t)
 			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
 			mockPRRepo := mocks.NewPRRepository(t)
 			log := logger.New("dev")
 			if tt.setup != nil {
 				tt.setup(mockUOW, mockTx, mockPRRepo)
 			}
-			svc := app.NewService(mockUOW, mocks.NewReviewerSelector(t), log)
-			pr, err := svc.MergePR(ctx, prID)
+			svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), log)
+			pr, err := svc.MergePR(ctx, prID, nil)
 			if tt.wantErr != nil {
 				require.Error(t, err)
 				require.ErrorIs(t, err, tt.wantErr)
@@ -270,6 +469,102 @@ func TestPRService_MergePR(t *testing.T) {
 	}
 }
 
+func TestPRService_MergePR_IfMatch(t *testing.T) {
+	prID := "pr-etag"
+	t.Run("stale version", func(t *testing.T) {
+		ctx := context.Background()
+		mockUOW := mocks.NewUnitOfWork(t)
+		mockTx := mocks.NewTransaction(t)
+		mockPRRepo := mocks.NewPRRepository(t)
+		mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+		mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+		mockPRRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusOPEN, Version: 3}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+		_, err := svc.MergePR(ctx, prID, utils.ParseIfMatch(`"2"`))
+		require.ErrorIs(t, err, utils.ErrPreconditionFailed)
+	})
+	t.Run("current version", func(t *testing.T) {
+		ctx := context.Background()
+		mockUOW := mocks.NewUnitOfWork(t)
+		mockTx := mocks.NewTransaction(t)
+		mockPRRepo := mocks.NewPRRepository(t)
+		mockAuditRepo := mocks.NewAuditRepository(t)
+		mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+		mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+		mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+		mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Return(nil)
+		mockPRRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusOPEN, Version: 3}, nil)
+		mockPRRepo.EXPECT().UpdateStatus(ctx, prID, models.PRStatusMERGED, mock.Anything).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+
+		svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+		pr, err := svc.MergePR(ctx, prID, utils.ParseIfMatch(`"1", "3"`))
+		require.NoError(t, err)
+		require.Equal(t, int64(4), pr.Version)
+	})
+}
+
+func TestPRService_CloseAndReopen(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-close"
+	tests := []struct {
+		name    string
+		reopen  bool
+		current models.PRStatus
+		want    models.PRStatus
+		wantErr error
+	}{
+		{name: "close open", current: models.PRStatusOPEN, want: models.PRStatusCLOSED},
+		{name: "close closed idempotent", current: models.PRStatusCLOSED, want: models.PRStatusCLOSED},
+		{name: "close merged", current: models.PRStatusMERGED, wantErr: utils.ErrAlreadyMerged},
+		{name: "reopen closed", reopen: true, current: models.PRStatusCLOSED, want: models.PRStatusOPEN},
+		{name: "reopen open idempotent", reopen: true, current: models.PRStatusOPEN, want: models.PRStatusOPEN},
+		{name: "reopen merged", reopen: true, current: models.PRStatusMERGED, wantErr: utils.ErrAlreadyMerged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockPRRepo := mocks.NewPRRepository(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+			mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+			mockPRRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: tt.current}, nil)
+			switch {
+			case tt.wantErr != nil:
+				mockTx.EXPECT().Rollback(ctx).Return(nil)
+			case tt.current == tt.want:
+				mockTx.EXPECT().Commit(ctx).Return(nil)
+			default:
+				action := models.AuditActionPRClose
+				if tt.reopen {
+					action = models.AuditActionPRReopen
+				}
+				mockPRRepo.EXPECT().UpdateStatus(ctx, prID, tt.want, mock.Anything).Return(nil)
+				mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+				mockAuditRepo.EXPECT().Append(ctx, mock.MatchedBy(func(e *models.AuditEntry) bool { return e.Action == action })).Return(nil)
+				mockTx.EXPECT().Commit(ctx).Return(nil)
+			}
+
+			svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+			call := svc.ClosePR
+			if tt.reopen {
+				call = svc.ReopenPR
+			}
+			pr, err := call(ctx, prID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, pr.Status)
+		})
+	}
+}
+
 func TestPRService_GetAndList(t *testing.T) {
 	ctx := context.Background()
 	prID := "pr-get"
@@ -284,13 +579,13 @@ func TestPRService_GetAndList(t *testing.T) {
 		{
 			name: "get success and list with filter",
 			setupGet: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, uowport.TxOptions{ReadOnly: true}, mock.Anything).RunInTx(tx)
 				tx.EXPECT().PRRepository().Return(prRepo)
 				prRepo.EXPECT().GetPRByID(ctx, prID).Return(&models.PullRequest{ID: prID}, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil)
 			},
 			setupList: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, uowport.TxOptions{ReadOnly: true, Replica: true}, mock.Anything).RunInTx(tx)
 				tx.EXPECT().PRRepository().Return(prRepo)
 				prRepo.EXPECT().ListPRsByReviewer(ctx, reviewer, &stOpen).Return([]*models.PullRequest{{ID: prID}}, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -304,7 +599,7 @@ func TestPRService_GetAndList(t *testing.T) {
 			mockTx := mocks.NewTransaction(t)
 			mockPRRepo := mocks.NewPRRepository(t)
 			log := logger.New("dev")
-			svc := app.NewService(mockUOW, mocks.NewReviewerSelector(t), log)
+			svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), log)
 			if tt.setupGet != nil {
 				tt.setupGet(mockUOW, mockTx, mockPRRepo)
 			}
@@ -320,3 +615,887 @@ func TestPRService_GetAndList(t *testing.T) {
 		})
 	}
 }
+
+func TestPRService_SelectionRunsSerializable(t *testing.T) {
+	ctx := context.Background()
+	serializable := uowport.TxOptions{Isolation: uowport.Serializable}
+	dbErr := errors.New("could not serialize access")
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockUOW.EXPECT().Do(ctx, serializable, mock.Anything).Return(dbErr).Times(3)
+	svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+
+	_, err := svc.CreatePR(ctx, "pr-1", "u1", "title", nil, nil)
+	require.ErrorIs(t, err, dbErr)
+	_, err = svc.ReassignReviewer(ctx, "pr-1", "u2", "", "", nil)
+	require.ErrorIs(t, err, dbErr)
+	_, err = svc.AddReviewer(ctx, "pr-1", "u3", nil)
+	require.ErrorIs(t, err, dbErr)
+}
+
+func TestPRService_MergePR_Audited(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-audit"
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockPRRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusOPEN}, nil)
+	mockPRRepo.EXPECT().UpdateStatus(ctx, prID, models.PRStatusMERGED, mock.Anything).Return(nil)
+	mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+	mockAuditRepo.EXPECT().Append(ctx, mock.MatchedBy(func(e *models.AuditEntry) bool {
+		return e.Action == models.AuditActionPRMerge && e.EntityType == models.AuditEntityPR && e.EntityID == prID &&
+			len(e.Before) > 0 && len(e.After) > 0
+	})).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+	pr, err := svc.MergePR(ctx, prID, nil)
+	require.NoError(t, err)
+	require.Equal(t, models.PRStatusMERGED, pr.Status)
+}
+
+func TestPRService_MergePR_PublishesFeedAfterCommit(t *testing.T) {
+	ctx := utils.WithActor(context.Background(), "admin")
+	prID := "pr-feed"
+
+	for _, commitErr := range []error{nil, errors.New("serialization failure")} {
+		mockUOW := mocks.NewUnitOfWork(t)
+		mockTx := mocks.NewTransaction(t)
+		mockPRRepo := mocks.NewPRRepository(t)
+		mockAuditRepo := mocks.NewAuditRepository(t)
+		feed := mocks.NewFeedPublisher(t)
+
+		mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+		mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+		mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+		mockTx.EXPECT().Rollback(ctx).Maybe().Return(nil)
+		mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Return(nil)
+		mockPRRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Title: "Fix", Status: models.PRStatusOPEN, ReviewerIDs: []string{"u1", "u2"}}, nil)
+		mockPRRepo.EXPECT().UpdateStatus(ctx, prID, models.PRStatusMERGED, mock.Anything).Return(nil)
+		mockPRRepo.EXPECT().AppendFeedEvents(ctx, mock.Anything).RunAndReturn(func(_ context.Context, events []*models.ReviewFeedEvent) error {
+			for i, e := range events {
+				e.ID = int64(i + 1)
+			}
+			return nil
+		})
+		mockTx.EXPECT().Commit(ctx).Return(commitErr)
+		if commitErr == nil {
+			feed.EXPECT().Publish(mock.MatchedBy(func(events []*models.ReviewFeedEvent) bool {
+				return len(events) == 2 && events[0].ID == 1 && events[1].ID == 2 &&
+					events[0].UserID == "u1" && events[1].UserID == "u2" &&
+					events[0].Type == models.ReviewFeedMerged && events[0].PRTitle == "Fix" && events[0].Actor == "admin"
+			})).Once()
+		}
+
+		svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), feed, logger.New("dev"))
+		_, err := svc.MergePR(ctx, prID, nil)
+		if commitErr != nil {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+	}
+}
+
+func TestPRService_MergePR_AuditFailureRollsBack(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-audit"
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockPRRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusOPEN}, nil)
+	mockPRRepo.EXPECT().UpdateStatus(ctx, prID, models.PRStatusMERGED, mock.Anything).Return(nil)
+	mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+	mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Return(utils.ErrInternal)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+	pr, err := svc.MergePR(ctx, prID, nil)
+	require.ErrorIs(t, err, utils.ErrInternal)
+	require.Nil(t, pr)
+}
+
+func TestPRService_ReassignReviewer_InvalidReason(t *testing.T) {
+	svc := app.NewService(mocks.NewUnitOfWork(t), services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+	pr, err := svc.ReassignReviewer(context.Background(), "pr-1", "u1", "", models.AssignmentReasonInitial, nil)
+	require.ErrorIs(t, err, utils.ErrInvalidReason)
+	require.Nil(t, pr)
+}
+
+func TestPRService_GetReviewerHistory(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-h"
+
+	tests := []struct {
+		name    string
+		setup   func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository)
+		wantLen int
+		wantErr error
+	}{
+		{
+			name: "timeline returned",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().GetPRByID(ctx, prID).Return(&models.PullRequest{ID: prID}, nil)
+				prRepo.EXPECT().ListReviewerHistory(ctx, prID).Return([]*models.ReviewerHistoryEntry{
+					{PRID: prID, ReviewerID: "u1", Event: models.ReviewerEventAssigned, Reason: models.AssignmentReasonInitial},
+					{PRID: prID, ReviewerID: "u1", Event: models.ReviewerEventUnassigned, Reason: models.AssignmentReasonDeactivation},
+				}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantLen: 2,
+		},
+		{
+			name: "pr not found",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().GetPRByID(ctx, prID).Return(nil, utils.ErrPRNotFound)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrPRNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockPRRepo := mocks.NewPRRepository(t)
+			tt.setup(mockUOW, mockTx, mockPRRepo)
+			svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+			res, err := svc.GetReviewerHistory(ctx, prID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res, tt.wantLen)
+		})
+	}
+}
+
+func TestPRService_CreatePR_RecordsExplanation(t *testing.T) {
+	ctx := context.Background()
+	authorID := "user-author"
+	teamID := uuid.New()
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockTx.EXPECT().TeamRepository().Maybe().Return(mockTeamRepo)
+	mockTeamRepo.EXPECT().GetTeamByID(ctx, mock.Anything).Maybe().Return(&models.Team{}, nil)
+	mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, mock.Anything).Maybe().Return(nil, nil)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+	mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+	mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockSel := mocks.NewReviewerSelector(t)
+
+	members := append(activeUsers("u2", authorID, "u1"), &models.User{ID: "u3"})
+	scores := []models.CandidateScore{
+		{UserID: "u1", Score: 2, Selected: true},
+		{UserID: "u2", Score: 1},
+	}
+
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockUserRepo.EXPECT().GetUserByID(ctx, authorID).Return(&models.User{ID: authorID}, nil)
+	mockUserRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+	mockUserRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(members, nil)
+	mockSel.EXPECT().Select(ctx, mockTx, mock.Anything).
+		Return(&services.Selection{Strategy: "weighted", ReviewerIDs: []string{"u1"}, Scores: scores}, nil)
+	mockPRRepo.EXPECT().CreatePR(ctx, mock.Anything).Return(nil)
+	mockPRRepo.EXPECT().AppendReviewerHistory(ctx, mock.Anything).Return(nil)
+	mockPRRepo.EXPECT().AppendSelectionExplanation(ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, e *models.SelectionExplanation) error {
+			require.Equal(t, "pr-x", e.PRID)
+			require.Equal(t, models.AssignmentReasonInitial, e.Reason)
+			require.Equal(t, "weighted", e.Strategy)
+			require.Equal(t, 2, e.Slots)
+			require.Equal(t, []string{"u1", "u2"}, e.Pool)
+			require.Equal(t, []models.ExcludedCandidate{
+				{UserID: "u3", Reason: models.ExclusionReasonInactive},
+				{UserID: authorID, Reason: models.ExclusionReasonAuthor},
+			}, e.Excluded)
+			require.Equal(t, scores, e.Scores)
+			require.Equal(t, []string{"u1"}, e.Selected)
+			return nil
+		})
+	mockPRRepo.EXPECT().AppendFeedEvents(ctx, mock.Anything).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	svc := app.NewService(mockUOW, services.SingleSelector(mockSel), newFeed(t), logger.New("dev"))
+	_, err := svc.CreatePR(ctx, "pr-x", authorID, "feat", nil, nil)
+	require.NoError(t, err)
+}
+
+func TestPRService_ExplainSelection(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-e"
+
+	t.Run("explanations returned", func(t *testing.T) {
+		mockUOW := mocks.NewUnitOfWork(t)
+		mockTx := mocks.NewTransaction(t)
+		mockPRRepo := mocks.NewPRRepository(t)
+		mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+		mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+		mockPRRepo.EXPECT().GetPRByID(ctx, prID).Return(&models.PullRequest{ID: prID}, nil)
+		mockPRRepo.EXPECT().ListSelectionExplanations(ctx, prID).Return([]*models.SelectionExplanation{
+			{PRID: prID, Reason: models.AssignmentReasonInitial},
+		}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+		res, err := svc.ExplainSelection(ctx, prID)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+	})
+
+	t.Run("pr not found", func(t *testing.T) {
+		mockUOW := mocks.NewUnitOfWork(t)
+		mockTx := mocks.NewTransaction(t)
+		mockPRRepo := mocks.NewPRRepository(t)
+		mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+		mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+		mockPRRepo.EXPECT().GetPRByID(ctx, prID).Return(nil, utils.ErrPRNotFound)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+		_, err := svc.ExplainSelection(ctx, prID)
+		require.ErrorIs(t, err, utils.ErrPRNotFound)
+	})
+}
+
+func TestPRService_ListPRs(t *testing.T) {
+	ctx := context.Background()
+	open := models.PRStatusOPEN
+	closed := models.PRStatusCLOSED
+	bogus := models.PRStatus("DRAFT")
+
+	tests := []struct {
+		name      string
+		filter    models.PRFilter
+		setup     func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository, teamRepo *mocks.TeamRepository)
+		wantLen   int
+		wantTotal int
+		wantLimit int
+		wantErr   error
+	}{
+		{
+			name:   "default limit applied",
+			filter: models.PRFilter{AuthorID: "u1", Status: &open},
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository, teamRepo *mocks.TeamRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().ListPRs(ctx, models.PRFilter{AuthorID: "u1", Status: &open, Limit: app.DefaultListLimit}).
+					Return([]*models.PullRequest{{ID: "pr-1"}, {ID: "pr-2"}}, 7, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantLen:   2,
+			wantTotal: 7,
+			wantLimit: app.DefaultListLimit,
+		},
+		{
+			name:   "closed status accepted",
+			filter: models.PRFilter{Status: &closed, Limit: 10},
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository, teamRepo *mocks.TeamRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().ListPRs(ctx, models.PRFilter{Status: &closed, Limit: 10}).Return([]*models.PullRequest{{ID: "pr-3"}}, 1, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantLen:   1,
+			wantTotal: 1,
+			wantLimit: 10,
+		},
+		{
+			name:   "team checked before listing",
+			filter: models.PRFilter{TeamName: "core", Limit: 10},
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository, teamRepo *mocks.TeamRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(teamRepo)
+				teamRepo.EXPECT().GetTeamByName(ctx, "core").Return(&models.Team{Name: "core"}, nil)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().ListPRs(ctx, models.PRFilter{TeamName: "core", Limit: 10}).Return(nil, 0, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantLimit: 10,
+		},
+		{
+			name:   "unknown team",
+			filter: models.PRFilter{TeamName: "absent"},
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository, teamRepo *mocks.TeamRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(teamRepo)
+				teamRepo.EXPECT().GetTeamByName(ctx, "absent").Return(nil, utils.ErrTeamNotFound)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrTeamNotFound,
+		},
+		{
+			name:    "limit too large",
+			filter:  models.PRFilter{Limit: app.MaxListLimit + 1},
+			setup:   func(*mocks.UnitOfWork, *mocks.Transaction, *mocks.PRRepository, *mocks.TeamRepository) {},
+			wantErr: utils.ErrInvalidArgument,
+		},
+		{
+			name:    "unknown status",
+			filter:  models.PRFilter{Status: &bogus},
+			setup:   func(*mocks.UnitOfWork, *mocks.Transaction, *mocks.PRRepository, *mocks.TeamRepository) {},
+			wantErr: utils.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockPRRepo := mocks.NewPRRepository(t)
+			mockTeamRepo := mocks.NewTeamRepository(t)
+			tt.setup(mockUOW, mockTx, mockPRRepo, mockTeamRepo)
+			svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+			page, err := svc.ListPRs(ctx, tt.filter)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, page.PullRequests, tt.wantLen)
+			require.Equal(t, tt.wantTotal, page.Total)
+			require.Equal(t, tt.wantLimit, page.Limit)
+		})
+	}
+}
+
+func TestPRService_ReassignReviewer_ExplicitTarget(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-2"
+	authorID := "user-author"
+	teamID := uuid.New()
+	openPR := func() *models.PullRequest {
+		return &models.PullRequest{ID: prID, AuthorID: authorID, Status: models.PRStatusOPEN, ReviewerIDs: []string{"u-old", "u-other"}}
+	}
+
+	tests := []struct {
+		name    string
+		newID   string
+		setup   func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository)
+		wantErr error
+	}{
+		{
+			name:  "chosen reviewer assigned without selector",
+			newID: "u-new",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(openPR(), nil)
+				tx.EXPECT().UserRepository().Return(userRepo)
+				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+				userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, "u-old", "u-other", "u-new"), nil)
+				prRepo.EXPECT().RemoveReviewer(ctx, prID, "u-old").Return(nil)
+				prRepo.EXPECT().AddReviewer(ctx, prID, "u-new").Return(nil)
+				prRepo.EXPECT().AppendReviewerHistory(ctx, mock.Anything).Return(nil).Times(2)
+				prRepo.EXPECT().GetPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, ReviewerIDs: []string{"u-other", "u-new"}}, nil)
+				prRepo.EXPECT().AppendFeedEvents(ctx, mock.Anything).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{
+			name:  "inactive or foreign user rejected",
+			newID: "u-stranger",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(openPR(), nil)
+				tx.EXPECT().UserRepository().Return(userRepo)
+				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+				userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, "u-old", "u-other"), nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrReviewerNotEligible,
+		},
+		{
+			name:  "author rejected",
+			newID: authorID,
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(openPR(), nil)
+				tx.EXPECT().UserRepository().Return(userRepo)
+				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+				userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, "u-old", "u-other"), nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrReviewerNotEligible,
+		},
+		{
+			name:  "already assigned rejected",
+			newID: "u-other",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(openPR(), nil)
+				tx.EXPECT().UserRepository().Return(userRepo)
+				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+				userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, "u-old", "u-other"), nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrReviewerAlreadyAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockTeamRepo := mocks.NewTeamRepository(t)
+			mockTx.EXPECT().TeamRepository().Maybe().Return(mockTeamRepo)
+			mockTeamRepo.EXPECT().GetTeamByID(ctx, mock.Anything).Maybe().Return(&models.Team{}, nil)
+			mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, mock.Anything).Maybe().Return(nil, nil)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockPRRepo := mocks.NewPRRepository(t)
+			mockPRRepo.EXPECT().AppendSelectionExplanation(ctx, mock.Anything).Maybe().Return(nil)
+			tt.setup(mockUOW, mockTx, mockUserRepo, mockPRRepo)
+			svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+			pr, err := svc.ReassignReviewer(ctx, prID, "u-old", tt.newID, "", nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, pr)
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, pr.ReviewerIDs, tt.newID)
+		})
+	}
+}
+
+func TestPRService_Constraints(t *testing.T) {
+	ctx := context.Background()
+	authorID := "junior"
+	teamID := uuid.New()
+	constraints := []models.ReviewerConstraint{
+		{Kind: models.ConstraintMustIncludeOneOf, AuthorIDs: []string{authorID}, ReviewerIDs: []string{"senior"}},
+		{Kind: models.ConstraintNeverPair, AuthorIDs: []string{authorID}, ReviewerIDs: []string{"friend"}},
+	}
+
+	newHarness := func(t *testing.T) (*mocks.UnitOfWork, *mocks.Transaction, *mocks.UserRepository, *mocks.PRRepository) {
+		mockUOW := mocks.NewUnitOfWork(t)
+		mockTx := mocks.NewTransaction(t)
+		mockTeamRepo := mocks.NewTeamRepository(t)
+		mockTx.EXPECT().TeamRepository().Maybe().Return(mockTeamRepo)
+		mockTeamRepo.EXPECT().GetTeamByID(ctx, teamID).Maybe().Return(&models.Team{}, nil)
+		mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, teamID).Return(constraints, nil)
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockPRRepo := mocks.NewPRRepository(t)
+		mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+		mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+		mockTx.EXPECT().PRRepository().Maybe().Return(mockPRRepo)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockUserRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+		return mockUOW, mockTx, mockUserRepo, mockPRRepo
+	}
+
+	t.Run("create without available senior fails", func(t *testing.T) {
+		mockUOW, _, mockUserRepo, _ := newHarness(t)
+		mockUserRepo.EXPECT().GetUserByID(ctx, authorID).Return(&models.User{ID: authorID}, nil)
+		mockUserRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(append(activeUsers(authorID, "friend", "u1"), &models.User{ID: "senior"}), nil)
+
+		svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+		_, err := svc.CreatePR(ctx, "pr-c", authorID, "feat", nil, nil)
+		require.ErrorIs(t, err, utils.ErrConstraintsUnsatisfied)
+	})
+
+	t.Run("explicit never-pair target rejected", func(t *testing.T) {
+		mockUOW, _, mockUserRepo, mockPRRepo := newHarness(t)
+		mockPRRepo.EXPECT().LockPRByID(ctx, "pr-r").Return(&models.PullRequest{ID: "pr-r", AuthorID: authorID, Status: models.PRStatusOPEN, ReviewerIDs: []string{"senior", "u1"}}, nil)
+		mockUserRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, "senior", "u1", "friend"), nil)
+
+		svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+		_, err := svc.ReassignReviewer(ctx, "pr-r", "u1", "friend", "", nil)
+		require.ErrorIs(t, err, utils.ErrConstraintsUnsatisfied)
+	})
+
+	t.Run("explicit target cannot drop the only senior", func(t *testing.T) {
+		mockUOW, _, mockUserRepo, mockPRRepo := newHarness(t)
+		mockPRRepo.EXPECT().LockPRByID(ctx, "pr-r").Return(&models.PullRequest{ID: "pr-r", AuthorID: authorID, Status: models.PRStatusOPEN, ReviewerIDs: []string{"senior", "u1"}}, nil)
+		mockUserRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers(authorID, "senior", "u1", "u2"), nil)
+
+		svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+		_, err := svc.ReassignReviewer(ctx, "pr-r", "senior", "u2", "", nil)
+		require.ErrorIs(t, err, utils.ErrConstraintsUnsatisfied)
+	})
+}
+
+func TestPRService_BatchCreatePRs(t *testing.T) {
+	ctx := context.Background()
+	teamID := uuid.New()
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockTx.EXPECT().TeamRepository().Maybe().Return(mockTeamRepo)
+	mockTeamRepo.EXPECT().GetTeamByID(ctx, teamID).Maybe().Return(&models.Team{}, nil)
+	mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, teamID).Return(nil, nil)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+	mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockSel := mocks.NewReviewerSelector(t)
+
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockPRRepo.EXPECT().ExistingPRIDs(ctx, []string{"old", "auto", "preset", "auto", "bad", "ghost", "race"}).
+		Return(map[string]struct{}{"old": {}}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, "a1").Return(&models.User{ID: "a1"}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, "ghost").Return(nil, utils.ErrUserNotFound)
+	mockUserRepo.EXPECT().GetTeamIDByUserID(ctx, "a1").Return(teamID, nil)
+	mockUserRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers("a1", "u1", "u2"), nil)
+	mockSel.EXPECT().Select(ctx, mockTx, mock.Anything).Return(&services.Selection{ReviewerIDs: []string{"u1"}}, nil).Times(2)
+	mockPRRepo.EXPECT().CreatePRs(ctx, mock.MatchedBy(func(prs []*models.PullRequest) bool {
+		return len(prs) == 3 && prs[1].ID == "preset" && len(prs[1].ReviewerIDs) == 1 && prs[1].ReviewerIDs[0] == "u2"
+	})).Return(map[string]struct{}{"auto": {}, "preset": {}}, nil)
+	mockPRRepo.EXPECT().AppendReviewerHistoryBatch(ctx, mock.MatchedBy(func(entries []*models.ReviewerHistoryEntry) bool {
+		return len(entries) == 2 && entries[0].PRID == "auto" && entries[1].ReviewerID == "u2"
+	})).Return(nil)
+	mockPRRepo.EXPECT().AppendSelectionExplanation(ctx, mock.MatchedBy(func(e *models.SelectionExplanation) bool { return e.PRID == "auto" })).Return(nil)
+	mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Return(nil).Times(2)
+	mockPRRepo.EXPECT().AppendFeedEvents(ctx, mock.Anything).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	svc := app.NewService(mockUOW, services.SingleSelector(mockSel), newFeed(t), logger.New("dev"))
+	res, err := svc.BatchCreatePRs(ctx, []*models.BatchPRItem{
+		{ID: "old", Title: "t", AuthorID: "a1"},
+		{ID: "auto", Title: "t", AuthorID: "a1"},
+		{ID: "preset", Title: "t", AuthorID: "a1", ReviewerIDs: []string{"u2"}},
+		{ID: "auto", Title: "t", AuthorID: "a1"},
+		{ID: "bad", Title: "", AuthorID: "a1"},
+		{ID: "ghost", Title: "t", AuthorID: "ghost"},
+		{ID: "race", Title: "t", AuthorID: "a1"},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 7)
+	want := []models.BatchStatus{
+		models.BatchStatusExists, models.BatchStatusCreated, models.BatchStatusCreated, models.BatchStatusExists,
+		models.BatchStatusError, models.BatchStatusError, models.BatchStatusExists,
+	}
+	for i, st := range want {
+		require.Equal(t, st, res[i].Status, "item %d", i)
+	}
+	require.Equal(t, []string{"u1"}, res[1].PR.ReviewerIDs)
+	require.ErrorIs(t, res[4].Err, utils.ErrInvalidArgument)
+	require.ErrorIs(t, res[5].Err, utils.ErrUserNotFound)
+}
+
+func TestPRService_BatchCreatePRs_SpreadsLoad(t *testing.T) {
+	ctx := context.Background()
+	teamID := uuid.New()
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().TeamRepository().Return(mockTeamRepo)
+	mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, teamID).Return(nil, nil)
+	mockTeamRepo.EXPECT().GetTeamByID(ctx, teamID).Return(&models.Team{ID: teamID}, nil)
+	mockPRRepo.EXPECT().ExistingPRIDs(ctx, []string{"pr-1", "pr-2"}).Return(map[string]struct{}{}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, "a1").Return(&models.User{ID: "a1"}, nil)
+	mockUserRepo.EXPECT().GetTeamIDByUserID(ctx, "a1").Return(teamID, nil)
+	mockUserRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers("a1", "u1", "u2", "u3", "u4"), nil)
+	mockUserRepo.EXPECT().ListUsersByIDs(ctx, mock.Anything).Return(nil, nil)
+	// в БД ни у кого нет открытых ревью: различать кандидатов может только нагрузка от самой пачки
+	mockPRRepo.EXPECT().CountOpenReviewsByReviewers(ctx, mock.Anything).Return(map[string]int{}, nil)
+	mockPRRepo.EXPECT().CreatePRs(ctx, mock.Anything).Return(map[string]struct{}{"pr-1": {}, "pr-2": {}}, nil)
+	mockPRRepo.EXPECT().AppendReviewerHistoryBatch(ctx, mock.Anything).Return(nil)
+	mockPRRepo.EXPECT().AppendSelectionExplanation(ctx, mock.Anything).Return(nil)
+	mockPRRepo.EXPECT().AppendFeedEvents(ctx, mock.Anything).Return(nil)
+	mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	weighted := reviewerselector.NewWeightedSelector(reviewerselector.WeightedParams{LoadPenalty: 1})
+	svc := app.NewService(mockUOW, services.SingleSelector(weighted), newFeed(t), logger.New("dev"))
+	res, err := svc.BatchCreatePRs(ctx, []*models.BatchPRItem{
+		{ID: "pr-1", Title: "t", AuthorID: "a1"},
+		{ID: "pr-2", Title: "t", AuthorID: "a1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"u1", "u2"}, res[0].PR.ReviewerIDs)
+	require.Equal(t, []string{"u3", "u4"}, res[1].PR.ReviewerIDs)
+}
+
+func TestPRService_BatchCreatePRs_DBErrorAbortsBatch(t *testing.T) {
+	ctx := context.Background()
+	dbErr := errors.New("serialization failure")
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+	mockPRRepo.EXPECT().ExistingPRIDs(ctx, []string{"a", "b"}).Return(map[string]struct{}{}, nil)
+	// после ошибки БД транзакция прервана: второй элемент не обрабатывается, ошибка уходит в Do для повтора
+	mockUserRepo.EXPECT().GetUserByID(ctx, "a1").Return(nil, dbErr).Once()
+
+	svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+	_, err := svc.BatchCreatePRs(ctx, []*models.BatchPRItem{
+		{ID: "a", Title: "t", AuthorID: "a1"},
+		{ID: "b", Title: "t", AuthorID: "a1"},
+	})
+	require.ErrorIs(t, err, dbErr)
+}
+
+func TestPRService_BatchCreatePRs_Limits(t *testing.T) {
+	svc := app.NewService(mocks.NewUnitOfWork(t), services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+	_, err := svc.BatchCreatePRs(context.Background(), nil)
+	require.ErrorIs(t, err, utils.ErrInvalidArgument)
+
+	items := make([]*models.BatchPRItem, app.MaxBatchSize+1)
+	_, err = svc.BatchCreatePRs(context.Background(), items)
+	require.ErrorIs(t, err, utils.ErrBatchTooLarge)
+}
+
+func TestPRService_AddReviewer(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-3"
+	authorID := "user-author"
+	teamID := uuid.New()
+
+	tests := []struct {
+		name    string
+		setup   func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, teamRepo *mocks.TeamRepository)
+		wantErr error
+	}{
+		{
+			name: "added with manual reason",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, teamRepo *mocks.TeamRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: models.PRStatusOPEN}, nil)
+				tx.EXPECT().UserRepository().Return(userRepo)
+				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+				userRepo.EXPECT().ListActiveMembersByTeamID(ctx, teamID).Return([]string{authorID, "u1"}, nil)
+				tx.EXPECT().TeamRepository().Return(teamRepo)
+				teamRepo.EXPECT().ListReviewerConstraints(ctx, teamID).Return(nil, nil)
+				prRepo.EXPECT().AddReviewer(ctx, prID, "u1").Return(nil)
+				prRepo.EXPECT().AppendReviewerHistory(ctx, mock.MatchedBy(func(e *models.ReviewerHistoryEntry) bool {
+					return e.ReviewerID == "u1" && e.Event == models.ReviewerEventAssigned && e.Reason == models.AssignmentReasonManual
+				})).Return(nil)
+				prRepo.EXPECT().GetPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, ReviewerIDs: []string{"u1"}}, nil)
+				prRepo.EXPECT().AppendFeedEvents(ctx, mock.MatchedBy(func(events []*models.ReviewFeedEvent) bool {
+					return len(events) == 1 && events[0].Type == models.ReviewFeedAssigned && events[0].Reason == models.AssignmentReasonManual
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{
+			name: "reviewer cap reached",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, teamRepo *mocks.TeamRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: models.PRStatusOPEN, ReviewerIDs: []string{"u2", "u3"}}, nil)
+				tx.EXPECT().UserRepository().Return(userRepo)
+				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+				userRepo.EXPECT().ListActiveMembersByTeamID(ctx, teamID).Return([]string{authorID, "u1", "u2", "u3"}, nil)
+				tx.EXPECT().TeamRepository().Return(teamRepo)
+				teamRepo.EXPECT().ListReviewerConstraints(ctx, teamID).Return(nil, nil)
+				prRepo.EXPECT().AddReviewer(ctx, prID, "u1").Return(utils.ErrTooManyReviewers)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrTooManyReviewers,
+		},
+		{
+			name: "never_pair with author",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, teamRepo *mocks.TeamRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: models.PRStatusOPEN}, nil)
+				tx.EXPECT().UserRepository().Return(userRepo)
+				userRepo.EXPECT().GetTeamIDByUserID(ctx, authorID).Return(teamID, nil)
+				userRepo.EXPECT().ListActiveMembersByTeamID(ctx, teamID).Return([]string{authorID, "u1"}, nil)
+				tx.EXPECT().TeamRepository().Return(teamRepo)
+				teamRepo.EXPECT().ListReviewerConstraints(ctx, teamID).Return([]models.ReviewerConstraint{
+					{Kind: models.ConstraintNeverPair, AuthorIDs: []string{authorID}, ReviewerIDs: []string{"u1"}},
+				}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrConstraintsUnsatisfied,
+		},
+		{
+			name: "merged pr",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, prRepo *mocks.PRRepository, teamRepo *mocks.TeamRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusMERGED}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrAlreadyMerged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockPRRepo := mocks.NewPRRepository(t)
+			mockTeamRepo := mocks.NewTeamRepository(t)
+			tt.setup(mockUOW, mockTx, mockUserRepo, mockPRRepo, mockTeamRepo)
+			svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+			pr, err := svc.AddReviewer(ctx, prID, "u1", nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []string{"u1"}, pr.ReviewerIDs)
+		})
+	}
+}
+
+func TestPRService_RemoveReviewer(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-4"
+
+	tests := []struct {
+		name    string
+		setup   func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository)
+		wantErr error
+	}{
+		{
+			name: "removed with manual reason",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusOPEN, ReviewerIDs: []string{"u1", "u2"}}, nil)
+				prRepo.EXPECT().RemoveReviewer(ctx, prID, "u1").Return(nil)
+				prRepo.EXPECT().AppendReviewerHistory(ctx, mock.MatchedBy(func(e *models.ReviewerHistoryEntry) bool {
+					return e.ReviewerID == "u1" && e.Event == models.ReviewerEventUnassigned && e.Reason == models.AssignmentReasonManual
+				})).Return(nil)
+				prRepo.EXPECT().GetPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, ReviewerIDs: []string{"u2"}}, nil)
+				prRepo.EXPECT().AppendFeedEvents(ctx, mock.MatchedBy(func(events []*models.ReviewFeedEvent) bool {
+					return len(events) == 1 && events[0].Type == models.ReviewFeedUnassigned
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{
+			name: "not assigned",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, prRepo *mocks.PRRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().LockPRByID(ctx, prID).Return(&models.PullRequest{ID: prID, Status: models.PRStatusOPEN, ReviewerIDs: []string{"u2"}}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrReviewerNotAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
+			mockPRRepo := mocks.NewPRRepository(t)
+			tt.setup(mockUOW, mockTx, mockPRRepo)
+			svc := app.NewService(mockUOW, services.SingleSelector(mocks.NewReviewerSelector(t)), newFeed(t), logger.New("dev"))
+			pr, err := svc.RemoveReviewer(ctx, prID, "u1", nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []string{"u2"}, pr.ReviewerIDs)
+		})
+	}
+}
+
+// newFeed возвращает издателя ленты, который принимает любые события.
+func newFeed(t *testing.T) *mocks.FeedPublisher {
+	feed := mocks.NewFeedPublisher(t)
+	feed.EXPECT().Publish(mock.Anything).Maybe()
+	return feed
+}
+
+func activeUsers(ids ...string) []*models.User {
+	res := make([]*models.User, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, &models.User{ID: id, IsActive: true})
+	}
+	return res
+}
+
+func TestPRService_ReleaseTeamReviews(t *testing.T) {
+	ctx := context.Background()
+	teamID := uuid.New()
+
+	mockTx := mocks.NewTransaction(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockTx.EXPECT().TeamRepository().Return(mockTeamRepo)
+	mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+	mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Return(nil)
+	mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, teamID).Return(nil, nil)
+
+	// Список читается в транзакции вызывающего; PR автора из другой команды не трогается.
+	mockPRRepo.EXPECT().ListPRsByReviewer(ctx, "gone", mock.Anything).Return([]*models.PullRequest{
+		{ID: "pr-a", AuthorID: "a", Status: models.PRStatusOPEN, ReviewerIDs: []string{"gone"}},
+		{ID: "pr-x", AuthorID: "stranger", Status: models.PRStatusOPEN, ReviewerIDs: []string{"gone"}},
+		{ID: "pr-b", AuthorID: "a", Status: models.PRStatusOPEN, ReviewerIDs: []string{"gone"}},
+	}, nil)
+	// pr-b смержили между выборкой и переназначением — пропускается.
+	mockPRRepo.EXPECT().LockPRByID(ctx, "pr-b").Return(&models.PullRequest{ID: "pr-b", Status: models.PRStatusMERGED}, nil)
+	mockPRRepo.EXPECT().LockPRByID(ctx, "pr-a").Return(&models.PullRequest{ID: "pr-a", AuthorID: "a", Status: models.PRStatusOPEN, ReviewerIDs: []string{"gone"}}, nil).Times(2)
+	mockUserRepo.EXPECT().GetTeamIDByUserID(ctx, "a").Return(teamID, nil)
+	mockUserRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(activeUsers("a"), nil)
+	mockPRRepo.EXPECT().RemoveReviewer(ctx, "pr-a", "gone").Return(nil)
+	mockPRRepo.EXPECT().AppendReviewerHistory(ctx, mock.Anything).Return(nil)
+	mockPRRepo.EXPECT().AppendFeedEvents(ctx, mock.Anything).Return(nil)
+	mockPRRepo.EXPECT().GetPRByID(ctx, "pr-a").Return(&models.PullRequest{ID: "pr-a"}, nil)
+
+	feed := mocks.NewFeedPublisher(t)
+	releaser := app.NewReviewReleaser(services.SingleSelector(mocks.NewReviewerSelector(t)), feed, logger.New("dev"))
+	got, publish, err := releaser.ReleaseTeamReviews(ctx, mockTx, "gone", []string{"a", "gone"}, models.AssignmentReasonTeamRemoval)
+	require.NoError(t, err)
+	require.Equal(t, []models.ReviewRelease{{PRID: "pr-a", ReviewerID: "gone", Outcome: models.ReleaseOutcomeUnassigned}}, got)
+
+	// события ленты рассылаются только по вызову publish после коммита
+	feed.EXPECT().Publish(mock.MatchedBy(func(events []*models.ReviewFeedEvent) bool {
+		return len(events) == 1 && events[0].PRID == "pr-a" && events[0].Type == models.ReviewFeedUnassigned
+	})).Once()
+	publish()
+}
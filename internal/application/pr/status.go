@@ -0,0 +1,76 @@
+package pr
+
+import (
+	"avito-test-pr-service/internal/application/audit"
+	"avito-test-pr-service/internal/domain/models"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/utils"
+	"context"
+)
+
+// ClosePR закрывает PR без мержа (PR закрыли в VCS). Ревьюверы остаются назначенными, но закрытые PR
+// не учитываются в нагрузке. Повторное закрытие идемпотентно, смерженный PR закрыть нельзя.
+func (s *Service) ClosePR(ctx context.Context, prID string) (*models.PullRequest, error) {
+	return s.setStatus(ctx, prID, models.PRStatusOPEN, models.PRStatusCLOSED, models.AuditActionPRClose)
+}
+
+// ReopenPR возвращает закрытый PR в OPEN; для открытого PR ничего не меняет.
+func (s *Service) ReopenPR(ctx context.Context, prID string) (*models.PullRequest, error) {
+	return s.setStatus(ctx, prID, models.PRStatusCLOSED, models.PRStatusOPEN, models.AuditActionPRReopen)
+}
+
+// requireOpen разрешает менять ревьюверов только у открытого PR.
+func requireOpen(pr *models.PullRequest) error {
+	switch pr.Status {
+	case models.PRStatusOPEN:
+		return nil
+	case models.PRStatusMERGED:
+		return utils.ErrAlreadyMerged
+	case models.PRStatusCLOSED:
+		return utils.ErrPRClosed
+	default:
+		return utils.ErrInvalidStatus
+	}
+}
+
+// setStatus переводит PR из статуса from в to; PR уже в статусе to возвращается без изменений.
+func (s *Service) setStatus(ctx context.Context, prID string, from, to models.PRStatus, action models.AuditAction) (*models.PullRequest, error) {
+	if prID == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	var res *models.PullRequest
+	err := s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		prRepo := tx.PRRepository()
+		pr, err := prRepo.LockPRByID(ctx, prID)
+		if err != nil {
+			return err
+		}
+		switch pr.Status {
+		case to:
+			res = pr
+			return nil
+		case models.PRStatusMERGED:
+			return utils.ErrAlreadyMerged
+		case from:
+		default:
+			return utils.ErrInvalidStatus
+		}
+		before := *pr
+		if err := prRepo.UpdateStatus(ctx, prID, to, nil); err != nil {
+			s.log.Error("SetPRStatus repo failed", "err", err, "pr_id", prID, "status", to)
+			return err
+		}
+		pr.Status = to
+		pr.Version++
+		if err := audit.Record(ctx, tx.AuditRepository(), action, models.AuditEntityPR, prID, before, pr); err != nil {
+			s.log.Error("SetPRStatus audit failed", "err", err, "pr_id", prID)
+			return err
+		}
+		res = pr
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,521 @@
+package team
+
+import (
+	"avito-test-pr-service/internal/application/audit"
+	"avito-test-pr-service/internal/domain/models"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/internal/utils"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// plannedTeam — изменения одной команды импорта, посчитанные до применения.
+type plannedTeam struct {
+	spec     models.RosterTeam
+	team     *models.Team // nil — команда будет создана
+	add      []string
+	remove   []string
+	settings *models.TeamSettings
+	changed  []string
+
+	ownersBefore      []models.CodeOwnerRule
+	constraintsBefore []models.ReviewerConstraint
+}
+
+// ExportRoster выгружает все команды с участниками и настройками, команды и участники упорядочены по имени и id.
+func (s *Service) ExportRoster(ctx context.Context) (*models.Roster, error) {
+	var roster *models.Roster
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		teamRepo := tx.TeamRepository()
+		userRepo := tx.UserRepository()
+		teams, err := teamRepo.ListTeams(ctx)
+		if err != nil {
+			s.log.Error("ExportRoster list teams failed", "err", err)
+			return err
+		}
+		sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+
+		roster = &models.Roster{Teams: make([]models.RosterTeam, 0, len(teams))}
+		for _, team := range teams {
+			users, err := userRepo.ListMembersByTeamID(ctx, team.ID)
+			if err != nil {
+				s.log.Error("ExportRoster list members failed", "err", err, "team_id", team.ID)
+				return err
+			}
+			sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+			owners, err := teamRepo.ListCodeOwners(ctx, team.ID)
+			if err != nil {
+				return err
+			}
+			constraints, err := teamRepo.ListReviewerConstraints(ctx, team.ID)
+			if err != nil {
+				return err
+			}
+			entry := models.RosterTeam{
+				Name:    team.Name,
+				Members: make([]models.RosterMember, 0, len(users)),
+				Settings: &models.TeamSettings{
+					SelectionStrategy:   team.SelectionStrategy,
+					SelectionParams:     team.SelectionParams,
+					CodeOwners:          nonNil(owners),
+					ReviewerConstraints: nonNil(constraints),
+				},
+			}
+			for _, u := range users {
+				entry.Members = append(entry.Members, models.RosterMember{UserID: u.ID, Username: u.Name, IsActive: u.IsActive})
+			}
+			roster.Teams = append(roster.Teams, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roster, nil
+}
+
+// ImportRoster приводит перечисленные команды к составу roster: создаёт недостающих пользователей и команды,
+// обновляет имена и флаги активности, добавляет и удаляет участников, заменяет настройки (если они заданы).
+// Команды, которых нет в roster, не затрагиваются. При dryRun только возвращает diff; иначе применяет его
+// в одной транзакции так же, как CreateTeamWithMembers.
+func (s *Service) ImportRoster(ctx context.Context, roster *models.Roster, dryRun bool) (*models.RosterDiff, error) {
+	res, err := s.importRoster(ctx, roster, dryRun, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Diff, nil
+}
+
+// importRoster применяет roster. Перед применением версии команд сверяются с ifMatch; при sync открытые
+// ревью удалённых участников снимаются в той же транзакции.
+func (s *Service) importRoster(ctx context.Context, roster *models.Roster, dryRun, sync bool, ifMatch *utils.IfMatch) (*models.TeamSync, error) {
+	if roster == nil {
+		return nil, utils.ErrInvalidArgument
+	}
+	members, err := s.normalizeRoster(roster)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		res     *models.TeamSync
+		plans   []*plannedTeam
+		publish []func()
+	)
+	opts := uow.TxOptions{}
+	if sync {
+		// замена ревьюверов выбирается по нагрузке, как в pr.ReassignReviewer
+		opts.Isolation = uow.Serializable
+	}
+	// план строится под блокировками команд, поэтому и dry run идёт в пишущей транзакции
+	err = s.uow.Do(ctx, opts, func(tx uow.Transaction) error {
+		res, publish = &models.TeamSync{Releases: []models.ReviewRelease{}}, nil
+		var err error
+		res.Diff, plans, err = s.planRoster(ctx, tx, roster, members)
+		if err != nil {
+			return err
+		}
+		if err := checkPlannedVersions(ifMatch, plans); err != nil {
+			return err
+		}
+		if dryRun || res.Diff.Empty() {
+			return nil
+		}
+		if err := s.applyRoster(ctx, tx, members, plans); err != nil {
+			return err
+		}
+		if !sync {
+			return nil
+		}
+		res.Releases, publish, err = s.releaseRemoved(ctx, tx, plans)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range publish {
+		p()
+	}
+	if dryRun || res.Diff.Empty() {
+		return res, nil
+	}
+	s.log.Info("ImportRoster success", "teams", len(plans), "created_users", len(res.Diff.CreateUsers),
+		"added_memberships", len(res.Diff.AddMemberships), "removed_memberships", len(res.Diff.RemoveMemberships),
+		"releases", len(res.Releases))
+	return res, nil
+}
+
+// SyncTeam приводит состав команды name к members (создаёт команду, если её нет); повторный вызов с тем же
+// составом ничего не меняет. Это ImportRoster для одной команды без настроек, сверяющий её версию с ifMatch.
+// Открытые ревью удалённых участников в PR авторов команды переназначаются (reason team_removal),
+// а если замены нет — снимаются; всё это в одной транзакции с изменением состава.
+func (s *Service) SyncTeam(ctx context.Context, name string, members []models.RosterMember, dryRun bool, ifMatch *utils.IfMatch) (*models.TeamSync, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	if members == nil {
+		members = []models.RosterMember{}
+	}
+	return s.importRoster(ctx, &models.Roster{Teams: []models.RosterTeam{{Name: name, Members: members}}}, dryRun, true, ifMatch)
+}
+
+// releaseRemoved снимает удалённых участников с открытых ревью PR, авторы которых состоят в той же команде
+// до или после синхронизации. Возвращает рассылки ленты, которые вызываются после коммита.
+func (s *Service) releaseRemoved(ctx context.Context, tx uow.Transaction, plans []*plannedTeam) ([]models.ReviewRelease, []func(), error) {
+	res := []models.ReviewRelease{}
+	var publish []func()
+	for _, plan := range plans {
+		if len(plan.remove) == 0 {
+			continue
+		}
+		authors := slices.Clone(plan.remove)
+		for _, m := range plan.spec.Members {
+			authors = append(authors, m.UserID)
+		}
+		for _, id := range plan.remove {
+			releases, p, err := s.releaser.ReleaseTeamReviews(ctx, tx, id, authors, models.AssignmentReasonTeamRemoval)
+			if err != nil {
+				s.log.Error("SyncTeam release reviews failed", "err", err, "team_name", plan.spec.Name, "user_id", id)
+				return nil, nil, err
+			}
+			res = append(res, releases...)
+			publish = append(publish, p)
+		}
+	}
+	return res, publish, nil
+}
+
+// checkPlannedVersions сверяет версии команд плана с ifMatch; новой команде заданное условие не подходит.
+func checkPlannedVersions(ifMatch *utils.IfMatch, plans []*plannedTeam) error {
+	for _, plan := range plans {
+		if plan.team == nil {
+			if ifMatch != nil {
+				return utils.ErrPreconditionFailed
+			}
+			continue
+		}
+		if err := ifMatch.Check(plan.team.Version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeRoster проверяет roster и возвращает уникальных участников в порядке появления.
+// Пользователь может входить в несколько команд, но с одинаковыми именем и флагом активности.
+func (s *Service) normalizeRoster(roster *models.Roster) ([]models.RosterMember, error) {
+	teamNames := make(map[string]struct{}, len(roster.Teams))
+	byID := make(map[string]models.RosterMember)
+	var members []models.RosterMember
+	for i := range roster.Teams {
+		t := &roster.Teams[i]
+		t.Name = strings.TrimSpace(t.Name)
+		if t.Name == "" {
+			return nil, fmt.Errorf("%w: team #%d: team_name is empty", utils.ErrInvalidArgument, i+1)
+		}
+		if _, dup := teamNames[t.Name]; dup {
+			return nil, fmt.Errorf("%w: team %q listed twice", utils.ErrInvalidArgument, t.Name)
+		}
+		teamNames[t.Name] = struct{}{}
+
+		inTeam := make(map[string]struct{}, len(t.Members))
+		for j := range t.Members {
+			m := &t.Members[j]
+			m.UserID, m.Username = strings.TrimSpace(m.UserID), strings.TrimSpace(m.Username)
+			if m.UserID == "" || m.Username == "" {
+				return nil, fmt.Errorf("%w: team %q: member #%d: user_id and username are required", utils.ErrInvalidArgument, t.Name, j+1)
+			}
+			if _, dup := inTeam[m.UserID]; dup {
+				return nil, fmt.Errorf("%w: team %q: user %s listed twice", utils.ErrInvalidArgument, t.Name, m.UserID)
+			}
+			inTeam[m.UserID] = struct{}{}
+			if prev, ok := byID[m.UserID]; ok {
+				if prev != *m {
+					return nil, fmt.Errorf("%w: user %s has conflicting username or is_active", utils.ErrInvalidArgument, m.UserID)
+				}
+				continue
+			}
+			byID[m.UserID] = *m
+			members = append(members, *m)
+		}
+
+		if t.Settings == nil {
+			continue
+		}
+		if _, err := s.selectors.Resolve(t.Settings.SelectionStrategy, t.Settings.SelectionParams); err != nil {
+			return nil, fmt.Errorf("team %q: %w", t.Name, err)
+		}
+		if len(t.Settings.SelectionParams) == 0 {
+			t.Settings.SelectionParams = json.RawMessage(`{}`)
+		}
+		owners, err := services.NormalizeCodeOwners(t.Settings.CodeOwners)
+		if err != nil {
+			return nil, fmt.Errorf("team %q: %w", t.Name, err)
+		}
+		constraints, err := services.NormalizeConstraints(t.Settings.ReviewerConstraints)
+		if err != nil {
+			return nil, fmt.Errorf("team %q: %w", t.Name, err)
+		}
+		t.Settings.CodeOwners, t.Settings.ReviewerConstraints = owners, constraints
+	}
+	return members, nil
+}
+
+func (s *Service) planRoster(ctx context.Context, tx uow.Transaction, roster *models.Roster, members []models.RosterMember) (*models.RosterDiff, []*plannedTeam, error) {
+	userRepo := tx.UserRepository()
+	teamRepo := tx.TeamRepository()
+	diff := models.NewRosterDiff()
+
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.UserID)
+	}
+	existing := make(map[string]*models.User, len(ids))
+	if len(ids) > 0 {
+		users, err := userRepo.ListUsersByIDs(ctx, ids)
+		if err != nil {
+			s.log.Error("ImportRoster list users failed", "err", err)
+			return nil, nil, err
+		}
+		for _, u := range users {
+			existing[u.ID] = u
+		}
+	}
+	for _, m := range members {
+		u, ok := existing[m.UserID]
+		switch {
+		case !ok:
+			diff.CreateUsers = append(diff.CreateUsers, m)
+			continue
+		case u.Name != m.Username:
+			diff.RenameUsers = append(diff.RenameUsers, models.UserRename{UserID: m.UserID, From: u.Name, To: m.Username})
+		}
+		switch {
+		case u.IsActive && !m.IsActive:
+			diff.DeactivateUsers = append(diff.DeactivateUsers, m.UserID)
+		case !u.IsActive && m.IsActive:
+			diff.ActivateUsers = append(diff.ActivateUsers, m.UserID)
+		}
+	}
+
+	var referenced []string
+	plans := make([]*plannedTeam, 0, len(roster.Teams))
+	for _, spec := range roster.Teams {
+		plan := &plannedTeam{spec: spec, settings: spec.Settings}
+		current := map[string]struct{}{}
+		// Команда блокируется до применения плана, чтобы её состав и версия не изменились между ними.
+		team, err := teamRepo.LockTeamByName(ctx, spec.Name)
+		switch {
+		case errors.Is(err, utils.ErrTeamNotFound):
+			diff.CreateTeams = append(diff.CreateTeams, spec.Name)
+			team = &models.Team{Name: spec.Name}
+		case err != nil:
+			s.log.Error("ImportRoster get team failed", "err", err, "team_name", spec.Name)
+			return nil, nil, err
+		default:
+			plan.team = team
+			users, err := userRepo.ListMembersByTeamID(ctx, team.ID)
+			if err != nil {
+				s.log.Error("ImportRoster list members failed", "err", err, "team_id", team.ID)
+				return nil, nil, err
+			}
+			for _, u := range users {
+				current[u.ID] = struct{}{}
+			}
+		}
+
+		desired := make(map[string]struct{}, len(spec.Members))
+		for _, m := range spec.Members {
+			desired[m.UserID] = struct{}{}
+			if _, ok := current[m.UserID]; !ok {
+				plan.add = append(plan.add, m.UserID)
+				diff.AddMemberships = append(diff.AddMemberships, models.Membership{TeamName: spec.Name, UserID: m.UserID})
+			}
+		}
+		for id := range current {
+			if _, ok := desired[id]; !ok {
+				plan.remove = append(plan.remove, id)
+			}
+		}
+		sort.Strings(plan.remove)
+		for _, id := range plan.remove {
+			diff.RemoveMemberships = append(diff.RemoveMemberships, models.Membership{TeamName: spec.Name, UserID: id})
+		}
+
+		if spec.Settings != nil {
+			if plan.team != nil {
+				if plan.ownersBefore, err = teamRepo.ListCodeOwners(ctx, team.ID); err != nil {
+					return nil, nil, err
+				}
+				if plan.constraintsBefore, err = teamRepo.ListReviewerConstraints(ctx, team.ID); err != nil {
+					return nil, nil, err
+				}
+			}
+			plan.changed = settingsChanges(team, plan.ownersBefore, plan.constraintsBefore, spec.Settings)
+			if len(plan.changed) > 0 {
+				diff.UpdateSettings = append(diff.UpdateSettings, models.SettingsChange{TeamName: spec.Name, Fields: plan.changed})
+			}
+			for _, rule := range spec.Settings.CodeOwners {
+				referenced = append(referenced, rule.OwnerIDs...)
+			}
+			for _, c := range spec.Settings.ReviewerConstraints {
+				referenced = append(referenced, c.AuthorIDs...)
+				referenced = append(referenced, c.ReviewerIDs...)
+			}
+		}
+		plans = append(plans, plan)
+	}
+
+	// Пользователи из настроек должны существовать или создаваться этим же импортом.
+	var missing []string
+	for _, id := range referenced {
+		if _, ok := existing[id]; !ok && !slices.ContainsFunc(diff.CreateUsers, func(m models.RosterMember) bool { return m.UserID == id }) {
+			missing = append(missing, id)
+		}
+	}
+	if err := checkUsersExist(ctx, userRepo, missing); err != nil {
+		return nil, nil, err
+	}
+	return diff, plans, nil
+}
+
+func (s *Service) applyRoster(ctx context.Context, tx uow.Transaction, members []models.RosterMember, plans []*plannedTeam) error {
+	userRepo := tx.UserRepository()
+	teamRepo := tx.TeamRepository()
+	auditRepo := tx.AuditRepository()
+
+	users := make(map[string]*models.User, len(members))
+	for _, m := range members {
+		u, err := s.processTeamMember(ctx, userRepo, auditRepo, &models.User{ID: m.UserID, Name: m.Username, IsActive: m.IsActive})
+		if err != nil {
+			return err
+		}
+		users[u.ID] = u
+	}
+
+	for _, plan := range plans {
+		team := plan.team
+		if team == nil {
+			team = &models.Team{ID: uuid.New(), Name: plan.spec.Name}
+			if err := teamRepo.CreateTeam(ctx, team); err != nil {
+				s.log.Error("ImportRoster create team failed", "err", err, "team_name", team.Name)
+				return err
+			}
+		}
+		for _, id := range plan.add {
+			if err := teamRepo.AddMember(ctx, team.ID, id); err != nil && !errors.Is(err, utils.ErrAlreadyExists) {
+				s.log.Error("ImportRoster add member failed", "err", err, "team_id", team.ID, "user_id", id)
+				return err
+			}
+			if plan.team == nil {
+				continue
+			}
+			if err := audit.Record(ctx, auditRepo, models.AuditActionTeamMemberAdd, models.AuditEntityTeam, team.ID.String(), nil, memberSnapshot{TeamID: team.ID, UserID: id}); err != nil {
+				return err
+			}
+		}
+		for _, id := range plan.remove {
+			if err := teamRepo.RemoveMember(ctx, team.ID, id); err != nil {
+				s.log.Error("ImportRoster remove member failed", "err", err, "team_id", team.ID, "user_id", id)
+				return err
+			}
+			if err := audit.Record(ctx, auditRepo, models.AuditActionTeamMemberRemove, models.AuditEntityTeam, team.ID.String(), memberSnapshot{TeamID: team.ID, UserID: id}, nil); err != nil {
+				return err
+			}
+		}
+		if plan.team == nil {
+			snapshot := teamSnapshot{Team: team}
+			for _, id := range plan.add {
+				snapshot.Members = append(snapshot.Members, users[id])
+			}
+			if err := audit.Record(ctx, auditRepo, models.AuditActionTeamCreate, models.AuditEntityTeam, team.ID.String(), nil, snapshot); err != nil {
+				return err
+			}
+		}
+		if err := s.applySettings(ctx, tx, team, plan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) applySettings(ctx context.Context, tx uow.Transaction, team *models.Team, plan *plannedTeam) error {
+	teamRepo := tx.TeamRepository()
+	auditRepo := tx.AuditRepository()
+	settings := plan.settings
+	for _, field := range plan.changed {
+		var err error
+		switch field {
+		case models.SettingSelectionStrategy:
+			if err = teamRepo.UpdateSelectionStrategy(ctx, team.ID, settings.SelectionStrategy, settings.SelectionParams); err != nil {
+				break
+			}
+			after := *team
+			after.SelectionStrategy, after.SelectionParams = settings.SelectionStrategy, settings.SelectionParams
+			err = audit.Record(ctx, auditRepo, models.AuditActionTeamStrategySet, models.AuditEntityTeam, team.ID.String(), team, after)
+		case models.SettingCodeOwners:
+			if err = teamRepo.ReplaceCodeOwners(ctx, team.ID, settings.CodeOwners); err != nil {
+				break
+			}
+			err = audit.Record(ctx, auditRepo, models.AuditActionTeamCodeOwners, models.AuditEntityTeam, team.ID.String(), plan.ownersBefore, settings.CodeOwners)
+		case models.SettingReviewerConstraints:
+			if err = teamRepo.ReplaceReviewerConstraints(ctx, team.ID, settings.ReviewerConstraints); err != nil {
+				break
+			}
+			err = audit.Record(ctx, auditRepo, models.AuditActionTeamConstraints, models.AuditEntityTeam, team.ID.String(), plan.constraintsBefore, settings.ReviewerConstraints)
+		}
+		if err != nil {
+			s.log.Error("ImportRoster apply settings failed", "err", err, "team_id", team.ID, "field", field)
+			return err
+		}
+	}
+	return nil
+}
+
+// settingsChanges возвращает поля настроек, которые импорт меняет у команды.
+func settingsChanges(team *models.Team, owners []models.CodeOwnerRule, constraints []models.ReviewerConstraint, want *models.TeamSettings) []string {
+	var changed []string
+	if team.SelectionStrategy != want.SelectionStrategy || !jsonEqual(team.SelectionParams, want.SelectionParams) {
+		changed = append(changed, models.SettingSelectionStrategy)
+	}
+	if !slices.EqualFunc(owners, want.CodeOwners, func(a, b models.CodeOwnerRule) bool {
+		return a.Pattern == b.Pattern && slices.Equal(a.OwnerIDs, b.OwnerIDs)
+	}) {
+		changed = append(changed, models.SettingCodeOwners)
+	}
+	if !slices.EqualFunc(constraints, want.ReviewerConstraints, func(a, b models.ReviewerConstraint) bool {
+		return a.Kind == b.Kind && slices.Equal(a.AuthorIDs, b.AuthorIDs) && slices.Equal(a.ReviewerIDs, b.ReviewerIDs)
+	}) {
+		changed = append(changed, models.SettingReviewerConstraints)
+	}
+	return changed
+}
+
+// jsonEqual сравнивает параметры стратегии по значению; пустые параметры равны {}.
+func jsonEqual(a, b json.RawMessage) bool {
+	decode := func(raw json.RawMessage) any {
+		var v any = map[string]any{}
+		if len(bytes.TrimSpace(raw)) > 0 {
+			_ = json.Unmarshal(raw, &v)
+		}
+		return v
+	}
+	return reflect.DeepEqual(decode(a), decode(b))
+}
+
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
@@ -1,25 +1,45 @@
 package team
 
 import (
+	"avito-test-pr-service/internal/application/audit"
 	"avito-test-pr-service/internal/domain/models"
 	"avito-test-pr-service/internal/domain/ports/input"
 	ports "avito-test-pr-service/internal/domain/ports/output"
+	audit_port "avito-test-pr-service/internal/domain/ports/output/audit"
+	team_port "avito-test-pr-service/internal/domain/ports/output/team"
 	uow "avito-test-pr-service/internal/domain/ports/output/uow"
 	user_port "avito-test-pr-service/internal/domain/ports/output/user"
+	"avito-test-pr-service/internal/domain/services"
 	"avito-test-pr-service/internal/utils"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 )
 
 type Service struct {
-	uow uow.UnitOfWork
-	log ports.Logger
+	uow       uow.UnitOfWork
+	selectors services.SelectorResolver
+	releaser  services.ReviewReleaser
+	log       ports.Logger
 }
 
-func NewService(uow uow.UnitOfWork, log ports.Logger) input.TeamInputPort {
-	return &Service{uow: uow, log: log}
+type teamSnapshot struct {
+	Team    *models.Team   `json:"team"`
+	Members []*models.User `json:"members,omitempty"`
+}
+
+type memberSnapshot struct {
+	TeamID uuid.UUID `json:"team_id"`
+	UserID string    `json:"user_id"`
+}
+
+var readOnly = uow.TxOptions{ReadOnly: true}
+
+func NewService(uow uow.UnitOfWork, selectors services.SelectorResolver, releaser services.ReviewReleaser, log ports.Logger) input.TeamInputPort {
+	return &Service{uow: uow, selectors: selectors, releaser: releaser, log: log}
 }
 
 func (s *Service) CreateTeam(ctx context.Context, name string) (*models.Team, error) {
@@ -27,30 +47,21 @@ func (s *Service) CreateTeam(ctx context.Context, name string) (*models.Team, er
 		return nil, utils.ErrInvalidArgument
 	}
 
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		s.log.Error("CreateTeam begin tx failed", "err", err, "name", name)
-		return nil, err
-	}
-	var commit bool
-	defer func() {
-		if !commit {
-			_ = tx.Rollback(ctx)
-		}
-	}()
-
-	repo := tx.TeamRepository()
 	team := &models.Team{ID: uuid.New(), Name: name}
-	if err := repo.CreateTeam(ctx, team); err != nil {
-		s.log.Error("CreateTeam repo failed", "err", err, "name", name)
-		return nil, err
-	}
-
-	if err := tx.Commit(ctx); err != nil {
-		s.log.Error("CreateTeam commit failed", "err", err, "team_id", team.ID)
+	err := s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		if err := tx.TeamRepository().CreateTeam(ctx, team); err != nil {
+			s.log.Error("CreateTeam repo failed", "err", err, "name", name)
+			return err
+		}
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionTeamCreate, models.AuditEntityTeam, team.ID.String(), nil, teamSnapshot{Team: team}); err != nil {
+			s.log.Error("CreateTeam audit failed", "err", err, "team_id", team.ID)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	commit = true
 	s.log.Info("CreateTeam success", "team_id", team.ID, "name", team.Name)
 	return team, nil
 }
@@ -61,41 +72,26 @@ func (s *Service) AddMember(ctx context.Context, teamID uuid.UUID, userID uuid.U
 	}
 	userIDStr := userID.String()
 
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		s.log.Error("AddMember begin tx failed", "err", err, "team_id", teamID, "user_id", userID)
-		return err
-	}
-	var commit bool
-	defer func() {
-		if !commit {
-			_ = tx.Rollback(ctx)
+	return s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		teamrepo := tx.TeamRepository()
+		if _, err := teamrepo.GetTeamByID(ctx, teamID); err != nil {
+			s.log.Error("AddMember team fetch failed", "err", err, "team_id", teamID)
+			return err
 		}
-	}()
-
-	teamrepo := tx.TeamRepository()
-	if _, err := teamrepo.GetTeamByID(ctx, teamID); err != nil {
-		s.log.Error("AddMember team fetch failed", "err", err, "team_id", teamID)
-		return err
-	}
-
-	userrepo := tx.UserRepository()
-	if _, err := userrepo.GetUserByID(ctx, userIDStr); err != nil {
-		s.log.Error("AddMember user fetch failed", "err", err, "user_id", userIDStr, "team_id", teamID)
-		return err
-	}
-
-	if err := teamrepo.AddMember(ctx, teamID, userIDStr); err != nil {
-		s.log.Error("AddMember repo failed", "err", err, "team_id", teamID, "user_id", userIDStr)
-		return err
-	}
-
-	if err := tx.Commit(ctx); err != nil {
-		s.log.Error("AddMember commit failed", "err", err, "team_id", teamID, "user_id", userID)
-		return err
-	}
-	commit = true
-	return nil
+		if _, err := tx.UserRepository().GetUserByID(ctx, userIDStr); err != nil {
+			s.log.Error("AddMember user fetch failed", "err", err, "user_id", userIDStr, "team_id", teamID)
+			return err
+		}
+		if err := teamrepo.AddMember(ctx, teamID, userIDStr); err != nil {
+			s.log.Error("AddMember repo failed", "err", err, "team_id", teamID, "user_id", userIDStr)
+			return err
+		}
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionTeamMemberAdd, models.AuditEntityTeam, teamID.String(), nil, memberSnapshot{TeamID: teamID, UserID: userIDStr}); err != nil {
+			s.log.Error("AddMember audit failed", "err", err, "team_id", teamID, "user_id", userIDStr)
+			return err
+		}
+		return nil
+	})
 }
 
 func (s *Service) RemoveMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error {
@@ -104,42 +100,26 @@ func (s *Service) RemoveMember(ctx context.Context, teamID uuid.UUID, userID uui
 	}
 	userIDStr := userID.String()
 
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		s.log.Error("RemoveMember begin tx failed", "err", err, "team_id", teamID, "user_id", userID)
-		return err
-	}
-	var commit bool
-	defer func() {
-		if !commit {
-			_ = tx.Rollback(ctx)
+	return s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		teamrepo := tx.TeamRepository()
+		if _, err := teamrepo.GetTeamByID(ctx, teamID); err != nil {
+			s.log.Error("RemoveMember team fetch failed", "err", err, "team_id", teamID)
+			return err
 		}
-	}()
-
-	teamrepo := tx.TeamRepository()
-
-	if _, err := teamrepo.GetTeamByID(ctx, teamID); err != nil {
-		s.log.Error("RemoveMember team fetch failed", "err", err, "team_id", teamID)
-		return err
-	}
-
-	userrepo := tx.UserRepository()
-	if _, err := userrepo.GetUserByID(ctx, userIDStr); err != nil {
-		s.log.Error("RemoveMember user fetch failed", "err", err, "user_id", userIDStr, "team_id", teamID)
-		return err
-	}
-
-	if err := teamrepo.RemoveMember(ctx, teamID, userIDStr); err != nil {
-		s.log.Error("RemoveMember repo failed", "err", err, "team_id", teamID, "user_id", userIDStr)
-		return err
-	}
-
-	if err := tx.Commit(ctx); err != nil {
-		s.log.Error("RemoveMember commit failed", "err", err, "team_id", teamID, "user_id", userID)
-		return err
-	}
-	commit = true
-	return nil
+		if _, err := tx.UserRepository().GetUserByID(ctx, userIDStr); err != nil {
+			s.log.Error("RemoveMember user fetch failed", "err", err, "user_id", userIDStr, "team_id", teamID)
+			return err
+		}
+		if err := teamrepo.RemoveMember(ctx, teamID, userIDStr); err != nil {
+			s.log.Error("RemoveMember repo failed", "err", err, "team_id", teamID, "user_id", userIDStr)
+			return err
+		}
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionTeamMemberRemove, models.AuditEntityTeam, teamID.String(), memberSnapshot{TeamID: teamID, UserID: userIDStr}, nil); err != nil {
+			s.log.Error("RemoveMember audit failed", "err", err, "team_id", teamID, "user_id", userIDStr)
+			return err
+		}
+		return nil
+	})
 }
 
 func (s *Service) GetTeam(ctx context.Context, id uuid.UUID) (*models.Team, error) {
@@ -147,33 +127,26 @@ func (s *Service) GetTeam(ctx context.Context, id uuid.UUID) (*models.Team, erro
 		return nil, utils.ErrInvalidArgument
 	}
 
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		s.log.Error("GetTeam begin tx failed", "err", err, "team_id", id)
-		return nil, err
-	}
-	defer func() { _ = tx.Rollback(ctx) }()
-
-	repo := tx.TeamRepository()
-	team, err := repo.GetTeamByID(ctx, id)
+	var team *models.Team
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		var err error
+		team, err = tx.TeamRepository().GetTeamByID(ctx, id)
+		return err
+	})
 	if err != nil {
 		s.log.Error("GetTeam repo failed", "err", err, "team_id", id)
 		return nil, err
 	}
-
 	return team, nil
 }
 
 func (s *Service) ListTeams(ctx context.Context) ([]*models.Team, error) {
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		s.log.Error("ListTeams begin tx failed", "err", err)
-		return nil, err
-	}
-	defer func() { _ = tx.Rollback(ctx) }()
-
-	repo := tx.TeamRepository()
-	res, err := repo.ListTeams(ctx)
+	var res []*models.Team
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		var err error
+		res, err = tx.TeamRepository().ListTeams(ctx)
+		return err
+	})
 	if err != nil {
 		s.log.Error("ListTeams repo failed", "err", err)
 		return nil, err
@@ -181,6 +154,9 @@ func (s *Service) ListTeams(ctx context.Context) ([]*models.Team, error) {
 	return res, nil
 }
 
+// CreateTeamWithMembers создаёт команду с участниками. Повтор для команды с тем же составом (те же пользователи
+// с теми же именами и флагами активности) идемпотентен и возвращает сохранённые команду и участников;
+// другой состав даёт ErrAlreadyExists — изменить его можно через SyncTeam.
 func (s *Service) CreateTeamWithMembers(ctx context.Context, name string, members []*models.User) (*models.Team, []*models.User, error) {
 	if name == "" {
 		return nil, nil, utils.ErrInvalidArgument
@@ -195,51 +171,86 @@ func (s *Service) CreateTeamWithMembers(ctx context.Context, name string, member
 			return nil, nil, utils.ErrInvalidArgument
 		}
 	}
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		s.log.Error("CreateTeamWithMembers begin tx failed", "err", err, "name", name)
-		return nil, nil, err
-	}
-	var commit bool
-	defer func() {
-		if !commit {
-			_ = tx.Rollback(ctx)
-		}
-	}()
-
-	teamRepo := tx.TeamRepository()
 	team := &models.Team{ID: uuid.New(), Name: name}
-	if err := teamRepo.CreateTeam(ctx, team); err != nil {
-		s.log.Error("CreateTeamWithMembers create team failed", "err", err, "name", name)
-		return nil, nil, err
-	}
-
-	userRepo := tx.UserRepository()
 	var resultUsers []*models.User
-	for _, memberSpec := range members {
-		processedUser, err := s.processTeamMember(ctx, userRepo, memberSpec)
-		if err != nil {
-			return nil, nil, err
+	err := s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		teamRepo := tx.TeamRepository()
+		if err := teamRepo.CreateTeam(ctx, team); err != nil {
+			s.log.Error("CreateTeamWithMembers create team failed", "err", err, "name", name)
+			return err
 		}
-		if err := teamRepo.AddMember(ctx, team.ID, processedUser.ID); err != nil {
-			if !errors.Is(err, utils.ErrAlreadyExists) {
-				s.log.Error("CreateTeamWithMembers add member failed", "err", err, "team_id", team.ID, "user_id", processedUser.ID)
-				return nil, nil, err
+		userRepo := tx.UserRepository()
+		auditRepo := tx.AuditRepository()
+		resultUsers = nil
+		for _, memberSpec := range members {
+			processedUser, err := s.processTeamMember(ctx, userRepo, auditRepo, memberSpec)
+			if err != nil {
+				return err
+			}
+			if err := teamRepo.AddMember(ctx, team.ID, processedUser.ID); err != nil {
+				if !errors.Is(err, utils.ErrAlreadyExists) {
+					s.log.Error("CreateTeamWithMembers add member failed", "err", err, "team_id", team.ID, "user_id", processedUser.ID)
+					return err
+				}
 			}
+			resultUsers = append(resultUsers, processedUser)
+		}
+		if err := audit.Record(ctx, auditRepo, models.AuditActionTeamCreate, models.AuditEntityTeam, team.ID.String(), nil, teamSnapshot{Team: team, Members: resultUsers}); err != nil {
+			s.log.Error("CreateTeamWithMembers audit failed", "err", err, "team_id", team.ID)
+			return err
 		}
-		resultUsers = append(resultUsers, processedUser)
+		return nil
+	})
+	if errors.Is(err, utils.ErrAlreadyExists) {
+		return s.replayTeam(ctx, name, members)
 	}
-
-	if err := tx.Commit(ctx); err != nil {
-		s.log.Error("CreateTeamWithMembers commit failed", "err", err, "team_id", team.ID)
+	if err != nil {
 		return nil, nil, err
 	}
-	commit = true
 	s.log.Info("CreateTeamWithMembers success", "team_id", team.ID, "name", team.Name, "members_count", len(resultUsers))
 	return team, resultUsers, nil
 }
 
-func (s *Service) processTeamMember(ctx context.Context, userRepo user_port.UserRepository, member *models.User) (*models.User, error) {
+// replayTeam возвращает уже существующую команду name и её участников в порядке members, если состав совпадает
+// с members, иначе ErrAlreadyExists.
+func (s *Service) replayTeam(ctx context.Context, name string, members []*models.User) (*models.Team, []*models.User, error) {
+	var (
+		team   *models.Team
+		stored []*models.User
+	)
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		var err error
+		if team, err = tx.TeamRepository().GetTeamByName(ctx, name); err != nil {
+			return err
+		}
+		stored, err = tx.UserRepository().ListMembersByTeamID(ctx, team.ID)
+		return err
+	})
+	if err != nil {
+		s.log.Error("CreateTeamWithMembers replay check failed", "err", err, "name", name)
+		return nil, nil, err
+	}
+	byID := make(map[string]*models.User, len(stored))
+	for _, u := range stored {
+		byID[u.ID] = u
+	}
+	requested := make(map[string]struct{}, len(members))
+	res := make([]*models.User, 0, len(members))
+	for _, m := range members {
+		u, ok := byID[m.ID]
+		if !ok || u.IsActive != m.IsActive || (m.Name != "" && u.Name != m.Name) {
+			return nil, nil, utils.ErrAlreadyExists
+		}
+		requested[m.ID] = struct{}{}
+		res = append(res, u)
+	}
+	if len(requested) != len(byID) {
+		return nil, nil, utils.ErrAlreadyExists
+	}
+	return team, res, nil
+}
+
+func (s *Service) processTeamMember(ctx context.Context, userRepo user_port.UserRepository, auditRepo audit_port.AuditRepository, member *models.User) (*models.User, error) {
 	if member.ID == "" {
 		return nil, utils.ErrInvalidArgument
 	}
@@ -258,30 +269,44 @@ func (s *Service) processTeamMember(ctx context.Context, userRepo user_port.User
 				s.log.Error("processTeamMember create user failed", "err", err, "user_id", member.ID)
 				return nil, err
 			}
+			if err := audit.Record(ctx, auditRepo, models.AuditActionUserCreate, models.AuditEntityUser, member.ID, nil, member); err != nil {
+				s.log.Error("processTeamMember audit failed", "err", err, "user_id", member.ID)
+				return nil, err
+			}
 			return member, nil
 		}
 		s.log.Error("processTeamMember get user failed", "err", err, "user_id", member.ID)
 		return nil, err
 	}
 
-	return s.updateExistingUser(ctx, userRepo, existingUser, member)
+	return s.updateExistingUser(ctx, userRepo, auditRepo, existingUser, member)
 }
 
-func (s *Service) updateExistingUser(ctx context.Context, userRepo user_port.UserRepository, existing *models.User, spec *models.User) (*models.User, error) {
+func (s *Service) updateExistingUser(ctx context.Context, userRepo user_port.UserRepository, auditRepo audit_port.AuditRepository, existing *models.User, spec *models.User) (*models.User, error) {
 	updatedUser := &models.User{ID: existing.ID, Name: existing.Name, IsActive: existing.IsActive}
 	if spec.IsActive != existing.IsActive {
 		if err := userRepo.UpdateUserActive(ctx, existing.ID, spec.IsActive); err != nil {
 			s.log.Error("updateExistingUser update active failed", "err", err, "user_id", existing.ID)
 			return nil, err
 		}
+		before := *updatedUser
 		updatedUser.IsActive = spec.IsActive
+		if err := audit.Record(ctx, auditRepo, models.AuditActionUserSetActive, models.AuditEntityUser, existing.ID, before, updatedUser); err != nil {
+			s.log.Error("updateExistingUser audit failed", "err", err, "user_id", existing.ID)
+			return nil, err
+		}
 	}
 	if spec.Name != "" && spec.Name != existing.Name {
 		if err := userRepo.UpdateUserName(ctx, existing.ID, spec.Name); err != nil {
 			s.log.Error("updateExistingUser update name failed", "err", err, "user_id", existing.ID)
 			return nil, err
 		}
+		before := *updatedUser
 		updatedUser.Name = spec.Name
+		if err := audit.Record(ctx, auditRepo, models.AuditActionUserRename, models.AuditEntityUser, existing.ID, before, updatedUser); err != nil {
+			s.log.Error("updateExistingUser audit failed", "err", err, "user_id", existing.ID)
+			return nil, err
+		}
 	}
 	return updatedUser, nil
 }
@@ -290,17 +315,237 @@ func (s *Service) GetTeamByName(ctx context.Context, name string) (*models.Team,
 	if name == "" {
 		return nil, utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
+	var team *models.Team
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		var err error
+		team, err = tx.TeamRepository().GetTeamByName(ctx, name)
+		return err
+	})
 	if err != nil {
-		s.log.Error("GetTeamByName begin tx failed", "err", err, "team_name", name)
+		s.log.Error("GetTeamByName repo failed", "err", err, "team_name", name)
 		return nil, err
 	}
-	defer func() { _ = tx.Rollback(ctx) }()
+	return team, nil
+}
 
-	repo := tx.TeamRepository()
-	team, err := repo.GetTeamByName(ctx, name)
+// SetCodeOwners заменяет правила CODEOWNERS команды содержимым content и возвращает их вместе с новой версией команды.
+// Все владельцы должны существовать; пустой content очищает правила.
+func (s *Service) SetCodeOwners(ctx context.Context, teamName string, content string, ifMatch *utils.IfMatch) ([]models.CodeOwnerRule, int64, error) {
+	if teamName == "" {
+		return nil, 0, utils.ErrInvalidArgument
+	}
+	rules, err := services.ParseCodeOwners(content)
 	if err != nil {
-		s.log.Error("GetTeamByName repo failed", "err", err, "team_name", name)
+		return nil, 0, err
+	}
+	var version int64
+	err = s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		teamRepo := tx.TeamRepository()
+		team, err := lockTeam(ctx, teamRepo, teamName, ifMatch)
+		if err != nil {
+			return err
+		}
+		version = team.Version + 1
+		if err := checkOwnersExist(ctx, tx.UserRepository(), rules); err != nil {
+			return err
+		}
+		before, err := teamRepo.ListCodeOwners(ctx, team.ID)
+		if err != nil {
+			return err
+		}
+		if err := teamRepo.ReplaceCodeOwners(ctx, team.ID, rules); err != nil {
+			s.log.Error("SetCodeOwners repo failed", "err", err, "team_id", team.ID)
+			return err
+		}
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionTeamCodeOwners, models.AuditEntityTeam, team.ID.String(), before, rules); err != nil {
+			s.log.Error("SetCodeOwners audit failed", "err", err, "team_id", team.ID)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if rules == nil {
+		rules = []models.CodeOwnerRule{}
+	}
+	return rules, version, nil
+}
+
+func (s *Service) GetCodeOwners(ctx context.Context, teamName string) ([]models.CodeOwnerRule, error) {
+	if teamName == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	var rules []models.CodeOwnerRule
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		teamRepo := tx.TeamRepository()
+		team, err := teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		rules, err = teamRepo.ListCodeOwners(ctx, team.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		rules = []models.CodeOwnerRule{}
+	}
+	return rules, nil
+}
+
+// SetReviewerConstraints заменяет правила подбора ревьюверов команды и возвращает их вместе с новой версией команды;
+// пустой список очищает их. Все упомянутые пользователи должны существовать.
+func (s *Service) SetReviewerConstraints(ctx context.Context, teamName string, constraints []models.ReviewerConstraint, ifMatch *utils.IfMatch) ([]models.ReviewerConstraint, int64, error) {
+	if teamName == "" {
+		return nil, 0, utils.ErrInvalidArgument
+	}
+	constraints, err := services.NormalizeConstraints(constraints)
+	if err != nil {
+		return nil, 0, err
+	}
+	var version int64
+	err = s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		teamRepo := tx.TeamRepository()
+		team, err := lockTeam(ctx, teamRepo, teamName, ifMatch)
+		if err != nil {
+			return err
+		}
+		version = team.Version + 1
+		var ids []string
+		for _, c := range constraints {
+			ids = append(ids, c.AuthorIDs...)
+			ids = append(ids, c.ReviewerIDs...)
+		}
+		if err := checkUsersExist(ctx, tx.UserRepository(), ids); err != nil {
+			return err
+		}
+		before, err := teamRepo.ListReviewerConstraints(ctx, team.ID)
+		if err != nil {
+			return err
+		}
+		if err := teamRepo.ReplaceReviewerConstraints(ctx, team.ID, constraints); err != nil {
+			s.log.Error("SetReviewerConstraints repo failed", "err", err, "team_id", team.ID)
+			return err
+		}
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionTeamConstraints, models.AuditEntityTeam, team.ID.String(), before, constraints); err != nil {
+			s.log.Error("SetReviewerConstraints audit failed", "err", err, "team_id", team.ID)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return constraints, version, nil
+}
+
+func (s *Service) GetReviewerConstraints(ctx context.Context, teamName string) ([]models.ReviewerConstraint, error) {
+	if teamName == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	var constraints []models.ReviewerConstraint
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		teamRepo := tx.TeamRepository()
+		team, err := teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		constraints, err = teamRepo.ListReviewerConstraints(ctx, team.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if constraints == nil {
+		constraints = []models.ReviewerConstraint{}
+	}
+	return constraints, nil
+}
+
+func checkOwnersExist(ctx context.Context, userRepo user_port.UserRepository, rules []models.CodeOwnerRule) error {
+	var ids []string
+	for _, rule := range rules {
+		ids = append(ids, rule.OwnerIDs...)
+	}
+	return checkUsersExist(ctx, userRepo, ids)
+}
+
+func checkUsersExist(ctx context.Context, userRepo user_port.UserRepository, all []string) error {
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, id := range all {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	users, err := userRepo.ListUsersByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	found := make(map[string]struct{}, len(users))
+	for _, u := range users {
+		found[u.ID] = struct{}{}
+	}
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			return fmt.Errorf("%w: %s", utils.ErrUserNotFound, id)
+		}
+	}
+	return nil
+}
+
+// SetSelectionStrategy задаёт стратегию выбора ревьюверов команды; пустое имя возвращает стратегию по умолчанию.
+// Имя и параметры проверяются реестром стратегий до сохранения.
+func (s *Service) SetSelectionStrategy(ctx context.Context, teamName string, strategy string, params json.RawMessage, ifMatch *utils.IfMatch) (*models.Team, error) {
+	if teamName == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	if _, err := s.selectors.Resolve(strategy, params); err != nil {
+		return nil, err
+	}
+	if len(params) == 0 {
+		params = json.RawMessage(`{}`)
+	}
+	var after models.Team
+	err := s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		teamRepo := tx.TeamRepository()
+		before, err := lockTeam(ctx, teamRepo, teamName, ifMatch)
+		if err != nil {
+			return err
+		}
+		if err := teamRepo.UpdateSelectionStrategy(ctx, before.ID, strategy, params); err != nil {
+			s.log.Error("SetSelectionStrategy repo failed", "err", err, "team_id", before.ID)
+			return err
+		}
+		after = *before
+		after.SelectionStrategy = strategy
+		after.SelectionParams = params
+		after.Version++
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionTeamStrategySet, models.AuditEntityTeam, before.ID.String(), before, after); err != nil {
+			s.log.Error("SetSelectionStrategy audit failed", "err", err, "team_id", before.ID)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &after, nil
+}
+
+// lockTeam блокирует команду до конца транзакции и сверяет её версию с ifMatch.
+func lockTeam(ctx context.Context, repo team_port.TeamRepository, name string, ifMatch *utils.IfMatch) (*models.Team, error) {
+	team, err := repo.LockTeamByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := ifMatch.Check(team.Version); err != nil {
 		return nil, err
 	}
 	return team, nil
@@ -2,11 +2,14 @@ package team_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
 	app "avito-test-pr-service/internal/application/team"
 	"avito-test-pr-service/internal/domain/models"
+	uowport "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/domain/services"
 	"avito-test-pr-service/internal/infrastructure/logger"
 	"avito-test-pr-service/internal/utils"
 	"avito-test-pr-service/mocks"
@@ -16,6 +19,19 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// testSelectors — реестр с двумя стратегиями; "strict" отвергает любые непустые параметры.
+func testSelectors() services.SelectorResolver {
+	reg := services.NewSelectorRegistry("random")
+	reg.Register("random", func(json.RawMessage) (services.ReviewerSelector, error) { return &mocks.ReviewerSelector{}, nil })
+	reg.Register("strict", func(p json.RawMessage) (services.ReviewerSelector, error) {
+		if string(p) != "{}" {
+			return nil, errors.New("no params allowed")
+		}
+		return &mocks.ReviewerSelector{}, nil
+	})
+	return reg
+}
+
 func TestTeamService_CreateTeam(t *testing.T) {
 	ctx := context.Background()
 	tests := []struct {
@@ -28,7 +44,7 @@ func TestTeamService_CreateTeam(t *testing.T) {
 			name:    "happy",
 			nameArg: "core",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().CreateTeam(ctx, mock.MatchedBy(func(tm *models.Team) bool { return tm != nil && tm.Name == "core" && tm.ID != uuid.Nil })).Return(nil)
 				tx.EXPECT().Commit(ctx).Return(nil)
@@ -44,7 +60,7 @@ func TestTeamService_CreateTeam(t *testing.T) {
 			name:    "repo fail",
 			nameArg: "core",
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().CreateTeam(ctx, mock.MatchedBy(func(tm *models.Team) bool { return tm != nil && tm.Name == "core" })).Return(utils.ErrAlreadyExists)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -56,12 +72,15 @@ func TestTeamService_CreateTeam(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUOW := mocks.NewUnitOfWork(t)
 			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
 			mockTeamRepo := mocks.NewTeamRepository(t)
 			log := logger.New("dev")
 			if tt.setup != nil {
 				tt.setup(mockUOW, mockTx, mockTeamRepo)
 			}
-			svc := app.NewService(mockUOW, log)
+			svc := app.NewService(mockUOW, testSelectors(), nil, log)
 			team, err := svc.CreateTeam(ctx, tt.nameArg)
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -92,7 +111,7 @@ func TestTeamService_AddMember(t *testing.T) {
 			teamID: tid,
 			userID: uid,
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().GetTeamByID(ctx, tid).Return(&models.Team{ID: tid, Name: "core"}, nil)
 				tx.EXPECT().UserRepository().Return(urepo)
@@ -114,7 +133,7 @@ func TestTeamService_AddMember(t *testing.T) {
 			teamID: tid,
 			userID: uid,
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().GetTeamByID(ctx, tid).Return(nil, utils.ErrTeamNotFound)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -126,7 +145,7 @@ func TestTeamService_AddMember(t *testing.T) {
 			teamID: tid,
 			userID: uid,
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().GetTeamByID(ctx, tid).Return(&models.Team{ID: tid, Name: "core"}, nil)
 				tx.EXPECT().UserRepository().Return(urepo)
@@ -140,13 +159,16 @@ func TestTeamService_AddMember(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUOW := mocks.NewUnitOfWork(t)
 			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
 			mockTeamRepo := mocks.NewTeamRepository(t)
 			mockUserRepo := mocks.NewUserRepository(t)
 			log := logger.New("dev")
 			if tt.setup != nil {
 				tt.setup(mockUOW, mockTx, mockTeamRepo, mockUserRepo)
 			}
-			svc := app.NewService(mockUOW, log)
+			svc := app.NewService(mockUOW, testSelectors(), nil, log)
 			err := svc.AddMember(ctx, tt.teamID, tt.userID)
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -174,7 +196,7 @@ func TestTeamService_RemoveMember(t *testing.T) {
 			teamID: tid,
 			userID: uid,
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().GetTeamByID(ctx, tid).Return(&models.Team{ID: tid, Name: "core"}, nil)
 				tx.EXPECT().UserRepository().Return(urepo)
@@ -196,7 +218,7 @@ func TestTeamService_RemoveMember(t *testing.T) {
 			teamID: tid,
 			userID: uid,
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().GetTeamByID(ctx, tid).Return(nil, utils.ErrTeamNotFound)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -208,7 +230,7 @@ func TestTeamService_RemoveMember(t *testing.T) {
 			teamID: tid,
 			userID: uid,
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().GetTeamByID(ctx, tid).Return(&models.Team{ID: tid, Name: "core"}, nil)
 				tx.EXPECT().UserRepository().Return(urepo)
@@ -222,13 +244,16 @@ func TestTeamService_RemoveMember(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUOW := mocks.NewUnitOfWork(t)
 			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
 			mockTeamRepo := mocks.NewTeamRepository(t)
 			mockUserRepo := mocks.NewUserRepository(t)
 			log := logger.New("dev")
 			if tt.setup != nil {
 				tt.setup(mockUOW, mockTx, mockTeamRepo, mockUserRepo)
 			}
-			svc := app.NewService(mockUOW, log)
+			svc := app.NewService(mockUOW, testSelectors(), nil, log)
 			err := svc.RemoveMember(ctx, tt.teamID, tt.userID)
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -251,13 +276,13 @@ func TestTeamService_GetTeam_ListTeams(t *testing.T) {
 		{
 			name: "get ok & list ok",
 			setupGet: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().GetTeamByID(ctx, tid).Return(&models.Team{ID: tid, Name: "core"}, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil)
 			},
 			setupList: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().ListTeams(ctx).Return([]*models.Team{{ID: tid, Name: "core"}}, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -271,7 +296,7 @@ func TestTeamService_GetTeam_ListTeams(t *testing.T) {
 			mockTx := mocks.NewTransaction(t)
 			mockTeamRepo := mocks.NewTeamRepository(t)
 			log := logger.New("dev")
-			svc := app.NewService(mockUOW, log)
+			svc := app.NewService(mockUOW, testSelectors(), nil, log)
 
 			if tt.setupGet != nil {
 				tt.setupGet(mockUOW, mockTx, mockTeamRepo)
@@ -309,7 +334,7 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 				{ID: existingUserID, Name: "bob-new", IsActive: true},
 			},
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().CreateTeam(ctx, mock.MatchedBy(func(tm *models.Team) bool { return tm != nil && tm.Name == "backend" })).Run(func(_ context.Context, tm *models.Team) { tm.ID = teamID }).Return(nil)
 				tx.EXPECT().UserRepository().Return(urepo)
@@ -333,7 +358,7 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 			name:    "begin_fail",
 			members: []*models.User{},
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(nil, errors.New("begin fail"))
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).Return(errors.New("begin fail"))
 			},
 			wantErr: errors.New("begin fail"),
 		},
@@ -341,10 +366,53 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 			name:    "create_team_fail",
 			members: []*models.User{},
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(trepo)
+				trepo.EXPECT().CreateTeam(ctx, mock.AnythingOfType("*models.Team")).Return(errors.New("insert fail"))
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: errors.New("insert fail"),
+		},
+		{
+			name: "replay_same_roster",
+			members: []*models.User{
+				{ID: "user-alice", Name: "alice", IsActive: true},
+				{ID: existingUserID, IsActive: false},
+			},
+			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
+				tx.EXPECT().UserRepository().Return(urepo)
 				trepo.EXPECT().CreateTeam(ctx, mock.AnythingOfType("*models.Team")).Return(utils.ErrAlreadyExists)
 				tx.EXPECT().Rollback(ctx).Return(nil)
+				trepo.EXPECT().GetTeamByName(ctx, "backend").Return(&models.Team{ID: teamID, Name: "backend"}, nil)
+				urepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return([]*models.User{
+					{ID: existingUserID, Name: "bob", IsActive: false},
+					{ID: "user-alice", Name: "alice", IsActive: true},
+				}, nil)
+			},
+			check: func(t *testing.T, team *models.Team, users []*models.User, err error) {
+				require.NoError(t, err)
+				require.Equal(t, teamID, team.ID)
+				require.Len(t, users, 2)
+				require.Equal(t, "user-alice", users[0].ID)
+				require.Equal(t, "bob", users[1].Name)
+			},
+		},
+		{
+			name:    "replay_different_roster",
+			members: []*models.User{{ID: "user-alice", Name: "alice", IsActive: true}},
+			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(trepo)
+				tx.EXPECT().UserRepository().Return(urepo)
+				trepo.EXPECT().CreateTeam(ctx, mock.AnythingOfType("*models.Team")).Return(utils.ErrAlreadyExists)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+				trepo.EXPECT().GetTeamByName(ctx, "backend").Return(&models.Team{ID: teamID, Name: "backend"}, nil)
+				urepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return([]*models.User{
+					{ID: "user-alice", Name: "alice", IsActive: true},
+					{ID: existingUserID, Name: "bob", IsActive: true},
+				}, nil)
 			},
 			wantErr: utils.ErrAlreadyExists,
 		},
@@ -352,7 +420,7 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 			name:    "create_user_fail",
 			members: []*models.User{{ID: "user-alice", Name: "alice", IsActive: true}},
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().CreateTeam(ctx, mock.AnythingOfType("*models.Team")).Return(nil)
 				tx.EXPECT().UserRepository().Return(urepo)
@@ -366,7 +434,7 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 			name:    "get_user_fail_non_notfound",
 			members: []*models.User{{ID: existingUserID, Name: "bob", IsActive: true}},
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().CreateTeam(ctx, mock.AnythingOfType("*models.Team")).Return(nil)
 				tx.EXPECT().UserRepository().Return(urepo)
@@ -379,7 +447,7 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 			name:    "update_active_fail",
 			members: []*models.User{{ID: existingUserID, Name: "bob", IsActive: true}},
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().CreateTeam(ctx, mock.AnythingOfType("*models.Team")).Return(nil)
 				tx.EXPECT().UserRepository().Return(urepo)
@@ -393,7 +461,7 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 			name:    "update_name_fail",
 			members: []*models.User{{ID: existingUserID, Name: "bob-new", IsActive: false}},
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().CreateTeam(ctx, mock.AnythingOfType("*models.Team")).Return(nil)
 				tx.EXPECT().UserRepository().Return(urepo)
@@ -407,7 +475,7 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 			name:    "add_member_fail_non_idempotent",
 			members: []*models.User{{ID: "user-alice", Name: "alice", IsActive: true}},
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().CreateTeam(ctx, mock.MatchedBy(func(tm *models.Team) bool { return tm != nil })).Run(func(_ context.Context, tm *models.Team) {
 					tm.ID = teamID
@@ -424,7 +492,7 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 			name:    "commit_fail",
 			members: []*models.User{{ID: "user-alice", Name: "alice", IsActive: true}},
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().CreateTeam(ctx, mock.AnythingOfType("*models.Team")).Return(nil)
 				tx.EXPECT().UserRepository().Return(urepo)
@@ -442,6 +510,9 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUOW := mocks.NewUnitOfWork(t)
 			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
 			mockTeamRepo := mocks.NewTeamRepository(t)
 			mockUserRepo := mocks.NewUserRepository(t)
 			log := logger.New("dev")
@@ -450,7 +521,7 @@ func TestTeamService_CreateTeamWithMembers(t *testing.T) {
 				tt.mockSetup(mockUOW, mockTx, mockTeamRepo, mockUserRepo)
 			}
 
-			svc := app.NewService(mockUOW, log)
+			svc := app.NewService(mockUOW, testSelectors(), nil, log)
 			team, users, err := svc.CreateTeamWithMembers(ctx, "backend", tt.members)
 
 			if tt.wantErr != nil {
@@ -483,7 +554,7 @@ func TestTeamService_GetTeamByName(t *testing.T) {
 			name:    "happy",
 			argName: teamName,
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().GetTeamByName(ctx, teamName).Return(team, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -499,7 +570,7 @@ func TestTeamService_GetTeamByName(t *testing.T) {
 			name:    "begin fail",
 			argName: teamName,
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(nil, errors.New("begin fail"))
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).Return(errors.New("begin fail"))
 			},
 			wantErr: errors.New("begin fail"),
 		},
@@ -507,7 +578,7 @@ func TestTeamService_GetTeamByName(t *testing.T) {
 			name:    "not found",
 			argName: teamName,
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().GetTeamByName(ctx, teamName).Return(nil, utils.ErrTeamNotFound)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -518,7 +589,7 @@ func TestTeamService_GetTeamByName(t *testing.T) {
 			name:    "db error",
 			argName: teamName,
 			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().TeamRepository().Return(trepo)
 				trepo.EXPECT().GetTeamByName(ctx, teamName).Return(nil, errors.New("db error"))
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -538,7 +609,7 @@ func TestTeamService_GetTeamByName(t *testing.T) {
 				tt.setup(mockUOW, mockTx, mockTeamRepo)
 			}
 
-			svc := app.NewService(mockUOW, log)
+			svc := app.NewService(mockUOW, testSelectors(), nil, log)
 			res, err := svc.GetTeamByName(ctx, tt.argName)
 
 			if tt.wantErr != nil {
@@ -559,3 +630,527 @@ func TestTeamService_GetTeamByName(t *testing.T) {
 		})
 	}
 }
+
+func TestTeamService_SetCodeOwners(t *testing.T) {
+	ctx := context.Background()
+	teamName := "core"
+	team := &models.Team{ID: uuid.New(), Name: teamName}
+
+	tests := []struct {
+		name    string
+		content string
+		setup   func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository, arepo *mocks.AuditRepository)
+		wantLen int
+		wantErr error
+	}{
+		{
+			name:    "rules replaced and audited",
+			content: "* u1\n/internal/db/ @u2 u1\n",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository, arepo *mocks.AuditRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(trepo)
+				tx.EXPECT().UserRepository().Return(urepo)
+				tx.EXPECT().AuditRepository().Return(arepo)
+				trepo.EXPECT().LockTeamByName(ctx, teamName).Return(team, nil)
+				urepo.EXPECT().ListUsersByIDs(ctx, []string{"u1", "u2"}).Return([]*models.User{{ID: "u1"}, {ID: "u2"}}, nil)
+				trepo.EXPECT().ListCodeOwners(ctx, team.ID).Return(nil, nil)
+				trepo.EXPECT().ReplaceCodeOwners(ctx, team.ID, mock.MatchedBy(func(rules []models.CodeOwnerRule) bool { return len(rules) == 2 })).Return(nil)
+				arepo.EXPECT().Append(ctx, mock.MatchedBy(func(e *models.AuditEntry) bool {
+					return e.Action == models.AuditActionTeamCodeOwners && e.EntityID == team.ID.String()
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+			wantLen: 2,
+		},
+		{
+			name:    "unknown owner",
+			content: "* ghost\n",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository, arepo *mocks.AuditRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(trepo)
+				tx.EXPECT().UserRepository().Return(urepo)
+				trepo.EXPECT().LockTeamByName(ctx, teamName).Return(team, nil)
+				urepo.EXPECT().ListUsersByIDs(ctx, []string{"ghost"}).Return(nil, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrUserNotFound,
+		},
+		{
+			name:    "malformed file",
+			content: "*.go\n",
+			setup: func(*mocks.UnitOfWork, *mocks.Transaction, *mocks.TeamRepository, *mocks.UserRepository, *mocks.AuditRepository) {
+			},
+			wantErr: utils.ErrInvalidCodeOwners,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockTeamRepo := mocks.NewTeamRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			tt.setup(mockUOW, mockTx, mockTeamRepo, mockUserRepo, mockAuditRepo)
+
+			svc := app.NewService(mockUOW, testSelectors(), nil, logger.New("dev"))
+			rules, version, err := svc.SetCodeOwners(ctx, teamName, tt.content, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, rules, tt.wantLen)
+			require.Equal(t, team.Version+1, version)
+		})
+	}
+}
+
+func TestTeamService_SetReviewerConstraints(t *testing.T) {
+	ctx := context.Background()
+	teamName := "core"
+	team := &models.Team{ID: uuid.New(), Name: teamName}
+	valid := []models.ReviewerConstraint{
+		{Kind: models.ConstraintMustIncludeOneOf, AuthorIDs: []string{"j1"}, ReviewerIDs: []string{"s1", "s2"}},
+		{Kind: models.ConstraintNeverPair, AuthorIDs: []string{"j1"}, ReviewerIDs: []string{"s1"}},
+	}
+
+	tests := []struct {
+		name        string
+		constraints []models.ReviewerConstraint
+		setup       func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository, arepo *mocks.AuditRepository)
+		wantErr     error
+	}{
+		{
+			name:        "constraints replaced and audited",
+			constraints: valid,
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository, arepo *mocks.AuditRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(trepo)
+				tx.EXPECT().UserRepository().Return(urepo)
+				tx.EXPECT().AuditRepository().Return(arepo)
+				trepo.EXPECT().LockTeamByName(ctx, teamName).Return(team, nil)
+				urepo.EXPECT().ListUsersByIDs(ctx, []string{"j1", "s1", "s2"}).Return([]*models.User{{ID: "j1"}, {ID: "s1"}, {ID: "s2"}}, nil)
+				trepo.EXPECT().ListReviewerConstraints(ctx, team.ID).Return(nil, nil)
+				trepo.EXPECT().ReplaceReviewerConstraints(ctx, team.ID, valid).Return(nil)
+				arepo.EXPECT().Append(ctx, mock.MatchedBy(func(e *models.AuditEntry) bool {
+					return e.Action == models.AuditActionTeamConstraints && e.EntityID == team.ID.String()
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{
+			name:        "unknown user",
+			constraints: []models.ReviewerConstraint{{Kind: models.ConstraintPreferPair, ReviewerIDs: []string{"ghost"}}},
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository, arepo *mocks.AuditRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(trepo)
+				tx.EXPECT().UserRepository().Return(urepo)
+				trepo.EXPECT().LockTeamByName(ctx, teamName).Return(team, nil)
+				urepo.EXPECT().ListUsersByIDs(ctx, []string{"ghost"}).Return(nil, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrUserNotFound,
+		},
+		{
+			name:        "invalid kind",
+			constraints: []models.ReviewerConstraint{{Kind: "sometimes", ReviewerIDs: []string{"s1"}}},
+			setup: func(*mocks.UnitOfWork, *mocks.Transaction, *mocks.TeamRepository, *mocks.UserRepository, *mocks.AuditRepository) {
+			},
+			wantErr: utils.ErrInvalidConstraint,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockTeamRepo := mocks.NewTeamRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			tt.setup(mockUOW, mockTx, mockTeamRepo, mockUserRepo, mockAuditRepo)
+
+			svc := app.NewService(mockUOW, testSelectors(), nil, logger.New("dev"))
+			got, version, err := svc.SetReviewerConstraints(ctx, teamName, tt.constraints, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.constraints, got)
+			require.Equal(t, team.Version+1, version)
+		})
+	}
+}
+
+func TestTeamService_SetSelectionStrategy(t *testing.T) {
+	ctx := context.Background()
+	teamID := uuid.New()
+	tests := []struct {
+		name     string
+		strategy string
+		params   json.RawMessage
+		setup    func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository)
+		wantErr  error
+	}{
+		{
+			name:     "success",
+			strategy: "strict",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(trepo)
+				trepo.EXPECT().LockTeamByName(ctx, "core").Return(&models.Team{ID: teamID, Name: "core"}, nil)
+				trepo.EXPECT().UpdateSelectionStrategy(ctx, teamID, "strict", json.RawMessage(`{}`)).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{name: "unknown strategy", strategy: "magic", wantErr: utils.ErrUnknownStrategy},
+		{name: "invalid params", strategy: "strict", params: json.RawMessage(`{"x":1}`), wantErr: utils.ErrInvalidStrategyParams},
+		{
+			name:     "team not found",
+			strategy: "random",
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(trepo)
+				trepo.EXPECT().LockTeamByName(ctx, "core").Return(nil, utils.ErrTeamNotFound)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrTeamNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
+			mockTeamRepo := mocks.NewTeamRepository(t)
+			if tt.setup != nil {
+				tt.setup(mockUOW, mockTx, mockTeamRepo)
+			}
+			svc := app.NewService(mockUOW, testSelectors(), nil, logger.New("dev"))
+			team, err := svc.SetSelectionStrategy(ctx, "core", tt.strategy, tt.params, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.strategy, team.SelectionStrategy)
+		})
+	}
+}
+
+func TestTeamService_SetSelectionStrategy_IfMatch(t *testing.T) {
+	ctx := context.Background()
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().TeamRepository().Return(mockTeamRepo)
+	mockTeamRepo.EXPECT().LockTeamByName(ctx, "core").Return(&models.Team{ID: uuid.New(), Name: "core", Version: 5}, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	svc := app.NewService(mockUOW, testSelectors(), nil, logger.New("dev"))
+	_, err := svc.SetSelectionStrategy(ctx, "core", "strict", nil, utils.ParseIfMatch(`"4"`))
+	require.ErrorIs(t, err, utils.ErrPreconditionFailed)
+}
+
+func TestTeamService_ImportRoster(t *testing.T) {
+	ctx := context.Background()
+	core := &models.Team{ID: uuid.New(), Name: "core"}
+	roster := func() *models.Roster {
+		return &models.Roster{Teams: []models.RosterTeam{
+			{Name: "core", Members: []models.RosterMember{
+				{UserID: "u1", Username: "Alicia", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+			}},
+			{Name: "infra", Members: []models.RosterMember{{UserID: "u3", Username: "Carol", IsActive: true}}},
+		}}
+	}
+	// Текущее состояние: core = {u1 Alice, u2 Bob}, infra не существует, u3 нет.
+	plan := func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
+		uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+		tx.EXPECT().TeamRepository().Return(trepo)
+		tx.EXPECT().UserRepository().Return(urepo)
+		urepo.EXPECT().ListUsersByIDs(ctx, []string{"u1", "u3"}).Return([]*models.User{{ID: "u1", Name: "Alice", IsActive: false}}, nil)
+		trepo.EXPECT().LockTeamByName(ctx, "core").Return(core, nil)
+		urepo.EXPECT().ListMembersByTeamID(ctx, core.ID).Return([]*models.User{{ID: "u1"}, {ID: "u2"}}, nil)
+		trepo.EXPECT().LockTeamByName(ctx, "infra").Return(nil, utils.ErrTeamNotFound)
+	}
+	wantDiff := &models.RosterDiff{
+		CreateTeams:       []string{"infra"},
+		CreateUsers:       []models.RosterMember{{UserID: "u3", Username: "Carol", IsActive: true}},
+		RenameUsers:       []models.UserRename{{UserID: "u1", From: "Alice", To: "Alicia"}},
+		ActivateUsers:     []string{"u1"},
+		DeactivateUsers:   []string{},
+		AddMemberships:    []models.Membership{{TeamName: "core", UserID: "u3"}, {TeamName: "infra", UserID: "u3"}},
+		RemoveMemberships: []models.Membership{{TeamName: "core", UserID: "u2"}},
+		UpdateSettings:    []models.SettingsChange{},
+	}
+
+	tests := []struct {
+		name    string
+		roster  *models.Roster
+		dryRun  bool
+		setup   func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository)
+		want    *models.RosterDiff
+		wantErr error
+	}{
+		{
+			name:   "dry run only reports diff",
+			roster: roster(),
+			dryRun: true,
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
+				plan(uow, tx, trepo, urepo)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+			want: wantDiff,
+		},
+		{
+			name:   "apply",
+			roster: roster(),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
+				plan(uow, tx, trepo, urepo)
+				urepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{ID: "u1", Name: "Alice", IsActive: false}, nil)
+				urepo.EXPECT().UpdateUserActive(ctx, "u1", true).Return(nil)
+				urepo.EXPECT().UpdateUserName(ctx, "u1", "Alicia").Return(nil)
+				urepo.EXPECT().GetUserByID(ctx, "u3").Return(nil, utils.ErrUserNotFound)
+				urepo.EXPECT().CreateUser(ctx, mock.MatchedBy(func(u *models.User) bool { return u.ID == "u3" && u.Name == "Carol" && u.IsActive })).Return(nil)
+				trepo.EXPECT().AddMember(ctx, core.ID, "u3").Return(nil)
+				trepo.EXPECT().RemoveMember(ctx, core.ID, "u2").Return(nil)
+				trepo.EXPECT().CreateTeam(ctx, mock.MatchedBy(func(tm *models.Team) bool { return tm.Name == "infra" && tm.ID != uuid.Nil })).Return(nil)
+				trepo.EXPECT().AddMember(ctx, mock.MatchedBy(func(id uuid.UUID) bool { return id != core.ID }), "u3").Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+			want: wantDiff,
+		},
+		{
+			name: "settings reference unknown user",
+			roster: &models.Roster{Teams: []models.RosterTeam{{Name: "core", Settings: &models.TeamSettings{
+				CodeOwners: []models.CodeOwnerRule{{Pattern: "*", OwnerIDs: []string{"ghost"}}},
+			}}}},
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, trepo *mocks.TeamRepository, urepo *mocks.UserRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().TeamRepository().Return(trepo)
+				tx.EXPECT().UserRepository().Return(urepo)
+				trepo.EXPECT().LockTeamByName(ctx, "core").Return(core, nil)
+				urepo.EXPECT().ListMembersByTeamID(ctx, core.ID).Return(nil, nil)
+				trepo.EXPECT().ListCodeOwners(ctx, core.ID).Return(nil, nil)
+				trepo.EXPECT().ListReviewerConstraints(ctx, core.ID).Return(nil, nil)
+				urepo.EXPECT().ListUsersByIDs(ctx, []string{"ghost"}).Return(nil, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: utils.ErrUserNotFound,
+		},
+		{
+			name:    "team listed twice",
+			roster:  &models.Roster{Teams: []models.RosterTeam{{Name: "core"}, {Name: " core "}}},
+			wantErr: utils.ErrInvalidArgument,
+		},
+		{
+			name: "conflicting member specs",
+			roster: &models.Roster{Teams: []models.RosterTeam{
+				{Name: "a", Members: []models.RosterMember{{UserID: "u1", Username: "Alice", IsActive: true}}},
+				{Name: "b", Members: []models.RosterMember{{UserID: "u1", Username: "Alice", IsActive: false}}},
+			}},
+			wantErr: utils.ErrInvalidArgument,
+		},
+		{
+			name:    "invalid strategy",
+			roster:  &models.Roster{Teams: []models.RosterTeam{{Name: "core", Settings: &models.TeamSettings{SelectionStrategy: "magic"}}}},
+			wantErr: utils.ErrUnknownStrategy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
+			mockTeamRepo := mocks.NewTeamRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			if tt.setup != nil {
+				tt.setup(mockUOW, mockTx, mockTeamRepo, mockUserRepo)
+			}
+			svc := app.NewService(mockUOW, testSelectors(), nil, logger.New("dev"))
+			diff, err := svc.ImportRoster(ctx, tt.roster, tt.dryRun)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, diff)
+		})
+	}
+}
+
+func TestTeamService_ImportRoster_Settings(t *testing.T) {
+	ctx := context.Background()
+	core := &models.Team{ID: uuid.New(), Name: "core", SelectionStrategy: "random", SelectionParams: json.RawMessage(`{}`)}
+	owners := []models.CodeOwnerRule{{Pattern: "*.go", OwnerIDs: []string{"u1"}}}
+	roster := &models.Roster{Teams: []models.RosterTeam{{
+		Name:    "core",
+		Members: []models.RosterMember{{UserID: "u1", Username: "Alice", IsActive: true}},
+		Settings: &models.TeamSettings{
+			SelectionStrategy: "strict",
+			CodeOwners:        []models.CodeOwnerRule{{Pattern: "*.go", OwnerIDs: []string{"@u1"}}},
+		},
+	}}}
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().TeamRepository().Return(mockTeamRepo)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+	mockUserRepo.EXPECT().ListUsersByIDs(ctx, []string{"u1"}).Return([]*models.User{{ID: "u1", Name: "Alice", IsActive: true}}, nil)
+	mockTeamRepo.EXPECT().LockTeamByName(ctx, "core").Return(core, nil)
+	mockUserRepo.EXPECT().ListMembersByTeamID(ctx, core.ID).Return([]*models.User{{ID: "u1"}}, nil)
+	mockTeamRepo.EXPECT().ListCodeOwners(ctx, core.ID).Return(owners, nil)
+	mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, core.ID).Return(nil, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{ID: "u1", Name: "Alice", IsActive: true}, nil)
+	mockTeamRepo.EXPECT().UpdateSelectionStrategy(ctx, core.ID, "strict", json.RawMessage(`{}`)).Return(nil)
+	mockAuditRepo.EXPECT().Append(ctx, mock.MatchedBy(func(e *models.AuditEntry) bool {
+		return e.Action == models.AuditActionTeamStrategySet
+	})).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	svc := app.NewService(mockUOW, testSelectors(), nil, logger.New("dev"))
+	diff, err := svc.ImportRoster(ctx, roster, false)
+	require.NoError(t, err)
+	require.Equal(t, []models.SettingsChange{{TeamName: "core", Fields: []string{models.SettingSelectionStrategy}}}, diff.UpdateSettings)
+	require.Empty(t, diff.AddMemberships)
+}
+
+func TestTeamService_ExportRoster(t *testing.T) {
+	ctx := context.Background()
+	a := &models.Team{ID: uuid.New(), Name: "a", SelectionStrategy: "random"}
+	b := &models.Team{ID: uuid.New(), Name: "b"}
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().TeamRepository().Return(mockTeamRepo)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+	mockTeamRepo.EXPECT().ListTeams(ctx).Return([]*models.Team{b, a}, nil)
+	mockUserRepo.EXPECT().ListMembersByTeamID(ctx, a.ID).Return([]*models.User{{ID: "u2", Name: "Bob"}, {ID: "u1", Name: "Alice", IsActive: true}}, nil)
+	mockUserRepo.EXPECT().ListMembersByTeamID(ctx, b.ID).Return(nil, nil)
+	mockTeamRepo.EXPECT().ListCodeOwners(ctx, mock.Anything).Return(nil, nil)
+	mockTeamRepo.EXPECT().ListReviewerConstraints(ctx, mock.Anything).Return(nil, nil)
+
+	svc := app.NewService(mockUOW, testSelectors(), nil, logger.New("dev"))
+	roster, err := svc.ExportRoster(ctx)
+	require.NoError(t, err)
+	require.Len(t, roster.Teams, 2)
+	require.Equal(t, "a", roster.Teams[0].Name)
+	require.Equal(t, []models.RosterMember{{UserID: "u1", Username: "Alice", IsActive: true}, {UserID: "u2", Username: "Bob"}}, roster.Teams[0].Members)
+	require.Equal(t, "random", roster.Teams[0].Settings.SelectionStrategy)
+	require.NotNil(t, roster.Teams[1].Members)
+	require.NotNil(t, roster.Teams[1].Settings.CodeOwners)
+}
+
+func TestTeamService_SyncTeam(t *testing.T) {
+	ctx := context.Background()
+	svc := app.NewService(mocks.NewUnitOfWork(t), testSelectors(), nil, logger.New("dev"))
+	_, err := svc.SyncTeam(ctx, " ", nil, true, nil)
+	require.ErrorIs(t, err, utils.ErrInvalidArgument)
+
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockTeamRepo := mocks.NewTeamRepository(t)
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().TeamRepository().Return(mockTeamRepo)
+	mockTx.EXPECT().UserRepository().Return(mocks.NewUserRepository(t))
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+	mockTeamRepo.EXPECT().LockTeamByName(ctx, "core").Return(nil, utils.ErrTeamNotFound)
+
+	svc = app.NewService(mockUOW, testSelectors(), nil, logger.New("dev"))
+	res, err := svc.SyncTeam(ctx, "core", nil, true, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"core"}, res.Diff.CreateTeams)
+	require.Empty(t, res.Diff.AddMemberships)
+	require.Empty(t, res.Releases)
+
+	// If-Match не выполняется для команды, которой ещё нет.
+	mockUOW = mocks.NewUnitOfWork(t)
+	mockTx = mocks.NewTransaction(t)
+	mockTeamRepo = mocks.NewTeamRepository(t)
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().TeamRepository().Return(mockTeamRepo)
+	mockTx.EXPECT().UserRepository().Return(mocks.NewUserRepository(t))
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+	mockTeamRepo.EXPECT().LockTeamByName(ctx, "core").Return(nil, utils.ErrTeamNotFound)
+
+	svc = app.NewService(mockUOW, testSelectors(), nil, logger.New("dev"))
+	_, err = svc.SyncTeam(ctx, "core", nil, true, utils.ParseIfMatch("*"))
+	require.ErrorIs(t, err, utils.ErrPreconditionFailed)
+}
+
+func TestTeamService_SyncTeamReleasesReviews(t *testing.T) {
+	ctx := context.Background()
+	core := &models.Team{ID: uuid.New(), Name: "core"}
+	members := []models.RosterMember{{UserID: "u1", Username: "Alice", IsActive: true}}
+	releases := []models.ReviewRelease{{PRID: "pr-1", ReviewerID: "u2", Outcome: models.ReleaseOutcomeUnassigned}}
+
+	tests := []struct {
+		name       string
+		releaseErr error
+	}{
+		{name: "released in sync transaction"},
+		{name: "release failure rolls back sync", releaseErr: errors.New("db down")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Текущее состояние: core = {u1, u2}; u2 удаляется из команды.
+			var steps []string
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockTeamRepo := mocks.NewTeamRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			releaser := mocks.NewReviewReleaser(t)
+			mockUOW.EXPECT().Do(ctx, uowport.TxOptions{Isolation: uowport.Serializable}, mock.Anything).RunInTx(mockTx)
+			mockTx.EXPECT().TeamRepository().Return(mockTeamRepo)
+			mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+			mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Return(nil)
+			mockUserRepo.EXPECT().ListUsersByIDs(ctx, []string{"u1"}).Return([]*models.User{{ID: "u1", Name: "Alice", IsActive: true}}, nil)
+			mockTeamRepo.EXPECT().LockTeamByName(ctx, "core").Return(core, nil)
+			mockUserRepo.EXPECT().ListMembersByTeamID(ctx, core.ID).Return([]*models.User{{ID: "u1"}, {ID: "u2"}}, nil)
+			mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{ID: "u1", Name: "Alice", IsActive: true}, nil)
+			mockTeamRepo.EXPECT().RemoveMember(ctx, core.ID, "u2").Return(nil)
+			// авторы — участники команды до и после синхронизации
+			call := releaser.EXPECT().ReleaseTeamReviews(ctx, mockTx, "u2", []string{"u2", "u1"}, models.AssignmentReasonTeamRemoval)
+			if tt.releaseErr != nil {
+				call.Return(nil, nil, tt.releaseErr)
+				mockTx.EXPECT().Rollback(ctx).Return(nil)
+			} else {
+				call.Return(releases, func() { steps = append(steps, "publish") }, nil)
+				mockTx.EXPECT().Commit(ctx).RunAndReturn(func(context.Context) error {
+					steps = append(steps, "commit")
+					return nil
+				})
+			}
+
+			svc := app.NewService(mockUOW, testSelectors(), releaser, logger.New("dev"))
+			res, err := svc.SyncTeam(ctx, "core", members, false, nil)
+			if tt.releaseErr != nil {
+				require.ErrorIs(t, err, tt.releaseErr)
+				require.Empty(t, steps)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []models.Membership{{TeamName: "core", UserID: "u2"}}, res.Diff.RemoveMemberships)
+			require.Equal(t, releases, res.Releases)
+			require.Equal(t, []string{"commit", "publish"}, steps)
+		})
+	}
+}
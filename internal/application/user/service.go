@@ -1,13 +1,17 @@
 package user
 
 import (
+	"avito-test-pr-service/internal/application/audit"
 	"avito-test-pr-service/internal/domain/models"
 	"avito-test-pr-service/internal/domain/ports/input"
 	ports "avito-test-pr-service/internal/domain/ports/output"
 	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/domain/services"
 	"avito-test-pr-service/internal/utils"
 	"context"
 	"errors"
+	"net/mail"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -21,33 +25,32 @@ func NewService(uow uow.UnitOfWork, log ports.Logger) input.UserInputPort {
 	return &Service{uow: uow, log: log}
 }
 
+var (
+	readOnly = uow.TxOptions{ReadOnly: true}
+	// replicaRead — списки, которые можно отдать с реплики с допустимым отставанием.
+	replicaRead = uow.TxOptions{ReadOnly: true, Replica: true}
+)
+
 func (s *Service) CreateUser(ctx context.Context, id string, name string, isActive bool) (*models.User, error) {
 	if id == "" || name == "" {
 		s.log.Error("CreateUser invalid argument", "id", id, "name", name)
 		return nil, utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		s.log.Error("CreateUser begin tx failed", "err", err, "id", id)
-		return nil, err
-	}
-	var commit bool
-	defer func() {
-		if !commit {
-			_ = tx.Rollback(ctx)
-		}
-	}()
-	repo := tx.UserRepository()
 	u := &models.User{ID: id, Name: name, IsActive: isActive}
-	if err := repo.CreateUser(ctx, u); err != nil {
-		s.log.Error("CreateUser repo failed", "err", err, "id", id)
-		return nil, err
-	}
-	if err := tx.Commit(ctx); err != nil {
-		s.log.Error("CreateUser commit failed", "err", err, "id", id)
+	err := s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		if err := tx.UserRepository().CreateUser(ctx, u); err != nil {
+			s.log.Error("CreateUser repo failed", "err", err, "id", id)
+			return err
+		}
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionUserCreate, models.AuditEntityUser, id, nil, u); err != nil {
+			s.log.Error("CreateUser audit failed", "err", err, "id", id)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	commit = true
 	return u, nil
 }
 
@@ -55,43 +58,35 @@ func (s *Service) UpdateUserActive(ctx context.Context, id string, isActive bool
 	if id == "" {
 		return utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		return err
-	}
-	var commit bool
-	defer func() {
-		if !commit {
-			_ = tx.Rollback(ctx)
+	return s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		repo := tx.UserRepository()
+		before, err := repo.GetUserByID(ctx, id)
+		if err != nil {
+			return err
 		}
-	}()
-	repo := tx.UserRepository()
-	if _, err := repo.GetUserByID(ctx, id); err != nil {
-		return err
-	}
-	if err := repo.UpdateUserActive(ctx, id, isActive); err != nil {
-		return err
-	}
-	if err := tx.Commit(ctx); err != nil {
-		return err
-	}
-	commit = true
-	return nil
+		if err := repo.UpdateUserActive(ctx, id, isActive); err != nil {
+			return err
+		}
+		after := *before
+		after.IsActive = isActive
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionUserSetActive, models.AuditEntityUser, id, before, after); err != nil {
+			s.log.Error("UpdateUserActive audit failed", "err", err, "id", id)
+			return err
+		}
+		return nil
+	})
 }
 
 func (s *Service) GetUser(ctx context.Context, id string) (*models.User, error) {
 	if id == "" {
 		return nil, utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		_ = tx.Rollback(ctx)
-	}()
-	repo := tx.UserRepository()
-	u, err := repo.GetUserByID(ctx, id)
+	var u *models.User
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		var err error
+		u, err = tx.UserRepository().GetUserByID(ctx, id)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -99,15 +94,28 @@ func (s *Service) GetUser(ctx context.Context, id string) (*models.User, error)
 }
 
 func (s *Service) ListUsers(ctx context.Context) ([]*models.User, error) {
-	tx, err := s.uow.Begin(ctx)
+	var users []*models.User
+	err := s.uow.Do(ctx, replicaRead, func(tx uow.Transaction) error {
+		var err error
+		users, err = tx.UserRepository().ListUsers(ctx)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		_ = tx.Rollback(ctx)
-	}()
-	repo := tx.UserRepository()
-	users, err := repo.ListUsers(ctx)
+	return users, nil
+}
+
+func (s *Service) ListUsersByIDs(ctx context.Context, ids []string) ([]*models.User, error) {
+	if len(ids) == 0 {
+		return []*models.User{}, nil
+	}
+	var users []*models.User
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		var err error
+		users, err = tx.UserRepository().ListUsersByIDs(ctx, ids)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -118,52 +126,121 @@ func (s *Service) GetUserTeamName(ctx context.Context, id string) (string, error
 	if id == "" {
 		return "", utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		return "", err
-	}
-	defer func() {
-		_ = tx.Rollback(ctx)
-	}()
-	repo := tx.UserRepository()
-	teamID, err := repo.GetTeamIDByUserID(ctx, id)
-	if err != nil {
-		if errors.Is(err, utils.ErrUserNoTeam) {
-			return "", nil
+	var name string
+	err := s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		name = ""
+		teamID, err := tx.UserRepository().GetTeamIDByUserID(ctx, id)
+		if err != nil {
+			if errors.Is(err, utils.ErrUserNoTeam) {
+				return nil
+			}
+			return err
 		}
-		return "", err
-	}
-	teamRepo := tx.TeamRepository()
-	team, err := teamRepo.GetTeamByID(ctx, teamID)
+		team, err := tx.TeamRepository().GetTeamByID(ctx, teamID)
+		if err != nil {
+			return err
+		}
+		name = team.Name
+		return nil
+	})
 	if err != nil {
 		return "", err
 	}
-	return team.Name, nil
+	return name, nil
 }
 
 func (s *Service) UpdateUserName(ctx context.Context, id string, name string) error {
 	if id == "" || name == "" {
 		return utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
+	return s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		repo := tx.UserRepository()
+		before, err := repo.GetUserByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := repo.UpdateUserName(ctx, id, name); err != nil {
+			return err
+		}
+		after := *before
+		after.Name = name
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionUserRename, models.AuditEntityUser, id, before, after); err != nil {
+			s.log.Error("UpdateUserName audit failed", "err", err, "id", id)
+			return err
+		}
+		return nil
+	})
+}
+
+// SetUserTags заменяет набор тегов навыков пользователя нормализованным списком.
+func (s *Service) SetUserTags(ctx context.Context, id string, tags []string) (*models.User, error) {
+	if id == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	normalized, err := services.NormalizeTags(tags)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	var commit bool
-	defer func() {
-		if !commit {
-			_ = tx.Rollback(ctx)
+	var after models.User
+	err = s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		repo := tx.UserRepository()
+		before, err := repo.GetUserByID(ctx, id)
+		if err != nil {
+			return err
 		}
-	}()
-	repo := tx.UserRepository()
-	if err := repo.UpdateUserName(ctx, id, name); err != nil {
-		return err
+		if err := repo.SetUserTags(ctx, id, normalized); err != nil {
+			s.log.Error("SetUserTags repo failed", "err", err, "id", id)
+			return err
+		}
+		after = *before
+		after.Tags = normalized
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionUserSetTags, models.AuditEntityUser, id, before, after); err != nil {
+			s.log.Error("SetUserTags audit failed", "err", err, "id", id)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	if err := tx.Commit(ctx); err != nil {
-		return err
+	return &after, nil
+}
+
+// SetUserEmail задаёт адрес для уведомлений на почту; пустой адрес их отключает.
+func (s *Service) SetUserEmail(ctx context.Context, id string, email string) (*models.User, error) {
+	if id == "" {
+		return nil, utils.ErrInvalidArgument
 	}
-	commit = true
-	return nil
+	email = strings.TrimSpace(email)
+	if email != "" {
+		addr, err := mail.ParseAddress(email)
+		if err != nil || addr.Address != email {
+			return nil, utils.ErrInvalidEmail
+		}
+	}
+	var after models.User
+	err := s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		repo := tx.UserRepository()
+		before, err := repo.GetUserByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := repo.SetUserEmail(ctx, id, email); err != nil {
+			s.log.Error("SetUserEmail repo failed", "err", err, "id", id)
+			return err
+		}
+		after = *before
+		after.Email = email
+		if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionUserSetEmail, models.AuditEntityUser, id, before, after); err != nil {
+			s.log.Error("SetUserEmail audit failed", "err", err, "id", id)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &after, nil
 }
 
 func (s *Service) ListMembersByTeamID(ctx context.Context, teamID string) ([]*models.User, error) {
@@ -174,13 +251,12 @@ func (s *Service) ListMembersByTeamID(ctx context.Context, teamID string) ([]*mo
 	if err != nil {
 		return nil, utils.ErrInvalidArgument
 	}
-	tx, err := s.uow.Begin(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = tx.Rollback(ctx) }()
-	repo := tx.UserRepository()
-	res, err := repo.ListMembersByTeamID(ctx, parsed)
+	var res []*models.User
+	err = s.uow.Do(ctx, readOnly, func(tx uow.Transaction) error {
+		var err error
+		res, err = tx.UserRepository().ListMembersByTeamID(ctx, parsed)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
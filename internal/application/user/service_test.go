@@ -7,6 +7,7 @@ import (
 
 	app "avito-test-pr-service/internal/application/user"
 	"avito-test-pr-service/internal/domain/models"
+	uowport "avito-test-pr-service/internal/domain/ports/output/uow"
 	"avito-test-pr-service/internal/infrastructure/logger"
 	"avito-test-pr-service/internal/utils"
 	"avito-test-pr-service/mocks"
@@ -33,7 +34,7 @@ func TestUserService_CreateUser(t *testing.T) {
 			nameArg: "alice",
 			active:  true,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(repo)
 				repo.EXPECT().CreateUser(ctx, mock.MatchedBy(func(u *models.User) bool { return u.ID == "u-alice" && u.Name == "alice" && u.IsActive })).Return(nil)
 				tx.EXPECT().Commit(ctx).Return(nil)
@@ -54,7 +55,7 @@ func TestUserService_CreateUser(t *testing.T) {
 			nameArg: "bob",
 			active:  true,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(nil, errors.New("db down"))
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).Return(errors.New("db down"))
 			},
 			wantErr: errors.New("db down"),
 		},
@@ -64,7 +65,7 @@ func TestUserService_CreateUser(t *testing.T) {
 			nameArg: "carol",
 			active:  true,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(repo)
 				repo.EXPECT().CreateUser(ctx, mock.MatchedBy(func(u *models.User) bool { return u.ID == "u-carol" })).Return(utils.ErrUserExists)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -78,7 +79,7 @@ func TestUserService_CreateUser(t *testing.T) {
 			nameArg: "dave",
 			active:  true,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(repo)
 				repo.EXPECT().CreateUser(ctx, mock.MatchedBy(func(u *models.User) bool { return u.ID == "u-dave" })).Return(nil)
 				tx.EXPECT().Commit(ctx).Return(errors.New("commit fail"))
@@ -91,6 +92,9 @@ func TestUserService_CreateUser(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUOW := mocks.NewUnitOfWork(t)
 			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
 			mockRepo := mocks.NewUserRepository(t)
 			log := logger.New("dev")
 			if tt.mockSetup != nil {
@@ -128,7 +132,7 @@ func TestUserService_UpdateUserActive(t *testing.T) {
 		useIs     bool
 	}{
 		{"success", uid, false, func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-			uow.EXPECT().Begin(ctx).Return(tx, nil)
+			uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 			tx.EXPECT().UserRepository().Return(repo)
 			repo.EXPECT().GetUserByID(ctx, uid).Return(&models.User{ID: uid, Name: "alice", IsActive: true}, nil)
 			repo.EXPECT().UpdateUserActive(ctx, uid, false).Return(nil)
@@ -136,23 +140,23 @@ func TestUserService_UpdateUserActive(t *testing.T) {
 		}, nil, false},
 		{"invalid id", "", true, func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {}, utils.ErrInvalidArgument, true},
 		{"begin fails", uid, true, func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-			uow.EXPECT().Begin(ctx).Return(nil, errors.New("begin fail"))
+			uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).Return(errors.New("begin fail"))
 		}, errors.New("begin fail"), false},
 		{"get not found", uid, true, func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-			uow.EXPECT().Begin(ctx).Return(tx, nil)
+			uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 			tx.EXPECT().UserRepository().Return(repo)
 			repo.EXPECT().GetUserByID(ctx, uid).Return(nil, utils.ErrUserNotFound)
 			tx.EXPECT().Rollback(ctx).Return(nil)
 		}, utils.ErrUserNotFound, true},
 		{"update fails", uid, true, func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-			uow.EXPECT().Begin(ctx).Return(tx, nil)
+			uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 			tx.EXPECT().UserRepository().Return(repo)
 			repo.EXPECT().GetUserByID(ctx, uid).Return(&models.User{ID: uid, Name: "alice", IsActive: true}, nil)
 			repo.EXPECT().UpdateUserActive(ctx, uid, true).Return(errors.New("update fail"))
 			tx.EXPECT().Rollback(ctx).Return(nil)
 		}, errors.New("update fail"), false},
 		{"commit fails", uid, true, func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-			uow.EXPECT().Begin(ctx).Return(tx, nil)
+			uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 			tx.EXPECT().UserRepository().Return(repo)
 			repo.EXPECT().GetUserByID(ctx, uid).Return(&models.User{ID: uid, Name: "alice", IsActive: true}, nil)
 			repo.EXPECT().UpdateUserActive(ctx, uid, true).Return(nil)
@@ -164,6 +168,9 @@ func TestUserService_UpdateUserActive(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUOW := mocks.NewUnitOfWork(t)
 			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
 			mockRepo := mocks.NewUserRepository(t)
 			log := logger.New("dev")
 			if tt.mockSetup != nil {
@@ -199,7 +206,7 @@ func TestUserService_GetUser(t *testing.T) {
 			name:   "success",
 			userID: uid,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(repo)
 				repo.EXPECT().GetUserByID(ctx, uid).Return(&models.User{ID: uid, Name: "alice", IsActive: true}, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -216,7 +223,7 @@ func TestUserService_GetUser(t *testing.T) {
 			name:   "begin fails",
 			userID: uid,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(nil, errors.New("begin fail"))
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).Return(errors.New("begin fail"))
 			},
 			wantErr: errors.New("begin fail"),
 		},
@@ -224,7 +231,7 @@ func TestUserService_GetUser(t *testing.T) {
 			name:   "repo not found",
 			userID: uid,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(repo)
 				repo.EXPECT().GetUserByID(ctx, uid).Return(nil, utils.ErrUserNotFound)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -274,7 +281,7 @@ func TestUserService_ListUsers(t *testing.T) {
 		{
 			name: "success",
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, uowport.TxOptions{ReadOnly: true, Replica: true}, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(repo)
 				repo.EXPECT().ListUsers(ctx).Return([]*models.User{{ID: uid, Name: "alice", IsActive: true}}, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -283,14 +290,14 @@ func TestUserService_ListUsers(t *testing.T) {
 		{
 			name: "begin fails",
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(nil, errors.New("begin fail"))
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).Return(errors.New("begin fail"))
 			},
 			wantErr: errors.New("begin fail"),
 		},
 		{
 			name: "repo fails",
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(repo)
 				repo.EXPECT().ListUsers(ctx).Return(nil, errors.New("query fail"))
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -344,7 +351,7 @@ func TestUserService_GetUserTeamName(t *testing.T) {
 			name:   "success returns team name",
 			userID: uid,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, teamRepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetTeamIDByUserID(ctx, uid).Return(teamUUID, nil)
 				tx.EXPECT().TeamRepository().Return(teamRepo)
@@ -365,7 +372,7 @@ func TestUserService_GetUserTeamName(t *testing.T) {
 			name:   "begin fails",
 			userID: uid,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, teamRepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(nil, errors.New("begin fail"))
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).Return(errors.New("begin fail"))
 			},
 			wantErr: errors.New("begin fail"),
 		},
@@ -373,7 +380,7 @@ func TestUserService_GetUserTeamName(t *testing.T) {
 			name:   "no team returns empty name",
 			userID: uid,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, teamRepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetTeamIDByUserID(ctx, uid).Return(uuid.Nil, utils.ErrUserNoTeam)
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -384,7 +391,7 @@ func TestUserService_GetUserTeamName(t *testing.T) {
 			name:   "get team id fails",
 			userID: uid,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, teamRepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetTeamIDByUserID(ctx, uid).Return(uuid.Nil, errors.New("db fail"))
 				tx.EXPECT().Rollback(ctx).Return(nil)
@@ -395,7 +402,7 @@ func TestUserService_GetUserTeamName(t *testing.T) {
 			name:   "get team by id fails",
 			userID: uid,
 			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, userRepo *mocks.UserRepository, teamRepo *mocks.TeamRepository) {
-				uow.EXPECT().Begin(ctx).Return(tx, nil)
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
 				tx.EXPECT().UserRepository().Return(userRepo)
 				userRepo.EXPECT().GetTeamIDByUserID(ctx, uid).Return(teamUUID, nil)
 				tx.EXPECT().TeamRepository().Return(teamRepo)
@@ -432,3 +439,170 @@ func TestUserService_GetUserTeamName(t *testing.T) {
 		})
 	}
 }
+
+func TestUserService_ListUsersByIDs(t *testing.T) {
+	ctx := context.Background()
+	ids := []string{"u1", "u2"}
+	tests := []struct {
+		name      string
+		ids       []string
+		mockSetup func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository)
+		wantLen   int
+		wantErr   error
+	}{
+		{
+			name: "success",
+			ids:  ids,
+			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().UserRepository().Return(repo)
+				repo.EXPECT().ListUsersByIDs(ctx, ids).Return([]*models.User{{ID: "u1"}, {ID: "u2"}}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantLen: 2,
+		},
+		{
+			name:    "empty ids skip db",
+			ids:     nil,
+			wantLen: 0,
+		},
+		{
+			name: "repo fails",
+			ids:  ids,
+			mockSetup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
+				uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+				tx.EXPECT().UserRepository().Return(repo)
+				repo.EXPECT().ListUsersByIDs(ctx, ids).Return(nil, errors.New("query fail"))
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			wantErr: errors.New("query fail"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockRepo := mocks.NewUserRepository(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockUOW, mockTx, mockRepo)
+			}
+			svc := app.NewService(mockUOW, logger.New("dev"))
+			users, err := svc.ListUsersByIDs(ctx, tt.ids)
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, users, tt.wantLen)
+		})
+	}
+}
+
+func TestUserService_SetUserTags(t *testing.T) {
+	ctx := context.Background()
+	uid := "u-1"
+	tests := []struct {
+		name      string
+		userID    string
+		tags      []string
+		mockSetup func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository)
+		want      []string
+		wantErr   error
+	}{
+		{"success normalizes", uid, []string{"Go", " postgres", "go"}, func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
+			uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+			tx.EXPECT().UserRepository().Return(repo)
+			repo.EXPECT().GetUserByID(ctx, uid).Return(&models.User{ID: uid, Name: "alice"}, nil)
+			repo.EXPECT().SetUserTags(ctx, uid, []string{"go", "postgres"}).Return(nil)
+			tx.EXPECT().Commit(ctx).Return(nil)
+		}, []string{"go", "postgres"}, nil},
+		{"invalid id", "", nil, nil, nil, utils.ErrInvalidArgument},
+		{"invalid tag", uid, []string{"no spaces"}, nil, nil, utils.ErrInvalidTag},
+		{"user not found", uid, []string{"go"}, func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
+			uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+			tx.EXPECT().UserRepository().Return(repo)
+			repo.EXPECT().GetUserByID(ctx, uid).Return(nil, utils.ErrUserNotFound)
+			tx.EXPECT().Rollback(ctx).Return(nil)
+		}, nil, utils.ErrUserNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
+			mockRepo := mocks.NewUserRepository(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockUOW, mockTx, mockRepo)
+			}
+			svc := app.NewService(mockUOW, logger.New("dev"))
+			u, err := svc.SetUserTags(ctx, tt.userID, tt.tags)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, u.Tags)
+		})
+	}
+}
+
+func TestUserService_SetUserEmail(t *testing.T) {
+	ctx := context.Background()
+	uid := "u-1"
+	tests := []struct {
+		name      string
+		userID    string
+		email     string
+		mockSetup func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository)
+		want      string
+		wantErr   error
+	}{
+		{"success trims", uid, " alice@example.com ", func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
+			uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+			tx.EXPECT().UserRepository().Return(repo)
+			repo.EXPECT().GetUserByID(ctx, uid).Return(&models.User{ID: uid, Name: "alice"}, nil)
+			repo.EXPECT().SetUserEmail(ctx, uid, "alice@example.com").Return(nil)
+			tx.EXPECT().Commit(ctx).Return(nil)
+		}, "alice@example.com", nil},
+		{"empty clears", uid, "", func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
+			uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+			tx.EXPECT().UserRepository().Return(repo)
+			repo.EXPECT().GetUserByID(ctx, uid).Return(&models.User{ID: uid, Email: "alice@example.com"}, nil)
+			repo.EXPECT().SetUserEmail(ctx, uid, "").Return(nil)
+			tx.EXPECT().Commit(ctx).Return(nil)
+		}, "", nil},
+		{"invalid id", "", "alice@example.com", nil, "", utils.ErrInvalidArgument},
+		{"invalid email", uid, "alice", nil, "", utils.ErrInvalidEmail},
+		{"display name rejected", uid, "Alice <alice@example.com>", nil, "", utils.ErrInvalidEmail},
+		{"user not found", uid, "alice@example.com", func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository) {
+			uow.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(tx)
+			tx.EXPECT().UserRepository().Return(repo)
+			repo.EXPECT().GetUserByID(ctx, uid).Return(nil, utils.ErrUserNotFound)
+			tx.EXPECT().Rollback(ctx).Return(nil)
+		}, "", utils.ErrUserNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockAuditRepo := mocks.NewAuditRepository(t)
+			mockTx.EXPECT().AuditRepository().Maybe().Return(mockAuditRepo)
+			mockAuditRepo.EXPECT().Append(ctx, mock.Anything).Maybe().Return(nil)
+			mockRepo := mocks.NewUserRepository(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockUOW, mockTx, mockRepo)
+			}
+			svc := app.NewService(mockUOW, logger.New("dev"))
+			u, err := svc.SetUserEmail(ctx, tt.userID, tt.email)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, u.Email)
+		})
+	}
+}
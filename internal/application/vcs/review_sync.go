@@ -0,0 +1,228 @@
+package vcs
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	ports "avito-test-pr-service/internal/domain/ports/output"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	vcs_port "avito-test-pr-service/internal/domain/ports/output/vcs"
+	"avito-test-pr-service/internal/utils"
+	"context"
+	"errors"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// ReviewSyncOptions — параметры фоновой синхронизации ревьюверов с VCS.
+type ReviewSyncOptions struct {
+	// Workers — число обработчиков; события одного PR всегда попадают к одному обработчику и идут по порядку.
+	Workers   int
+	QueueSize int
+	// MaxAttempts — попыток на вызов API, включая первую; Backoff удваивается до MaxBackoff.
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+	CallTimeout time.Duration
+}
+
+func DefaultReviewSyncOptions() ReviewSyncOptions {
+	return ReviewSyncOptions{
+		Workers:     4,
+		QueueSize:   1024,
+		MaxAttempts: 5,
+		Backoff:     time.Second,
+		MaxBackoff:  30 * time.Second,
+		CallTimeout: 10 * time.Second,
+	}
+}
+
+// ReviewSync получает события ленты ревьюверов после коммита (как feed.Publisher) и асинхронно
+// повторяет назначения и снятия в VCS, где живёт PR. PR без связи с VCS и пользователи без
+// сопоставленного логина пропускаются. Временные ошибки API повторяются с экспоненциальной паузой,
+// ответ с utils.ErrVCSRejected — нет; неудачи только логируются и на операции сервиса не влияют.
+type ReviewSync struct {
+	uow        uow.UnitOfWork
+	requesters map[models.VCSProvider]vcs_port.ReviewRequester
+	opts       ReviewSyncOptions
+	log        ports.Logger
+
+	mu     sync.RWMutex
+	closed bool
+	queues []chan []*models.ReviewFeedEvent
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+func NewReviewSync(uow uow.UnitOfWork, requesters map[models.VCSProvider]vcs_port.ReviewRequester, opts ReviewSyncOptions, log ports.Logger) *ReviewSync {
+	def := DefaultReviewSyncOptions()
+	if opts.Workers <= 0 {
+		opts.Workers = def.Workers
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = def.QueueSize
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = def.MaxAttempts
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = def.Backoff
+	}
+	if opts.MaxBackoff < opts.Backoff {
+		opts.MaxBackoff = opts.Backoff
+	}
+	if opts.CallTimeout <= 0 {
+		opts.CallTimeout = def.CallTimeout
+	}
+	s := &ReviewSync{uow: uow, requesters: requesters, opts: opts, log: log, done: make(chan struct{})}
+	for i := 0; i < opts.Workers; i++ {
+		q := make(chan []*models.ReviewFeedEvent, opts.QueueSize)
+		s.queues = append(s.queues, q)
+		s.wg.Add(1)
+		go s.work(q)
+	}
+	return s
+}
+
+// Publish раскладывает события по PR и ставит в очередь, не блокируя вызывающего.
+// При переполненной очереди события отбрасываются с предупреждением.
+func (s *ReviewSync) Publish(events []*models.ReviewFeedEvent) {
+	var order []string
+	byPR := make(map[string][]*models.ReviewFeedEvent)
+	for _, e := range events {
+		if e.Type != models.ReviewFeedAssigned && e.Type != models.ReviewFeedUnassigned {
+			continue
+		}
+		if _, ok := byPR[e.PRID]; !ok {
+			order = append(order, e.PRID)
+		}
+		byPR[e.PRID] = append(byPR[e.PRID], e)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return
+	}
+	for _, prID := range order {
+		select {
+		case s.queues[shard(prID, len(s.queues))] <- byPR[prID]:
+		default:
+			s.log.Warn("Review sync queue is full, events dropped", "pr_id", prID, "events", len(byPR[prID]))
+		}
+	}
+}
+
+// Close прекращает приём событий, обрабатывает уже поставленные в очередь без повторов и ждёт обработчиков.
+func (s *ReviewSync) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.done)
+	for _, q := range s.queues {
+		close(q)
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+func shard(prID string, n int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(prID))
+	return int(h.Sum32() % uint32(n))
+}
+
+func (s *ReviewSync) work(q <-chan []*models.ReviewFeedEvent) {
+	defer s.wg.Done()
+	for events := range q {
+		s.sync(events)
+	}
+}
+
+// sync применяет события одного PR по порядку: подряд идущие события одного типа — одним вызовом API.
+func (s *ReviewSync) sync(events []*models.ReviewFeedEvent) {
+	prID := events[0].PRID
+	link, logins, err := s.load(prID, events)
+	if errors.Is(err, utils.ErrVCSLinkNotFound) {
+		return
+	}
+	if err != nil {
+		s.log.Error("Review sync load failed", "err", err, "pr_id", prID)
+		return
+	}
+	requester := s.requesters[link.Provider]
+	if requester == nil {
+		return
+	}
+
+	for i := 0; i < len(events); {
+		typ := events[i].Type
+		var batch []string
+		for ; i < len(events) && events[i].Type == typ; i++ {
+			if login, ok := logins[events[i].UserID]; ok {
+				batch = append(batch, login)
+			}
+		}
+		if len(batch) == 0 {
+			continue
+		}
+		call := requester.RequestReviewers
+		if typ == models.ReviewFeedUnassigned {
+			call = requester.RemoveReviewers
+		}
+		s.retry(link, string(typ), batch, call)
+	}
+}
+
+func (s *ReviewSync) load(prID string, events []*models.ReviewFeedEvent) (*models.VCSPullRequestLink, map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.opts.CallTimeout)
+	defer cancel()
+	var (
+		link   *models.VCSPullRequestLink
+		logins map[string]string
+	)
+	err := s.uow.Do(ctx, uow.TxOptions{ReadOnly: true}, func(tx uow.Transaction) error {
+		var err error
+		link, err = tx.PRRepository().GetVCSLink(ctx, prID)
+		if err != nil || s.requesters[link.Provider] == nil {
+			return err
+		}
+		userIDs := make([]string, 0, len(events))
+		for _, e := range events {
+			userIDs = append(userIDs, e.UserID)
+		}
+		logins, err = tx.UserRepository().GetVCSLogins(ctx, link.Provider, userIDs)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return link, logins, nil
+}
+
+func (s *ReviewSync) retry(link *models.VCSPullRequestLink, op string, logins []string,
+	call func(context.Context, *models.VCSPullRequestLink, []string) error) {
+	backoff := s.opts.Backoff
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), s.opts.CallTimeout)
+		err := call(ctx, link, logins)
+		cancel()
+		if err == nil {
+			return
+		}
+		if errors.Is(err, utils.ErrVCSRejected) || attempt >= s.opts.MaxAttempts {
+			s.log.Error("Review sync failed", "err", err, "pr_id", link.PRID, "provider", link.Provider, "op", op, "logins", logins, "attempts", attempt)
+			return
+		}
+		s.log.Warn("Review sync attempt failed", "err", err, "pr_id", link.PRID, "op", op, "attempt", attempt, "retry_in", backoff.String())
+		select {
+		case <-time.After(backoff):
+		case <-s.done:
+			s.log.Error("Review sync aborted on shutdown", "err", err, "pr_id", link.PRID, "op", op, "logins", logins)
+			return
+		}
+		backoff = min(backoff*2, s.opts.MaxBackoff)
+	}
+}
@@ -0,0 +1,122 @@
+package vcs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	app "avito-test-pr-service/internal/application/vcs"
+	"avito-test-pr-service/internal/domain/models"
+	vcs_port "avito-test-pr-service/internal/domain/ports/output/vcs"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/utils"
+	"avito-test-pr-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var syncLink = &models.VCSPullRequestLink{PRID: "gh-1-42", Provider: models.VCSProviderGitHub, Repository: "acme/pr-service", Number: 42}
+
+func syncOptions() app.ReviewSyncOptions {
+	return app.ReviewSyncOptions{Workers: 2, MaxAttempts: 3, Backoff: time.Millisecond, CallTimeout: time.Second}
+}
+
+func feedEvent(prID, userID string, typ models.ReviewFeedEventType) *models.ReviewFeedEvent {
+	return &models.ReviewFeedEvent{PRID: prID, UserID: userID, Type: typ}
+}
+
+// expectLoad настраивает чтение связей PR и логинов; PR без связи в links считается не связанным с VCS.
+func expectLoad(t *testing.T, u *mocks.UnitOfWork, links map[string]*models.VCSPullRequestLink, logins map[string]string) {
+	tx := mocks.NewTransaction(t)
+	prRepo := mocks.NewPRRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	u.EXPECT().Do(mock.Anything, mock.Anything, mock.Anything).RunInTx(tx)
+	tx.EXPECT().Rollback(mock.Anything).Return(nil)
+	tx.EXPECT().PRRepository().Return(prRepo)
+	tx.EXPECT().UserRepository().Return(userRepo).Maybe()
+	prRepo.EXPECT().GetVCSLink(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, prID string) (*models.VCSPullRequestLink, error) {
+		if link, ok := links[prID]; ok {
+			return link, nil
+		}
+		return nil, utils.ErrVCSLinkNotFound
+	})
+	userRepo.EXPECT().GetVCSLogins(mock.Anything, models.VCSProviderGitHub, mock.Anything).Return(logins, nil).Maybe()
+}
+
+func TestReviewSync_AppliesEventsInOrder(t *testing.T) {
+	mockUOW := mocks.NewUnitOfWork(t)
+	requester := mocks.NewReviewRequester(t)
+	// u9 не сопоставлен с логином GitHub, manual-1 создан не из VCS.
+	expectLoad(t, mockUOW, map[string]*models.VCSPullRequestLink{"gh-1-42": syncLink}, map[string]string{"u2": "bob", "u3": "carol", "u4": "dave"})
+
+	var calls []string
+	record := func(op string) func(context.Context, *models.VCSPullRequestLink, []string) error {
+		return func(_ context.Context, _ *models.VCSPullRequestLink, logins []string) error {
+			calls = append(calls, fmt.Sprint(op, logins))
+			return nil
+		}
+	}
+	requester.EXPECT().RequestReviewers(mock.Anything, syncLink, mock.Anything).RunAndReturn(record("request"))
+	requester.EXPECT().RemoveReviewers(mock.Anything, syncLink, mock.Anything).RunAndReturn(record("remove"))
+
+	s := app.NewReviewSync(mockUOW, map[models.VCSProvider]vcs_port.ReviewRequester{models.VCSProviderGitHub: requester}, syncOptions(), logger.New("dev"))
+	s.Publish([]*models.ReviewFeedEvent{
+		feedEvent("gh-1-42", "u2", models.ReviewFeedAssigned),
+		feedEvent("gh-1-42", "u3", models.ReviewFeedAssigned),
+		feedEvent("gh-1-42", "u9", models.ReviewFeedAssigned),
+		feedEvent("manual-1", "u2", models.ReviewFeedAssigned),
+	})
+	s.Publish([]*models.ReviewFeedEvent{
+		feedEvent("gh-1-42", "u2", models.ReviewFeedUnassigned),
+		feedEvent("gh-1-42", "u4", models.ReviewFeedAssigned),
+		feedEvent("gh-1-42", "u3", models.ReviewFeedMerged),
+	})
+	s.Close()
+	s.Publish([]*models.ReviewFeedEvent{feedEvent("gh-1-42", "u2", models.ReviewFeedAssigned)})
+
+	require.Equal(t, []string{"request[bob carol]", "remove[bob]", "request[dave]"}, calls)
+}
+
+func TestReviewSync_Retries(t *testing.T) {
+	tests := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+	}{
+		{name: "transient error then success", errs: []error{errors.New("502"), errors.New("timeout"), nil}, wantCalls: 3},
+		{name: "rejected is not retried", errs: []error{fmt.Errorf("%w: 422", utils.ErrVCSRejected)}, wantCalls: 1},
+		{name: "gives up after max attempts", errs: []error{errors.New("502"), errors.New("502"), errors.New("502")}, wantCalls: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			requester := mocks.NewReviewRequester(t)
+			expectLoad(t, mockUOW, map[string]*models.VCSPullRequestLink{"gh-1-42": syncLink}, map[string]string{"u2": "bob"})
+
+			calls := 0
+			finished := make(chan struct{})
+			requester.EXPECT().RequestReviewers(mock.Anything, syncLink, []string{"bob"}).
+				RunAndReturn(func(context.Context, *models.VCSPullRequestLink, []string) error {
+					err := tt.errs[calls]
+					calls++
+					if calls == tt.wantCalls {
+						close(finished)
+					}
+					return err
+				})
+
+			s := app.NewReviewSync(mockUOW, map[models.VCSProvider]vcs_port.ReviewRequester{models.VCSProviderGitHub: requester}, syncOptions(), logger.New("dev"))
+			s.Publish([]*models.ReviewFeedEvent{feedEvent("gh-1-42", "u2", models.ReviewFeedAssigned)})
+			select {
+			case <-finished:
+			case <-time.After(5 * time.Second):
+				t.Fatal("review sync did not finish")
+			}
+			s.Close()
+			require.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package vcs
+
+import (
+	"avito-test-pr-service/internal/application/audit"
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/domain/ports/input"
+	ports "avito-test-pr-service/internal/domain/ports/output"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/internal/utils"
+	"context"
+	"errors"
+	"strings"
+)
+
+// Service переводит события PR из VCS в операции PR-сервиса. Автор PR определяется по таблице
+// сопоставления логинов, общей для всех VCS; PR, автор которого не сопоставлен, пропускается.
+type Service struct {
+	uow       uow.UnitOfWork
+	prService input.PRInputPort
+	log       ports.Logger
+}
+
+func NewService(uow uow.UnitOfWork, prService input.PRInputPort, log ports.Logger) input.VCSInputPort {
+	return &Service{uow: uow, prService: prService, log: log}
+}
+
+// HandlePullRequestEvent применяет событие идемпотентно: повторная доставка вебхука не меняет результат.
+// Без X-Actor-ID инициатором в журнале аудита записывается отправитель события («github:login»).
+// Открытие существующего PR даёт exists, переоткрытие неизвестного PR создаёт его,
+// мерж и закрытие неизвестного PR пропускаются. Черновик не создаётся; перевод в черновик закрывает PR,
+// а готовность к ревью — переоткрывает.
+func (s *Service) HandlePullRequestEvent(ctx context.Context, event *models.VCSPullRequestEvent) (*models.VCSEventResult, error) {
+	if event == nil || !event.Provider.IsValid() || event.PRID == "" {
+		return nil, utils.ErrInvalidArgument
+	}
+	if utils.ActorFromContext(ctx) == "" && event.Sender != "" {
+		ctx = utils.WithActor(ctx, string(event.Provider)+":"+event.Sender)
+	}
+	switch event.Action {
+	case models.VCSActionOpened:
+		return s.open(ctx, event)
+	case models.VCSActionReopened, models.VCSActionReady:
+		pr, err := s.prService.ReopenPR(ctx, event.PRID)
+		if errors.Is(err, utils.ErrPRNotFound) {
+			return s.open(ctx, event)
+		}
+		return s.result(models.VCSOutcomeReopened, pr, err)
+	case models.VCSActionMerged:
+		pr, err := s.prService.MergePR(ctx, event.PRID, nil)
+		return s.result(models.VCSOutcomeMerged, pr, err)
+	case models.VCSActionClosed, models.VCSActionDraft:
+		pr, err := s.prService.ClosePR(ctx, event.PRID)
+		return s.result(models.VCSOutcomeClosed, pr, err)
+	default:
+		return &models.VCSEventResult{Outcome: models.VCSOutcomeIgnored, Reason: "unsupported action " + string(event.Action)}, nil
+	}
+}
+
+func (s *Service) open(ctx context.Context, event *models.VCSPullRequestEvent) (*models.VCSEventResult, error) {
+	if event.Draft {
+		return &models.VCSEventResult{Outcome: models.VCSOutcomeIgnored, Reason: "pull request is a draft"}, nil
+	}
+	authorID, err := s.resolveAuthor(ctx, event)
+	if errors.Is(err, utils.ErrVCSIdentityNotFound) {
+		s.log.Warn("VCS author is not mapped", "provider", event.Provider, "login", event.AuthorLogin, "pr_id", event.PRID)
+		return &models.VCSEventResult{Outcome: models.VCSOutcomeIgnored, Reason: "author " + event.AuthorLogin + " is not mapped to a user"}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	pr, err := s.prService.CreatePR(ctx, event.PRID, authorID, event.Title, nil, labels(event.Labels))
+	if errors.Is(err, utils.ErrPRExists) {
+		pr, err = s.prService.GetPR(ctx, event.PRID)
+		return s.result(models.VCSOutcomeExists, pr, err)
+	}
+	return s.result(models.VCSOutcomeCreated, pr, err)
+}
+
+// labels оставляет метки VCS, подходящие под алфавит тегов; остальные («good first issue»)
+// не участвуют в подборе по навыкам и не должны мешать созданию PR.
+func labels(raw []string) []string {
+	res := make([]string, 0, len(raw))
+	for _, l := range raw {
+		if _, err := services.NormalizeTags([]string{l}); err == nil {
+			res = append(res, l)
+		}
+	}
+	return res
+}
+
+func (s *Service) result(outcome models.VCSEventOutcome, pr *models.PullRequest, err error) (*models.VCSEventResult, error) {
+	if errors.Is(err, utils.ErrPRNotFound) {
+		return &models.VCSEventResult{Outcome: models.VCSOutcomeIgnored, Reason: "pull request is not tracked"}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.VCSEventResult{Outcome: outcome, PR: pr}, nil
+}
+
+// resolveAuthor находит пользователя-автора PR и в той же транзакции сохраняет связь PR с VCS.
+// Связь пишется до создания PR, чтобы синхронизация ревьюверов нашла её уже для первых назначений.
+func (s *Service) resolveAuthor(ctx context.Context, event *models.VCSPullRequestEvent) (string, error) {
+	if event.AuthorLogin == "" {
+		return "", utils.ErrVCSIdentityNotFound
+	}
+	var userID string
+	err := s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		identity, err := tx.UserRepository().GetVCSIdentity(ctx, event.Provider, strings.ToLower(event.AuthorLogin))
+		if err != nil {
+			return err
+		}
+		userID = identity.UserID
+		if event.Repository == "" || event.Number <= 0 {
+			return nil
+		}
+		link := &models.VCSPullRequestLink{PRID: event.PRID, Provider: event.Provider, Repository: event.Repository, Number: event.Number}
+		return tx.PRRepository().SetVCSLink(ctx, link)
+	})
+	if err != nil {
+		return "", err
+	}
+	return userID, nil
+}
+
+// SetIdentities добавляет или перепривязывает логины VCS в одной транзакции.
+func (s *Service) SetIdentities(ctx context.Context, identities []*models.VCSIdentity) ([]*models.VCSIdentity, error) {
+	if len(identities) == 0 {
+		return nil, utils.ErrInvalidArgument
+	}
+	for _, id := range identities {
+		if !id.Provider.IsValid() {
+			return nil, utils.ErrInvalidProvider
+		}
+		if strings.TrimSpace(id.Login) == "" || id.UserID == "" {
+			return nil, utils.ErrInvalidArgument
+		}
+	}
+	var res []*models.VCSIdentity
+	err := s.uow.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+		userRepo := tx.UserRepository()
+		res = make([]*models.VCSIdentity, 0, len(identities))
+		for _, id := range identities {
+			identity := &models.VCSIdentity{Provider: id.Provider, Login: strings.ToLower(strings.TrimSpace(id.Login)), UserID: id.UserID}
+			before, err := userRepo.GetVCSIdentity(ctx, identity.Provider, identity.Login)
+			if err != nil && !errors.Is(err, utils.ErrVCSIdentityNotFound) {
+				return err
+			}
+			if before != nil && before.UserID == identity.UserID {
+				res = append(res, identity)
+				continue
+			}
+			if err := userRepo.SetVCSIdentity(ctx, identity); err != nil {
+				s.log.Error("SetVCSIdentities repo failed", "err", err, "provider", identity.Provider, "login", identity.Login)
+				return err
+			}
+			if err := audit.Record(ctx, tx.AuditRepository(), models.AuditActionUserVCSIdentity, models.AuditEntityUser, identity.UserID, before, identity); err != nil {
+				s.log.Error("SetVCSIdentities audit failed", "err", err, "user_id", identity.UserID)
+				return err
+			}
+			res = append(res, identity)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *Service) ListIdentities(ctx context.Context, provider models.VCSProvider) ([]*models.VCSIdentity, error) {
+	if provider != "" && !provider.IsValid() {
+		return nil, utils.ErrInvalidProvider
+	}
+	var res []*models.VCSIdentity
+	err := s.uow.Do(ctx, uow.TxOptions{ReadOnly: true}, func(tx uow.Transaction) error {
+		var err error
+		res, err = tx.UserRepository().ListVCSIdentities(ctx, provider)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,254 @@
+package vcs_test
+
+import (
+	"context"
+	"testing"
+
+	app "avito-test-pr-service/internal/application/vcs"
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/utils"
+	"avito-test-pr-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func event(action models.VCSPullRequestAction) *models.VCSPullRequestEvent {
+	return &models.VCSPullRequestEvent{
+		Provider:    models.VCSProviderGitHub,
+		Action:      action,
+		PRID:        "gh-1-42",
+		Title:       "Add search index",
+		AuthorLogin: "Octo-Alice",
+		Labels:      []string{"go", "good first issue"},
+		Sender:      "bob",
+	}
+}
+
+// expectLogin настраивает транзакцию поиска автора по логину.
+func expectLogin(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, userID string, err error) {
+	uow.EXPECT().Do(mock.Anything, mock.Anything, mock.Anything).RunInTx(tx)
+	tx.EXPECT().UserRepository().Return(repo)
+	var identity *models.VCSIdentity
+	if err == nil {
+		identity = &models.VCSIdentity{Provider: models.VCSProviderGitHub, Login: "octo-alice", UserID: userID}
+		tx.EXPECT().Commit(mock.Anything).Return(nil)
+	} else {
+		tx.EXPECT().Rollback(mock.Anything).Return(nil)
+	}
+	repo.EXPECT().GetVCSIdentity(mock.Anything, models.VCSProviderGitHub, "octo-alice").Return(identity, err)
+}
+
+func TestVCSService_HandlePullRequestEvent(t *testing.T) {
+	open := &models.PullRequest{ID: "gh-1-42", Status: models.PRStatusOPEN}
+	tests := []struct {
+		name        string
+		event       *models.VCSPullRequestEvent
+		setup       func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort)
+		wantOutcome models.VCSEventOutcome
+		wantErr     error
+	}{
+		{
+			name:  "opened creates PR with valid labels",
+			event: event(models.VCSActionOpened),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort) {
+				expectLogin(uow, tx, repo, "u1", nil)
+				prs.EXPECT().CreatePR(mock.MatchedBy(func(ctx context.Context) bool {
+					return utils.ActorFromContext(ctx) == "github:bob"
+				}), "gh-1-42", "u1", "Add search index", []string(nil), []string{"go"}).Return(open, nil)
+			},
+			wantOutcome: models.VCSOutcomeCreated,
+		},
+		{
+			name:  "redelivered opened returns existing PR",
+			event: event(models.VCSActionOpened),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort) {
+				expectLogin(uow, tx, repo, "u1", nil)
+				prs.EXPECT().CreatePR(mock.Anything, "gh-1-42", "u1", mock.Anything, mock.Anything, mock.Anything).Return(nil, utils.ErrPRExists)
+				prs.EXPECT().GetPR(mock.Anything, "gh-1-42").Return(open, nil)
+			},
+			wantOutcome: models.VCSOutcomeExists,
+		},
+		{
+			name:  "unmapped author is ignored",
+			event: event(models.VCSActionOpened),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort) {
+				expectLogin(uow, tx, repo, "", utils.ErrVCSIdentityNotFound)
+			},
+			wantOutcome: models.VCSOutcomeIgnored,
+		},
+		{
+			name:  "author without team",
+			event: event(models.VCSActionOpened),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort) {
+				expectLogin(uow, tx, repo, "u1", nil)
+				prs.EXPECT().CreatePR(mock.Anything, "gh-1-42", "u1", mock.Anything, mock.Anything, mock.Anything).Return(nil, utils.ErrUserNoTeam)
+			},
+			wantErr: utils.ErrUserNoTeam,
+		},
+		{
+			name:  "merged",
+			event: event(models.VCSActionMerged),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort) {
+				prs.EXPECT().MergePR(mock.Anything, "gh-1-42", mock.Anything).Return(&models.PullRequest{ID: "gh-1-42", Status: models.PRStatusMERGED}, nil)
+			},
+			wantOutcome: models.VCSOutcomeMerged,
+		},
+		{
+			name:  "closed untracked PR is ignored",
+			event: event(models.VCSActionClosed),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort) {
+				prs.EXPECT().ClosePR(mock.Anything, "gh-1-42").Return(nil, utils.ErrPRNotFound)
+			},
+			wantOutcome: models.VCSOutcomeIgnored,
+		},
+		{
+			name:  "reopened untracked PR is created",
+			event: event(models.VCSActionReopened),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort) {
+				prs.EXPECT().ReopenPR(mock.Anything, "gh-1-42").Return(nil, utils.ErrPRNotFound)
+				expectLogin(uow, tx, repo, "u1", nil)
+				prs.EXPECT().CreatePR(mock.Anything, "gh-1-42", "u1", mock.Anything, mock.Anything, mock.Anything).Return(open, nil)
+			},
+			wantOutcome: models.VCSOutcomeCreated,
+		},
+		{
+			name:  "reopen of merged PR conflicts",
+			event: event(models.VCSActionReopened),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort) {
+				prs.EXPECT().ReopenPR(mock.Anything, "gh-1-42").Return(nil, utils.ErrAlreadyMerged)
+			},
+			wantErr: utils.ErrAlreadyMerged,
+		},
+		{
+			name: "opened draft is not created",
+			event: func() *models.VCSPullRequestEvent {
+				e := event(models.VCSActionOpened)
+				e.Draft = true
+				return e
+			}(),
+			wantOutcome: models.VCSOutcomeIgnored,
+		},
+		{
+			name:  "converted to draft closes PR",
+			event: event(models.VCSActionDraft),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort) {
+				prs.EXPECT().ClosePR(mock.Anything, "gh-1-42").Return(&models.PullRequest{ID: "gh-1-42", Status: models.PRStatusCLOSED}, nil)
+			},
+			wantOutcome: models.VCSOutcomeClosed,
+		},
+		{
+			name:  "ready draft is created",
+			event: event(models.VCSActionReady),
+			setup: func(uow *mocks.UnitOfWork, tx *mocks.Transaction, repo *mocks.UserRepository, prs *mocks.PRInputPort) {
+				prs.EXPECT().ReopenPR(mock.Anything, "gh-1-42").Return(nil, utils.ErrPRNotFound)
+				expectLogin(uow, tx, repo, "u1", nil)
+				prs.EXPECT().CreatePR(mock.Anything, "gh-1-42", "u1", mock.Anything, mock.Anything, mock.Anything).Return(open, nil)
+			},
+			wantOutcome: models.VCSOutcomeCreated,
+		},
+		{name: "unknown action", event: event("edited"), wantOutcome: models.VCSOutcomeIgnored},
+		{name: "unknown provider", event: &models.VCSPullRequestEvent{Provider: "svn", PRID: "x"}, wantErr: utils.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := mocks.NewUnitOfWork(t)
+			mockTx := mocks.NewTransaction(t)
+			mockRepo := mocks.NewUserRepository(t)
+			mockPRs := mocks.NewPRInputPort(t)
+			if tt.setup != nil {
+				tt.setup(mockUOW, mockTx, mockRepo, mockPRs)
+			}
+			svc := app.NewService(mockUOW, mockPRs, logger.New("dev"))
+			res, err := svc.HandlePullRequestEvent(context.Background(), tt.event)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantOutcome, res.Outcome)
+			if res.Outcome == models.VCSOutcomeIgnored {
+				require.NotEmpty(t, res.Reason)
+			}
+		})
+	}
+}
+
+func TestVCSService_OpenedSavesLinkBeforeCreate(t *testing.T) {
+	ctx := context.Background()
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockPRRepo := mocks.NewPRRepository(t)
+	mockPRs := mocks.NewPRInputPort(t)
+
+	e := event(models.VCSActionOpened)
+	e.Repository, e.Number = "acme/pr-service", 42
+	linked := false
+	mockUOW.EXPECT().Do(mock.Anything, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().UserRepository().Return(mockUserRepo)
+	mockTx.EXPECT().PRRepository().Return(mockPRRepo)
+	mockUserRepo.EXPECT().GetVCSIdentity(mock.Anything, models.VCSProviderGitHub, "octo-alice").
+		Return(&models.VCSIdentity{UserID: "u1"}, nil)
+	mockPRRepo.EXPECT().SetVCSLink(mock.Anything, &models.VCSPullRequestLink{
+		PRID: "gh-1-42", Provider: models.VCSProviderGitHub, Repository: "acme/pr-service", Number: 42,
+	}).RunAndReturn(func(context.Context, *models.VCSPullRequestLink) error {
+		linked = true
+		return nil
+	})
+	mockTx.EXPECT().Commit(mock.Anything).Return(nil)
+	mockPRs.EXPECT().CreatePR(mock.Anything, "gh-1-42", "u1", mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(context.Context, string, string, string, []string, []string) (*models.PullRequest, error) {
+			require.True(t, linked, "link must be saved before reviewers are assigned")
+			return &models.PullRequest{ID: "gh-1-42", Status: models.PRStatusOPEN}, nil
+		})
+
+	res, err := app.NewService(mockUOW, mockPRs, logger.New("dev")).HandlePullRequestEvent(ctx, e)
+	require.NoError(t, err)
+	require.Equal(t, models.VCSOutcomeCreated, res.Outcome)
+}
+
+func TestVCSService_SetIdentities(t *testing.T) {
+	ctx := context.Background()
+	mockUOW := mocks.NewUnitOfWork(t)
+	mockTx := mocks.NewTransaction(t)
+	mockRepo := mocks.NewUserRepository(t)
+	mockAuditRepo := mocks.NewAuditRepository(t)
+
+	mockUOW.EXPECT().Do(ctx, mock.Anything, mock.Anything).RunInTx(mockTx)
+	mockTx.EXPECT().UserRepository().Return(mockRepo)
+	mockTx.EXPECT().AuditRepository().Return(mockAuditRepo)
+	// octo-alice уже привязан к u1 — без записи и аудита; bob перепривязывается с u9 на u2.
+	mockRepo.EXPECT().GetVCSIdentity(ctx, models.VCSProviderGitHub, "octo-alice").
+		Return(&models.VCSIdentity{Provider: models.VCSProviderGitHub, Login: "octo-alice", UserID: "u1"}, nil)
+	mockRepo.EXPECT().GetVCSIdentity(ctx, models.VCSProviderGitLab, "bob").
+		Return(&models.VCSIdentity{Provider: models.VCSProviderGitLab, Login: "bob", UserID: "u9"}, nil)
+	mockRepo.EXPECT().SetVCSIdentity(ctx, &models.VCSIdentity{Provider: models.VCSProviderGitLab, Login: "bob", UserID: "u2"}).Return(nil)
+	mockAuditRepo.EXPECT().Append(ctx, mock.MatchedBy(func(e *models.AuditEntry) bool {
+		return e.Action == models.AuditActionUserVCSIdentity && e.EntityID == "u2"
+	})).Return(nil).Once()
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	svc := app.NewService(mockUOW, mocks.NewPRInputPort(t), logger.New("dev"))
+	res, err := svc.SetIdentities(ctx, []*models.VCSIdentity{
+		{Provider: models.VCSProviderGitHub, Login: " Octo-Alice ", UserID: "u1"},
+		{Provider: models.VCSProviderGitLab, Login: "Bob", UserID: "u2"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*models.VCSIdentity{
+		{Provider: models.VCSProviderGitHub, Login: "octo-alice", UserID: "u1"},
+		{Provider: models.VCSProviderGitLab, Login: "bob", UserID: "u2"},
+	}, res)
+}
+
+func TestVCSService_SetIdentities_Invalid(t *testing.T) {
+	svc := app.NewService(mocks.NewUnitOfWork(t), mocks.NewPRInputPort(t), logger.New("dev"))
+	_, err := svc.SetIdentities(context.Background(), []*models.VCSIdentity{{Provider: "svn", Login: "a", UserID: "u1"}})
+	require.ErrorIs(t, err, utils.ErrInvalidProvider)
+	_, err = svc.SetIdentities(context.Background(), []*models.VCSIdentity{{Provider: models.VCSProviderGitHub, Login: " ", UserID: "u1"}})
+	require.ErrorIs(t, err, utils.ErrInvalidArgument)
+	_, err = svc.ListIdentities(context.Background(), "svn")
+	require.ErrorIs(t, err, utils.ErrInvalidProvider)
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionTeamCreate       AuditAction = "team.create"
+	AuditActionTeamMemberAdd    AuditAction = "team.member_add"
+	AuditActionTeamMemberRemove AuditAction = "team.member_remove"
+	AuditActionTeamCodeOwners   AuditAction = "team.code_owners_set"
+	AuditActionTeamStrategySet  AuditAction = "team.strategy_set"
+	AuditActionTeamConstraints  AuditAction = "team.constraints_set"
+	AuditActionUserCreate       AuditAction = "user.create"
+	AuditActionUserSetActive    AuditAction = "user.set_active"
+	AuditActionUserRename       AuditAction = "user.rename"
+	AuditActionUserSetTags      AuditAction = "user.set_tags"
+	AuditActionUserSetEmail     AuditAction = "user.set_email"
+	AuditActionPRCreate         AuditAction = "pr.create"
+	AuditActionPRReassign       AuditAction = "pr.reassign"
+	AuditActionPRMerge          AuditAction = "pr.merge"
+	AuditActionPRReviewerAdd    AuditAction = "pr.reviewer_add"
+	AuditActionPRReviewerRemove AuditAction = "pr.reviewer_remove"
+	AuditActionPRClose          AuditAction = "pr.close"
+	AuditActionPRReopen         AuditAction = "pr.reopen"
+	AuditActionUserVCSIdentity  AuditAction = "user.vcs_identity_set"
+	AuditActionUserNotifyPrefs  AuditAction = "user.notifications_set"
+)
+
+type AuditEntityType string
+
+const (
+	AuditEntityTeam AuditEntityType = "team"
+	AuditEntityUser AuditEntityType = "user"
+	AuditEntityPR   AuditEntityType = "pull_request"
+)
+
+type AuditEntry struct {
+	ID int64
+	// Actor — инициатор со слов клиента (X-Actor-ID, x-actor-id); сервис его не аутентифицирует.
+	Actor      string
+	RequestID  string
+	Action     AuditAction
+	EntityType AuditEntityType
+	EntityID   string
+	Before     json.RawMessage
+	After      json.RawMessage
+	CreatedAt  time.Time
+}
+
+type AuditFilter struct {
+	EntityType AuditEntityType
+	EntityID   string
+	Actor      string
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	Offset     int
+}
@@ -0,0 +1,7 @@
+package models
+
+// CodeOwnerRule — строка CODEOWNERS: glob-шаблон пути и владельцы (user_id).
+type CodeOwnerRule struct {
+	Pattern  string   `json:"pattern"`
+	OwnerIDs []string `json:"owners"`
+}
@@ -0,0 +1,127 @@
+package models
+
+import "time"
+
+// NotificationKind — шаблон уведомления ревьюверу в чат.
+type NotificationKind string
+
+const (
+	NotificationAssigned NotificationKind = "assigned"
+	// NotificationReassignedAway — ревью передано другому или ревьювер снят вручную.
+	NotificationReassignedAway NotificationKind = "reassigned_away"
+	NotificationMerged         NotificationKind = "merged"
+	// NotificationSLABreached — ревью забрали у ревьювера по эскалации SLA.
+	NotificationSLABreached NotificationKind = "sla_breached"
+)
+
+// NotificationKinds — все виды уведомлений; по умолчанию пользователь получает каждый.
+var NotificationKinds = []NotificationKind{NotificationAssigned, NotificationReassignedAway, NotificationMerged, NotificationSLABreached}
+
+func (k NotificationKind) IsValid() bool {
+	switch k {
+	case NotificationAssigned, NotificationReassignedAway, NotificationMerged, NotificationSLABreached:
+		return true
+	}
+	return false
+}
+
+// NotificationKindOf сопоставляет событие ленты с видом уведомления.
+func NotificationKindOf(e *ReviewFeedEvent) NotificationKind {
+	switch {
+	case e.Type == ReviewFeedAssigned:
+		return NotificationAssigned
+	case e.Type == ReviewFeedMerged:
+		return NotificationMerged
+	case e.Reason == AssignmentReasonSLAEscalation:
+		return NotificationSLABreached
+	default:
+		return NotificationReassignedAway
+	}
+}
+
+type NotificationDelivery string
+
+const (
+	// NotificationDeliveryImmediate — сообщение на каждое событие.
+	NotificationDeliveryImmediate NotificationDelivery = "immediate"
+	// NotificationDeliveryDigest — события копятся и приходят одним сообщением раз в день.
+	NotificationDeliveryDigest NotificationDelivery = "digest"
+	NotificationDeliveryOff    NotificationDelivery = "off"
+)
+
+func (d NotificationDelivery) IsValid() bool {
+	return d == NotificationDeliveryImmediate || d == NotificationDeliveryDigest || d == NotificationDeliveryOff
+}
+
+// NotificationPreferences — настройки уведомлений пользователя. Delivery — доставка в чат, EmailDelivery —
+// на почту (адрес — User.Email). Без ChatHandle или адреса почты соответствующий канал молчит.
+type NotificationPreferences struct {
+	UserID        string
+	ChatHandle    string
+	Delivery      NotificationDelivery
+	EmailDelivery NotificationDelivery
+	Kinds         []NotificationKind
+}
+
+// DefaultNotificationPreferences — настройки пользователя, который их не задавал.
+func DefaultNotificationPreferences(userID string) *NotificationPreferences {
+	return &NotificationPreferences{
+		UserID:        userID,
+		Delivery:      NotificationDeliveryImmediate,
+		EmailDelivery: NotificationDeliveryImmediate,
+		Kinds:         NotificationKinds,
+	}
+}
+
+// Wants сообщает, включён ли у пользователя этот вид уведомлений.
+func (p *NotificationPreferences) Wants(kind NotificationKind) bool {
+	for _, k := range p.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// ChatDelivery — режим доставки в чат с учётом того, что без ChatHandle писать некому.
+func (p *NotificationPreferences) ChatDelivery() NotificationDelivery {
+	if p.ChatHandle == "" {
+		return NotificationDeliveryOff
+	}
+	return p.Delivery
+}
+
+// ChatMessage — сообщение во входящий вебхук чата; Channel — адресат вида @handle.
+type ChatMessage struct {
+	Channel string
+	Text    string
+}
+
+// EmailMessage — письмо с текстовой и HTML-версией; пустой HTML — только текст.
+type EmailMessage struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+type SLAStatus string
+
+const (
+	SLAStatusOK SLAStatus = "ok"
+	// SLAStatusDueSoon — до истечения SLA осталось меньше четверти срока.
+	SLAStatusDueSoon  SLAStatus = "due_soon"
+	SLAStatusBreached SLAStatus = "breached"
+)
+
+// SLAStatusOf оценивает ревью возраста age при сроке sla; нулевой sla — SLA не задан, ревью всегда в срок.
+func SLAStatusOf(age, sla time.Duration) SLAStatus {
+	switch {
+	case sla <= 0 || age < sla*3/4:
+		return SLAStatusOK
+	case age < sla:
+		return SLAStatusDueSoon
+	default:
+		return SLAStatusBreached
+	}
+}
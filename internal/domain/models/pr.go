@@ -5,12 +5,33 @@ import (
 )
 
 type PullRequest struct {
-	ID          string
-	Title       string
-	AuthorID    string
-	Status      PRStatus
-	ReviewerIDs []string
-	CreatedAt   time.Time
-	MergedAt    *time.Time
-	UpdatedAt   time.Time
+	ID           string     `json:"id"`
+	Title        string     `json:"title"`
+	AuthorID     string     `json:"author_id"`
+	Status       PRStatus   `json:"status"`
+	ReviewerIDs  []string   `json:"reviewer_ids"`
+	ChangedFiles []string   `json:"changed_files,omitempty"`
+	Labels       []string   `json:"labels,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	MergedAt     *time.Time `json:"merged_at,omitempty"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	// Version растёт при каждом изменении PR и отдаётся клиентам как ETag.
+	Version int64 `json:"version"`
+}
+
+type PRFilter struct {
+	AuthorID   string
+	TeamName   string
+	Status     *PRStatus
+	ReviewerID string
+	Limit      int
+	Offset     int
+}
+
+// PRPage — страница списка PR; Limit — размер страницы, применённый сервисом.
+type PRPage struct {
+	PullRequests []*PullRequest
+	Total        int
+	Limit        int
+	Offset       int
 }
@@ -0,0 +1,28 @@
+package models
+
+type BatchStatus string
+
+const (
+	BatchStatusCreated BatchStatus = "created"
+	BatchStatusExists  BatchStatus = "exists"
+	BatchStatusError   BatchStatus = "error"
+)
+
+// BatchPRItem — один PR в пакетном создании. ReviewerIDs == nil означает автоматический выбор ревьюверов,
+// непустой или пустой срез — ревьюверы заданы явно (например, перенесены из другой системы).
+type BatchPRItem struct {
+	ID           string
+	Title        string
+	AuthorID     string
+	ChangedFiles []string
+	Labels       []string
+	ReviewerIDs  []string
+}
+
+// BatchPRResult — итог по одному элементу пачки; PR заполнен для created, Err — для error.
+type BatchPRResult struct {
+	PullRequestID string
+	Status        BatchStatus
+	PR            *PullRequest
+	Err           error
+}
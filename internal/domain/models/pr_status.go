@@ -5,4 +5,14 @@ type PRStatus string
 const (
 	PRStatusOPEN   PRStatus = "OPEN"
 	PRStatusMERGED PRStatus = "MERGED"
+	// PRStatusCLOSED — PR закрыт в VCS без мержа; может быть переоткрыт.
+	PRStatusCLOSED PRStatus = "CLOSED"
 )
+
+func (s PRStatus) IsValid() bool {
+	switch s {
+	case PRStatusOPEN, PRStatusMERGED, PRStatusCLOSED:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+type ReviewFeedEventType string
+
+const (
+	ReviewFeedAssigned   ReviewFeedEventType = "assigned"
+	ReviewFeedUnassigned ReviewFeedEventType = "unassigned"
+	// ReviewFeedMerged — смержен PR, на который ревьювер назначен.
+	ReviewFeedMerged ReviewFeedEventType = "merged"
+)
+
+// ReviewFeedEvent — событие ленты ревьювера. ID — сквозной номер события,
+// по нему клиент продолжает поток после переподключения (Last-Event-ID).
+type ReviewFeedEvent struct {
+	ID        int64
+	UserID    string
+	Type      ReviewFeedEventType
+	PRID      string
+	PRTitle   string
+	Reason    AssignmentReason
+	Actor     string
+	CreatedAt time.Time
+}
@@ -0,0 +1,27 @@
+package models
+
+type ConstraintKind string
+
+const (
+	// ConstraintMustIncludeOneOf — среди ревьюверов PR должен быть хотя бы один из ReviewerIDs.
+	ConstraintMustIncludeOneOf ConstraintKind = "must_include_one_of"
+	// ConstraintNeverPair — ReviewerIDs никогда не назначаются на PR авторов из AuthorIDs.
+	ConstraintNeverPair ConstraintKind = "never_pair"
+	// ConstraintPreferPair — ReviewerIDs назначаются в первую очередь, если доступны.
+	ConstraintPreferPair ConstraintKind = "prefer_pair"
+)
+
+// ReviewerConstraint — правило подбора ревьюверов команды. Пустой AuthorIDs означает «для всех авторов».
+type ReviewerConstraint struct {
+	Kind        ConstraintKind `json:"kind"`
+	AuthorIDs   []string       `json:"author_ids"`
+	ReviewerIDs []string       `json:"reviewer_ids"`
+}
+
+func IsValidConstraintKind(k ConstraintKind) bool {
+	switch k {
+	case ConstraintMustIncludeOneOf, ConstraintNeverPair, ConstraintPreferPair:
+		return true
+	}
+	return false
+}
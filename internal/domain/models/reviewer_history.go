@@ -0,0 +1,56 @@
+package models
+
+import "time"
+
+type ReviewerEvent string
+
+const (
+	ReviewerEventAssigned   ReviewerEvent = "ASSIGNED"
+	ReviewerEventUnassigned ReviewerEvent = "UNASSIGNED"
+)
+
+type AssignmentReason string
+
+const (
+	AssignmentReasonInitial        AssignmentReason = "initial"
+	AssignmentReasonManualReassign AssignmentReason = "manual_reassign"
+	AssignmentReasonDeactivation   AssignmentReason = "deactivation"
+	AssignmentReasonSLAEscalation  AssignmentReason = "sla_escalation"
+	AssignmentReasonTeamRemoval    AssignmentReason = "team_removal"
+	AssignmentReasonManual         AssignmentReason = "manual"
+)
+
+// IsValidReassignReason сообщает, можно ли указать r причиной замены ревьювера
+// (допустимы все причины, кроме первичного автоназначения).
+func IsValidReassignReason(r AssignmentReason) bool {
+	switch r {
+	case AssignmentReasonManualReassign, AssignmentReasonDeactivation, AssignmentReasonSLAEscalation, AssignmentReasonTeamRemoval:
+		return true
+	}
+	return false
+}
+
+type ReviewerHistoryEntry struct {
+	ID         int64
+	PRID       string
+	ReviewerID string
+	Event      ReviewerEvent
+	Reason     AssignmentReason
+	Actor      string
+	CreatedAt  time.Time
+}
+
+type ReleaseOutcome string
+
+const (
+	ReleaseOutcomeReassigned ReleaseOutcome = "reassigned"
+	ReleaseOutcomeUnassigned ReleaseOutcome = "unassigned"
+)
+
+// ReviewRelease — что стало с открытым ревью ревьювера, ушедшего из команды.
+type ReviewRelease struct {
+	PRID       string
+	ReviewerID string
+	Outcome    ReleaseOutcome
+	ReplacedBy string
+}
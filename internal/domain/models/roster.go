@@ -0,0 +1,89 @@
+package models
+
+import "encoding/json"
+
+// Roster — состав команд с участниками и настройками; формат /admin/export и /admin/import.
+type Roster struct {
+	Teams []RosterTeam `json:"teams"`
+}
+
+type RosterTeam struct {
+	Name     string         `json:"team_name"`
+	Members  []RosterMember `json:"members"`
+	Settings *TeamSettings  `json:"settings,omitempty"`
+}
+
+type RosterMember struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+// TeamSettings — настройки команды. При импорте nil оставляет текущие настройки без изменений.
+type TeamSettings struct {
+	SelectionStrategy   string               `json:"selection_strategy"`
+	SelectionParams     json.RawMessage      `json:"selection_params,omitempty"`
+	CodeOwners          []CodeOwnerRule      `json:"code_owners"`
+	ReviewerConstraints []ReviewerConstraint `json:"reviewer_constraints"`
+}
+
+// Поля настроек, попадающие в RosterDiff.UpdateSettings.
+const (
+	SettingSelectionStrategy   = "selection_strategy"
+	SettingCodeOwners          = "code_owners"
+	SettingReviewerConstraints = "reviewer_constraints"
+)
+
+// RosterDiff — изменения, которые вносит импорт состава.
+type RosterDiff struct {
+	CreateTeams       []string         `json:"create_teams"`
+	CreateUsers       []RosterMember   `json:"create_users"`
+	RenameUsers       []UserRename     `json:"rename_users"`
+	ActivateUsers     []string         `json:"activate_users"`
+	DeactivateUsers   []string         `json:"deactivate_users"`
+	AddMemberships    []Membership     `json:"add_memberships"`
+	RemoveMemberships []Membership     `json:"remove_memberships"`
+	UpdateSettings    []SettingsChange `json:"update_settings"`
+}
+
+// TeamSync — результат синхронизации состава команды: дифф и что стало с открытыми ревью удалённых участников.
+type TeamSync struct {
+	Diff     *RosterDiff
+	Releases []ReviewRelease
+}
+
+type UserRename struct {
+	UserID string `json:"user_id"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+type Membership struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+}
+
+type SettingsChange struct {
+	TeamName string   `json:"team_name"`
+	Fields   []string `json:"fields"`
+}
+
+// NewRosterDiff возвращает пустой diff с инициализированными списками, чтобы в JSON они были [], а не null.
+func NewRosterDiff() *RosterDiff {
+	return &RosterDiff{
+		CreateTeams:       []string{},
+		CreateUsers:       []RosterMember{},
+		RenameUsers:       []UserRename{},
+		ActivateUsers:     []string{},
+		DeactivateUsers:   []string{},
+		AddMemberships:    []Membership{},
+		RemoveMemberships: []Membership{},
+		UpdateSettings:    []SettingsChange{},
+	}
+}
+
+func (d *RosterDiff) Empty() bool {
+	return len(d.CreateTeams) == 0 && len(d.CreateUsers) == 0 && len(d.RenameUsers) == 0 &&
+		len(d.ActivateUsers) == 0 && len(d.DeactivateUsers) == 0 && len(d.AddMemberships) == 0 &&
+		len(d.RemoveMemberships) == 0 && len(d.UpdateSettings) == 0
+}
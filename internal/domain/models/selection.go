@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// ExclusionReason — почему кандидат не допущен к выбору. Отсутствия (OOO) не отслеживаются: на время отпуска
+// пользователя деактивируют, и он исключается как inactive.
+type ExclusionReason string
+
+const (
+	ExclusionReasonAuthor          ExclusionReason = "author"
+	ExclusionReasonInactive        ExclusionReason = "inactive"
+	ExclusionReasonAlreadyAssigned ExclusionReason = "already_assigned"
+	ExclusionReasonConstraint      ExclusionReason = "constraint"
+)
+
+type ExcludedCandidate struct {
+	UserID string          `json:"user_id"`
+	Reason ExclusionReason `json:"reason"`
+}
+
+// CandidateScore — скор кандидата в выбранной стратегии; Factors раскладывает его на составляющие.
+type CandidateScore struct {
+	UserID   string             `json:"user_id"`
+	Score    float64            `json:"score"`
+	Factors  map[string]float64 `json:"factors,omitempty"`
+	Selected bool               `json:"selected"`
+}
+
+// SelectionExplanation объясняет одно автоматическое назначение ревьюверов:
+// кто рассматривался, кто и почему исключён, какие скоры получили кандидаты.
+type SelectionExplanation struct {
+	ID        int64               `json:"-"`
+	PRID      string              `json:"pull_request_id"`
+	Reason    AssignmentReason    `json:"reason"`
+	Strategy  string              `json:"strategy"`
+	Slots     int                 `json:"slots"`
+	Pool      []string            `json:"pool"`
+	Excluded  []ExcludedCandidate `json:"excluded"`
+	Scores    []CandidateScore    `json:"scores"`
+	Selected  []string            `json:"selected"`
+	CreatedAt time.Time           `json:"created_at"`
+}
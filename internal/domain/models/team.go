@@ -1,14 +1,19 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Team struct {
-	ID        uuid.UUID
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID                uuid.UUID       `json:"id"`
+	Name              string          `json:"name"`
+	SelectionStrategy string          `json:"selection_strategy,omitempty"`
+	SelectionParams   json.RawMessage `json:"selection_params,omitempty"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+	// Version растёт при каждом изменении состава или настроек команды и отдаётся клиентам как ETag.
+	Version int64 `json:"version"`
 }
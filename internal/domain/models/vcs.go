@@ -0,0 +1,77 @@
+package models
+
+type VCSProvider string
+
+const (
+	VCSProviderGitHub VCSProvider = "github"
+	VCSProviderGitLab VCSProvider = "gitlab"
+)
+
+func (p VCSProvider) IsValid() bool {
+	return p == VCSProviderGitHub || p == VCSProviderGitLab
+}
+
+// VCSIdentity связывает логин в VCS с пользователем сервиса; логин хранится в нижнем регистре.
+type VCSIdentity struct {
+	Provider VCSProvider
+	Login    string
+	UserID   string
+}
+
+// VCSPullRequestAction — действие с PR в VCS, приведённое к операциям сервиса.
+type VCSPullRequestAction string
+
+const (
+	VCSActionOpened   VCSPullRequestAction = "opened"
+	VCSActionMerged   VCSPullRequestAction = "merged"
+	VCSActionClosed   VCSPullRequestAction = "closed"
+	VCSActionReopened VCSPullRequestAction = "reopened"
+	// VCSActionDraft — PR переведён в черновик: ревью не нужно, PR закрывается до готовности.
+	VCSActionDraft VCSPullRequestAction = "draft"
+	// VCSActionReady — черновик готов к ревью: PR открывается заново или создаётся.
+	VCSActionReady VCSPullRequestAction = "ready"
+)
+
+// VCSPullRequestEvent — событие вебхука, разобранное адаптером конкретной VCS.
+type VCSPullRequestEvent struct {
+	Provider    VCSProvider
+	Action      VCSPullRequestAction
+	PRID        string
+	Title       string
+	AuthorLogin string
+	Labels      []string
+	// Draft — PR открыт как черновик; такой PR не создаётся до перехода в ready.
+	Draft bool
+	// Sender — логин того, кто совершил действие; попадает в журнал аудита.
+	Sender string
+	// Repository и Number адресуют PR в API VCS (см. VCSPullRequestLink).
+	Repository string
+	Number     int
+}
+
+// VCSPullRequestLink — где PR сервиса живёт в VCS. Repository — идентификатор репозитория
+// в API: «owner/repo» для GitHub, числовой id проекта для GitLab.
+type VCSPullRequestLink struct {
+	PRID       string
+	Provider   VCSProvider
+	Repository string
+	Number     int
+}
+
+type VCSEventOutcome string
+
+const (
+	VCSOutcomeCreated  VCSEventOutcome = "created"
+	VCSOutcomeExists   VCSEventOutcome = "exists"
+	VCSOutcomeMerged   VCSEventOutcome = "merged"
+	VCSOutcomeClosed   VCSEventOutcome = "closed"
+	VCSOutcomeReopened VCSEventOutcome = "reopened"
+	VCSOutcomeIgnored  VCSEventOutcome = "ignored"
+)
+
+// VCSEventResult — что сервис сделал с событием; для ignored в Reason объясняется почему.
+type VCSEventResult struct {
+	Outcome VCSEventOutcome
+	PR      *PullRequest
+	Reason  string
+}
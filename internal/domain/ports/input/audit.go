@@ -0,0 +1,12 @@
+package input
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"context"
+)
+
+//go:generate mockery --name AuditInputPort --dir . --output ../../../../mocks --outpkg mocks --with-expecter --filename AuditInputPort.go
+
+type AuditInputPort interface {
+	ListEntries(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error)
+}
@@ -0,0 +1,14 @@
+package input
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"context"
+)
+
+//go:generate mockery --name NotificationInputPort --dir . --output ../../../../mocks --outpkg mocks --with-expecter --filename NotificationInputPort.go
+
+// NotificationInputPort — настройки уведомлений ревьюверов в чат.
+type NotificationInputPort interface {
+	GetPreferences(ctx context.Context, userID string) (*models.NotificationPreferences, error)
+	SetPreferences(ctx context.Context, prefs *models.NotificationPreferences) (*models.NotificationPreferences, error)
+}
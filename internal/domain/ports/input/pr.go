@@ -2,15 +2,24 @@ package input
 
 import (
 	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
 	"context"
 )
 
 //go:generate mockery --name PRInputPort --dir . --output ../../../../mocks --outpkg mocks --with-expecter --filename PRInputPort.go
 
 type PRInputPort interface {
-	CreatePR(ctx context.Context, prID string, authorID string, title string) (*models.PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (*models.PullRequest, error)
-	MergePR(ctx context.Context, prID string) (*models.PullRequest, error)
+	CreatePR(ctx context.Context, prID string, authorID string, title string, changedFiles []string, labels []string) (*models.PullRequest, error)
+	BatchCreatePRs(ctx context.Context, items []*models.BatchPRItem) ([]*models.BatchPRResult, error)
+	ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason models.AssignmentReason, ifMatch *utils.IfMatch) (*models.PullRequest, error)
+	AddReviewer(ctx context.Context, prID string, reviewerID string, ifMatch *utils.IfMatch) (*models.PullRequest, error)
+	RemoveReviewer(ctx context.Context, prID string, reviewerID string, ifMatch *utils.IfMatch) (*models.PullRequest, error)
+	MergePR(ctx context.Context, prID string, ifMatch *utils.IfMatch) (*models.PullRequest, error)
+	ClosePR(ctx context.Context, prID string) (*models.PullRequest, error)
+	ReopenPR(ctx context.Context, prID string) (*models.PullRequest, error)
 	GetPR(ctx context.Context, prID string) (*models.PullRequest, error)
 	ListPRsByAssignee(ctx context.Context, reviewerID string, status *models.PRStatus) ([]*models.PullRequest, error)
+	GetReviewerHistory(ctx context.Context, prID string) ([]*models.ReviewerHistoryEntry, error)
+	ExplainSelection(ctx context.Context, prID string) ([]*models.SelectionExplanation, error)
+	ListPRs(ctx context.Context, filter models.PRFilter) (*models.PRPage, error)
 }
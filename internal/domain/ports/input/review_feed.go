@@ -0,0 +1,13 @@
+package input
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"context"
+)
+
+//go:generate mockery --name ReviewFeedInputPort --dir . --output ../../../../mocks --outpkg mocks --with-expecter --filename ReviewFeedInputPort.go
+
+type ReviewFeedInputPort interface {
+	ListEvents(ctx context.Context, userID string, afterID int64, limit int) ([]*models.ReviewFeedEvent, error)
+	Subscribe(userID string) (<-chan *models.ReviewFeedEvent, func())
+}
@@ -2,7 +2,9 @@ package input
 
 import (
 	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
 	"context"
+	"encoding/json"
 
 	"github.com/google/uuid"
 )
@@ -17,4 +19,12 @@ type TeamInputPort interface {
 	GetTeam(ctx context.Context, id uuid.UUID) (*models.Team, error)
 	GetTeamByName(ctx context.Context, name string) (*models.Team, error)
 	ListTeams(ctx context.Context) ([]*models.Team, error)
+	SetCodeOwners(ctx context.Context, teamName string, content string, ifMatch *utils.IfMatch) ([]models.CodeOwnerRule, int64, error)
+	GetCodeOwners(ctx context.Context, teamName string) ([]models.CodeOwnerRule, error)
+	SetReviewerConstraints(ctx context.Context, teamName string, constraints []models.ReviewerConstraint, ifMatch *utils.IfMatch) ([]models.ReviewerConstraint, int64, error)
+	GetReviewerConstraints(ctx context.Context, teamName string) ([]models.ReviewerConstraint, error)
+	SetSelectionStrategy(ctx context.Context, teamName string, strategy string, params json.RawMessage, ifMatch *utils.IfMatch) (*models.Team, error)
+	ExportRoster(ctx context.Context) (*models.Roster, error)
+	ImportRoster(ctx context.Context, roster *models.Roster, dryRun bool) (*models.RosterDiff, error)
+	SyncTeam(ctx context.Context, name string, members []models.RosterMember, dryRun bool, ifMatch *utils.IfMatch) (*models.TeamSync, error)
 }
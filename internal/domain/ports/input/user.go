@@ -15,4 +15,7 @@ type UserInputPort interface {
 	ListUsers(ctx context.Context) ([]*models.User, error)
 	GetUserTeamName(ctx context.Context, id string) (string, error)
 	ListMembersByTeamID(ctx context.Context, teamID string) ([]*models.User, error)
+	ListUsersByIDs(ctx context.Context, ids []string) ([]*models.User, error)
+	SetUserTags(ctx context.Context, id string, tags []string) (*models.User, error)
+	SetUserEmail(ctx context.Context, id string, email string) (*models.User, error)
 }
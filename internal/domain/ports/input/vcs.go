@@ -0,0 +1,15 @@
+package input
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"context"
+)
+
+//go:generate mockery --name VCSInputPort --dir . --output ../../../../mocks --outpkg mocks --with-expecter --filename VCSInputPort.go
+
+// VCSInputPort — приём событий вебхуков VCS и сопоставление логинов VCS с пользователями сервиса.
+type VCSInputPort interface {
+	HandlePullRequestEvent(ctx context.Context, event *models.VCSPullRequestEvent) (*models.VCSEventResult, error)
+	SetIdentities(ctx context.Context, identities []*models.VCSIdentity) ([]*models.VCSIdentity, error)
+	ListIdentities(ctx context.Context, provider models.VCSProvider) ([]*models.VCSIdentity, error)
+}
@@ -0,0 +1,13 @@
+package audit
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"context"
+)
+
+//go:generate mockery --name AuditRepository --dir . --output ../../../../../mocks --outpkg mocks --with-expecter --filename AuditRepository.go
+
+type AuditRepository interface {
+	Append(ctx context.Context, entry *models.AuditEntry) error
+	List(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error)
+}
@@ -0,0 +1,18 @@
+package feed
+
+import "avito-test-pr-service/internal/domain/models"
+
+//go:generate mockery --name Publisher --dir . --output ../../../../../mocks --outpkg mocks --with-expecter --filename FeedPublisher.go --structname FeedPublisher
+//go:generate mockery --name Broker --dir . --output ../../../../../mocks --outpkg mocks --with-expecter --filename FeedBroker.go --structname FeedBroker
+
+// Publisher рассылает подписчикам уже сохранённые события ленты ревьюверов.
+type Publisher interface {
+	Publish(events []*models.ReviewFeedEvent)
+}
+
+// Broker — Publisher с подпиской на события одного пользователя. Канал подписки закрывается,
+// если подписчик не успевает читать; пропущенные события он дочитывает из хранилища.
+type Broker interface {
+	Publisher
+	Subscribe(userID string) (<-chan *models.ReviewFeedEvent, func())
+}
@@ -0,0 +1,20 @@
+package notify
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"context"
+)
+
+//go:generate mockery --name ChatSender --dir . --output ../../../../../mocks --outpkg mocks --with-expecter --filename ChatSender.go
+
+// ChatSender доставляет сообщение адресату в чате.
+type ChatSender interface {
+	Send(ctx context.Context, msg *models.ChatMessage) error
+}
+
+//go:generate mockery --name MailSender --dir . --output ../../../../../mocks --outpkg mocks --with-expecter --filename MailSender.go
+
+// MailSender отправляет письмо.
+type MailSender interface {
+	Send(ctx context.Context, msg *models.EmailMessage) error
+}
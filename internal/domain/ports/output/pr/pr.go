@@ -10,11 +10,36 @@ import (
 
 type PRRepository interface {
 	CreatePR(ctx context.Context, pr *models.PullRequest) error
+	// CreatePRs вставляет PR пачкой; уже существующие id пропускаются. Возвращает id созданных PR.
+	CreatePRs(ctx context.Context, prs []*models.PullRequest) (map[string]struct{}, error)
+	ExistingPRIDs(ctx context.Context, ids []string) (map[string]struct{}, error)
 	GetPRByID(ctx context.Context, id string) (*models.PullRequest, error)
 	LockPRByID(ctx context.Context, id string) (*models.PullRequest, error)
 	AddReviewer(ctx context.Context, prID string, reviewerID string) error
 	RemoveReviewer(ctx context.Context, prID string, reviewerID string) error
 	UpdateStatus(ctx context.Context, prID string, status models.PRStatus, mergedAt *time.Time) error
 	ListPRsByReviewer(ctx context.Context, reviewerID string, status *models.PRStatus) ([]*models.PullRequest, error)
+	ListPRs(ctx context.Context, filter models.PRFilter) ([]*models.PullRequest, int, error)
 	CountReviewersByPRID(ctx context.Context, prID string) (int, error)
+	CountOpenReviewsByReviewers(ctx context.Context, reviewerIDs []string) (map[string]int, error)
+	AppendReviewerHistory(ctx context.Context, entry *models.ReviewerHistoryEntry) error
+	AppendReviewerHistoryBatch(ctx context.Context, entries []*models.ReviewerHistoryEntry) error
+	ListReviewerHistory(ctx context.Context, prID string) ([]*models.ReviewerHistoryEntry, error)
+	AppendSelectionExplanation(ctx context.Context, explanation *models.SelectionExplanation) error
+	ListSelectionExplanations(ctx context.Context, prID string) ([]*models.SelectionExplanation, error)
+	// AppendFeedEvents сохраняет события ленты ревьюверов и заполняет их ID и CreatedAt.
+	AppendFeedEvents(ctx context.Context, events []*models.ReviewFeedEvent) error
+	// ListFeedEvents возвращает до limit событий пользователя с ID больше afterID в порядке ID.
+	ListFeedEvents(ctx context.Context, userID string, afterID int64, limit int) ([]*models.ReviewFeedEvent, error)
+	// SetVCSLink сохраняет или обновляет связь PR с VCS; PR может быть ещё не создан.
+	SetVCSLink(ctx context.Context, link *models.VCSPullRequestLink) error
+	GetVCSLink(ctx context.Context, prID string) (*models.VCSPullRequestLink, error)
+	// AddDigestEvents откладывает уже сохранённые события ленты до ежедневного дайджеста.
+	AddDigestEvents(ctx context.Context, events []*models.ReviewFeedEvent) error
+	// ListDigestEvents возвращает все отложенные события в порядке пользователя и ID.
+	ListDigestEvents(ctx context.Context) ([]*models.ReviewFeedEvent, error)
+	// DeleteDigestEvents убирает отправленные события пользователя с ID не больше throughID.
+	DeleteDigestEvents(ctx context.Context, userID string, throughID int64) error
+	// ClaimDigestDay отмечает, что дайджест за день day (YYYY-MM-DD) отправляется; false — день уже отмечен.
+	ClaimDigestDay(ctx context.Context, day string) (bool, error)
 }
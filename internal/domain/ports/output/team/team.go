@@ -3,6 +3,7 @@ package team
 import (
 	"avito-test-pr-service/internal/domain/models"
 	"context"
+	"encoding/json"
 
 	"github.com/google/uuid"
 )
@@ -13,7 +14,15 @@ type TeamRepository interface {
 	CreateTeam(ctx context.Context, team *models.Team) error
 	GetTeamByID(ctx context.Context, id uuid.UUID) (*models.Team, error)
 	GetTeamByName(ctx context.Context, name string) (*models.Team, error)
+	LockTeamByName(ctx context.Context, name string) (*models.Team, error)
 	ListTeams(ctx context.Context) ([]*models.Team, error)
+	UpdateSelectionStrategy(ctx context.Context, teamID uuid.UUID, strategy string, params json.RawMessage) error
 	AddMember(ctx context.Context, teamID uuid.UUID, userID string) error
 	RemoveMember(ctx context.Context, teamID uuid.UUID, userID string) error
+	ReplaceCodeOwners(ctx context.Context, teamID uuid.UUID, rules []models.CodeOwnerRule) error
+	ListCodeOwners(ctx context.Context, teamID uuid.UUID) ([]models.CodeOwnerRule, error)
+	ReplaceReviewerConstraints(ctx context.Context, teamID uuid.UUID, constraints []models.ReviewerConstraint) error
+	ListReviewerConstraints(ctx context.Context, teamID uuid.UUID) ([]models.ReviewerConstraint, error)
+	LockRotationCursor(ctx context.Context, teamID uuid.UUID) (string, error)
+	SetRotationCursor(ctx context.Context, teamID uuid.UUID, userID string) error
 }
@@ -1,6 +1,7 @@
 package uow
 
 import (
+	audit "avito-test-pr-service/internal/domain/ports/output/audit"
 	pr "avito-test-pr-service/internal/domain/ports/output/pr"
 	team "avito-test-pr-service/internal/domain/ports/output/team"
 	user "avito-test-pr-service/internal/domain/ports/output/user"
@@ -10,8 +11,34 @@ import (
 //go:generate mockery --name UnitOfWork --dir . --output ../../../../../mocks --outpkg mocks --with-expecter --filename UnitOfWork.go
 //go:generate mockery --name Transaction --dir . --output ../../../../../mocks --outpkg mocks --with-expecter --filename Transaction.go
 
+// IsolationLevel — уровень изоляции транзакции; пустое значение означает уровень по умолчанию БД.
+type IsolationLevel string
+
+const (
+	ReadCommitted  IsolationLevel = "read committed"
+	RepeatableRead IsolationLevel = "repeatable read"
+	Serializable   IsolationLevel = "serializable"
+)
+
+// TxOptions задаёт параметры транзакции для UnitOfWork.Do.
+// MaxAttempts ограничивает число попыток; ноль — значение по умолчанию реализации.
+// Replica вместе с ReadOnly разрешает открыть транзакцию через BeginRead, то есть допускает отстающие данные.
+type TxOptions struct {
+	Isolation   IsolationLevel
+	ReadOnly    bool
+	Replica     bool
+	MaxAttempts int
+}
+
 type UnitOfWork interface {
 	Begin(ctx context.Context) (Transaction, error)
+	// BeginRead открывает read-only транзакцию на реплике, если она настроена и отстаёт не больше допустимого,
+	// иначе — на основном пуле. Такую транзакцию только откатывают.
+	BeginRead(ctx context.Context) (Transaction, error)
+	// Do выполняет fn в транзакции с заданными опциями и фиксирует её, если fn вернула nil.
+	// При конфликте сериализации (40001) или дедлоке (40P01) транзакция откатывается и fn
+	// вызывается повторно, поэтому fn не должна иметь побочных эффектов вне транзакции.
+	Do(ctx context.Context, opts TxOptions, fn func(tx Transaction) error) error
 }
 
 type Transaction interface {
@@ -20,4 +47,5 @@ type Transaction interface {
 	UserRepository() user.UserRepository
 	TeamRepository() team.TeamRepository
 	PRRepository() pr.PRRepository
+	AuditRepository() audit.AuditRepository
 }
@@ -17,4 +17,18 @@ type UserRepository interface {
 	GetTeamIDByUserID(ctx context.Context, userID string) (uuid.UUID, error)
 	ListActiveMembersByTeamID(ctx context.Context, teamID uuid.UUID) ([]string, error)
 	ListMembersByTeamID(ctx context.Context, teamID uuid.UUID) ([]*models.User, error)
+	ListUsersByIDs(ctx context.Context, ids []string) ([]*models.User, error)
+	SetUserTags(ctx context.Context, userID string, tags []string) error
+	SetUserEmail(ctx context.Context, id string, email string) error
+	GetVCSIdentity(ctx context.Context, provider models.VCSProvider, login string) (*models.VCSIdentity, error)
+	SetVCSIdentity(ctx context.Context, identity *models.VCSIdentity) error
+	ListVCSIdentities(ctx context.Context, provider models.VCSProvider) ([]*models.VCSIdentity, error)
+	// GetVCSLogins возвращает логины пользователей в VCS (user_id → login); пользователи без логина пропускаются.
+	GetVCSLogins(ctx context.Context, provider models.VCSProvider, userIDs []string) (map[string]string, error)
+	// GetNotificationPreferences возвращает сохранённые настройки уведомлений (user_id → настройки);
+	// пользователи, не менявшие настройки, пропускаются.
+	GetNotificationPreferences(ctx context.Context, userIDs []string) (map[string]*models.NotificationPreferences, error)
+	SetNotificationPreferences(ctx context.Context, prefs *models.NotificationPreferences) error
+	// ListEmailDigestRecipients возвращает пользователей с адресом почты, выбравших ежедневный дайджест на почту.
+	ListEmailDigestRecipients(ctx context.Context) ([]*models.User, error)
 }
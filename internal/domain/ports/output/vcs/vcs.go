@@ -0,0 +1,15 @@
+package vcs
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"context"
+)
+
+//go:generate mockery --name ReviewRequester --dir . --output ../../../../../mocks --outpkg mocks --with-expecter --filename ReviewRequester.go
+
+// ReviewRequester запрашивает и снимает ревью в VCS. Логины — из сопоставления vcs_identities.
+// Ошибка с utils.ErrVCSRejected означает, что VCS отклонила запрос и повторять его бессмысленно.
+type ReviewRequester interface {
+	RequestReviewers(ctx context.Context, link *models.VCSPullRequestLink, logins []string) error
+	RemoveReviewers(ctx context.Context, link *models.VCSPullRequestLink, logins []string) error
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ParseCodeOwners разбирает файл в формате CODEOWNERS: «шаблон владелец...» на строку,
+// пустые строки и комментарии (#) пропускаются, ведущий @ у владельца отбрасывается.
+func ParseCodeOwners(content string) ([]models.CodeOwnerRule, error) {
+	var rules []models.CodeOwnerRule
+	for i, line := range strings.Split(content, "\n") {
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("%w: line %d: no owners for %q", utils.ErrInvalidCodeOwners, i+1, fields[0])
+		}
+		if _, err := path.Match(strings.ReplaceAll(fields[0], "**", "*"), ""); err != nil {
+			return nil, fmt.Errorf("%w: line %d: bad pattern %q", utils.ErrInvalidCodeOwners, i+1, fields[0])
+		}
+		rule := models.CodeOwnerRule{Pattern: fields[0]}
+		for _, owner := range fields[1:] {
+			owner = strings.TrimPrefix(owner, "@")
+			if owner == "" {
+				return nil, fmt.Errorf("%w: line %d: empty owner", utils.ErrInvalidCodeOwners, i+1)
+			}
+			rule.OwnerIDs = append(rule.OwnerIDs, owner)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// NormalizeCodeOwners проверяет правила, заданные списком, теми же правилами, что и ParseCodeOwners.
+func NormalizeCodeOwners(rules []models.CodeOwnerRule) ([]models.CodeOwnerRule, error) {
+	out := make([]models.CodeOwnerRule, 0, len(rules))
+	for i, rule := range rules {
+		pattern := strings.TrimSpace(rule.Pattern)
+		if pattern == "" || strings.ContainsAny(pattern, " \t") {
+			return nil, fmt.Errorf("%w: rule %d: bad pattern %q", utils.ErrInvalidCodeOwners, i+1, rule.Pattern)
+		}
+		if _, err := path.Match(strings.ReplaceAll(pattern, "**", "*"), ""); err != nil {
+			return nil, fmt.Errorf("%w: rule %d: bad pattern %q", utils.ErrInvalidCodeOwners, i+1, rule.Pattern)
+		}
+		normalized := models.CodeOwnerRule{Pattern: pattern}
+		for _, owner := range rule.OwnerIDs {
+			if owner = strings.TrimPrefix(strings.TrimSpace(owner), "@"); owner != "" {
+				normalized.OwnerIDs = append(normalized.OwnerIDs, owner)
+			}
+		}
+		if len(normalized.OwnerIDs) == 0 {
+			return nil, fmt.Errorf("%w: rule %d: no owners for %q", utils.ErrInvalidCodeOwners, i+1, pattern)
+		}
+		out = append(out, normalized)
+	}
+	return out, nil
+}
+
+// MatchCodeOwners возвращает владельцев затронутых путей, упорядоченных по числу путей,
+// которыми они владеют (при равенстве — по порядку появления). Как и в CODEOWNERS,
+// для каждого пути действует последнее совпавшее правило.
+func MatchCodeOwners(rules []models.CodeOwnerRule, paths []string) []string {
+	order, _ := MatchCodeOwnersWithCounts(rules, paths)
+	return order
+}
+
+// MatchCodeOwnersWithCounts — как MatchCodeOwners, дополнительно возвращает число путей каждого владельца.
+func MatchCodeOwnersWithCounts(rules []models.CodeOwnerRule, paths []string) ([]string, map[string]int) {
+	score := make(map[string]int)
+	var order []string
+	for _, p := range paths {
+		p = strings.TrimPrefix(path.Clean("/"+p), "/")
+		var matched *models.CodeOwnerRule
+		for i := range rules {
+			if MatchCodeOwnersPattern(rules[i].Pattern, p) {
+				matched = &rules[i]
+			}
+		}
+		if matched == nil {
+			continue
+		}
+		for _, owner := range matched.OwnerIDs {
+			if _, seen := score[owner]; !seen {
+				order = append(order, owner)
+			}
+			score[owner]++
+		}
+	}
+	// устойчивая сортировка вставками: владельцев немного
+	for i := 1; i < len(order); i++ {
+		for j := i; j > 0 && score[order[j]] > score[order[j-1]]; j-- {
+			order[j], order[j-1] = order[j-1], order[j]
+		}
+	}
+	return order, score
+}
+
+// MatchCodeOwnersPattern сопоставляет путь с шаблоном CODEOWNERS.
+// Поддерживаются *, ?, ** (любое число каталогов), ведущий / (привязка к корню)
+// и завершающий / (всё содержимое каталога). Шаблон без / совпадает на любой глубине.
+func MatchCodeOwnersPattern(pattern, filePath string) bool {
+	anchored := strings.HasPrefix(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+	if !anchored && !strings.Contains(strings.TrimSuffix(pattern, "/**"), "/") {
+		pattern = "**/" + pattern
+	}
+	patSegs := strings.Split(pattern, "/")
+	pathSegs := strings.Split(filePath, "/")
+	if matchSegments(patSegs, pathSegs) {
+		return true
+	}
+	// шаблон без масок в последнем сегменте обозначает и каталог: совпадение с префиксом пути
+	if last := patSegs[len(patSegs)-1]; !strings.ContainsAny(last, "*?[") {
+		for n := len(pathSegs) - 1; n > 0; n-- {
+			if matchSegments(patSegs, pathSegs[:n]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchSegments(pat, segs []string) bool {
+	for len(pat) > 0 {
+		if pat[0] == "**" {
+			rest := pat[1:]
+			for i := 0; i <= len(segs); i++ {
+				if matchSegments(rest, segs[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segs) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pat[0], segs[0]); !ok {
+			return false
+		}
+		pat, segs = pat[1:], segs[1:]
+	}
+	return len(segs) == 0
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCodeOwners(t *testing.T) {
+	content := `
+# default owners
+*            @u1
+/internal/db/ u2 u3   # storage
+docs/*.md    u4
+`
+	rules, err := ParseCodeOwners(content)
+	require.NoError(t, err)
+	require.Equal(t, []models.CodeOwnerRule{
+		{Pattern: "*", OwnerIDs: []string{"u1"}},
+		{Pattern: "/internal/db/", OwnerIDs: []string{"u2", "u3"}},
+		{Pattern: "docs/*.md", OwnerIDs: []string{"u4"}},
+	}, rules)
+
+	_, err = ParseCodeOwners("*.go\n")
+	require.True(t, errors.Is(err, utils.ErrInvalidCodeOwners))
+
+	_, err = ParseCodeOwners("[ u1\n")
+	require.True(t, errors.Is(err, utils.ErrInvalidCodeOwners))
+
+	rules, err = ParseCodeOwners("")
+	require.NoError(t, err)
+	require.Empty(t, rules)
+}
+
+func TestNormalizeCodeOwners(t *testing.T) {
+	rules, err := NormalizeCodeOwners([]models.CodeOwnerRule{{Pattern: " *.go ", OwnerIDs: []string{"@u1", " ", "u2"}}})
+	require.NoError(t, err)
+	require.Equal(t, []models.CodeOwnerRule{{Pattern: "*.go", OwnerIDs: []string{"u1", "u2"}}}, rules)
+
+	for _, bad := range []models.CodeOwnerRule{
+		{Pattern: "*.go"},
+		{Pattern: "", OwnerIDs: []string{"u1"}},
+		{Pattern: "a b", OwnerIDs: []string{"u1"}},
+		{Pattern: "[", OwnerIDs: []string{"u1"}},
+	} {
+		_, err := NormalizeCodeOwners([]models.CodeOwnerRule{bad})
+		require.ErrorIs(t, err, utils.ErrInvalidCodeOwners, "%+v", bad)
+	}
+}
+
+func TestMatchCodeOwnersPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*", "main.go", true},
+		{"*", "internal/app/main.go", true},
+		{"*.go", "internal/app/main.go", true},
+		{"*.go", "README.md", false},
+		{"/internal/db/", "internal/db/conn.go", true},
+		{"/internal/db/", "internal/db/pg/pool.go", true},
+		{"/internal/db/", "pkg/internal/db/conn.go", false},
+		{"db/", "pkg/internal/db/conn.go", true},
+		{"docs/*", "docs/a.md", true},
+		{"docs/*", "docs/sub/a.md", false},
+		{"apps/web", "apps/web/src/index.ts", true},
+		{"apps/web", "other/apps/web/index.ts", false},
+		{"**/logs", "deploy/prod/logs/app.log", true},
+		{"/cmd/**/main.go", "cmd/server/main.go", true},
+		{"/cmd/**/main.go", "cmd/main.go", true},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, MatchCodeOwnersPattern(tt.pattern, tt.path), "%s vs %s", tt.pattern, tt.path)
+	}
+}
+
+func TestMatchCodeOwners(t *testing.T) {
+	rules := []models.CodeOwnerRule{
+		{Pattern: "*", OwnerIDs: []string{"lead"}},
+		{Pattern: "/internal/db/", OwnerIDs: []string{"dba"}},
+		{Pattern: "*.md", OwnerIDs: []string{"writer", "dba"}},
+	}
+
+	// последнее совпавшее правило побеждает, владельцы упорядочены по числу путей
+	owners := MatchCodeOwners(rules, []string{"internal/db/conn.go", "internal/db/tx.go", "README.md", "/main.go"})
+	require.Equal(t, []string{"dba", "writer", "lead"}, owners)
+
+	require.Empty(t, MatchCodeOwners(nil, []string{"main.go"}))
+	require.Empty(t, MatchCodeOwners(rules, nil))
+}
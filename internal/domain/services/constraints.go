@@ -0,0 +1,200 @@
+package services
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/utils"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// NormalizeConstraints проверяет правила и убирает пробелы, пустые и повторяющиеся идентификаторы.
+// never_pair обязан перечислять авторов: запрет «для всех» — это деактивация, а не ограничение пары.
+func NormalizeConstraints(constraints []models.ReviewerConstraint) ([]models.ReviewerConstraint, error) {
+	out := make([]models.ReviewerConstraint, 0, len(constraints))
+	for i, c := range constraints {
+		if !models.IsValidConstraintKind(c.Kind) {
+			return nil, fmt.Errorf("%w: #%d: unknown kind %q", utils.ErrInvalidConstraint, i, c.Kind)
+		}
+		c.AuthorIDs = normalizeIDs(c.AuthorIDs)
+		c.ReviewerIDs = normalizeIDs(c.ReviewerIDs)
+		if len(c.ReviewerIDs) == 0 {
+			return nil, fmt.Errorf("%w: #%d: reviewer_ids is empty", utils.ErrInvalidConstraint, i)
+		}
+		if c.Kind == models.ConstraintNeverPair && len(c.AuthorIDs) == 0 {
+			return nil, fmt.Errorf("%w: #%d: never_pair requires author_ids", utils.ErrInvalidConstraint, i)
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func normalizeIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
+
+// ConstraintPlan — ограничения команды, относящиеся к конкретному автору.
+type ConstraintPlan struct {
+	Forbidden map[string]struct{}
+	Required  [][]string
+	Preferred []string
+}
+
+// PlanConstraints отбирает правила, применимые к автору authorID.
+func PlanConstraints(constraints []models.ReviewerConstraint, authorID string) ConstraintPlan {
+	plan := ConstraintPlan{Forbidden: make(map[string]struct{})}
+	for _, c := range constraints {
+		if len(c.AuthorIDs) > 0 && !utils.ContainsString(c.AuthorIDs, authorID) {
+			continue
+		}
+		switch c.Kind {
+		case models.ConstraintNeverPair:
+			for _, id := range c.ReviewerIDs {
+				plan.Forbidden[id] = struct{}{}
+			}
+		case models.ConstraintMustIncludeOneOf:
+			plan.Required = append(plan.Required, c.ReviewerIDs)
+		case models.ConstraintPreferPair:
+			for _, id := range c.ReviewerIDs {
+				if !utils.ContainsString(plan.Preferred, id) {
+					plan.Preferred = append(plan.Preferred, id)
+				}
+			}
+		}
+	}
+	return plan
+}
+
+func (p ConstraintPlan) Allows(reviewerID string) bool {
+	_, forbidden := p.Forbidden[reviewerID]
+	return !forbidden
+}
+
+// Unsatisfied возвращает группы must_include_one_of, не покрытые ревьюверами assigned.
+func (p ConstraintPlan) Unsatisfied(assigned []string) [][]string {
+	var res [][]string
+	for _, group := range p.Required {
+		if !intersects(group, assigned) {
+			res = append(res, group)
+		}
+	}
+	return res
+}
+
+// SelectWithConstraints выбирает до req.Count ревьюверов из req.Candidates так, чтобы вместе с assigned
+// были покрыты все группы must_include_one_of: сначала по одному кандидату из каждой непокрытой группы,
+// затем предпочтительные (prefer_pair), затем остальные. Каждый этап выбирает стратегия selector;
+// курсор очереди (RotationSelector) сдвигается один раз по итогам выбора, а не на каждом этапе.
+// Кандидаты из never_pair должны быть исключены вызывающим заранее.
+func SelectWithConstraints(ctx context.Context, tx uow.Transaction, selector ReviewerSelector, req SelectionRequest, plan ConstraintPlan, assigned []string) (*Selection, error) {
+	if len(plan.Required) == 0 && len(plan.Preferred) == 0 {
+		return selector.Select(ctx, tx, req)
+	}
+	result := &Selection{}
+	rest := req.Candidates
+	pick := func(candidates []string, count int) error {
+		stage := req
+		stage.Candidates, stage.Count, stage.HoldRotation = candidates, count, true
+		sel, err := selector.Select(ctx, tx, stage)
+		if err != nil {
+			return err
+		}
+		result.merge(sel)
+		picked := make(map[string]struct{}, len(sel.ReviewerIDs))
+		for _, id := range sel.ReviewerIDs {
+			picked[id] = struct{}{}
+		}
+		rest = utils.FilterStrings(rest, picked)
+		return nil
+	}
+
+	for _, group := range plan.Unsatisfied(assigned) {
+		if intersects(group, result.ReviewerIDs) {
+			continue
+		}
+		candidates := intersection(rest, group)
+		if len(result.ReviewerIDs) >= req.Count || len(candidates) == 0 {
+			return nil, fmt.Errorf("%w: none of [%s] can be assigned", utils.ErrConstraintsUnsatisfied, strings.Join(group, ", "))
+		}
+		if err := pick(candidates, 1); err != nil {
+			return nil, err
+		}
+		if !intersects(group, result.ReviewerIDs) {
+			return nil, fmt.Errorf("%w: none of [%s] was selected", utils.ErrConstraintsUnsatisfied, strings.Join(group, ", "))
+		}
+	}
+	if free := req.Count - len(result.ReviewerIDs); free > 0 {
+		if preferred := intersection(rest, plan.Preferred); len(preferred) > 0 {
+			if err := pick(preferred, min(free, len(preferred))); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if free := req.Count - len(result.ReviewerIDs); free > 0 && len(rest) > 0 {
+		if err := pick(rest, free); err != nil {
+			return nil, err
+		}
+	}
+	if rotation, ok := selector.(RotationSelector); ok && len(result.ReviewerIDs) > 0 {
+		if err := rotation.Advance(ctx, tx, req, result.ReviewerIDs); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// merge дописывает результат очередного этапа выбора; скор кандидата берётся из этапа, где он выбран.
+func (s *Selection) merge(other *Selection) {
+	if s.Strategy == "" {
+		s.Strategy = other.Strategy
+	}
+	s.ReviewerIDs = append(s.ReviewerIDs, other.ReviewerIDs...)
+	index := make(map[string]int, len(s.Scores))
+	for i, sc := range s.Scores {
+		index[sc.UserID] = i
+	}
+	for _, sc := range other.Scores {
+		i, ok := index[sc.UserID]
+		switch {
+		case !ok:
+			index[sc.UserID] = len(s.Scores)
+			s.Scores = append(s.Scores, sc)
+		case sc.Selected:
+			s.Scores[i] = sc
+		}
+	}
+}
+
+func intersects(a, b []string) bool {
+	for _, id := range a {
+		if utils.ContainsString(b, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// intersection возвращает элементы list, входящие в set, в порядке list.
+func intersection(list, set []string) []string {
+	var out []string
+	for _, id := range list {
+		if utils.ContainsString(set, id) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
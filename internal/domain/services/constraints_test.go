@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+// firstSelector выбирает первых кандидатов по порядку и запоминает наборы кандидатов каждого вызова.
+type firstSelector struct{ calls [][]string }
+
+func (s *firstSelector) Select(_ context.Context, _ uow.Transaction, req SelectionRequest) (*Selection, error) {
+	s.calls = append(s.calls, req.Candidates)
+	sel := &Selection{Strategy: "first"}
+	for i, id := range req.Candidates {
+		picked := i < req.Count
+		sel.Scores = append(sel.Scores, models.CandidateScore{UserID: id, Score: float64(-i), Selected: picked})
+		if picked {
+			sel.ReviewerIDs = append(sel.ReviewerIDs, id)
+		}
+	}
+	return sel, nil
+}
+
+func TestNormalizeConstraints(t *testing.T) {
+	got, err := NormalizeConstraints([]models.ReviewerConstraint{
+		{Kind: models.ConstraintMustIncludeOneOf, AuthorIDs: []string{" j1 ", "j1", ""}, ReviewerIDs: []string{"s1", "s2", "s1"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"j1"}, got[0].AuthorIDs)
+	require.Equal(t, []string{"s1", "s2"}, got[0].ReviewerIDs)
+
+	for _, bad := range []models.ReviewerConstraint{
+		{Kind: "sometimes", ReviewerIDs: []string{"s1"}},
+		{Kind: models.ConstraintPreferPair, ReviewerIDs: []string{" "}},
+		{Kind: models.ConstraintNeverPair, ReviewerIDs: []string{"s1"}},
+	} {
+		_, err := NormalizeConstraints([]models.ReviewerConstraint{bad})
+		require.ErrorIs(t, err, utils.ErrInvalidConstraint, "%+v", bad)
+	}
+}
+
+func TestPlanConstraints(t *testing.T) {
+	constraints := []models.ReviewerConstraint{
+		{Kind: models.ConstraintMustIncludeOneOf, AuthorIDs: []string{"j1"}, ReviewerIDs: []string{"s1", "s2"}},
+		{Kind: models.ConstraintNeverPair, AuthorIDs: []string{"j1", "j2"}, ReviewerIDs: []string{"x"}},
+		{Kind: models.ConstraintPreferPair, ReviewerIDs: []string{"p1"}},
+	}
+	plan := PlanConstraints(constraints, "j1")
+	require.Equal(t, [][]string{{"s1", "s2"}}, plan.Required)
+	require.False(t, plan.Allows("x"))
+	require.True(t, plan.Allows("s1"))
+	require.Equal(t, []string{"p1"}, plan.Preferred)
+	require.Empty(t, plan.Unsatisfied([]string{"s2"}))
+	require.Len(t, plan.Unsatisfied([]string{"p1"}), 1)
+
+	other := PlanConstraints(constraints, "m1")
+	require.Empty(t, other.Required)
+	require.True(t, other.Allows("x"))
+	require.Equal(t, []string{"p1"}, other.Preferred)
+}
+
+// rotatingSelector — firstSelector с очередью: считает сдвиги курсора.
+type rotatingSelector struct {
+	firstSelector
+	held     []bool
+	advanced [][]string
+}
+
+func (s *rotatingSelector) Select(ctx context.Context, tx uow.Transaction, req SelectionRequest) (*Selection, error) {
+	s.held = append(s.held, req.HoldRotation)
+	return s.firstSelector.Select(ctx, tx, req)
+}
+
+func (s *rotatingSelector) Advance(_ context.Context, _ uow.Transaction, _ SelectionRequest, selected []string) error {
+	s.advanced = append(s.advanced, selected)
+	return nil
+}
+
+func TestSelectWithConstraints(t *testing.T) {
+	ctx := context.Background()
+	req := SelectionRequest{AuthorID: "j1", Candidates: []string{"a", "b", "p1", "s2"}, Count: 2}
+
+	t.Run("no constraints -> single call", func(t *testing.T) {
+		sel := &firstSelector{}
+		res, err := SelectWithConstraints(ctx, nil, sel, req, ConstraintPlan{}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b"}, res.ReviewerIDs)
+		require.Len(t, sel.calls, 1)
+	})
+
+	t.Run("required group, then preferred", func(t *testing.T) {
+		sel := &firstSelector{}
+		plan := ConstraintPlan{Required: [][]string{{"s1", "s2"}}, Preferred: []string{"p1"}}
+		res, err := SelectWithConstraints(ctx, nil, sel, req, plan, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"s2", "p1"}, res.ReviewerIDs)
+		require.Equal(t, "first", res.Strategy)
+		require.Equal(t, [][]string{{"s2"}, {"p1"}}, sel.calls)
+	})
+
+	t.Run("staged selection advances rotation once", func(t *testing.T) {
+		sel := &rotatingSelector{}
+		plan := ConstraintPlan{Required: [][]string{{"s1", "s2"}}}
+		res, err := SelectWithConstraints(ctx, nil, sel, req, plan, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"s2", "a"}, res.ReviewerIDs)
+		require.Equal(t, []bool{true, true}, sel.held)
+		require.Equal(t, [][]string{{"s2", "a"}}, sel.advanced)
+	})
+
+	t.Run("single call moves rotation itself", func(t *testing.T) {
+		sel := &rotatingSelector{}
+		_, err := SelectWithConstraints(ctx, nil, sel, req, ConstraintPlan{}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []bool{false}, sel.held)
+		require.Empty(t, sel.advanced)
+	})
+
+	t.Run("group covered by assigned reviewer", func(t *testing.T) {
+		sel := &firstSelector{}
+		plan := ConstraintPlan{Required: [][]string{{"s1", "s2"}}}
+		res, err := SelectWithConstraints(ctx, nil, sel, SelectionRequest{Candidates: []string{"a", "b"}, Count: 1}, plan, []string{"s1"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a"}, res.ReviewerIDs)
+	})
+
+	t.Run("group has no available candidate", func(t *testing.T) {
+		plan := ConstraintPlan{Required: [][]string{{"s1"}}}
+		_, err := SelectWithConstraints(ctx, nil, &firstSelector{}, req, plan, nil)
+		require.ErrorIs(t, err, utils.ErrConstraintsUnsatisfied)
+	})
+
+	t.Run("more groups than slots", func(t *testing.T) {
+		plan := ConstraintPlan{Required: [][]string{{"a"}, {"b"}, {"s2"}}}
+		_, err := SelectWithConstraints(ctx, nil, &firstSelector{}, req, plan, nil)
+		require.ErrorIs(t, err, utils.ErrConstraintsUnsatisfied)
+	})
+
+	t.Run("scores merged without duplicates", func(t *testing.T) {
+		plan := ConstraintPlan{Required: [][]string{{"b", "s2"}}}
+		res, err := SelectWithConstraints(ctx, nil, &firstSelector{}, req, plan, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"b", "a"}, res.ReviewerIDs)
+		seen := map[string]bool{}
+		for _, sc := range res.Scores {
+			require.False(t, seen[sc.UserID], "duplicate score for %s", sc.UserID)
+			seen[sc.UserID] = true
+		}
+		require.True(t, seen["p1"])
+	})
+}
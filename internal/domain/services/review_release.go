@@ -0,0 +1,15 @@
+package services
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"context"
+)
+
+//go:generate mockery --name ReviewReleaser --dir . --output ../../../mocks --outpkg mocks --with-expecter --filename ReviewReleaser.go
+
+// ReviewReleaser снимает ревьювера, ушедшего из команды, с открытых PR её участников authorIDs
+// в транзакции tx вызывающего. publish рассылает события ленты и вызывается только после коммита.
+type ReviewReleaser interface {
+	ReleaseTeamReviews(ctx context.Context, tx uow.Transaction, reviewerID string, authorIDs []string, reason models.AssignmentReason) (releases []models.ReviewRelease, publish func(), err error)
+}
@@ -1,7 +1,70 @@
 package services
 
+import (
+	"avito-test-pr-service/internal/domain/models"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
 //go:generate mockery --name ReviewerSelector --dir . --output ../../../mocks --outpkg mocks --with-expecter --filename ReviewerSelector.go
 
+// SelectionRequest описывает один выбор ревьюверов.
+// Candidates уже отфильтрованы: активные участники команды автора без автора и уже назначенных.
+type SelectionRequest struct {
+	TeamID     uuid.UUID
+	PRID       string
+	AuthorID   string
+	Candidates []string
+	Count      int
+	FilePaths  []string
+	Labels     []string
+	// HoldRotation — запрос является этапом составного выбора: стратегии с очередью не сдвигают курсор,
+	// это делает RotationSelector.Advance по итогам всего выбора.
+	HoldRotation bool
+	// PendingLoad — ревью, назначенные в этой же транзакции, но ещё не записанные в БД (предыдущие PR пачки);
+	// стратегии, учитывающие нагрузку, прибавляют их к открытым ревью из БД.
+	PendingLoad map[string]int
+}
+
+// Selection — результат выбора: ревьюверы в порядке приоритета и скоры всех рассмотренных кандидатов.
+type Selection struct {
+	Strategy    string
+	ReviewerIDs []string
+	Scores      []models.CandidateScore
+}
+
+// ReviewerSelector выбирает до req.Count ревьюверов из req.Candidates.
+// Стратегии, которым нужны данные из БД, читают их через tx — в той же транзакции, что и изменение PR.
 type ReviewerSelector interface {
-	Select(candidates []string, count int) []string
+	Select(ctx context.Context, tx uow.Transaction, req SelectionRequest) (*Selection, error)
+}
+
+// RotationSelector — стратегия с курсором очереди. Advance сдвигает курсор за ревьюверов selected,
+// выбранных по этапам с HoldRotation.
+type RotationSelector interface {
+	ReviewerSelector
+	Advance(ctx context.Context, tx uow.Transaction, req SelectionRequest, selected []string) error
+}
+
+// TopScored сортирует кандидатов по убыванию скора (при равенстве — по user_id) и выбирает первых count.
+func TopScored(strategy string, scores []models.CandidateScore, count int) *Selection {
+	sorted := append([]models.CandidateScore(nil), scores...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Score != sorted[j].Score {
+			return sorted[i].Score > sorted[j].Score
+		}
+		return sorted[i].UserID < sorted[j].UserID
+	})
+	sel := &Selection{Strategy: strategy, Scores: sorted}
+	for i := range sorted {
+		if i >= count {
+			break
+		}
+		sorted[i].Selected = true
+		sel.ReviewerIDs = append(sel.ReviewerIDs, sorted[i].UserID)
+	}
+	return sel
 }
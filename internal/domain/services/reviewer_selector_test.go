@@ -0,0 +1,27 @@
+package services
+
+import (
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopScored(t *testing.T) {
+	sel := TopScored("weighted", []models.CandidateScore{
+		{UserID: "c", Score: 1},
+		{UserID: "a", Score: 2},
+		{UserID: "b", Score: 2},
+		{UserID: "d", Score: -1},
+	}, 2)
+	require.Equal(t, "weighted", sel.Strategy)
+	require.Equal(t, []string{"a", "b"}, sel.ReviewerIDs)
+	require.Len(t, sel.Scores, 4)
+	require.Equal(t, "c", sel.Scores[2].UserID)
+	require.True(t, sel.Scores[1].Selected)
+	require.False(t, sel.Scores[2].Selected)
+
+	empty := TopScored("random", nil, 2)
+	require.Empty(t, empty.ReviewerIDs)
+}
@@ -0,0 +1,31 @@
+package services
+
+import "sort"
+
+// RotationOrder возвращает candidates в порядке очереди: участники команды обходятся по кругу
+// в порядке user_id, начиная со следующего после cursor. Участники вне candidates (автор,
+// неактивные, уже назначенные) пропускаются, но сохраняют своё место в порядке.
+func RotationOrder(members []string, cursor string, candidates []string) []string {
+	if len(members) == 0 || len(candidates) == 0 {
+		return nil
+	}
+	order := append([]string(nil), members...)
+	sort.Strings(order)
+	allowed := make(map[string]struct{}, len(candidates))
+	for _, id := range candidates {
+		allowed[id] = struct{}{}
+	}
+
+	start := sort.SearchStrings(order, cursor)
+	if start < len(order) && order[start] == cursor {
+		start++
+	}
+	var res []string
+	for i := 0; i < len(order); i++ {
+		id := order[(start+i)%len(order)]
+		if _, ok := allowed[id]; ok {
+			res = append(res, id)
+		}
+	}
+	return res
+}
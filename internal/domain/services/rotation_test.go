@@ -0,0 +1,15 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotationOrder(t *testing.T) {
+	got := RotationOrder([]string{"c", "a", "d", "b"}, "b", []string{"a", "b", "d"})
+	require.Equal(t, []string{"d", "a", "b"}, got)
+	// курсор удалённого участника продолжает очередь с его места
+	require.Equal(t, []string{"c", "d", "a", "b"}, RotationOrder([]string{"a", "b", "c", "d"}, "bb", []string{"a", "b", "c", "d"}))
+	require.Nil(t, RotationOrder(nil, "", []string{"a"}))
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// SelectorFactory создаёт стратегию выбора ревьюверов по JSON-параметрам команды.
+type SelectorFactory func(params json.RawMessage) (ReviewerSelector, error)
+
+// SelectorResolver возвращает стратегию по имени; пустое имя означает стратегию по умолчанию.
+type SelectorResolver interface {
+	Resolve(name string, params json.RawMessage) (ReviewerSelector, error)
+}
+
+// SelectorRegistry — реестр стратегий по имени. Созданные стратегии кешируются по имени и параметрам,
+// чтобы состояние (генератор случайных чисел и т.п.) переживало отдельные запросы.
+type SelectorRegistry struct {
+	mu          sync.Mutex
+	defaultName string
+	factories   map[string]SelectorFactory
+	cache       map[string]ReviewerSelector
+}
+
+func NewSelectorRegistry(defaultName string) *SelectorRegistry {
+	return &SelectorRegistry{
+		defaultName: defaultName,
+		factories:   make(map[string]SelectorFactory),
+		cache:       make(map[string]ReviewerSelector),
+	}
+}
+
+func (r *SelectorRegistry) Register(name string, factory SelectorFactory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[name] = factory
+}
+
+func (r *SelectorRegistry) Names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *SelectorRegistry) DefaultName() string { return r.defaultName }
+
+func (r *SelectorRegistry) Resolve(name string, params json.RawMessage) (ReviewerSelector, error) {
+	if name == "" {
+		name = r.defaultName
+	}
+	if len(params) == 0 {
+		params = json.RawMessage(`{}`)
+	}
+	key := name + "\x00" + string(params)
+
+	r.mu.Lock()
+	if sel, ok := r.cache[key]; ok {
+		r.mu.Unlock()
+		return sel, nil
+	}
+	factory, ok := r.factories[name]
+	r.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", utils.ErrUnknownStrategy, name)
+	}
+
+	// фабрика может сама обращаться к реестру (например, за fallback-стратегией), поэтому вызывается без блокировки
+	sel, err := factory(params)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", utils.ErrInvalidStrategyParams, name, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cached, ok := r.cache[key]; ok {
+		return cached, nil
+	}
+	r.cache[key] = sel
+	return sel, nil
+}
+
+// SingleSelector — резолвер, всегда возвращающий одну стратегию независимо от настроек команды.
+func SingleSelector(sel ReviewerSelector) SelectorResolver {
+	return singleSelector{sel: sel}
+}
+
+type singleSelector struct {
+	sel ReviewerSelector
+}
+
+func (s singleSelector) Resolve(string, json.RawMessage) (ReviewerSelector, error) {
+	return s.sel, nil
+}
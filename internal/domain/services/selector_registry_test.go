@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+type namedSelector struct{ name string }
+
+func (s *namedSelector) Select(context.Context, uow.Transaction, SelectionRequest) (*Selection, error) {
+	return &Selection{Strategy: s.name}, nil
+}
+
+func TestSelectorRegistry_Resolve(t *testing.T) {
+	builds := 0
+	reg := NewSelectorRegistry("a")
+	reg.Register("a", func(json.RawMessage) (ReviewerSelector, error) {
+		builds++
+		return &namedSelector{name: "a"}, nil
+	})
+	reg.Register("b", func(p json.RawMessage) (ReviewerSelector, error) {
+		if string(p) != "{}" {
+			return nil, errors.New("bad params")
+		}
+		return &namedSelector{name: "b"}, nil
+	})
+	require.Equal(t, []string{"a", "b"}, reg.Names())
+
+	def, err := reg.Resolve("", nil)
+	require.NoError(t, err)
+	require.Equal(t, "a", def.(*namedSelector).name)
+
+	again, err := reg.Resolve("a", json.RawMessage(`{}`))
+	require.NoError(t, err)
+	require.Same(t, def, again)
+	require.Equal(t, 1, builds)
+
+	_, err = reg.Resolve("missing", nil)
+	require.ErrorIs(t, err, utils.ErrUnknownStrategy)
+
+	_, err = reg.Resolve("b", json.RawMessage(`{"x":1}`))
+	require.ErrorIs(t, err, utils.ErrInvalidStrategyParams)
+}
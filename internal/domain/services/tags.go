@@ -0,0 +1,48 @@
+package services
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9+#._-]{1,32}$`)
+
+// NormalizeTags приводит теги навыков и метки PR к нижнему регистру, убирает пробелы и дубликаты
+// и сортирует. Возвращает utils.ErrInvalidTag для тегов вне допустимого алфавита.
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(tags))
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if !tagPattern.MatchString(t) {
+			return nil, utils.ErrInvalidTag
+		}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		out = append(out, t)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// TagOverlap считает количество общих тегов у двух нормализованных наборов.
+func TagOverlap(a, b []string) int {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := make(map[string]struct{}, len(a))
+	for _, t := range a {
+		set[t] = struct{}{}
+	}
+	n := 0
+	for _, t := range b {
+		if _, ok := set[t]; ok {
+			n++
+		}
+	}
+	return n
+}
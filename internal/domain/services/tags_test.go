@@ -0,0 +1,31 @@
+package services
+
+import (
+	"testing"
+
+	"avito-test-pr-service/internal/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	got, err := NormalizeTags([]string{" Go", "postgres", "go", "c++"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c++", "go", "postgres"}, got)
+
+	got, err = NormalizeTags(nil)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	_, err = NormalizeTags([]string{"front end"})
+	require.ErrorIs(t, err, utils.ErrInvalidTag)
+
+	_, err = NormalizeTags([]string{""})
+	require.ErrorIs(t, err, utils.ErrInvalidTag)
+}
+
+func TestTagOverlap(t *testing.T) {
+	require.Equal(t, 2, TagOverlap([]string{"go", "postgres", "k8s"}, []string{"postgres", "go"}))
+	require.Equal(t, 0, TagOverlap(nil, []string{"go"}))
+	require.Equal(t, 0, TagOverlap([]string{"go"}, []string{"frontend"}))
+}
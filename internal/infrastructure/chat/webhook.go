@@ -0,0 +1,62 @@
+// Package chat — доставка уведомлений через входящие вебхуки Slack и Mattermost.
+package chat
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBody — сколько байт тела ответа с ошибкой попадает в текст ошибки.
+const maxErrorBody = 512
+
+// payload — общее подмножество формата входящих вебхуков Slack и Mattermost.
+// Mattermost доставляет сообщение с channel вида @handle в личку; Slack учитывает channel только
+// у устаревших вебхуков, новые пишут в канал, выбранный при создании.
+type payload struct {
+	Channel  string `json:"channel,omitempty"`
+	Username string `json:"username,omitempty"`
+	Text     string `json:"text"`
+}
+
+// WebhookSender отправляет сообщения POST-запросом на адрес входящего вебхука.
+type WebhookSender struct {
+	url      string
+	username string
+	client   *http.Client
+}
+
+func NewWebhookSender(url, username string, client *http.Client) *WebhookSender {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &WebhookSender{url: url, username: username, client: client}
+}
+
+func (s *WebhookSender) Send(ctx context.Context, msg *models.ChatMessage) error {
+	body, err := json.Marshal(payload{Channel: msg.Channel, Username: s.username, Text: msg.Text})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	return fmt.Errorf("chat webhook responded %d: %s", resp.StatusCode, strings.TrimSpace(string(excerpt)))
+}
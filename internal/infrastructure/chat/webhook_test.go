@@ -0,0 +1,46 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSender(t *testing.T) {
+	var got []map[string]string
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		got = append(got, body)
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_, _ = w.Write([]byte("no_service\n"))
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	s := NewWebhookSender(srv.URL+"/hooks/abc", "pr-service", srv.Client())
+	ctx := context.Background()
+
+	require.NoError(t, s.Send(ctx, &models.ChatMessage{Channel: "@bob", Text: "You were assigned"}))
+	require.NoError(t, s.Send(ctx, &models.ChatMessage{Text: "broadcast"}))
+	require.Equal(t, []map[string]string{
+		{"channel": "@bob", "username": "pr-service", "text": "You were assigned"},
+		{"username": "pr-service", "text": "broadcast"},
+	}, got)
+
+	status = http.StatusNotFound
+	err := s.Send(ctx, &models.ChatMessage{Channel: "@bob", Text: "x"})
+	require.EqualError(t, err, "chat webhook responded 404: no_service")
+}
@@ -9,9 +9,12 @@ import (
 )
 
 type Config struct {
-	Env        string
-	HTTPServer HTTPServer
-	Database   Database
+	Env           string
+	HTTPServer    HTTPServer
+	GRPCServer    GRPCServer
+	Database      Database
+	Integrations  Integrations
+	Notifications Notifications
 }
 
 type HTTPServer struct {
@@ -23,6 +26,69 @@ type HTTPServer struct {
 	RequestTimeout time.Duration
 }
 
+type GRPCServer struct {
+	Address        string
+	Port           int
+	RequestTimeout time.Duration
+}
+
+// Integrations — настройки вебхуков VCS. Секреты лучше передавать через переменные окружения.
+type Integrations struct {
+	GitHub     GitHubIntegration
+	GitLab     GitLabIntegration
+	ReviewSync ReviewSync
+}
+
+type GitHubIntegration struct {
+	// WebhookSecret — секрет вебхука для проверки X-Hub-Signature-256; пустой отключает приём событий.
+	WebhookSecret string
+	// Token — токен REST API для запроса ревью; пустой отключает синхронизацию ревьюверов с GitHub.
+	Token  string
+	APIURL string
+}
+
+type GitLabIntegration struct {
+	// WebhookSecret — ожидаемое значение X-Gitlab-Token; пустое отключает приём событий.
+	WebhookSecret string
+	// Token — токен REST API (scope api); пустой отключает синхронизацию ревьюверов с GitLab.
+	Token  string
+	APIURL string
+}
+
+// ReviewSync — повторы вызовов API VCS при синхронизации ревьюверов.
+type ReviewSync struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Notifications — уведомления ревьюверов через входящий вебхук Slack или Mattermost и по почте.
+type Notifications struct {
+	// WebhookURL — адрес входящего вебхука; пустой отключает отправку, настройки пользователей при этом доступны.
+	WebhookURL string
+	// Username — имя отправителя в чате.
+	Username string
+	// DigestAt — время ежедневного дайджеста «ЧЧ:ММ» в часовом поясе Timezone.
+	DigestAt string
+	Timezone string
+	// Templates переопределяет шаблоны text/template по видам уведомлений и шаблон digest.
+	Templates map[string]string
+	SMTP      SMTP
+	// ReviewSLA — срок ревью, по которому почтовый дайджест помечает просроченные ревью.
+	ReviewSLA time.Duration
+	// EmailTemplatesDir — каталог с email.txt.tmpl и email.html.tmpl вместо встроенных шаблонов писем.
+	EmailTemplatesDir string
+}
+
+// SMTP — почтовый сервер для уведомлений; пустой Host отключает отправку писем.
+type SMTP struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
 type Database struct {
 	Username       string
 	Password       string
@@ -30,6 +96,19 @@ type Database struct {
 	Port           string
 	DbName         string
 	MigrationsPath string
+	// MaxConns и MinConns — размер пула основного сервера; ноль оставляет значения pgx по умолчанию.
+	MaxConns int32
+	MinConns int32
+	Replica  DatabaseReplica
+}
+
+// DatabaseReplica — реплика для чтений (GetPR, ListPRsByAssignee, GetTeam, ListUsers); пустой DSN отключает её.
+type DatabaseReplica struct {
+	DSN      string
+	MaxConns int32
+	MinConns int32
+	// MaxStaleness — допустимое отставание реплики; при большем отставании или ошибке читается основной сервер.
+	MaxStaleness time.Duration
 }
 
 func MustLoad() *Config {
@@ -46,12 +125,51 @@ func MustLoad() *Config {
 	viper.SetDefault("http_server.idle_timeout", "60s")
 	viper.SetDefault("http_server.request_timeout", "10s")
 
+	viper.SetDefault("grpc_server.address", "0.0.0.0")
+	viper.SetDefault("grpc_server.port", 9090)
+	viper.SetDefault("grpc_server.request_timeout", "10s")
+
 	viper.SetDefault("database.username", "postgres")
 	viper.SetDefault("database.password", "admin")
 	viper.SetDefault("database.host", "pr-db")
 	viper.SetDefault("database.port", "5432")
 	viper.SetDefault("database.db_name", "prservice")
 	viper.SetDefault("database.migrations_path", "migrations")
+	viper.SetDefault("database.max_conns", 0)
+	viper.SetDefault("database.min_conns", 0)
+	viper.SetDefault("database.replica.dsn", "")
+	_ = viper.BindEnv("database.replica.dsn", "DATABASE_REPLICA_DSN")
+	viper.SetDefault("database.replica.max_conns", 0)
+	viper.SetDefault("database.replica.min_conns", 0)
+	viper.SetDefault("database.replica.max_staleness", "5s")
+
+	viper.SetDefault("integrations.github.webhook_secret", "")
+	viper.SetDefault("integrations.gitlab.webhook_secret", "")
+	_ = viper.BindEnv("integrations.github.webhook_secret", "GITHUB_WEBHOOK_SECRET")
+	_ = viper.BindEnv("integrations.gitlab.webhook_secret", "GITLAB_WEBHOOK_SECRET")
+	viper.SetDefault("integrations.github.token", "")
+	viper.SetDefault("integrations.github.api_url", "https://api.github.com")
+	viper.SetDefault("integrations.gitlab.token", "")
+	viper.SetDefault("integrations.gitlab.api_url", "https://gitlab.com")
+	_ = viper.BindEnv("integrations.github.token", "GITHUB_TOKEN")
+	_ = viper.BindEnv("integrations.gitlab.token", "GITLAB_TOKEN")
+	viper.SetDefault("integrations.review_sync.max_attempts", 5)
+	viper.SetDefault("integrations.review_sync.backoff", "1s")
+	viper.SetDefault("integrations.review_sync.max_backoff", "30s")
+
+	viper.SetDefault("notifications.webhook_url", "")
+	_ = viper.BindEnv("notifications.webhook_url", "NOTIFICATIONS_WEBHOOK_URL")
+	viper.SetDefault("notifications.username", "pr-service")
+	viper.SetDefault("notifications.digest_at", "09:00")
+	viper.SetDefault("notifications.timezone", "UTC")
+	viper.SetDefault("notifications.review_sla", "48h")
+	viper.SetDefault("notifications.email_templates_dir", "")
+	viper.SetDefault("notifications.smtp.host", "")
+	viper.SetDefault("notifications.smtp.port", 587)
+	viper.SetDefault("notifications.smtp.from", "pr-service@localhost")
+	_ = viper.BindEnv("notifications.smtp.host", "SMTP_HOST")
+	_ = viper.BindEnv("notifications.smtp.username", "SMTP_USERNAME")
+	_ = viper.BindEnv("notifications.smtp.password", "SMTP_PASSWORD")
 
 	if err := viper.ReadInConfig(); err != nil {
 		log.Printf("Error reading config file: %s", err)
@@ -68,6 +186,11 @@ func MustLoad() *Config {
 			IdleTimeout:    viper.GetDuration("http_server.idle_timeout"),
 			RequestTimeout: viper.GetDuration("http_server.request_timeout"),
 		},
+		GRPCServer: GRPCServer{
+			Address:        viper.GetString("grpc_server.address"),
+			Port:           viper.GetInt("grpc_server.port"),
+			RequestTimeout: viper.GetDuration("grpc_server.request_timeout"),
+		},
 		Database: Database{
 			Username:       viper.GetString("database.username"),
 			Password:       viper.GetString("database.password"),
@@ -75,6 +198,47 @@ func MustLoad() *Config {
 			Port:           viper.GetString("database.port"),
 			DbName:         viper.GetString("database.db_name"),
 			MigrationsPath: viper.GetString("database.migrations_path"),
+			MaxConns:       viper.GetInt32("database.max_conns"),
+			MinConns:       viper.GetInt32("database.min_conns"),
+			Replica: DatabaseReplica{
+				DSN:          viper.GetString("database.replica.dsn"),
+				MaxConns:     viper.GetInt32("database.replica.max_conns"),
+				MinConns:     viper.GetInt32("database.replica.min_conns"),
+				MaxStaleness: viper.GetDuration("database.replica.max_staleness"),
+			},
+		},
+		Integrations: Integrations{
+			GitHub: GitHubIntegration{
+				WebhookSecret: viper.GetString("integrations.github.webhook_secret"),
+				Token:         viper.GetString("integrations.github.token"),
+				APIURL:        viper.GetString("integrations.github.api_url"),
+			},
+			GitLab: GitLabIntegration{
+				WebhookSecret: viper.GetString("integrations.gitlab.webhook_secret"),
+				Token:         viper.GetString("integrations.gitlab.token"),
+				APIURL:        viper.GetString("integrations.gitlab.api_url"),
+			},
+			ReviewSync: ReviewSync{
+				MaxAttempts: viper.GetInt("integrations.review_sync.max_attempts"),
+				Backoff:     viper.GetDuration("integrations.review_sync.backoff"),
+				MaxBackoff:  viper.GetDuration("integrations.review_sync.max_backoff"),
+			},
+		},
+		Notifications: Notifications{
+			WebhookURL: viper.GetString("notifications.webhook_url"),
+			Username:   viper.GetString("notifications.username"),
+			DigestAt:   viper.GetString("notifications.digest_at"),
+			Timezone:   viper.GetString("notifications.timezone"),
+			Templates:  viper.GetStringMapString("notifications.templates"),
+			SMTP: SMTP{
+				Host:     viper.GetString("notifications.smtp.host"),
+				Port:     viper.GetInt("notifications.smtp.port"),
+				Username: viper.GetString("notifications.smtp.username"),
+				Password: viper.GetString("notifications.smtp.password"),
+				From:     viper.GetString("notifications.smtp.from"),
+			},
+			ReviewSLA:         viper.GetDuration("notifications.review_sla"),
+			EmailTemplatesDir: viper.GetString("notifications.email_templates_dir"),
 		},
 	}
 
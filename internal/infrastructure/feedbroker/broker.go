@@ -0,0 +1,95 @@
+package feedbroker
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	feed_port "avito-test-pr-service/internal/domain/ports/output/feed"
+	"sync"
+)
+
+// DefaultBuffer — сколько событий подписчик может не прочитать, прежде чем брокер его отключит.
+const DefaultBuffer = 64
+
+type subscriber struct {
+	ch chan *models.ReviewFeedEvent
+}
+
+// Broker раздаёт события ленты подписчикам внутри процесса. Публикация не блокируется:
+// подписчик с заполненным буфером отключается (канал закрывается) и дочитывает пропущенное из базы.
+type Broker struct {
+	mu     sync.Mutex
+	buffer int
+	subs   map[string]map[*subscriber]struct{}
+	closed bool
+}
+
+var _ feed_port.Broker = (*Broker)(nil)
+
+func NewBroker(buffer int) *Broker {
+	if buffer <= 0 {
+		buffer = DefaultBuffer
+	}
+	return &Broker{buffer: buffer, subs: make(map[string]map[*subscriber]struct{})}
+}
+
+// Subscribe подписывает на события пользователя; возвращённая функция отписывает и может вызываться повторно.
+func (b *Broker) Subscribe(userID string) (<-chan *models.ReviewFeedEvent, func()) {
+	sub := &subscriber{ch: make(chan *models.ReviewFeedEvent, b.buffer)}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(sub.ch)
+		return sub.ch, func() {}
+	}
+	if b.subs[userID] == nil {
+		b.subs[userID] = make(map[*subscriber]struct{})
+	}
+	b.subs[userID][sub] = struct{}{}
+	return sub.ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(userID, sub)
+	}
+}
+
+func (b *Broker) Publish(events []*models.ReviewFeedEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, e := range events {
+		for sub := range b.subs[e.UserID] {
+			select {
+			case sub.ch <- e:
+			default:
+				b.remove(e.UserID, sub)
+			}
+		}
+	}
+}
+
+// Close отключает всех подписчиков и закрывает брокер для новых; вызывается при остановке сервера,
+// чтобы открытые потоки SSE завершились и не задерживали graceful shutdown.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for userID, subs := range b.subs {
+		for sub := range subs {
+			b.remove(userID, sub)
+		}
+	}
+}
+
+// remove отключает подписчика; вызывается под b.mu.
+func (b *Broker) remove(userID string, sub *subscriber) {
+	subs, ok := b.subs[userID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	close(sub.ch)
+	if len(subs) == 0 {
+		delete(b.subs, userID)
+	}
+}
@@ -0,0 +1,60 @@
+package feedbroker
+
+import (
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+
+	"github.com/stretchr/testify/require"
+)
+
+func event(id int64, userID string) *models.ReviewFeedEvent {
+	return &models.ReviewFeedEvent{ID: id, UserID: userID, Type: models.ReviewFeedAssigned}
+}
+
+func TestBroker_FanOut(t *testing.T) {
+	b := NewBroker(4)
+	first, unsubFirst := b.Subscribe("u1")
+	second, unsubSecond := b.Subscribe("u1")
+	other, unsubOther := b.Subscribe("u2")
+	defer unsubFirst()
+	defer unsubSecond()
+	defer unsubOther()
+
+	b.Publish([]*models.ReviewFeedEvent{event(1, "u1"), event(2, "u2")})
+
+	require.Equal(t, int64(1), (<-first).ID)
+	require.Equal(t, int64(1), (<-second).ID)
+	require.Equal(t, int64(2), (<-other).ID)
+	require.Empty(t, first)
+}
+
+func TestBroker_SlowSubscriberDropped(t *testing.T) {
+	b := NewBroker(1)
+	slow, unsub := b.Subscribe("u1")
+
+	b.Publish([]*models.ReviewFeedEvent{event(1, "u1"), event(2, "u1")})
+
+	e, ok := <-slow
+	require.True(t, ok)
+	require.Equal(t, int64(1), e.ID)
+	_, ok = <-slow
+	require.False(t, ok, "overflowing subscriber must be disconnected")
+
+	unsub() // повторная отписка после отключения безопасна
+	b.Publish([]*models.ReviewFeedEvent{event(3, "u1")})
+}
+
+func TestBroker_Close(t *testing.T) {
+	b := NewBroker(1)
+	ch, unsub := b.Subscribe("u1")
+
+	b.Close()
+	_, ok := <-ch
+	require.False(t, ok)
+	unsub()
+
+	late, _ := b.Subscribe("u1")
+	_, ok = <-late
+	require.False(t, ok, "subscription after Close must be closed immediately")
+}
@@ -0,0 +1,17 @@
+package feedbroker
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	feed_port "avito-test-pr-service/internal/domain/ports/output/feed"
+)
+
+// Fanout передаёт события всем получателям по очереди; получатели сами не должны блокировать публикацию.
+type Fanout []feed_port.Publisher
+
+var _ feed_port.Publisher = Fanout(nil)
+
+func (f Fanout) Publish(events []*models.ReviewFeedEvent) {
+	for _, p := range f {
+		p.Publish(events)
+	}
+}
@@ -0,0 +1,221 @@
+package grpc
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	prservicev1 "avito-test-pr-service/pkg/api/prservice/v1"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toProtoUser(u *models.User) *prservicev1.User {
+	return &prservicev1.User{Id: u.ID, Name: u.Name, IsActive: u.IsActive, Tags: u.Tags, Email: u.Email}
+}
+
+func toProtoUsers(users []*models.User) []*prservicev1.User {
+	out := make([]*prservicev1.User, 0, len(users))
+	for _, u := range users {
+		out = append(out, toProtoUser(u))
+	}
+	return out
+}
+
+func toProtoTeam(t *models.Team) (*prservicev1.Team, error) {
+	params, err := toStruct(t.SelectionParams)
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.Team{Id: t.ID.String(), Name: t.Name, SelectionStrategy: t.SelectionStrategy, SelectionParams: params}, nil
+}
+
+func toProtoPR(pr *models.PullRequest) *prservicev1.PullRequest {
+	out := &prservicev1.PullRequest{
+		Id:           pr.ID,
+		Title:        pr.Title,
+		AuthorId:     pr.AuthorID,
+		Status:       string(pr.Status),
+		ReviewerIds:  pr.ReviewerIDs,
+		ChangedFiles: pr.ChangedFiles,
+		Labels:       pr.Labels,
+		CreatedAt:    toTimestamp(pr.CreatedAt),
+	}
+	if pr.MergedAt != nil {
+		out.MergedAt = timestamppb.New(*pr.MergedAt)
+	}
+	return out
+}
+
+func toProtoPRs(prs []*models.PullRequest) []*prservicev1.PullRequest {
+	out := make([]*prservicev1.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		out = append(out, toProtoPR(pr))
+	}
+	return out
+}
+
+func toProtoCodeOwners(rules []models.CodeOwnerRule) []*prservicev1.CodeOwnerRule {
+	out := make([]*prservicev1.CodeOwnerRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, &prservicev1.CodeOwnerRule{Pattern: r.Pattern, OwnerIds: r.OwnerIDs})
+	}
+	return out
+}
+
+func fromProtoCodeOwners(rules []*prservicev1.CodeOwnerRule) []models.CodeOwnerRule {
+	out := make([]models.CodeOwnerRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, models.CodeOwnerRule{Pattern: r.GetPattern(), OwnerIDs: r.GetOwnerIds()})
+	}
+	return out
+}
+
+func toProtoConstraints(constraints []models.ReviewerConstraint) []*prservicev1.ReviewerConstraint {
+	out := make([]*prservicev1.ReviewerConstraint, 0, len(constraints))
+	for _, c := range constraints {
+		out = append(out, &prservicev1.ReviewerConstraint{Kind: string(c.Kind), AuthorIds: c.AuthorIDs, ReviewerIds: c.ReviewerIDs})
+	}
+	return out
+}
+
+func fromProtoConstraints(constraints []*prservicev1.ReviewerConstraint) []models.ReviewerConstraint {
+	out := make([]models.ReviewerConstraint, 0, len(constraints))
+	for _, c := range constraints {
+		out = append(out, models.ReviewerConstraint{Kind: models.ConstraintKind(c.GetKind()), AuthorIDs: c.GetAuthorIds(), ReviewerIDs: c.GetReviewerIds()})
+	}
+	return out
+}
+
+func toProtoReleases(releases []models.ReviewRelease) []*prservicev1.ReviewRelease {
+	out := make([]*prservicev1.ReviewRelease, 0, len(releases))
+	for _, r := range releases {
+		out = append(out, &prservicev1.ReviewRelease{PullRequestId: r.PRID, UserId: r.ReviewerID, Outcome: string(r.Outcome), ReplacedBy: r.ReplacedBy})
+	}
+	return out
+}
+
+func toProtoExplanation(e *models.SelectionExplanation) *prservicev1.SelectionExplanation {
+	out := &prservicev1.SelectionExplanation{
+		PullRequestId: e.PRID,
+		Reason:        string(e.Reason),
+		Strategy:      e.Strategy,
+		Slots:         int32(e.Slots),
+		Pool:          e.Pool,
+		Selected:      e.Selected,
+		CreatedAt:     toTimestamp(e.CreatedAt),
+	}
+	for _, ex := range e.Excluded {
+		out.Excluded = append(out.Excluded, &prservicev1.ExcludedCandidate{UserId: ex.UserID, Reason: string(ex.Reason)})
+	}
+	for _, sc := range e.Scores {
+		out.Scores = append(out.Scores, &prservicev1.CandidateScore{UserId: sc.UserID, Score: sc.Score, Factors: sc.Factors, Selected: sc.Selected})
+	}
+	return out
+}
+
+func toProtoRosterMembers(members []models.RosterMember) []*prservicev1.RosterMember {
+	out := make([]*prservicev1.RosterMember, 0, len(members))
+	for _, m := range members {
+		out = append(out, &prservicev1.RosterMember{UserId: m.UserID, Username: m.Username, IsActive: m.IsActive})
+	}
+	return out
+}
+
+func fromProtoRosterMembers(members []*prservicev1.RosterMember) []models.RosterMember {
+	out := make([]models.RosterMember, 0, len(members))
+	for _, m := range members {
+		out = append(out, models.RosterMember{UserID: m.GetUserId(), Username: m.GetUsername(), IsActive: m.GetIsActive()})
+	}
+	return out
+}
+
+func toProtoRoster(roster *models.Roster) (*prservicev1.Roster, error) {
+	out := &prservicev1.Roster{Teams: make([]*prservicev1.RosterTeam, 0, len(roster.Teams))}
+	for _, t := range roster.Teams {
+		team := &prservicev1.RosterTeam{TeamName: t.Name, Members: toProtoRosterMembers(t.Members)}
+		if t.Settings != nil {
+			params, err := toStruct(t.Settings.SelectionParams)
+			if err != nil {
+				return nil, err
+			}
+			team.Settings = &prservicev1.TeamSettings{
+				SelectionStrategy:   t.Settings.SelectionStrategy,
+				SelectionParams:     params,
+				CodeOwners:          toProtoCodeOwners(t.Settings.CodeOwners),
+				ReviewerConstraints: toProtoConstraints(t.Settings.ReviewerConstraints),
+			}
+		}
+		out.Teams = append(out.Teams, team)
+	}
+	return out, nil
+}
+
+func fromProtoRoster(roster *prservicev1.Roster) (*models.Roster, error) {
+	out := &models.Roster{Teams: make([]models.RosterTeam, 0, len(roster.GetTeams()))}
+	for _, t := range roster.GetTeams() {
+		team := models.RosterTeam{Name: t.GetTeamName(), Members: fromProtoRosterMembers(t.GetMembers())}
+		if s := t.GetSettings(); s != nil {
+			params, err := fromStruct(s.GetSelectionParams())
+			if err != nil {
+				return nil, err
+			}
+			team.Settings = &models.TeamSettings{
+				SelectionStrategy:   s.GetSelectionStrategy(),
+				SelectionParams:     params,
+				CodeOwners:          fromProtoCodeOwners(s.GetCodeOwners()),
+				ReviewerConstraints: fromProtoConstraints(s.GetReviewerConstraints()),
+			}
+		}
+		out.Teams = append(out.Teams, team)
+	}
+	return out, nil
+}
+
+func toProtoDiff(diff *models.RosterDiff) *prservicev1.RosterDiff {
+	out := &prservicev1.RosterDiff{
+		CreateTeams:     diff.CreateTeams,
+		CreateUsers:     toProtoRosterMembers(diff.CreateUsers),
+		ActivateUsers:   diff.ActivateUsers,
+		DeactivateUsers: diff.DeactivateUsers,
+	}
+	for _, r := range diff.RenameUsers {
+		out.RenameUsers = append(out.RenameUsers, &prservicev1.UserRename{UserId: r.UserID, From: r.From, To: r.To})
+	}
+	for _, m := range diff.AddMemberships {
+		out.AddMemberships = append(out.AddMemberships, &prservicev1.Membership{TeamName: m.TeamName, UserId: m.UserID})
+	}
+	for _, m := range diff.RemoveMemberships {
+		out.RemoveMemberships = append(out.RemoveMemberships, &prservicev1.Membership{TeamName: m.TeamName, UserId: m.UserID})
+	}
+	for _, c := range diff.UpdateSettings {
+		out.UpdateSettings = append(out.UpdateSettings, &prservicev1.SettingsChange{TeamName: c.TeamName, Fields: c.Fields})
+	}
+	return out
+}
+
+// toStruct переводит параметры стратегии из JSON в google.protobuf.Struct; пустые параметры — nil.
+func toStruct(raw json.RawMessage) (*structpb.Struct, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+	return structpb.NewStruct(m)
+}
+
+func fromStruct(s *structpb.Struct) (json.RawMessage, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return s.MarshalJSON()
+}
+
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain — значение ErrorInfo.Domain в ошибках сервиса.
+const ErrorDomain = "prservice"
+
+// toStatus возвращает ошибку gRPC с ErrorInfo, reason которого совпадает с кодом ошибки HTTP API.
+// Текст неизвестных ошибок наружу не отдаётся.
+func (s *Server) toStatus(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err).Err()
+	}
+	m := utils.MapError(err)
+	msg := err.Error()
+	if m.GRPCCode == codes.Internal {
+		s.log.Error("gRPC call failed", slog.String("method", method), slog.Any("err", err))
+		msg = utils.ErrInternal.Error()
+	}
+	st := status.New(m.GRPCCode, msg)
+	if detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{Reason: m.Code, Domain: ErrorDomain}); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// invalidArgument — ошибка разбора запроса до вызова сервиса.
+func invalidArgument(msg string) error {
+	st := status.New(codes.InvalidArgument, msg)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: utils.HTTPCodeConverter(http.StatusBadRequest), Domain: ErrorDomain}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
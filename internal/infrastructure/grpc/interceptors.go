@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/utils"
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ключи метаданных — аналоги заголовков X-Request-Id и X-Actor-ID HTTP API.
+// x-actor-id, как и X-Actor-ID, не аутентифицируется и записывается в аудит со слов клиента.
+const (
+	RequestIDMetadata = "x-request-id"
+	ActorMetadata     = "x-actor-id"
+)
+
+// auditContextInterceptor кладёт в контекст идентификатор запроса и инициатора изменения для журнала аудита.
+// Идентификатор запроса без x-request-id генерируется и возвращается клиенту в заголовке ответа.
+func auditContextInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	requestID := firstValue(md, RequestIDMetadata)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadata, requestID))
+	ctx = utils.WithRequestID(ctx, requestID)
+	ctx = utils.WithActor(ctx, firstValue(md, ActorMetadata))
+	return handler(ctx, req)
+}
+
+func loggingInterceptor(log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		entry := log.With(
+			slog.String("request_id", utils.RequestIDFromContext(ctx)),
+			slog.String("method", info.FullMethod),
+		)
+		entry.Info("request started")
+
+		t1 := time.Now()
+		resp, err := handler(ctx, req)
+
+		entry.Info("request completed",
+			slog.String("code", status.Code(err).String()),
+			slog.String("duration", time.Since(t1).String()),
+		)
+		return resp, err
+	}
+}
+
+func recoveryInterceptor(log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error("gRPC handler panic", slog.String("method", info.FullMethod), slog.Any("panic", r), slog.String("stack", string(debug.Stack())))
+				err = status.Error(codes.Internal, utils.ErrInternal.Error())
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// errorInterceptor переводит ошибки домена в статусы gRPC.
+func (s *Server) errorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, s.toStatus(info.FullMethod, err)
+	}
+	return resp, nil
+}
+
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
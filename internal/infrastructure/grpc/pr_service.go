@@ -0,0 +1,239 @@
+package grpc
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/utils"
+	prservicev1 "avito-test-pr-service/pkg/api/prservice/v1"
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+)
+
+// prService реализует PullRequestService поверх PRInputPort.
+type prService struct {
+	prservicev1.UnimplementedPullRequestServiceServer
+
+	log       *logger.Logger
+	prService input.PRInputPort
+}
+
+func (s *prService) CreatePR(ctx context.Context, req *prservicev1.CreatePRRequest) (*prservicev1.PullRequest, error) {
+	if err := required("id", req.GetId(), "author_id", req.GetAuthorId(), "title", req.GetTitle()); err != nil {
+		return nil, err
+	}
+	pr, err := s.prService.CreatePR(ctx, req.GetId(), req.GetAuthorId(), req.GetTitle(), req.GetChangedFiles(), req.GetLabels())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPR(pr), nil
+}
+
+func (s *prService) BatchCreatePRs(ctx context.Context, req *prservicev1.BatchCreatePRsRequest) (*prservicev1.BatchCreatePRsResponse, error) {
+	items := make([]*models.BatchPRItem, 0, len(req.GetItems()))
+	for _, it := range req.GetItems() {
+		item := &models.BatchPRItem{ID: it.GetId(), Title: it.GetTitle(), AuthorID: it.GetAuthorId(), ChangedFiles: it.GetChangedFiles(), Labels: it.GetLabels()}
+		if it.GetReviewerIds() != nil {
+			item.ReviewerIDs = append([]string{}, it.GetReviewerIds().GetIds()...)
+		}
+		items = append(items, item)
+	}
+	results, err := s.prService.BatchCreatePRs(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	resp := &prservicev1.BatchCreatePRsResponse{Results: make([]*prservicev1.BatchPRResult, 0, len(results))}
+	for _, res := range results {
+		item := &prservicev1.BatchPRResult{PullRequestId: res.PullRequestID, Status: string(res.Status)}
+		if res.PR != nil {
+			item.Pr = toProtoPR(res.PR)
+		}
+		if res.Err != nil {
+			item.ErrorCode, item.ErrorMessage = s.batchItemError(res)
+		}
+		resp.Results = append(resp.Results, item)
+	}
+	return resp, nil
+}
+
+// batchItemError возвращает код и сообщение ошибки элемента пачки по тем же правилам, что и для одиночных вызовов.
+func (s *prService) batchItemError(res *models.BatchPRResult) (string, string) {
+	m := utils.MapError(res.Err)
+	if m.GRPCCode == codes.Internal {
+		s.log.Error("BatchCreatePRs item failed", slog.Any("err", res.Err), slog.String("pr_id", res.PullRequestID))
+		return m.Code, utils.ErrInternal.Error()
+	}
+	return m.Code, res.Err.Error()
+}
+
+func (s *prService) ReassignReviewer(ctx context.Context, req *prservicev1.ReassignReviewerRequest) (*prservicev1.PullRequest, error) {
+	if err := required("pull_request_id", req.GetPullRequestId(), "old_reviewer_id", req.GetOldReviewerId()); err != nil {
+		return nil, err
+	}
+	pr, err := s.prService.ReassignReviewer(ctx, req.GetPullRequestId(), req.GetOldReviewerId(), req.GetNewReviewerId(), models.AssignmentReason(req.GetReason()), nil)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPR(pr), nil
+}
+
+func (s *prService) AddReviewer(ctx context.Context, req *prservicev1.ReviewerChangeRequest) (*prservicev1.PullRequest, error) {
+	if err := required("pull_request_id", req.GetPullRequestId(), "reviewer_id", req.GetReviewerId()); err != nil {
+		return nil, err
+	}
+	pr, err := s.prService.AddReviewer(ctx, req.GetPullRequestId(), req.GetReviewerId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPR(pr), nil
+}
+
+func (s *prService) RemoveReviewer(ctx context.Context, req *prservicev1.ReviewerChangeRequest) (*prservicev1.PullRequest, error) {
+	if err := required("pull_request_id", req.GetPullRequestId(), "reviewer_id", req.GetReviewerId()); err != nil {
+		return nil, err
+	}
+	pr, err := s.prService.RemoveReviewer(ctx, req.GetPullRequestId(), req.GetReviewerId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPR(pr), nil
+}
+
+func (s *prService) MergePR(ctx context.Context, req *prservicev1.PullRequestIDRequest) (*prservicev1.PullRequest, error) {
+	if err := required("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+	pr, err := s.prService.MergePR(ctx, req.GetPullRequestId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPR(pr), nil
+}
+
+func (s *prService) ClosePR(ctx context.Context, req *prservicev1.PullRequestIDRequest) (*prservicev1.PullRequest, error) {
+	if err := required("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+	pr, err := s.prService.ClosePR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPR(pr), nil
+}
+
+func (s *prService) ReopenPR(ctx context.Context, req *prservicev1.PullRequestIDRequest) (*prservicev1.PullRequest, error) {
+	if err := required("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+	pr, err := s.prService.ReopenPR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPR(pr), nil
+}
+
+func (s *prService) GetPR(ctx context.Context, req *prservicev1.PullRequestIDRequest) (*prservicev1.PullRequest, error) {
+	if err := required("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+	pr, err := s.prService.GetPR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPR(pr), nil
+}
+
+func (s *prService) ListPRsByAssignee(ctx context.Context, req *prservicev1.ListPRsByAssigneeRequest) (*prservicev1.PullRequestList, error) {
+	if err := required("reviewer_id", req.GetReviewerId()); err != nil {
+		return nil, err
+	}
+	status, err := parseStatus(req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+	prs, err := s.prService.ListPRsByAssignee(ctx, req.GetReviewerId(), status)
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.PullRequestList{PullRequests: toProtoPRs(prs)}, nil
+}
+
+func (s *prService) GetReviewerHistory(ctx context.Context, req *prservicev1.PullRequestIDRequest) (*prservicev1.ReviewerHistory, error) {
+	if err := required("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+	entries, err := s.prService.GetReviewerHistory(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &prservicev1.ReviewerHistory{PullRequestId: req.GetPullRequestId(), Entries: make([]*prservicev1.ReviewerHistoryEntry, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &prservicev1.ReviewerHistoryEntry{
+			ReviewerId: e.ReviewerID,
+			Event:      string(e.Event),
+			Reason:     string(e.Reason),
+			Actor:      e.Actor,
+			At:         toTimestamp(e.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (s *prService) ExplainSelection(ctx context.Context, req *prservicev1.PullRequestIDRequest) (*prservicev1.SelectionExplanations, error) {
+	if err := required("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+	selections, err := s.prService.ExplainSelection(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &prservicev1.SelectionExplanations{PullRequestId: req.GetPullRequestId(), Selections: make([]*prservicev1.SelectionExplanation, 0, len(selections))}
+	for _, e := range selections {
+		resp.Selections = append(resp.Selections, toProtoExplanation(e))
+	}
+	return resp, nil
+}
+
+func (s *prService) ListPRs(ctx context.Context, req *prservicev1.ListPRsRequest) (*prservicev1.ListPRsResponse, error) {
+	status, err := parseStatus(req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+	filter := models.PRFilter{
+		AuthorID:   req.GetAuthorId(),
+		TeamName:   req.GetTeamName(),
+		Status:     status,
+		ReviewerID: req.GetReviewerId(),
+		Limit:      int(req.GetLimit()),
+		Offset:     int(req.GetOffset()),
+	}
+	page, err := s.prService.ListPRs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.ListPRsResponse{PullRequests: toProtoPRs(page.PullRequests), Total: int32(page.Total)}, nil
+}
+
+// parseStatus разбирает необязательный фильтр по статусу PR.
+func parseStatus(raw string) (*models.PRStatus, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	status := models.PRStatus(raw)
+	if !status.IsValid() {
+		return nil, invalidArgument("status must be OPEN, MERGED or CLOSED")
+	}
+	return &status, nil
+}
+
+// required проверяет пары «имя поля, значение» и возвращает InvalidArgument для первого пустого поля.
+func required(pairs ...string) error {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			return invalidArgument(pairs[i] + " is required")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/config"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	prservicev1 "avito-test-pr-service/pkg/api/prservice/v1"
+	"context"
+	"log/slog"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+type Server struct {
+	address string
+	log     *logger.Logger
+
+	mu     sync.Mutex
+	server *grpc.Server
+	health *health.Server
+
+	prService   input.PRInputPort
+	teamService input.TeamInputPort
+	userService input.UserInputPort
+}
+
+func NewServer(address string, log *logger.Logger, prSvc input.PRInputPort, teamSvc input.TeamInputPort, userSvc input.UserInputPort) *Server {
+	return &Server{
+		address:     address,
+		log:         log,
+		prService:   prSvc,
+		teamService: teamSvc,
+		userService: userSvc,
+	}
+}
+
+func (s *Server) Run(cfg *config.Config) error {
+	lis, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	s.log.Info("Starting gRPC server", slog.String("address", s.address))
+	return s.serve(lis, cfg)
+}
+
+func (s *Server) serve(lis net.Listener, cfg *config.Config) error {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		auditContextInterceptor,
+		loggingInterceptor(s.log),
+		recoveryInterceptor(s.log),
+		s.errorInterceptor,
+		timeoutInterceptor(cfg.GRPCServer.RequestTimeout),
+	))
+
+	prservicev1.RegisterPullRequestServiceServer(server, &prService{log: s.log, prService: s.prService})
+	prservicev1.RegisterTeamServiceServer(server, &teamService{log: s.log, teamService: s.teamService})
+	prservicev1.RegisterUserServiceServer(server, &userService{userService: s.userService})
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	for name := range server.GetServiceInfo() {
+		healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	reflection.Register(server)
+
+	s.mu.Lock()
+	s.server, s.health = server, healthServer
+	s.mu.Unlock()
+
+	return server.Serve(lis)
+}
+
+// Shutdown переводит health в NOT_SERVING и дожидается завершения текущих вызовов;
+// если ctx истекает раньше, оставшиеся соединения закрываются принудительно.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	server, healthServer := s.server, s.health
+	s.mu.Unlock()
+	if server == nil {
+		return nil
+	}
+	healthServer.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		server.Stop()
+		return ctx.Err()
+	}
+}
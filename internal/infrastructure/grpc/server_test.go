@@ -0,0 +1,285 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net"
+	"strings"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/config"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/utils"
+	"avito-test-pr-service/mocks"
+	prservicev1 "avito-test-pr-service/pkg/api/prservice/v1"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testEnv struct {
+	conn *grpc.ClientConn
+	pr   *mocks.PRInputPort
+	team *mocks.TeamInputPort
+	user *mocks.UserInputPort
+	srv  *Server
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	env := &testEnv{pr: mocks.NewPRInputPort(t), team: mocks.NewTeamInputPort(t), user: mocks.NewUserInputPort(t)}
+	env.srv = NewServer("bufconn", logger.New("test"), env.pr, env.team, env.user)
+
+	lis := bufconn.Listen(1 << 20)
+	served := make(chan error, 1)
+	go func() { served <- env.srv.serve(lis, &config.Config{}) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	env.conn = conn
+	t.Cleanup(func() {
+		_ = conn.Close()
+		require.NoError(t, env.srv.Shutdown(context.Background()))
+		require.NoError(t, <-served)
+	})
+	return env
+}
+
+func TestServer_HealthAndReflection(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	health := healthpb.NewHealthClient(env.conn)
+	for _, service := range []string{"", prservicev1.PullRequestService_ServiceDesc.ServiceName, prservicev1.TeamService_ServiceDesc.ServiceName, prservicev1.UserService_ServiceDesc.ServiceName} {
+		resp, err := health.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err, service)
+		require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus(), service)
+	}
+
+	stream, err := reflectionpb.NewServerReflectionClient(env.conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{}}))
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	var names []string
+	for _, s := range resp.GetListServicesResponse().GetService() {
+		names = append(names, s.GetName())
+	}
+	require.Contains(t, names, "prservice.v1.PullRequestService")
+	require.Contains(t, names, "prservice.v1.TeamService")
+	require.Contains(t, names, "prservice.v1.UserService")
+	require.NoError(t, stream.CloseSend())
+}
+
+func TestServer_CreatePRPassesActor(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), ActorMetadata, "admin", RequestIDMetadata, "req-1")
+
+	env.pr.EXPECT().CreatePR(mock.MatchedBy(func(ctx context.Context) bool {
+		return utils.ActorFromContext(ctx) == "admin" && utils.RequestIDFromContext(ctx) == "req-1"
+	}), "pr-1", "u1", "Fix", []string{"a.go"}, []string(nil)).
+		Return(&models.PullRequest{ID: "pr-1", Title: "Fix", AuthorID: "u1", Status: models.PRStatusOPEN, ReviewerIDs: []string{"u2"}}, nil)
+
+	var header metadata.MD
+	pr, err := prservicev1.NewPullRequestServiceClient(env.conn).CreatePR(ctx,
+		&prservicev1.CreatePRRequest{Id: "pr-1", AuthorId: "u1", Title: "Fix", ChangedFiles: []string{"a.go"}}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Equal(t, "OPEN", pr.GetStatus())
+	require.Equal(t, []string{"u2"}, pr.GetReviewerIds())
+	require.Nil(t, pr.GetMergedAt())
+	require.Equal(t, []string{"req-1"}, header.Get(RequestIDMetadata))
+}
+
+func TestServer_ErrorMapping(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantReason string
+		wantMsg    string
+	}{
+		{name: "not found", err: utils.ErrPRNotFound, wantCode: codes.NotFound, wantReason: "NOT_FOUND", wantMsg: utils.ErrPRNotFound.Error()},
+		{name: "merged", err: utils.ErrAlreadyMerged, wantCode: codes.FailedPrecondition, wantReason: "PR_MERGED", wantMsg: utils.ErrAlreadyMerged.Error()},
+		{name: "no candidate", err: utils.ErrNoReplacementCandidates, wantCode: codes.FailedPrecondition, wantReason: "NO_CANDIDATE", wantMsg: utils.ErrNoReplacementCandidates.Error()},
+		{name: "exists", err: utils.ErrPRExists, wantCode: codes.AlreadyExists, wantReason: "PR_EXISTS", wantMsg: utils.ErrPRExists.Error()},
+		{name: "invalid reason", err: utils.ErrInvalidReason, wantCode: codes.InvalidArgument, wantReason: "BAD_REQUEST", wantMsg: utils.ErrInvalidReason.Error()},
+		{name: "unknown error is hidden", err: errors.New("connection refused"), wantCode: codes.Internal, wantReason: "INTERNAL", wantMsg: utils.ErrInternal.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			env.pr.EXPECT().MergePR(mock.Anything, "pr-1", mock.Anything).Return(nil, tt.err)
+
+			_, err := prservicev1.NewPullRequestServiceClient(env.conn).MergePR(context.Background(), &prservicev1.PullRequestIDRequest{PullRequestId: "pr-1"})
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, tt.wantCode, st.Code())
+			require.Equal(t, tt.wantMsg, st.Message())
+			require.Len(t, st.Details(), 1)
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			require.Equal(t, tt.wantReason, info.GetReason())
+			require.Equal(t, ErrorDomain, info.GetDomain())
+		})
+	}
+}
+
+func TestDomainCode_CoversAllErrors(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		code codes.Code
+	}{
+		"ErrUserNotFound":            {utils.ErrUserNotFound, codes.NotFound},
+		"ErrUserExists":              {utils.ErrUserExists, codes.AlreadyExists},
+		"ErrTeamNotFound":            {utils.ErrTeamNotFound, codes.NotFound},
+		"ErrTeamExists":              {utils.ErrTeamExists, codes.AlreadyExists},
+		"ErrUserNoTeam":              {utils.ErrUserNoTeam, codes.NotFound},
+		"ErrNotFound":                {utils.ErrNotFound, codes.NotFound},
+		"ErrAlreadyExists":           {utils.ErrAlreadyExists, codes.AlreadyExists},
+		"ErrInvalidArgument":         {utils.ErrInvalidArgument, codes.InvalidArgument},
+		"ErrInternal":                {utils.ErrInternal, codes.Internal},
+		"ErrInvalidJSON":             {utils.ErrInvalidJSON, codes.InvalidArgument},
+		"ErrValidationFailed":        {utils.ErrValidationFailed, codes.InvalidArgument},
+		"ErrInvalidUserID":           {utils.ErrInvalidUserID, codes.InvalidArgument},
+		"ErrInvalidAuthorID":         {utils.ErrInvalidAuthorID, codes.InvalidArgument},
+		"ErrInvalidOldUserID":        {utils.ErrInvalidOldUserID, codes.InvalidArgument},
+		"ErrInvalidPRIDFormat":       {utils.ErrInvalidPRIDFormat, codes.InvalidArgument},
+		"ErrPRIDRequired":            {utils.ErrPRIDRequired, codes.InvalidArgument},
+		"ErrAlreadyMerged":           {utils.ErrAlreadyMerged, codes.FailedPrecondition},
+		"ErrPRClosed":                {utils.ErrPRClosed, codes.FailedPrecondition},
+		"ErrPRExists":                {utils.ErrPRExists, codes.AlreadyExists},
+		"ErrPRNotFound":              {utils.ErrPRNotFound, codes.NotFound},
+		"ErrTooManyReviewers":        {utils.ErrTooManyReviewers, codes.FailedPrecondition},
+		"ErrReviewerAlreadyAssigned": {utils.ErrReviewerAlreadyAssigned, codes.FailedPrecondition},
+		"ErrReviewerNotAssigned":     {utils.ErrReviewerNotAssigned, codes.FailedPrecondition},
+		"ErrInvalidStatus":           {utils.ErrInvalidStatus, codes.InvalidArgument},
+		"ErrNoReplacementCandidates": {utils.ErrNoReplacementCandidates, codes.FailedPrecondition},
+		"ErrInvalidReason":           {utils.ErrInvalidReason, codes.InvalidArgument},
+		"ErrReviewerNotEligible":     {utils.ErrReviewerNotEligible, codes.FailedPrecondition},
+		"ErrInvalidCodeOwners":       {utils.ErrInvalidCodeOwners, codes.InvalidArgument},
+		"ErrUnknownStrategy":         {utils.ErrUnknownStrategy, codes.InvalidArgument},
+		"ErrInvalidStrategyParams":   {utils.ErrInvalidStrategyParams, codes.InvalidArgument},
+		"ErrInvalidTag":              {utils.ErrInvalidTag, codes.InvalidArgument},
+		"ErrInvalidConstraint":       {utils.ErrInvalidConstraint, codes.InvalidArgument},
+		"ErrConstraintsUnsatisfied":  {utils.ErrConstraintsUnsatisfied, codes.FailedPrecondition},
+		"ErrBatchTooLarge":           {utils.ErrBatchTooLarge, codes.InvalidArgument},
+		"ErrVCSIdentityNotFound":     {utils.ErrVCSIdentityNotFound, codes.NotFound},
+		"ErrInvalidProvider":         {utils.ErrInvalidProvider, codes.InvalidArgument},
+		"ErrVCSLinkNotFound":         {utils.ErrVCSLinkNotFound, codes.NotFound},
+		"ErrVCSRejected":             {utils.ErrVCSRejected, codes.FailedPrecondition},
+		"ErrInvalidNotificationKind": {utils.ErrInvalidNotificationKind, codes.InvalidArgument},
+		"ErrInvalidDelivery":         {utils.ErrInvalidDelivery, codes.InvalidArgument},
+		"ErrInvalidChatHandle":       {utils.ErrInvalidChatHandle, codes.InvalidArgument},
+		"ErrInvalidEmail":            {utils.ErrInvalidEmail, codes.InvalidArgument},
+		"ErrPreconditionFailed":      {utils.ErrPreconditionFailed, codes.FailedPrecondition},
+	}
+
+	// Каждая ошибка из custom_errors.go должна быть в таблице: новая ошибка без кода не пройдёт незамеченной.
+	file, err := parser.ParseFile(token.NewFileSet(), "../../utils/custom_errors.go", nil, 0)
+	require.NoError(t, err)
+	for name, obj := range file.Scope.Objects {
+		if obj.Kind == ast.Var && strings.HasPrefix(name, "Err") {
+			require.Contains(t, tests, name, "add %s to utils.errorTable and this table", name)
+		}
+	}
+
+	for name, tt := range tests {
+		require.Equal(t, tt.code, utils.MapError(tt.err).GRPCCode, name)
+	}
+	require.Equal(t, "PRECONDITION_FAILED", utils.MapError(utils.ErrPreconditionFailed).Code)
+}
+
+func TestServer_InvalidArgumentBeforeService(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	_, err := prservicev1.NewPullRequestServiceClient(env.conn).GetPR(ctx, &prservicev1.PullRequestIDRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = prservicev1.NewTeamServiceClient(env.conn).GetTeam(ctx, &prservicev1.TeamIDRequest{TeamId: "not-a-uuid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = prservicev1.NewPullRequestServiceClient(env.conn).ListPRs(ctx, &prservicev1.ListPRsRequest{Status: "DRAFT"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_CloseAndReopenPR(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	client := prservicev1.NewPullRequestServiceClient(env.conn)
+
+	env.pr.EXPECT().ClosePR(mock.Anything, "pr-1").Return(&models.PullRequest{ID: "pr-1", AuthorID: "u1", Status: models.PRStatusCLOSED}, nil)
+	closed, err := client.ClosePR(ctx, &prservicev1.PullRequestIDRequest{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+	require.Equal(t, string(models.PRStatusCLOSED), closed.GetStatus())
+
+	env.pr.EXPECT().ReopenPR(mock.Anything, "pr-1").Return(&models.PullRequest{ID: "pr-1", AuthorID: "u1", Status: models.PRStatusOPEN}, nil)
+	reopened, err := client.ReopenPR(ctx, &prservicev1.PullRequestIDRequest{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+	require.Equal(t, string(models.PRStatusOPEN), reopened.GetStatus())
+
+	env.pr.EXPECT().ReopenPR(mock.Anything, "pr-2").Return(nil, utils.ErrAlreadyMerged)
+	_, err = client.ReopenPR(ctx, &prservicev1.PullRequestIDRequest{PullRequestId: "pr-2"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = client.ClosePR(ctx, &prservicev1.PullRequestIDRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_SetUserEmail(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	client := prservicev1.NewUserServiceClient(env.conn)
+
+	env.user.EXPECT().SetUserEmail(mock.Anything, "u1", "alice@example.com").Return(&models.User{ID: "u1", Name: "alice", Email: "alice@example.com"}, nil)
+	user, err := client.SetUserEmail(ctx, &prservicev1.SetUserEmailRequest{Id: "u1", Email: "alice@example.com"})
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", user.GetEmail())
+
+	env.user.EXPECT().SetUserEmail(mock.Anything, "u1", "not-an-email").Return(nil, utils.ErrInvalidEmail)
+	_, err = client.SetUserEmail(ctx, &prservicev1.SetUserEmailRequest{Id: "u1", Email: "not-an-email"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	env.user.EXPECT().SetUserEmail(mock.Anything, "ghost", "").Return(nil, utils.ErrUserNotFound)
+	_, err = client.SetUserEmail(ctx, &prservicev1.SetUserEmailRequest{Id: "ghost"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.SetUserEmail(ctx, &prservicev1.SetUserEmailRequest{Email: "alice@example.com"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_SyncTeamReleasesRemovedMembers(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	members := []models.RosterMember{{UserID: "u1", Username: "alice", IsActive: true}}
+
+	env.team.EXPECT().SyncTeam(mock.Anything, "core", members, false, mock.Anything).Return(&models.TeamSync{
+		Diff:     &models.RosterDiff{RemoveMemberships: []models.Membership{{TeamName: "core", UserID: "u2"}}},
+		Releases: []models.ReviewRelease{{PRID: "pr-1", ReviewerID: "u2", Outcome: models.ReleaseOutcomeReassigned, ReplacedBy: "u1"}},
+	}, nil)
+
+	resp, err := prservicev1.NewTeamServiceClient(env.conn).SyncTeam(ctx, &prservicev1.SyncTeamRequest{
+		TeamName: "core",
+		Members:  []*prservicev1.RosterMember{{UserId: "u1", Username: "alice", IsActive: true}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetDiff().GetRemoveMemberships(), 1)
+	require.Len(t, resp.GetReleases(), 1)
+	require.Equal(t, "u1", resp.GetReleases()[0].GetReplacedBy())
+}
@@ -0,0 +1,224 @@
+package grpc
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	prservicev1 "avito-test-pr-service/pkg/api/prservice/v1"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// teamService реализует TeamService поверх TeamInputPort.
+type teamService struct {
+	prservicev1.UnimplementedTeamServiceServer
+
+	log         *logger.Logger
+	teamService input.TeamInputPort
+}
+
+func (s *teamService) CreateTeam(ctx context.Context, req *prservicev1.TeamNameRequest) (*prservicev1.Team, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	team, err := s.teamService.CreateTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoTeam(team)
+}
+
+func (s *teamService) CreateTeamWithMembers(ctx context.Context, req *prservicev1.CreateTeamWithMembersRequest) (*prservicev1.CreateTeamWithMembersResponse, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	members := make([]*models.User, 0, len(req.GetMembers()))
+	for _, m := range req.GetMembers() {
+		if err := required("members.id", m.GetId(), "members.name", m.GetName()); err != nil {
+			return nil, err
+		}
+		members = append(members, &models.User{ID: m.GetId(), Name: m.GetName(), IsActive: m.GetIsActive(), Tags: m.GetTags()})
+	}
+	team, users, err := s.teamService.CreateTeamWithMembers(ctx, req.GetTeamName(), members)
+	if err != nil {
+		return nil, err
+	}
+	out, err := toProtoTeam(team)
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.CreateTeamWithMembersResponse{Team: out, Members: toProtoUsers(users)}, nil
+}
+
+func (s *teamService) AddMember(ctx context.Context, req *prservicev1.MemberRequest) (*prservicev1.Empty, error) {
+	teamID, userID, err := parseMember(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.teamService.AddMember(ctx, teamID, userID); err != nil {
+		return nil, err
+	}
+	return &prservicev1.Empty{}, nil
+}
+
+func (s *teamService) RemoveMember(ctx context.Context, req *prservicev1.MemberRequest) (*prservicev1.Empty, error) {
+	teamID, userID, err := parseMember(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.teamService.RemoveMember(ctx, teamID, userID); err != nil {
+		return nil, err
+	}
+	return &prservicev1.Empty{}, nil
+}
+
+func (s *teamService) GetTeam(ctx context.Context, req *prservicev1.TeamIDRequest) (*prservicev1.Team, error) {
+	id, err := parseUUID("team_id", req.GetTeamId())
+	if err != nil {
+		return nil, err
+	}
+	team, err := s.teamService.GetTeam(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoTeam(team)
+}
+
+func (s *teamService) GetTeamByName(ctx context.Context, req *prservicev1.TeamNameRequest) (*prservicev1.Team, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	team, err := s.teamService.GetTeamByName(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoTeam(team)
+}
+
+func (s *teamService) ListTeams(ctx context.Context, _ *prservicev1.Empty) (*prservicev1.TeamList, error) {
+	teams, err := s.teamService.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &prservicev1.TeamList{Teams: make([]*prservicev1.Team, 0, len(teams))}
+	for _, t := range teams {
+		team, err := toProtoTeam(t)
+		if err != nil {
+			return nil, err
+		}
+		resp.Teams = append(resp.Teams, team)
+	}
+	return resp, nil
+}
+
+func (s *teamService) SetCodeOwners(ctx context.Context, req *prservicev1.SetCodeOwnersRequest) (*prservicev1.CodeOwners, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	rules, _, err := s.teamService.SetCodeOwners(ctx, req.GetTeamName(), req.GetContent(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.CodeOwners{TeamName: req.GetTeamName(), Rules: toProtoCodeOwners(rules)}, nil
+}
+
+func (s *teamService) GetCodeOwners(ctx context.Context, req *prservicev1.TeamNameRequest) (*prservicev1.CodeOwners, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	rules, err := s.teamService.GetCodeOwners(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.CodeOwners{TeamName: req.GetTeamName(), Rules: toProtoCodeOwners(rules)}, nil
+}
+
+func (s *teamService) SetReviewerConstraints(ctx context.Context, req *prservicev1.ReviewerConstraints) (*prservicev1.ReviewerConstraints, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	constraints, _, err := s.teamService.SetReviewerConstraints(ctx, req.GetTeamName(), fromProtoConstraints(req.GetConstraints()), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.ReviewerConstraints{TeamName: req.GetTeamName(), Constraints: toProtoConstraints(constraints)}, nil
+}
+
+func (s *teamService) GetReviewerConstraints(ctx context.Context, req *prservicev1.TeamNameRequest) (*prservicev1.ReviewerConstraints, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	constraints, err := s.teamService.GetReviewerConstraints(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.ReviewerConstraints{TeamName: req.GetTeamName(), Constraints: toProtoConstraints(constraints)}, nil
+}
+
+func (s *teamService) SetSelectionStrategy(ctx context.Context, req *prservicev1.SetSelectionStrategyRequest) (*prservicev1.Team, error) {
+	if err := required("team_name", req.GetTeamName(), "strategy", req.GetStrategy()); err != nil {
+		return nil, err
+	}
+	params, err := fromStruct(req.GetParams())
+	if err != nil {
+		return nil, invalidArgument("invalid params")
+	}
+	team, err := s.teamService.SetSelectionStrategy(ctx, req.GetTeamName(), req.GetStrategy(), params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoTeam(team)
+}
+
+func (s *teamService) ExportRoster(ctx context.Context, _ *prservicev1.Empty) (*prservicev1.Roster, error) {
+	roster, err := s.teamService.ExportRoster(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoRoster(roster)
+}
+
+func (s *teamService) ImportRoster(ctx context.Context, req *prservicev1.ImportRosterRequest) (*prservicev1.RosterDiff, error) {
+	roster, err := fromProtoRoster(req.GetRoster())
+	if err != nil {
+		return nil, invalidArgument("invalid roster settings")
+	}
+	diff, err := s.teamService.ImportRoster(ctx, roster, req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoDiff(diff), nil
+}
+
+// SyncTeam повторяет PUT /team/sync.
+func (s *teamService) SyncTeam(ctx context.Context, req *prservicev1.SyncTeamRequest) (*prservicev1.SyncTeamResponse, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	res, err := s.teamService.SyncTeam(ctx, req.GetTeamName(), fromProtoRosterMembers(req.GetMembers()), req.GetDryRun(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.SyncTeamResponse{Diff: toProtoDiff(res.Diff), Releases: toProtoReleases(res.Releases)}, nil
+}
+
+func parseMember(req *prservicev1.MemberRequest) (uuid.UUID, uuid.UUID, error) {
+	teamID, err := parseUUID("team_id", req.GetTeamId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return teamID, userID, nil
+}
+
+func parseUUID(field, raw string) (uuid.UUID, error) {
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, invalidArgument(field + " must be a UUID")
+	}
+	return id, nil
+}
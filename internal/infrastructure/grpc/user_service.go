@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	input "avito-test-pr-service/internal/domain/ports/input"
+	prservicev1 "avito-test-pr-service/pkg/api/prservice/v1"
+	"context"
+)
+
+// userService реализует UserService поверх UserInputPort.
+type userService struct {
+	prservicev1.UnimplementedUserServiceServer
+
+	userService input.UserInputPort
+}
+
+func (s *userService) CreateUser(ctx context.Context, req *prservicev1.CreateUserRequest) (*prservicev1.User, error) {
+	if err := required("id", req.GetId(), "name", req.GetName()); err != nil {
+		return nil, err
+	}
+	user, err := s.userService.CreateUser(ctx, req.GetId(), req.GetName(), req.GetIsActive())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoUser(user), nil
+}
+
+func (s *userService) UpdateUserActive(ctx context.Context, req *prservicev1.UpdateUserActiveRequest) (*prservicev1.Empty, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	if err := s.userService.UpdateUserActive(ctx, req.GetId(), req.GetIsActive()); err != nil {
+		return nil, err
+	}
+	return &prservicev1.Empty{}, nil
+}
+
+func (s *userService) UpdateUserName(ctx context.Context, req *prservicev1.UpdateUserNameRequest) (*prservicev1.Empty, error) {
+	if err := required("id", req.GetId(), "name", req.GetName()); err != nil {
+		return nil, err
+	}
+	if err := s.userService.UpdateUserName(ctx, req.GetId(), req.GetName()); err != nil {
+		return nil, err
+	}
+	return &prservicev1.Empty{}, nil
+}
+
+func (s *userService) GetUser(ctx context.Context, req *prservicev1.UserIDRequest) (*prservicev1.User, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	user, err := s.userService.GetUser(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoUser(user), nil
+}
+
+func (s *userService) ListUsers(ctx context.Context, _ *prservicev1.Empty) (*prservicev1.UserList, error) {
+	users, err := s.userService.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.UserList{Users: toProtoUsers(users)}, nil
+}
+
+func (s *userService) GetUserTeamName(ctx context.Context, req *prservicev1.UserIDRequest) (*prservicev1.TeamNameResponse, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	name, err := s.userService.GetUserTeamName(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.TeamNameResponse{TeamName: name}, nil
+}
+
+func (s *userService) ListMembersByTeamID(ctx context.Context, req *prservicev1.TeamIDRequest) (*prservicev1.UserList, error) {
+	if _, err := parseUUID("team_id", req.GetTeamId()); err != nil {
+		return nil, err
+	}
+	users, err := s.userService.ListMembersByTeamID(ctx, req.GetTeamId())
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.UserList{Users: toProtoUsers(users)}, nil
+}
+
+func (s *userService) ListUsersByIDs(ctx context.Context, req *prservicev1.ListUsersByIDsRequest) (*prservicev1.UserList, error) {
+	users, err := s.userService.ListUsersByIDs(ctx, req.GetIds())
+	if err != nil {
+		return nil, err
+	}
+	return &prservicev1.UserList{Users: toProtoUsers(users)}, nil
+}
+
+func (s *userService) SetUserTags(ctx context.Context, req *prservicev1.SetUserTagsRequest) (*prservicev1.User, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	user, err := s.userService.SetUserTags(ctx, req.GetId(), req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoUser(user), nil
+}
+
+func (s *userService) SetUserEmail(ctx context.Context, req *prservicev1.SetUserEmailRequest) (*prservicev1.User, error) {
+	if err := required("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	user, err := s.userService.SetUserEmail(ctx, req.GetId(), req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoUser(user), nil
+}
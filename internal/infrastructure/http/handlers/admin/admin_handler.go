@@ -0,0 +1,15 @@
+package admin
+
+import (
+	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/logger"
+)
+
+type AdminHandler struct {
+	teamService input.TeamInputPort
+	log         *logger.Logger
+}
+
+func NewAdminHandler(teamSvc input.TeamInputPort, log *logger.Logger) *AdminHandler {
+	return &AdminHandler{teamService: teamSvc, log: log}
+}
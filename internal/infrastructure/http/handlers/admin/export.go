@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"bytes"
+	"log/slog"
+	"net/http"
+)
+
+func (h *AdminHandler) Export(w http.ResponseWriter, r *http.Request) {
+	format, err := parseFormat(r.URL.Query().Get("format"), "")
+	if err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("Export request", slog.String("format", string(format)))
+
+	roster, err := h.teamService.ExportRoster(r.Context())
+	if err != nil {
+		h.log.Error("Export service failed", slog.Any("err", err))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+		return
+	}
+	var buf bytes.Buffer
+	if err := encodeRoster(&buf, format, roster); err != nil {
+		h.log.Error("Export encode failed", slog.Any("err", err), slog.String("format", string(format)))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+		return
+	}
+	w.Header().Set("Content-Type", contentTypes[format])
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type ImportResponse struct {
+	DryRun bool               `json:"dry_run"`
+	Diff   *models.RosterDiff `json:"diff"`
+}
+
+func (h *AdminHandler) Import(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	format, err := parseFormat(query.Get("format"), r.Header.Get("Content-Type"))
+	if err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+	dryRun := false
+	if raw := query.Get("dry_run"); raw != "" {
+		if dryRun, err = strconv.ParseBool(raw); err != nil {
+			_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), "dry_run must be true or false")
+			return
+		}
+	}
+	roster, err := decodeRoster(r.Body, format)
+	if err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("Import request", slog.String("format", string(format)), slog.Bool("dry_run", dryRun), slog.Int("teams", len(roster.Teams)))
+
+	diff, err := h.teamService.ImportRoster(r.Context(), roster, dryRun)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrInvalidArgument), errors.Is(err, utils.ErrInvalidCodeOwners), errors.Is(err, utils.ErrInvalidConstraint),
+			errors.Is(err, utils.ErrUnknownStrategy), errors.Is(err, utils.ErrInvalidStrategyParams):
+			_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		case errors.Is(err, utils.ErrUserNotFound):
+			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+		default:
+			h.log.Error("Import service failed", slog.Any("err", err))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+		}
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, ImportResponse{DryRun: dryRun, Diff: diff})
+}
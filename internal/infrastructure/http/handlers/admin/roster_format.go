@@ -0,0 +1,204 @@
+package admin
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type rosterFormat string
+
+const (
+	formatJSON rosterFormat = "json"
+	formatYAML rosterFormat = "yaml"
+	formatCSV  rosterFormat = "csv"
+)
+
+var contentTypes = map[rosterFormat]string{
+	formatJSON: "application/json",
+	formatYAML: "application/yaml",
+	formatCSV:  "text/csv",
+}
+
+var csvHeader = []string{"team_name", "user_id", "username", "is_active"}
+
+// parseFormat определяет формат по параметру format, а без него — по Content-Type; по умолчанию JSON.
+func parseFormat(format, contentType string) (rosterFormat, error) {
+	if format == "" {
+		mediaType, _, _ := mime.ParseMediaType(contentType)
+		switch mediaType {
+		case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+			return formatYAML, nil
+		case "text/csv":
+			return formatCSV, nil
+		default:
+			return formatJSON, nil
+		}
+	}
+	switch f := rosterFormat(strings.ToLower(format)); f {
+	case formatJSON, formatYAML, formatCSV:
+		return f, nil
+	}
+	return "", fmt.Errorf("%w: format must be one of json, yaml, csv", utils.ErrInvalidArgument)
+}
+
+func encodeRoster(w io.Writer, format rosterFormat, roster *models.Roster) error {
+	switch format {
+	case formatYAML:
+		return encodeRosterYAML(w, roster)
+	case formatCSV:
+		return encodeRosterCSV(w, roster)
+	default:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(roster)
+	}
+}
+
+// decodeRoster разбирает тело импорта; ошибки формата оборачиваются в ErrInvalidArgument.
+func decodeRoster(r io.Reader, format rosterFormat) (*models.Roster, error) {
+	var (
+		roster *models.Roster
+		err    error
+	)
+	switch format {
+	case formatYAML:
+		roster, err = decodeRosterYAML(r)
+	case formatCSV:
+		roster, err = decodeRosterCSV(r)
+	default:
+		roster = &models.Roster{}
+		if derr := json.NewDecoder(r).Decode(roster); derr != nil {
+			err = utils.ErrInvalidJSON
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", utils.ErrInvalidArgument, err.Error())
+	}
+	return roster, nil
+}
+
+// YAML строится из JSON-представления, чтобы имена полей и SelectionParams совпадали с JSON.
+func encodeRosterYAML(w io.Writer, roster *models.Roster) error {
+	raw, err := json.Marshal(roster)
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+	resetStyle(&doc)
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// resetStyle убирает flow- и кавычечный стиль, унаследованный от JSON, оставляя выбор кодировщику.
+func resetStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		resetStyle(c)
+	}
+}
+
+func decodeRosterYAML(r io.Reader) (*models.Roster, error) {
+	var doc any
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return &models.Roster{}, nil
+		}
+		return nil, fmt.Errorf("invalid yaml: %w", err)
+	}
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid yaml: %w", err)
+	}
+	roster := &models.Roster{}
+	if err := json.NewDecoder(bytes.NewReader(raw)).Decode(roster); err != nil {
+		return nil, fmt.Errorf("invalid yaml: %w", err)
+	}
+	return roster, nil
+}
+
+// CSV содержит только состав: строка на участника, строка с пустым user_id объявляет команду без участников.
+// Настройки команд в CSV не передаются, поэтому импорт из CSV их не меняет.
+func encodeRosterCSV(w io.Writer, roster *models.Roster) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, t := range roster.Teams {
+		if len(t.Members) == 0 {
+			if err := cw.Write([]string{t.Name, "", "", ""}); err != nil {
+				return err
+			}
+		}
+		for _, m := range t.Members {
+			if err := cw.Write([]string{t.Name, m.UserID, m.Username, strconv.FormatBool(m.IsActive)}); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func decodeRosterCSV(r io.Reader) (*models.Roster, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(csvHeader)
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return &models.Roster{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv: %w", err)
+	}
+	for i, name := range csvHeader {
+		if strings.TrimSpace(header[i]) != name {
+			return nil, fmt.Errorf("invalid csv: header must be %s", strings.Join(csvHeader, ","))
+		}
+	}
+
+	roster := &models.Roster{}
+	index := make(map[string]int)
+	for line := 2; ; line++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+		name := strings.TrimSpace(rec[0])
+		i, ok := index[name]
+		if !ok {
+			i = len(roster.Teams)
+			index[name] = i
+			roster.Teams = append(roster.Teams, models.RosterTeam{Name: name, Members: []models.RosterMember{}})
+		}
+		if strings.TrimSpace(rec[1]) == "" {
+			continue
+		}
+		active, err := strconv.ParseBool(strings.TrimSpace(rec[3]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: line %d: is_active must be true or false", line)
+		}
+		roster.Teams[i].Members = append(roster.Teams[i].Members, models.RosterMember{UserID: rec[1], Username: rec[2], IsActive: active})
+	}
+	return roster, nil
+}
@@ -0,0 +1,98 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func sampleRoster() *models.Roster {
+	return &models.Roster{Teams: []models.RosterTeam{
+		{
+			Name: "backend",
+			Members: []models.RosterMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "42", Username: "true", IsActive: false},
+			},
+			Settings: &models.TeamSettings{
+				SelectionStrategy:   "weighted",
+				SelectionParams:     json.RawMessage(`{"weights":{"u1":2}}`),
+				CodeOwners:          []models.CodeOwnerRule{{Pattern: "*.go", OwnerIDs: []string{"u1"}}},
+				ReviewerConstraints: []models.ReviewerConstraint{},
+			},
+		},
+		{Name: "empty", Members: []models.RosterMember{}},
+	}}
+}
+
+func TestRosterRoundTrip(t *testing.T) {
+	for _, format := range []rosterFormat{formatJSON, formatYAML} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, encodeRoster(&buf, format, sampleRoster()))
+			got, err := decodeRoster(&buf, format)
+			require.NoError(t, err)
+			require.Len(t, got.Teams, 2)
+			require.Equal(t, sampleRoster().Teams[0].Members, got.Teams[0].Members)
+			require.JSONEq(t, `{"weights":{"u1":2}}`, string(got.Teams[0].Settings.SelectionParams))
+			require.Equal(t, sampleRoster().Teams[0].Settings.CodeOwners, got.Teams[0].Settings.CodeOwners)
+			require.Equal(t, "empty", got.Teams[1].Name)
+			require.Nil(t, got.Teams[1].Settings)
+		})
+	}
+}
+
+func TestRosterYAMLIsBlockStyle(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, encodeRoster(&buf, formatYAML, sampleRoster()))
+	out := buf.String()
+	require.Contains(t, out, "- team_name: backend\n")
+	require.Contains(t, out, `user_id: "42"`)
+	require.Contains(t, out, `username: "true"`)
+	require.NotContains(t, out, "{\"")
+}
+
+func TestRosterCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, encodeRoster(&buf, formatCSV, sampleRoster()))
+	require.Equal(t, "team_name,user_id,username,is_active\nbackend,u1,Alice,true\nbackend,42,true,false\nempty,,,\n", buf.String())
+
+	got, err := decodeRoster(&buf, formatCSV)
+	require.NoError(t, err)
+	require.Len(t, got.Teams, 2)
+	require.Equal(t, sampleRoster().Teams[0].Members, got.Teams[0].Members)
+	require.Nil(t, got.Teams[0].Settings)
+	require.Empty(t, got.Teams[1].Members)
+
+	for _, bad := range []string{
+		"team,user\nbackend,u1\n",
+		"team_name,user_id,username,is_active\nbackend,u1,Alice,maybe\n",
+		"team_name,user_id,username,is_active\nbackend,u1\n",
+	} {
+		_, err := decodeRoster(strings.NewReader(bad), formatCSV)
+		require.ErrorIs(t, err, utils.ErrInvalidArgument, bad)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := parseFormat("", "text/csv; charset=utf-8")
+	require.NoError(t, err)
+	require.Equal(t, formatCSV, f)
+	f, err = parseFormat("", "application/x-yaml")
+	require.NoError(t, err)
+	require.Equal(t, formatYAML, f)
+	f, err = parseFormat("YAML", "text/csv")
+	require.NoError(t, err)
+	require.Equal(t, formatYAML, f)
+	f, err = parseFormat("", "")
+	require.NoError(t, err)
+	require.Equal(t, formatJSON, f)
+	_, err = parseFormat("xml", "")
+	require.ErrorIs(t, err, utils.ErrInvalidArgument)
+}
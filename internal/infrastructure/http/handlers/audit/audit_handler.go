@@ -0,0 +1,15 @@
+package audit
+
+import (
+	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/logger"
+)
+
+type AuditHandler struct {
+	auditService input.AuditInputPort
+	log          *logger.Logger
+}
+
+func NewAuditHandler(auditSvc input.AuditInputPort, log *logger.Logger) *AuditHandler {
+	return &AuditHandler{auditService: auditSvc, log: log}
+}
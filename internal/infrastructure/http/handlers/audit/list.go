@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type AuditEntryDTO struct {
+	ID         int64           `json:"id"`
+	Actor      string          `json:"actor"`
+	RequestID  string          `json:"request_id"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type ListAuditResponse struct {
+	Entries []AuditEntryDTO `json:"entries"`
+}
+
+func (h *AuditHandler) ListAudit(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseAuditFilter(r.URL.Query())
+	if err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("ListAudit request", slog.String("entity_type", string(filter.EntityType)), slog.String("entity_id", filter.EntityID), slog.String("actor", filter.Actor))
+
+	entries, err := h.auditService.ListEntries(r.Context(), filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrInvalidArgument):
+			_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+			return
+		default:
+			h.log.Error("ListAudit service failed", slog.Any("err", err))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+			return
+		}
+	}
+
+	resp := ListAuditResponse{Entries: make([]AuditEntryDTO, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, AuditEntryDTO{
+			ID:         e.ID,
+			Actor:      e.Actor,
+			RequestID:  e.RequestID,
+			Action:     string(e.Action),
+			EntityType: string(e.EntityType),
+			EntityID:   e.EntityID,
+			Before:     e.Before,
+			After:      e.After,
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+func parseAuditFilter(q url.Values) (models.AuditFilter, error) {
+	filter := models.AuditFilter{
+		EntityType: models.AuditEntityType(q.Get("entity_type")),
+		EntityID:   q.Get("entity_id"),
+		Actor:      q.Get("actor"),
+	}
+	if v := q.Get("from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, utils.ErrInvalidArgument
+		}
+		filter.From = &t
+	}
+	if v := q.Get("to"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, utils.ErrInvalidArgument
+		}
+		filter.To = &t
+	}
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return filter, utils.ErrInvalidArgument
+		}
+		filter.Limit = n
+	}
+	if v := q.Get("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return filter, utils.ErrInvalidArgument
+		}
+		filter.Offset = n
+	}
+	return filter, nil
+}
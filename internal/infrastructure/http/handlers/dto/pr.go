@@ -6,23 +6,50 @@ import (
 )
 
 type PRDTO struct {
-	PullRequestID     string     `json:"pull_request_id"`
-	PullRequestName   string     `json:"pull_request_name"`
-	AuthorID          string     `json:"author_id"`
-	Status            string     `json:"status"`
-	AssignedReviewers []string   `json:"assigned_reviewers"`
-	CreatedAt         time.Time  `json:"createdAt,omitempty"`
-	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+	PullRequestID     string        `json:"pull_request_id"`
+	PullRequestName   string        `json:"pull_request_name"`
+	AuthorID          string        `json:"author_id"`
+	Status            string        `json:"status"`
+	AssignedReviewers []string      `json:"assigned_reviewers"`
+	CreatedAt         time.Time     `json:"createdAt,omitempty"`
+	MergedAt          *time.Time    `json:"mergedAt,omitempty"`
+	ChangedFiles      []string      `json:"changed_files,omitempty"`
+	Labels            []string      `json:"labels,omitempty"`
+	Reviewers         []ReviewerDTO `json:"reviewers,omitempty"`
 }
 
+type ReviewerDTO struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+// ToPRDTO переводит PR в тело ответа; assigned_reviewers всегда массив, даже если ревьюверов нет.
 func ToPRDTO(pr *models.PullRequest) PRDTO {
 	return PRDTO{
 		PullRequestID:     pr.ID,
 		PullRequestName:   pr.Title,
 		AuthorID:          pr.AuthorID,
 		Status:            string(pr.Status),
-		AssignedReviewers: append([]string(nil), pr.ReviewerIDs...),
+		AssignedReviewers: append([]string{}, pr.ReviewerIDs...),
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
+		ChangedFiles:      pr.ChangedFiles,
+		Labels:            pr.Labels,
+	}
+}
+
+// ToPRDTOWithReviewers дополняет PRDTO именами и флагами активности ревьюверов.
+func ToPRDTOWithReviewers(pr *models.PullRequest, usersByID map[string]*models.User) PRDTO {
+	d := ToPRDTO(pr)
+	d.Reviewers = make([]ReviewerDTO, 0, len(pr.ReviewerIDs))
+	for _, id := range pr.ReviewerIDs {
+		rv := ReviewerDTO{UserID: id}
+		if u, ok := usersByID[id]; ok {
+			rv.Username = u.Name
+			rv.IsActive = u.IsActive
+		}
+		d.Reviewers = append(d.Reviewers, rv)
 	}
+	return d
 }
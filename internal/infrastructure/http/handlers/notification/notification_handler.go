@@ -0,0 +1,32 @@
+package notification
+
+import (
+	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/utils"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+type NotificationHandler struct {
+	notifyService input.NotificationInputPort
+	log           *logger.Logger
+}
+
+func NewNotificationHandler(notifySvc input.NotificationInputPort, log *logger.Logger) *NotificationHandler {
+	return &NotificationHandler{notifyService: notifySvc, log: log}
+}
+
+func writeNotificationError(w http.ResponseWriter, h *NotificationHandler, op string, userID string, err error) {
+	switch {
+	case errors.Is(err, utils.ErrUserNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+	case errors.Is(err, utils.ErrInvalidArgument) || errors.Is(err, utils.ErrInvalidChatHandle) ||
+		errors.Is(err, utils.ErrInvalidDelivery) || errors.Is(err, utils.ErrInvalidNotificationKind):
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+	default:
+		h.log.Error(op+" failed", slog.String("user_id", userID), slog.Any("err", err))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+	}
+}
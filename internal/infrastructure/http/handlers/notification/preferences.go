@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+type SetPreferencesRequest struct {
+	UserID        string   `json:"user_id" validate:"required"`
+	ChatHandle    string   `json:"chat_handle"`
+	Delivery      string   `json:"delivery"`
+	EmailDelivery string   `json:"email_delivery"`
+	Kinds         []string `json:"kinds"`
+}
+
+type PreferencesResponse struct {
+	UserID        string   `json:"user_id"`
+	ChatHandle    string   `json:"chat_handle"`
+	Delivery      string   `json:"delivery"`
+	EmailDelivery string   `json:"email_delivery"`
+	Kinds         []string `json:"kinds"`
+}
+
+// SetPreferences заменяет настройки уведомлений пользователя; пропущенные kinds — все виды.
+func (h *NotificationHandler) SetPreferences(w http.ResponseWriter, r *http.Request) {
+	var req SetPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidJSON.Error())
+		return
+	}
+	if err := utils.Validate(req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("SetNotificationPreferences request", slog.String("user_id", req.UserID), slog.String("delivery", req.Delivery))
+
+	prefs := &models.NotificationPreferences{
+		UserID:        req.UserID,
+		ChatHandle:    req.ChatHandle,
+		Delivery:      models.NotificationDelivery(req.Delivery),
+		EmailDelivery: models.NotificationDelivery(req.EmailDelivery),
+	}
+	for _, k := range req.Kinds {
+		prefs.Kinds = append(prefs.Kinds, models.NotificationKind(k))
+	}
+	res, err := h.notifyService.SetPreferences(r.Context(), prefs)
+	if err != nil {
+		writeNotificationError(w, h, "SetNotificationPreferences", req.UserID, err)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, toPreferencesResponse(res))
+}
+
+func (h *NotificationHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	userID := r.URL.Query().Get("user_id")
+	if userID == "" {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidUserID.Error())
+		return
+	}
+
+	h.log.Info("GetNotificationPreferences request", slog.String("user_id", userID))
+
+	res, err := h.notifyService.GetPreferences(r.Context(), userID)
+	if err != nil {
+		writeNotificationError(w, h, "GetNotificationPreferences", userID, err)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, toPreferencesResponse(res))
+}
+
+func toPreferencesResponse(p *models.NotificationPreferences) PreferencesResponse {
+	resp := PreferencesResponse{
+		UserID:        p.UserID,
+		ChatHandle:    p.ChatHandle,
+		Delivery:      string(p.Delivery),
+		EmailDelivery: string(p.EmailDelivery),
+		Kinds:         make([]string, 0, len(p.Kinds)),
+	}
+	for _, k := range p.Kinds {
+		resp.Kinds = append(resp.Kinds, string(k))
+	}
+	return resp
+}
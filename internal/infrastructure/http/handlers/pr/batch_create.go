@@ -0,0 +1,114 @@
+package pr
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/http/handlers/dto"
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+type BatchCreateItem struct {
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	ChangedFiles    []string `json:"changed_files,omitempty"`
+	Labels          []string `json:"labels,omitempty"`
+	Reviewers       []string `json:"reviewers,omitempty"`
+}
+
+type BatchCreateRequest struct {
+	PullRequests []BatchCreateItem `json:"pull_requests" validate:"required,min=1"`
+}
+
+type BatchCreateResult struct {
+	PullRequestID string              `json:"pull_request_id"`
+	Status        string              `json:"status"`
+	PR            *dto.PRDTO          `json:"pr,omitempty"`
+	Error         *utils.ErrorDetails `json:"error,omitempty"`
+}
+
+type BatchCreateResponse struct {
+	Results []BatchCreateResult `json:"results"`
+	Created int                 `json:"created"`
+	Exists  int                 `json:"exists"`
+	Failed  int                 `json:"failed"`
+}
+
+func (h *PRHandler) BatchCreate(w http.ResponseWriter, r *http.Request) {
+	var req BatchCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidJSON.Error())
+		return
+	}
+	if err := utils.Validate(req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+	items := make([]*models.BatchPRItem, 0, len(req.PullRequests))
+	for _, it := range req.PullRequests {
+		// отсутствующее поле reviewers декодируется в nil — автоматический выбор; явный [] — PR без ревьюверов
+		items = append(items, &models.BatchPRItem{
+			ID:           it.PullRequestID,
+			Title:        it.PullRequestName,
+			AuthorID:     it.AuthorID,
+			ChangedFiles: it.ChangedFiles,
+			Labels:       it.Labels,
+			ReviewerIDs:  it.Reviewers,
+		})
+	}
+
+	h.log.Info("BatchCreate request", slog.Int("count", len(items)))
+
+	results, err := h.prService.BatchCreatePRs(r.Context(), items)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrBatchTooLarge) || errors.Is(err, utils.ErrInvalidArgument):
+			_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+			return
+		default:
+			h.log.Error("BatchCreate failed", slog.Any("err", err), slog.Int("count", len(items)))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+			return
+		}
+	}
+
+	resp := BatchCreateResponse{Results: make([]BatchCreateResult, 0, len(results))}
+	for _, res := range results {
+		item := BatchCreateResult{PullRequestID: res.PullRequestID, Status: string(res.Status)}
+		switch res.Status {
+		case models.BatchStatusCreated:
+			resp.Created++
+			d := dto.ToPRDTO(res.PR)
+			item.PR = &d
+		case models.BatchStatusExists:
+			resp.Exists++
+		default:
+			resp.Failed++
+			item.Error = h.batchItemError(res)
+		}
+		resp.Results = append(resp.Results, item)
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+// batchItemError переводит ошибку элемента пачки в код и сообщение по тем же правилам, что и /pullRequest/create.
+func (h *PRHandler) batchItemError(res *models.BatchPRResult) *utils.ErrorDetails {
+	err := res.Err
+	var status int
+	switch {
+	case errors.Is(err, utils.ErrInvalidArgument) || errors.Is(err, utils.ErrInvalidTag):
+		status = http.StatusBadRequest
+	case errors.Is(err, utils.ErrUserNotFound) || errors.Is(err, utils.ErrTeamNotFound) || errors.Is(err, utils.ErrUserNoTeam):
+		status = http.StatusNotFound
+	case errors.Is(err, utils.ErrConstraintsUnsatisfied) || errors.Is(err, utils.ErrReviewerNotEligible) ||
+		errors.Is(err, utils.ErrReviewerAlreadyAssigned) || errors.Is(err, utils.ErrTooManyReviewers):
+		status = http.StatusConflict
+	default:
+		h.log.Error("BatchCreate item failed", slog.Any("err", err), slog.String("pr_id", res.PullRequestID))
+		return &utils.ErrorDetails{Code: utils.HTTPCodeConverter(http.StatusInternalServerError), Message: utils.ErrInternal.Error()}
+	}
+	return &utils.ErrorDetails{Code: utils.HTTPCodeConverter(status, err), Message: err.Error()}
+}
@@ -10,9 +10,11 @@ import (
 )
 
 type CreatePRRequest struct {
-	PullRequestID   string `json:"pull_request_id" validate:"required"`
-	PullRequestName string `json:"pull_request_name" validate:"required"`
-	AuthorID        string `json:"author_id" validate:"required"`
+	PullRequestID   string   `json:"pull_request_id" validate:"required"`
+	PullRequestName string   `json:"pull_request_name" validate:"required"`
+	AuthorID        string   `json:"author_id" validate:"required"`
+	ChangedFiles    []string `json:"changed_files,omitempty"`
+	Labels          []string `json:"labels,omitempty"`
 }
 
 type PRResponse struct {
@@ -34,12 +36,15 @@ func (h *PRHandler) CreatePR(w http.ResponseWriter, r *http.Request) {
 
 	h.log.Info("CreatePR request", slog.String("pr_id", prID), slog.String("author_id", authorID))
 
-	pr, err := h.prService.CreatePR(r.Context(), prID, authorID, req.PullRequestName)
+	pr, err := h.prService.CreatePR(r.Context(), prID, authorID, req.PullRequestName, req.ChangedFiles, req.Labels)
 	if err != nil {
 		switch {
-		case errors.Is(err, utils.ErrPRExists):
+		case errors.Is(err, utils.ErrPRExists) || errors.Is(err, utils.ErrConstraintsUnsatisfied):
 			_ = utils.WriteError(w, http.StatusConflict, utils.HTTPCodeConverter(http.StatusConflict, err), err.Error())
 			return
+		case errors.Is(err, utils.ErrInvalidTag):
+			_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+			return
 		case errors.Is(err, utils.ErrUserNotFound) || errors.Is(err, utils.ErrTeamNotFound):
 			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
 			return
@@ -0,0 +1,41 @@
+package pr
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+type ExplainResponse struct {
+	PullRequestID string                         `json:"pull_request_id"`
+	Selections    []*models.SelectionExplanation `json:"selections"`
+}
+
+func (h *PRHandler) Explain(w http.ResponseWriter, r *http.Request) {
+	prID := r.URL.Query().Get("pull_request_id")
+	if prID == "" {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrPRIDRequired.Error())
+		return
+	}
+
+	h.log.Info("Explain request", slog.String("pr_id", prID))
+
+	selections, err := h.prService.ExplainSelection(r.Context(), prID)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrPRNotFound):
+			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+			return
+		default:
+			h.log.Error("Explain failed", slog.Any("err", err), slog.String("pr_id", prID))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+			return
+		}
+	}
+	if selections == nil {
+		selections = []*models.SelectionExplanation{}
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, ExplainResponse{PullRequestID: prID, Selections: selections})
+}
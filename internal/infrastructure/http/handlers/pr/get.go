@@ -0,0 +1,72 @@
+package pr
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/http/handlers/dto"
+	"avito-test-pr-service/internal/utils"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+type GetPRResponse struct {
+	PR dto.PRDTO `json:"pr"`
+}
+
+func (h *PRHandler) GetPR(w http.ResponseWriter, r *http.Request) {
+	prID := r.URL.Query().Get("pull_request_id")
+	if prID == "" {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrPRIDRequired.Error())
+		return
+	}
+
+	h.log.Info("GetPR request", slog.String("pr_id", prID))
+
+	pr, err := h.prService.GetPR(r.Context(), prID)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrPRNotFound):
+			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+			return
+		default:
+			h.log.Error("GetPR failed", slog.Any("err", err), slog.String("pr_id", prID))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+			return
+		}
+	}
+
+	usersByID, err := h.loadReviewers(r.Context(), []*models.PullRequest{pr})
+	if err != nil {
+		h.log.Error("GetPR load reviewers failed", slog.Any("err", err), slog.String("pr_id", prID))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+		return
+	}
+
+	w.Header().Set("ETag", utils.ETag(pr.Version))
+	_ = utils.WriteJSON(w, http.StatusOK, GetPRResponse{PR: dto.ToPRDTOWithReviewers(pr, usersByID)})
+}
+
+// loadReviewers одним запросом загружает пользователей, назначенных ревьюверами в переданных PR.
+func (h *PRHandler) loadReviewers(ctx context.Context, prs []*models.PullRequest) (map[string]*models.User, error) {
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, pr := range prs {
+		for _, id := range pr.ReviewerIDs {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	users, err := h.userService.ListUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*models.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+	return byID, nil
+}
@@ -0,0 +1,57 @@
+package pr
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type HistoryEntry struct {
+	ReviewerID string    `json:"reviewer_id"`
+	Event      string    `json:"event"`
+	Reason     string    `json:"reason"`
+	Actor      string    `json:"actor,omitempty"`
+	At         time.Time `json:"at"`
+}
+
+type HistoryResponse struct {
+	PullRequestID string         `json:"pull_request_id"`
+	History       []HistoryEntry `json:"history"`
+}
+
+func (h *PRHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
+	prID := r.URL.Query().Get("pull_request_id")
+	if prID == "" {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrPRIDRequired.Error())
+		return
+	}
+
+	h.log.Info("GetHistory request", slog.String("pr_id", prID))
+
+	entries, err := h.prService.GetReviewerHistory(r.Context(), prID)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrPRNotFound):
+			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+			return
+		default:
+			h.log.Error("GetHistory failed", slog.Any("err", err), slog.String("pr_id", prID))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+			return
+		}
+	}
+
+	resp := HistoryResponse{PullRequestID: prID, History: make([]HistoryEntry, 0, len(entries))}
+	for _, e := range entries {
+		resp.History = append(resp.History, HistoryEntry{
+			ReviewerID: e.ReviewerID,
+			Event:      string(e.Event),
+			Reason:     string(e.Reason),
+			Actor:      e.Actor,
+			At:         e.CreatedAt,
+		})
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, resp)
+}
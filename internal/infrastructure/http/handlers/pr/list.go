@@ -0,0 +1,88 @@
+package pr
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/http/handlers/dto"
+	"avito-test-pr-service/internal/utils"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type ListPRsResponse struct {
+	PullRequests []dto.PRDTO `json:"pull_requests"`
+	Total        int         `json:"total"`
+	Limit        int         `json:"limit"`
+	Offset       int         `json:"offset"`
+}
+
+func (h *PRHandler) ListPRs(w http.ResponseWriter, r *http.Request) {
+	filter, err := parsePRFilter(r.URL.Query())
+	if err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("ListPRs request", slog.String("author_id", filter.AuthorID), slog.String("team_name", filter.TeamName), slog.String("reviewer_id", filter.ReviewerID))
+
+	page, err := h.prService.ListPRs(r.Context(), filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrInvalidArgument):
+			_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+			return
+		case errors.Is(err, utils.ErrTeamNotFound):
+			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+			return
+		default:
+			h.log.Error("ListPRs failed", slog.Any("err", err))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+			return
+		}
+	}
+
+	usersByID, err := h.loadReviewers(r.Context(), page.PullRequests)
+	if err != nil {
+		h.log.Error("ListPRs load reviewers failed", slog.Any("err", err))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+		return
+	}
+
+	resp := ListPRsResponse{PullRequests: make([]dto.PRDTO, 0, len(page.PullRequests)), Total: page.Total, Limit: page.Limit, Offset: page.Offset}
+	for _, p := range page.PullRequests {
+		resp.PullRequests = append(resp.PullRequests, dto.ToPRDTOWithReviewers(p, usersByID))
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+func parsePRFilter(q url.Values) (models.PRFilter, error) {
+	filter := models.PRFilter{
+		AuthorID:   q.Get("author_id"),
+		TeamName:   q.Get("team_name"),
+		ReviewerID: q.Get("reviewer_id"),
+	}
+	if v := q.Get("status"); v != "" {
+		status := models.PRStatus(v)
+		if !status.IsValid() {
+			return filter, utils.ErrInvalidArgument
+		}
+		filter.Status = &status
+	}
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return filter, utils.ErrInvalidArgument
+		}
+		filter.Limit = n
+	}
+	if v := q.Get("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return filter, utils.ErrInvalidArgument
+		}
+		filter.Offset = n
+	}
+	return filter, nil
+}
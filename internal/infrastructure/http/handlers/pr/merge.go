@@ -31,17 +31,24 @@ func (h *PRHandler) MergePR(w http.ResponseWriter, r *http.Request) {
 
 	h.log.Info("MergePR request", slog.String("pr_id", prID))
 
-	pr, err := h.prService.MergePR(r.Context(), prID)
+	pr, err := h.prService.MergePR(r.Context(), prID, utils.ParseIfMatch(r.Header.Get("If-Match")))
 	if err != nil {
 		switch {
 		case errors.Is(err, utils.ErrPRNotFound):
 			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
 			return
+		case errors.Is(err, utils.ErrPRClosed):
+			_ = utils.WriteError(w, http.StatusConflict, utils.HTTPCodeConverter(http.StatusConflict, err), err.Error())
+			return
+		case errors.Is(err, utils.ErrPreconditionFailed):
+			_ = utils.WriteError(w, http.StatusPreconditionFailed, utils.HTTPCodeConverter(http.StatusPreconditionFailed), err.Error())
+			return
 		default:
 			h.log.Error("MergePR failed", slog.Any("err", err), slog.String("pr_id", prID))
 			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
 			return
 		}
 	}
+	w.Header().Set("ETag", utils.ETag(pr.Version))
 	_ = utils.WriteJSON(w, http.StatusOK, MergePRResponse{PR: dto.ToPRDTO(pr)})
 }
@@ -6,10 +6,11 @@ import (
 )
 
 type PRHandler struct {
-	prService input.PRInputPort
-	log       ports.Logger
+	prService   input.PRInputPort
+	userService input.UserInputPort
+	log         ports.Logger
 }
 
-func NewPRHandler(s input.PRInputPort, log ports.Logger) *PRHandler {
-	return &PRHandler{prService: s, log: log}
+func NewPRHandler(s input.PRInputPort, userSvc input.UserInputPort, log ports.Logger) *PRHandler {
+	return &PRHandler{prService: s, userService: userSvc, log: log}
 }
@@ -1,6 +1,7 @@
 package pr
 
 import (
+	"avito-test-pr-service/internal/domain/models"
 	"avito-test-pr-service/internal/infrastructure/http/handlers/dto"
 	"avito-test-pr-service/internal/utils"
 	"encoding/json"
@@ -12,6 +13,8 @@ import (
 type ReassignPRRequest struct {
 	PullRequestID string `json:"pull_request_id" validate:"required"`
 	OldUserID     string `json:"old_user_id" validate:"required"`
+	NewUserID     string `json:"new_user_id,omitempty"`
+	Reason        string `json:"reason,omitempty"`
 }
 
 type ReassignPRResponse struct {
@@ -32,17 +35,24 @@ func (h *PRHandler) Reassign(w http.ResponseWriter, r *http.Request) {
 	prID := req.PullRequestID
 	oldID := req.OldUserID
 
-	h.log.Info("Reassign request", slog.String("pr_id", prID), slog.String("old_user_id", oldID))
+	h.log.Info("Reassign request", slog.String("pr_id", prID), slog.String("old_user_id", oldID), slog.String("new_user_id", req.NewUserID), slog.String("reason", req.Reason))
 
-	pr, err := h.prService.ReassignReviewer(r.Context(), prID, oldID)
+	pr, err := h.prService.ReassignReviewer(r.Context(), prID, oldID, req.NewUserID, models.AssignmentReason(req.Reason), utils.ParseIfMatch(r.Header.Get("If-Match")))
 	if err != nil {
 		switch {
+		case errors.Is(err, utils.ErrInvalidReason):
+			_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+			return
 		case errors.Is(err, utils.ErrPRNotFound) || errors.Is(err, utils.ErrUserNotFound):
 			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
 			return
-		case errors.Is(err, utils.ErrAlreadyMerged) || errors.Is(err, utils.ErrReviewerNotAssigned) || errors.Is(err, utils.ErrNoReplacementCandidates):
+		case errors.Is(err, utils.ErrAlreadyMerged) || errors.Is(err, utils.ErrPRClosed) || errors.Is(err, utils.ErrReviewerNotAssigned) || errors.Is(err, utils.ErrNoReplacementCandidates) ||
+			errors.Is(err, utils.ErrReviewerNotEligible) || errors.Is(err, utils.ErrReviewerAlreadyAssigned) || errors.Is(err, utils.ErrConstraintsUnsatisfied):
 			_ = utils.WriteError(w, http.StatusConflict, utils.HTTPCodeConverter(http.StatusConflict, err), err.Error())
 			return
+		case errors.Is(err, utils.ErrPreconditionFailed):
+			_ = utils.WriteError(w, http.StatusPreconditionFailed, utils.HTTPCodeConverter(http.StatusPreconditionFailed), err.Error())
+			return
 		default:
 			h.log.Error("Reassign failed", slog.Any("err", err), slog.String("pr_id", prID))
 			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
@@ -51,9 +61,10 @@ func (h *PRHandler) Reassign(w http.ResponseWriter, r *http.Request) {
 	}
 
 	body := dto.ToPRDTO(pr)
-	replacedBy := ""
-	if len(body.AssignedReviewers) > 0 {
+	replacedBy := req.NewUserID
+	if replacedBy == "" && len(body.AssignedReviewers) > 0 {
 		replacedBy = body.AssignedReviewers[len(body.AssignedReviewers)-1]
 	}
+	w.Header().Set("ETag", utils.ETag(pr.Version))
 	_ = utils.WriteJSON(w, http.StatusOK, ReassignPRResponse{PR: body, ReplacedBy: replacedBy})
 }
@@ -0,0 +1,95 @@
+package pr
+
+import (
+	"avito-test-pr-service/internal/infrastructure/http/handlers/dto"
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+type ReviewerChangeRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required"`
+	UserID        string `json:"user_id" validate:"required"`
+}
+
+type ReviewerChangeResponse struct {
+	PR dto.PRDTO `json:"pr"`
+}
+
+func (h *PRHandler) AddReviewer(w http.ResponseWriter, r *http.Request) {
+	req, ok := decodeReviewerChange(w, r)
+	if !ok {
+		return
+	}
+
+	h.log.Info("AddReviewer request", slog.String("pr_id", req.PullRequestID), slog.String("user_id", req.UserID))
+
+	pr, err := h.prService.AddReviewer(r.Context(), req.PullRequestID, req.UserID, utils.ParseIfMatch(r.Header.Get("If-Match")))
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrPRNotFound) || errors.Is(err, utils.ErrUserNotFound):
+			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+			return
+		case errors.Is(err, utils.ErrAlreadyMerged) || errors.Is(err, utils.ErrPRClosed) || errors.Is(err, utils.ErrTooManyReviewers) ||
+			errors.Is(err, utils.ErrReviewerAlreadyAssigned) || errors.Is(err, utils.ErrReviewerNotEligible):
+			_ = utils.WriteError(w, http.StatusConflict, utils.HTTPCodeConverter(http.StatusConflict, err), err.Error())
+			return
+		case errors.Is(err, utils.ErrPreconditionFailed):
+			_ = utils.WriteError(w, http.StatusPreconditionFailed, utils.HTTPCodeConverter(http.StatusPreconditionFailed), err.Error())
+			return
+		default:
+			h.log.Error("AddReviewer failed", slog.Any("err", err), slog.String("pr_id", req.PullRequestID))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+			return
+		}
+	}
+
+	w.Header().Set("ETag", utils.ETag(pr.Version))
+	_ = utils.WriteJSON(w, http.StatusOK, ReviewerChangeResponse{PR: dto.ToPRDTO(pr)})
+}
+
+func (h *PRHandler) RemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	req, ok := decodeReviewerChange(w, r)
+	if !ok {
+		return
+	}
+
+	h.log.Info("RemoveReviewer request", slog.String("pr_id", req.PullRequestID), slog.String("user_id", req.UserID))
+
+	pr, err := h.prService.RemoveReviewer(r.Context(), req.PullRequestID, req.UserID, utils.ParseIfMatch(r.Header.Get("If-Match")))
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrPRNotFound):
+			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+			return
+		case errors.Is(err, utils.ErrAlreadyMerged) || errors.Is(err, utils.ErrPRClosed) || errors.Is(err, utils.ErrReviewerNotAssigned):
+			_ = utils.WriteError(w, http.StatusConflict, utils.HTTPCodeConverter(http.StatusConflict, err), err.Error())
+			return
+		case errors.Is(err, utils.ErrPreconditionFailed):
+			_ = utils.WriteError(w, http.StatusPreconditionFailed, utils.HTTPCodeConverter(http.StatusPreconditionFailed), err.Error())
+			return
+		default:
+			h.log.Error("RemoveReviewer failed", slog.Any("err", err), slog.String("pr_id", req.PullRequestID))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+			return
+		}
+	}
+
+	w.Header().Set("ETag", utils.ETag(pr.Version))
+	_ = utils.WriteJSON(w, http.StatusOK, ReviewerChangeResponse{PR: dto.ToPRDTO(pr)})
+}
+
+func decodeReviewerChange(w http.ResponseWriter, r *http.Request) (ReviewerChangeRequest, bool) {
+	var req ReviewerChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidJSON.Error())
+		return req, false
+	}
+	if err := utils.Validate(req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return req, false
+	}
+	return req, true
+}
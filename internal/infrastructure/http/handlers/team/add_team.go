@@ -61,8 +61,12 @@ func (h *TeamHandler) AddTeam(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	h.writeAddTeamResponse(w, team.Name, users)
+}
+
+func (h *TeamHandler) writeAddTeamResponse(w http.ResponseWriter, teamName string, users []*models.User) {
 	var resp AddTeamResponse
-	resp.Team.TeamName = team.Name
+	resp.Team.TeamName = teamName
 	for _, u := range users {
 		resp.Team.Members = append(resp.Team.Members, AddTeamMember{
 			UserID:   u.ID,
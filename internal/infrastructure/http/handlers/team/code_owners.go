@@ -0,0 +1,73 @@
+package team
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+type SetCodeOwnersRequest struct {
+	TeamName string `json:"team_name" validate:"required"`
+	Content  string `json:"content"`
+}
+
+type CodeOwnersResponse struct {
+	TeamName string                 `json:"team_name"`
+	Rules    []models.CodeOwnerRule `json:"rules"`
+}
+
+func (h *TeamHandler) SetCodeOwners(w http.ResponseWriter, r *http.Request) {
+	var req SetCodeOwnersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidJSON.Error())
+		return
+	}
+	if err := utils.Validate(req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("SetCodeOwners request", slog.String("team_name", req.TeamName))
+
+	rules, version, err := h.teamService.SetCodeOwners(r.Context(), req.TeamName, req.Content, utils.ParseIfMatch(r.Header.Get("If-Match")))
+	if err != nil {
+		h.writeCodeOwnersError(w, err, req.TeamName)
+		return
+	}
+	w.Header().Set("ETag", utils.ETag(version))
+	_ = utils.WriteJSON(w, http.StatusOK, CodeOwnersResponse{TeamName: req.TeamName, Rules: rules})
+}
+
+func (h *TeamHandler) GetCodeOwners(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidArgument.Error())
+		return
+	}
+
+	h.log.Info("GetCodeOwners request", slog.String("team_name", teamName))
+
+	rules, err := h.teamService.GetCodeOwners(r.Context(), teamName)
+	if err != nil {
+		h.writeCodeOwnersError(w, err, teamName)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, CodeOwnersResponse{TeamName: teamName, Rules: rules})
+}
+
+func (h *TeamHandler) writeCodeOwnersError(w http.ResponseWriter, err error, teamName string) {
+	switch {
+	case errors.Is(err, utils.ErrInvalidArgument) || errors.Is(err, utils.ErrInvalidCodeOwners):
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+	case errors.Is(err, utils.ErrTeamNotFound) || errors.Is(err, utils.ErrUserNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+	case errors.Is(err, utils.ErrPreconditionFailed):
+		_ = utils.WriteError(w, http.StatusPreconditionFailed, utils.HTTPCodeConverter(http.StatusPreconditionFailed), err.Error())
+	default:
+		h.log.Error("CodeOwners service failed", slog.Any("err", err), slog.String("team_name", teamName))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+	}
+}
@@ -60,5 +60,6 @@ func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 	}
 
 	resp := GetTeamResponse{TeamName: team.Name, Members: members}
+	w.Header().Set("ETag", utils.ETag(team.Version))
 	_ = utils.WriteJSON(w, http.StatusOK, resp)
 }
@@ -0,0 +1,35 @@
+package team
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"log/slog"
+	"net/http"
+	"sort"
+)
+
+type TeamSummary struct {
+	TeamName          string `json:"team_name"`
+	SelectionStrategy string `json:"selection_strategy"`
+}
+
+type ListTeamsResponse struct {
+	Teams []TeamSummary `json:"teams"`
+}
+
+func (h *TeamHandler) ListTeams(w http.ResponseWriter, r *http.Request) {
+	h.log.Info("ListTeams request")
+
+	teams, err := h.teamService.ListTeams(r.Context())
+	if err != nil {
+		h.log.Error("ListTeams service failed", slog.Any("err", err))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+		return
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+
+	resp := ListTeamsResponse{Teams: make([]TeamSummary, 0, len(teams))}
+	for _, t := range teams {
+		resp.Teams = append(resp.Teams, TeamSummary{TeamName: t.Name, SelectionStrategy: t.SelectionStrategy})
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, resp)
+}
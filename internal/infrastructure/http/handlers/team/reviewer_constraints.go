@@ -0,0 +1,73 @@
+package team
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+type SetReviewerConstraintsRequest struct {
+	TeamName    string                      `json:"team_name" validate:"required"`
+	Constraints []models.ReviewerConstraint `json:"constraints"`
+}
+
+type ReviewerConstraintsResponse struct {
+	TeamName    string                      `json:"team_name"`
+	Constraints []models.ReviewerConstraint `json:"constraints"`
+}
+
+func (h *TeamHandler) SetReviewerConstraints(w http.ResponseWriter, r *http.Request) {
+	var req SetReviewerConstraintsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidJSON.Error())
+		return
+	}
+	if err := utils.Validate(req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("SetReviewerConstraints request", slog.String("team_name", req.TeamName), slog.Int("count", len(req.Constraints)))
+
+	constraints, version, err := h.teamService.SetReviewerConstraints(r.Context(), req.TeamName, req.Constraints, utils.ParseIfMatch(r.Header.Get("If-Match")))
+	if err != nil {
+		h.writeConstraintsError(w, err, req.TeamName)
+		return
+	}
+	w.Header().Set("ETag", utils.ETag(version))
+	_ = utils.WriteJSON(w, http.StatusOK, ReviewerConstraintsResponse{TeamName: req.TeamName, Constraints: constraints})
+}
+
+func (h *TeamHandler) GetReviewerConstraints(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidArgument.Error())
+		return
+	}
+
+	h.log.Info("GetReviewerConstraints request", slog.String("team_name", teamName))
+
+	constraints, err := h.teamService.GetReviewerConstraints(r.Context(), teamName)
+	if err != nil {
+		h.writeConstraintsError(w, err, teamName)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, ReviewerConstraintsResponse{TeamName: teamName, Constraints: constraints})
+}
+
+func (h *TeamHandler) writeConstraintsError(w http.ResponseWriter, err error, teamName string) {
+	switch {
+	case errors.Is(err, utils.ErrInvalidArgument) || errors.Is(err, utils.ErrInvalidConstraint):
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+	case errors.Is(err, utils.ErrTeamNotFound) || errors.Is(err, utils.ErrUserNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+	case errors.Is(err, utils.ErrPreconditionFailed):
+		_ = utils.WriteError(w, http.StatusPreconditionFailed, utils.HTTPCodeConverter(http.StatusPreconditionFailed), err.Error())
+	default:
+		h.log.Error("ReviewerConstraints service failed", slog.Any("err", err), slog.String("team_name", teamName))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+	}
+}
@@ -0,0 +1,84 @@
+package team
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+type SetSelectionStrategyRequest struct {
+	TeamName string          `json:"team_name" validate:"required"`
+	Strategy string          `json:"strategy"`
+	Params   json.RawMessage `json:"params,omitempty"`
+}
+
+type SelectionStrategyResponse struct {
+	TeamName string          `json:"team_name"`
+	Strategy string          `json:"strategy"`
+	Params   json.RawMessage `json:"params"`
+}
+
+func (h *TeamHandler) SetSelectionStrategy(w http.ResponseWriter, r *http.Request) {
+	var req SetSelectionStrategyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidJSON.Error())
+		return
+	}
+	if err := utils.Validate(req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("SetSelectionStrategy request", slog.String("team_name", req.TeamName), slog.String("strategy", req.Strategy))
+
+	team, err := h.teamService.SetSelectionStrategy(r.Context(), req.TeamName, req.Strategy, req.Params, utils.ParseIfMatch(r.Header.Get("If-Match")))
+	if err != nil {
+		h.writeSelectionStrategyError(w, err, req.TeamName)
+		return
+	}
+	w.Header().Set("ETag", utils.ETag(team.Version))
+	_ = utils.WriteJSON(w, http.StatusOK, toSelectionStrategyResponse(team))
+}
+
+func (h *TeamHandler) GetSelectionStrategy(w http.ResponseWriter, r *http.Request) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidArgument.Error())
+		return
+	}
+
+	h.log.Info("GetSelectionStrategy request", slog.String("team_name", teamName))
+
+	team, err := h.teamService.GetTeamByName(r.Context(), teamName)
+	if err != nil {
+		h.writeSelectionStrategyError(w, err, teamName)
+		return
+	}
+	w.Header().Set("ETag", utils.ETag(team.Version))
+	_ = utils.WriteJSON(w, http.StatusOK, toSelectionStrategyResponse(team))
+}
+
+func toSelectionStrategyResponse(team *models.Team) SelectionStrategyResponse {
+	params := team.SelectionParams
+	if len(params) == 0 {
+		params = json.RawMessage(`{}`)
+	}
+	return SelectionStrategyResponse{TeamName: team.Name, Strategy: team.SelectionStrategy, Params: params}
+}
+
+func (h *TeamHandler) writeSelectionStrategyError(w http.ResponseWriter, err error, teamName string) {
+	switch {
+	case errors.Is(err, utils.ErrInvalidArgument) || errors.Is(err, utils.ErrUnknownStrategy) || errors.Is(err, utils.ErrInvalidStrategyParams):
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+	case errors.Is(err, utils.ErrTeamNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+	case errors.Is(err, utils.ErrPreconditionFailed):
+		_ = utils.WriteError(w, http.StatusPreconditionFailed, utils.HTTPCodeConverter(http.StatusPreconditionFailed), err.Error())
+	default:
+		h.log.Error("SelectionStrategy service failed", slog.Any("err", err), slog.String("team_name", teamName))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+	}
+}
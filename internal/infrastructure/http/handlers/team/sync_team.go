@@ -0,0 +1,83 @@
+package team
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type SyncTeamRequest struct {
+	TeamName string          `json:"team_name" validate:"required"`
+	Members  []AddTeamMember `json:"members" validate:"required,dive"`
+}
+
+type ReviewReleaseDTO struct {
+	PullRequestID string `json:"pull_request_id"`
+	UserID        string `json:"user_id"`
+	Outcome       string `json:"outcome"`
+	ReplacedBy    string `json:"replaced_by,omitempty"`
+}
+
+type SyncTeamResponse struct {
+	DryRun   bool               `json:"dry_run"`
+	Team     GetTeamResponse    `json:"team"`
+	Diff     *models.RosterDiff `json:"diff"`
+	Releases []ReviewReleaseDTO `json:"releases"`
+}
+
+// SyncTeam приводит состав команды к переданному списку. Открытые ревью удалённых из команды участников
+// переназначаются (reason team_removal), а если замены нет — снимаются.
+func (h *TeamHandler) SyncTeam(w http.ResponseWriter, r *http.Request) {
+	var req SyncTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidJSON.Error())
+		return
+	}
+	if err := utils.Validate(req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+	dryRun := false
+	if raw := r.URL.Query().Get("dry_run"); raw != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(raw); err != nil {
+			_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), "dry_run must be true or false")
+			return
+		}
+	}
+
+	h.log.Info("SyncTeam request", slog.String("team_name", req.TeamName), slog.Int("members", len(req.Members)), slog.Bool("dry_run", dryRun))
+
+	members := make([]models.RosterMember, 0, len(req.Members))
+	for _, m := range req.Members {
+		members = append(members, models.RosterMember{UserID: m.UserID, Username: m.Username, IsActive: m.IsActive})
+	}
+	res, err := h.teamService.SyncTeam(r.Context(), req.TeamName, members, dryRun, utils.ParseIfMatch(r.Header.Get("If-Match")))
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrInvalidArgument):
+			_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		case errors.Is(err, utils.ErrPreconditionFailed):
+			_ = utils.WriteError(w, http.StatusPreconditionFailed, utils.HTTPCodeConverter(http.StatusPreconditionFailed), err.Error())
+		default:
+			h.log.Error("SyncTeam service failed", slog.String("team_name", req.TeamName), slog.Any("err", err))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+		}
+		return
+	}
+
+	resp := SyncTeamResponse{DryRun: dryRun, Diff: res.Diff, Releases: make([]ReviewReleaseDTO, 0, len(res.Releases))}
+	for _, rel := range res.Releases {
+		resp.Releases = append(resp.Releases, ReviewReleaseDTO{PullRequestID: rel.PRID, UserID: rel.ReviewerID, Outcome: string(rel.Outcome), ReplacedBy: rel.ReplacedBy})
+	}
+
+	resp.Team = GetTeamResponse{TeamName: req.TeamName, Members: make([]GetTeamMember, 0, len(req.Members))}
+	for _, m := range req.Members {
+		resp.Team.Members = append(resp.Team.Members, GetTeamMember{UserID: m.UserID, Username: m.Username, IsActive: m.IsActive})
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,57 @@
+package user
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+type SetEmailRequest struct {
+	UserID string `json:"user_id" validate:"required"`
+	Email  string `json:"email"`
+}
+
+type EmailResponse struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// SetEmail задаёт адрес для уведомлений на почту; пустой email его удаляет.
+func (h *UserHandler) SetEmail(w http.ResponseWriter, r *http.Request) {
+	var req SetEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidJSON.Error())
+		return
+	}
+	if err := utils.Validate(req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("SetEmail request", slog.String("user_id", req.UserID))
+
+	user, err := h.userService.SetUserEmail(r.Context(), req.UserID, req.Email)
+	if err != nil {
+		writeUserError(w, h, "SetEmail", req.UserID, err)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, EmailResponse{UserID: user.ID, Email: user.Email})
+}
+
+func (h *UserHandler) GetEmail(w http.ResponseWriter, r *http.Request) {
+	userID := r.URL.Query().Get("user_id")
+	if userID == "" {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidUserID.Error())
+		return
+	}
+
+	h.log.Info("GetEmail request", slog.String("user_id", userID))
+
+	user, err := h.userService.GetUser(r.Context(), userID)
+	if err != nil {
+		writeUserError(w, h, "GetEmail", userID, err)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, EmailResponse{UserID: user.ID, Email: user.Email})
+}
@@ -0,0 +1,36 @@
+package user
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"log/slog"
+	"net/http"
+	"sort"
+)
+
+type UserDTO struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type ListUsersResponse struct {
+	Users []UserDTO `json:"users"`
+}
+
+func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	h.log.Info("ListUsers request")
+
+	users, err := h.userService.ListUsers(r.Context())
+	if err != nil {
+		h.log.Error("ListUsers service failed", slog.Any("err", err))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+		return
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+
+	resp := ListUsersResponse{Users: make([]UserDTO, 0, len(users))}
+	for _, u := range users {
+		resp.Users = append(resp.Users, UserDTO{UserID: u.ID, Username: u.Name, IsActive: u.IsActive})
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,146 @@
+package user
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	lastEventIDHeader = "Last-Event-ID"
+	feedPageSize      = 500
+	feedHeartbeat     = 15 * time.Second
+	feedRetry         = 3 * time.Second
+)
+
+type ReviewFeedEventDTO struct {
+	EventID         int64  `json:"event_id"`
+	Type            string `json:"type"`
+	UserID          string `json:"user_id"`
+	PullRequestID   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+	Reason          string `json:"reason,omitempty"`
+	Actor           string `json:"actor,omitempty"`
+	At              string `json:"at"`
+}
+
+// ReviewFeed отдаёт ленту ревьювера потоком SSE: сначала сохранённые события после Last-Event-ID,
+// затем новые по мере публикации. id события — его номер в базе, поэтому EventSource после обрыва
+// продолжает с того же места. Если клиент не успевает читать, поток закрывается и клиент переподключается.
+func (h *UserHandler) ReviewFeed(w http.ResponseWriter, r *http.Request) {
+	userID := r.URL.Query().Get("user_id")
+	if userID == "" {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidUserID.Error())
+		return
+	}
+	var lastID int64
+	if raw := r.Header.Get(lastEventIDHeader); raw != "" {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || id < 0 {
+			_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), "Last-Event-ID must be a non-negative integer")
+			return
+		}
+		lastID = id
+	}
+
+	h.log.Info("ReviewFeed request", slog.String("user_id", userID), slog.Int64("last_event_id", lastID))
+
+	// Подписка до чтения базы: событие, закоммиченное между чтением и подпиской, не теряется.
+	live, unsubscribe := h.feedService.Subscribe(userID)
+	defer unsubscribe()
+
+	backlog, err := h.feedService.ListEvents(r.Context(), userID, lastID, feedPageSize)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrUserNotFound):
+			_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+		default:
+			h.log.Error("ReviewFeed backlog failed", slog.String("user_id", userID), slog.Any("err", err))
+			_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+		}
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// Поток живёт дольше WriteTimeout сервера.
+	_ = rc.SetWriteDeadline(time.Time{})
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", feedRetry.Milliseconds()); err != nil {
+		return
+	}
+
+	sent := make(map[int64]struct{})
+	for {
+		for _, e := range backlog {
+			if err := writeFeedEvent(w, e); err != nil {
+				return
+			}
+			sent[e.ID] = struct{}{}
+			lastID = e.ID
+		}
+		if len(backlog) < feedPageSize {
+			break
+		}
+		if backlog, err = h.feedService.ListEvents(r.Context(), userID, lastID, feedPageSize); err != nil {
+			h.log.Error("ReviewFeed backlog failed", slog.String("user_id", userID), slog.Any("err", err))
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(feedHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case e, ok := <-live:
+			if !ok {
+				return
+			}
+			if _, dup := sent[e.ID]; dup {
+				continue
+			}
+			if err := writeFeedEvent(w, e); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeFeedEvent(w http.ResponseWriter, e *models.ReviewFeedEvent) error {
+	data, err := json.Marshal(ReviewFeedEventDTO{
+		EventID:         e.ID,
+		Type:            string(e.Type),
+		UserID:          e.UserID,
+		PullRequestID:   e.PRID,
+		PullRequestName: e.PRTitle,
+		Reason:          string(e.Reason),
+		Actor:           e.Actor,
+		At:              e.CreatedAt.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	return err
+}
@@ -0,0 +1,76 @@
+package user
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+type SetTagsRequest struct {
+	UserID string   `json:"user_id" validate:"required"`
+	Tags   []string `json:"tags"`
+}
+
+type TagsResponse struct {
+	UserID string   `json:"user_id"`
+	Tags   []string `json:"tags"`
+}
+
+func (h *UserHandler) SetTags(w http.ResponseWriter, r *http.Request) {
+	var req SetTagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidJSON.Error())
+		return
+	}
+	if err := utils.Validate(req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("SetTags request", slog.String("user_id", req.UserID), slog.Int("tags", len(req.Tags)))
+
+	user, err := h.userService.SetUserTags(r.Context(), req.UserID, req.Tags)
+	if err != nil {
+		writeUserError(w, h, "SetTags", req.UserID, err)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, toTagsResponse(user.ID, user.Tags))
+}
+
+func (h *UserHandler) GetTags(w http.ResponseWriter, r *http.Request) {
+	userID := r.URL.Query().Get("user_id")
+	if userID == "" {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidUserID.Error())
+		return
+	}
+
+	h.log.Info("GetTags request", slog.String("user_id", userID))
+
+	user, err := h.userService.GetUser(r.Context(), userID)
+	if err != nil {
+		writeUserError(w, h, "GetTags", userID, err)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, toTagsResponse(user.ID, user.Tags))
+}
+
+func toTagsResponse(userID string, tags []string) TagsResponse {
+	if tags == nil {
+		tags = []string{}
+	}
+	return TagsResponse{UserID: userID, Tags: tags}
+}
+
+func writeUserError(w http.ResponseWriter, h *UserHandler, op string, userID string, err error) {
+	switch {
+	case errors.Is(err, utils.ErrUserNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+	case errors.Is(err, utils.ErrInvalidTag) || errors.Is(err, utils.ErrInvalidEmail) || errors.Is(err, utils.ErrInvalidArgument):
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+	default:
+		h.log.Error(op+" failed", slog.String("user_id", userID), slog.Any("err", err))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+	}
+}
@@ -8,9 +8,10 @@ import (
 type UserHandler struct {
 	userService input.UserInputPort
 	prService   input.PRInputPort
+	feedService input.ReviewFeedInputPort
 	log         *logger.Logger
 }
 
-func NewUserHandler(userSvc input.UserInputPort, prSvc input.PRInputPort, log *logger.Logger) *UserHandler {
-	return &UserHandler{userService: userSvc, prService: prSvc, log: log}
+func NewUserHandler(userSvc input.UserInputPort, prSvc input.PRInputPort, feedSvc input.ReviewFeedInputPort, log *logger.Logger) *UserHandler {
+	return &UserHandler{userService: userSvc, prService: prSvc, feedService: feedSvc, log: log}
 }
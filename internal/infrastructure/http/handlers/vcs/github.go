@@ -0,0 +1,61 @@
+package vcs
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/vcs/github"
+	"avito-test-pr-service/internal/utils"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+type WebhookResponse struct {
+	Outcome       string `json:"outcome"`
+	PullRequestID string `json:"pull_request_id,omitempty"`
+	Status        string `json:"status,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// GitHubWebhook принимает события GitHub. Подпись X-Hub-Signature-256 проверяется до разбора тела;
+// ping и события, кроме pull_request, подтверждаются с outcome=ignored.
+func (h *VCSHandler) GitHubWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), "cannot read body")
+		return
+	}
+	if h.cfg.GitHub.WebhookSecret == "" {
+		h.log.Warn("GitHub webhook rejected: webhook secret is not configured")
+	}
+	if !github.VerifySignature(h.cfg.GitHub.WebhookSecret, body, r.Header.Get(github.SignatureHeader)) {
+		_ = utils.WriteError(w, http.StatusUnauthorized, utils.HTTPCodeConverter(http.StatusUnauthorized), "invalid webhook signature")
+		return
+	}
+
+	eventType := r.Header.Get(github.EventHeader)
+	h.log.Info("GitHub webhook", slog.String("event", eventType), slog.String("delivery", r.Header.Get(github.DeliveryHeader)))
+	if eventType != github.EventPullRequest {
+		_ = utils.WriteJSON(w, http.StatusOK, WebhookResponse{Outcome: string(models.VCSOutcomeIgnored), Reason: "event " + eventType + " is not handled"})
+		return
+	}
+
+	event, err := github.ParsePullRequestEvent(body)
+	if err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+	res, err := h.vcsService.HandlePullRequestEvent(r.Context(), event)
+	if err != nil {
+		writeVCSError(w, h, "GitHubWebhook", err)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, toWebhookResponse(event, res))
+}
+
+func toWebhookResponse(event *models.VCSPullRequestEvent, res *models.VCSEventResult) WebhookResponse {
+	resp := WebhookResponse{Outcome: string(res.Outcome), PullRequestID: event.PRID, Reason: res.Reason}
+	if res.PR != nil {
+		resp.Status = string(res.PR.Status)
+	}
+	return resp
+}
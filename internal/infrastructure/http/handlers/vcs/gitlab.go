@@ -0,0 +1,46 @@
+package vcs
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/vcs/gitlab"
+	"avito-test-pr-service/internal/utils"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+// GitLabWebhook принимает события GitLab. X-Gitlab-Token сверяется с секретом до чтения тела;
+// события, кроме Merge Request Hook, подтверждаются с outcome=ignored.
+func (h *VCSHandler) GitLabWebhook(w http.ResponseWriter, r *http.Request) {
+	if h.cfg.GitLab.WebhookSecret == "" {
+		h.log.Warn("GitLab webhook rejected: webhook secret is not configured")
+	}
+	if !gitlab.VerifyToken(h.cfg.GitLab.WebhookSecret, r.Header.Get(gitlab.TokenHeader)) {
+		_ = utils.WriteError(w, http.StatusUnauthorized, utils.HTTPCodeConverter(http.StatusUnauthorized), "invalid webhook token")
+		return
+	}
+
+	eventType := r.Header.Get(gitlab.EventHeader)
+	h.log.Info("GitLab webhook", slog.String("event", eventType), slog.String("delivery", r.Header.Get(gitlab.DeliveryHeader)))
+	if eventType != gitlab.EventMergeRequest {
+		_ = utils.WriteJSON(w, http.StatusOK, WebhookResponse{Outcome: string(models.VCSOutcomeIgnored), Reason: "event " + eventType + " is not handled"})
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), "cannot read body")
+		return
+	}
+	event, err := gitlab.ParseMergeRequestEvent(body)
+	if err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+	res, err := h.vcsService.HandlePullRequestEvent(r.Context(), event)
+	if err != nil {
+		writeVCSError(w, h, "GitLabWebhook", err)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, toWebhookResponse(event, res))
+}
@@ -0,0 +1,70 @@
+package vcs
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+type IdentityDTO struct {
+	Provider string `json:"provider" validate:"required"`
+	Login    string `json:"login" validate:"required"`
+	UserID   string `json:"user_id" validate:"required"`
+}
+
+type SetIdentitiesRequest struct {
+	Identities []IdentityDTO `json:"identities" validate:"required,min=1,dive"`
+}
+
+type IdentitiesResponse struct {
+	Identities []IdentityDTO `json:"identities"`
+}
+
+// SetIdentities добавляет или перепривязывает логины VCS к пользователям; логины хранятся в нижнем регистре.
+func (h *VCSHandler) SetIdentities(w http.ResponseWriter, r *http.Request) {
+	var req SetIdentitiesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), utils.ErrInvalidJSON.Error())
+		return
+	}
+	if err := utils.Validate(req); err != nil {
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+		return
+	}
+
+	h.log.Info("SetIdentities request", slog.Int("identities", len(req.Identities)))
+
+	identities := make([]*models.VCSIdentity, 0, len(req.Identities))
+	for _, id := range req.Identities {
+		identities = append(identities, &models.VCSIdentity{Provider: models.VCSProvider(id.Provider), Login: id.Login, UserID: id.UserID})
+	}
+	res, err := h.vcsService.SetIdentities(r.Context(), identities)
+	if err != nil {
+		writeVCSError(w, h, "SetIdentities", err)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, toIdentitiesResponse(res))
+}
+
+func (h *VCSHandler) ListIdentities(w http.ResponseWriter, r *http.Request) {
+	provider := models.VCSProvider(r.URL.Query().Get("provider"))
+
+	h.log.Info("ListIdentities request", slog.String("provider", string(provider)))
+
+	res, err := h.vcsService.ListIdentities(r.Context(), provider)
+	if err != nil {
+		writeVCSError(w, h, "ListIdentities", err)
+		return
+	}
+	_ = utils.WriteJSON(w, http.StatusOK, toIdentitiesResponse(res))
+}
+
+func toIdentitiesResponse(identities []*models.VCSIdentity) IdentitiesResponse {
+	resp := IdentitiesResponse{Identities: make([]IdentityDTO, 0, len(identities))}
+	for _, id := range identities {
+		resp.Identities = append(resp.Identities, IdentityDTO{Provider: string(id.Provider), Login: id.Login, UserID: id.UserID})
+	}
+	return resp
+}
@@ -0,0 +1,38 @@
+package vcs
+
+import (
+	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/config"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/utils"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+// maxWebhookBody — предельный размер тела вебхука (GitHub обрезает payload на 25 МБ).
+const maxWebhookBody = 25 << 20
+
+type VCSHandler struct {
+	vcsService input.VCSInputPort
+	cfg        config.Integrations
+	log        *logger.Logger
+}
+
+func NewVCSHandler(vcsSvc input.VCSInputPort, cfg config.Integrations, log *logger.Logger) *VCSHandler {
+	return &VCSHandler{vcsService: vcsSvc, cfg: cfg, log: log}
+}
+
+func writeVCSError(w http.ResponseWriter, h *VCSHandler, op string, err error) {
+	switch {
+	case errors.Is(err, utils.ErrUserNotFound) || errors.Is(err, utils.ErrUserNoTeam):
+		_ = utils.WriteError(w, http.StatusNotFound, utils.HTTPCodeConverter(http.StatusNotFound), err.Error())
+	case errors.Is(err, utils.ErrInvalidArgument) || errors.Is(err, utils.ErrInvalidProvider) || errors.Is(err, utils.ErrInvalidTag):
+		_ = utils.WriteError(w, http.StatusBadRequest, utils.HTTPCodeConverter(http.StatusBadRequest), err.Error())
+	case errors.Is(err, utils.ErrAlreadyMerged) || errors.Is(err, utils.ErrPRClosed) || errors.Is(err, utils.ErrConstraintsUnsatisfied) || errors.Is(err, utils.ErrNoReplacementCandidates):
+		_ = utils.WriteError(w, http.StatusConflict, utils.HTTPCodeConverter(http.StatusConflict, err), err.Error())
+	default:
+		h.log.Error(op+" failed", slog.Any("err", err))
+		_ = utils.WriteError(w, http.StatusInternalServerError, utils.HTTPCodeConverter(http.StatusInternalServerError), utils.ErrInternal.Error())
+	}
+}
@@ -0,0 +1,106 @@
+package vcs
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/config"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/vcs/github"
+	"avito-test-pr-service/internal/infrastructure/vcs/gitlab"
+	"avito-test-pr-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func deliver(t *testing.T, h *VCSHandler, event, fixture, secret string) *httptest.ResponseRecorder {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("..", "..", "..", "vcs", "github", "testdata", fixture))
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/integrations/github/webhook", bytes.NewReader(body))
+	req.Header.Set(github.EventHeader, event)
+	req.Header.Set(github.SignatureHeader, github.Sign(secret, body))
+	rec := httptest.NewRecorder()
+	h.GitHubWebhook(rec, req)
+	return rec
+}
+
+func TestGitHubWebhook(t *testing.T) {
+	const secret = "s3cret"
+	cfg := config.Integrations{GitHub: config.GitHubIntegration{WebhookSecret: secret}}
+
+	t.Run("merged fixture reaches service", func(t *testing.T) {
+		svc := mocks.NewVCSInputPort(t)
+		svc.EXPECT().HandlePullRequestEvent(mock.Anything, mock.MatchedBy(func(e *models.VCSPullRequestEvent) bool {
+			return e.Action == models.VCSActionMerged && e.PRID == "gh-582347311-42" && e.Sender == "bob-reviews"
+		})).Return(&models.VCSEventResult{Outcome: models.VCSOutcomeMerged, PR: &models.PullRequest{Status: models.PRStatusMERGED}}, nil)
+
+		rec := deliver(t, NewVCSHandler(svc, cfg, logger.New("test")), github.EventPullRequest, "pull_request_closed_merged.json", secret)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp WebhookResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Equal(t, WebhookResponse{Outcome: "merged", PullRequestID: "gh-582347311-42", Status: "MERGED"}, resp)
+	})
+
+	t.Run("ping is acknowledged", func(t *testing.T) {
+		rec := deliver(t, NewVCSHandler(mocks.NewVCSInputPort(t), cfg, logger.New("test")), github.EventPing, "ping.json", secret)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"outcome":"ignored"`)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		rec := deliver(t, NewVCSHandler(mocks.NewVCSInputPort(t), cfg, logger.New("test")), github.EventPullRequest, "pull_request_opened.json", "other")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("secret not configured", func(t *testing.T) {
+		rec := deliver(t, NewVCSHandler(mocks.NewVCSInputPort(t), config.Integrations{}, logger.New("test")), github.EventPullRequest, "pull_request_opened.json", "")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func deliverGitLab(t *testing.T, h *VCSHandler, fixture, token string) *httptest.ResponseRecorder {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("..", "..", "..", "vcs", "gitlab", "testdata", fixture))
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/integrations/gitlab/webhook", bytes.NewReader(body))
+	req.Header.Set(gitlab.EventHeader, gitlab.EventMergeRequest)
+	req.Header.Set(gitlab.TokenHeader, token)
+	rec := httptest.NewRecorder()
+	h.GitLabWebhook(rec, req)
+	return rec
+}
+
+func TestGitLabWebhook(t *testing.T) {
+	const secret = "s3cret"
+	cfg := config.Integrations{GitLab: config.GitLabIntegration{WebhookSecret: secret}}
+
+	t.Run("draft toggle reaches service", func(t *testing.T) {
+		svc := mocks.NewVCSInputPort(t)
+		svc.EXPECT().HandlePullRequestEvent(mock.Anything, mock.MatchedBy(func(e *models.VCSPullRequestEvent) bool {
+			return e.Action == models.VCSActionDraft && e.PRID == "gl-278964-17"
+		})).Return(&models.VCSEventResult{Outcome: models.VCSOutcomeClosed, PR: &models.PullRequest{Status: models.PRStatusCLOSED}}, nil)
+
+		rec := deliverGitLab(t, NewVCSHandler(svc, cfg, logger.New("test")), "merge_request_update_draft.json", secret)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"outcome":"closed","pull_request_id":"gl-278964-17","status":"CLOSED"}`, rec.Body.String())
+	})
+
+	t.Run("wrong token", func(t *testing.T) {
+		rec := deliverGitLab(t, NewVCSHandler(mocks.NewVCSInputPort(t), cfg, logger.New("test")), "merge_request_open.json", "other")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("GitHub secret does not open GitLab", func(t *testing.T) {
+		cfg := config.Integrations{GitHub: config.GitHubIntegration{WebhookSecret: secret}}
+		rec := deliverGitLab(t, NewVCSHandler(mocks.NewVCSInputPort(t), cfg, logger.New("test")), "merge_request_open.json", secret)
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
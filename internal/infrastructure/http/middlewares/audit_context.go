@@ -0,0 +1,22 @@
+package middlewares
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// ActorHeader — инициатор изменения для журнала аудита. Заголовок не аутентифицируется: сервис записывает
+// значение как есть, поэтому actor в аудите достоверен, только если заголовок выставляет доверенный прокси.
+const ActorHeader = "X-Actor-ID"
+
+// AuditContextMiddleware кладёт в контекст request id и инициатора изменения для журнала аудита.
+func AuditContextMiddleware(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		ctx := utils.WithRequestID(r.Context(), middleware.GetReqID(r.Context()))
+		ctx = utils.WithActor(ctx, r.Header.Get(ActorHeader))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+	return http.HandlerFunc(fn)
+}
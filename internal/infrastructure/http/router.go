@@ -3,9 +3,13 @@ package http
 import (
 	input "avito-test-pr-service/internal/domain/ports/input"
 	"avito-test-pr-service/internal/infrastructure/config"
+	adminhandler "avito-test-pr-service/internal/infrastructure/http/handlers/admin"
+	audithandler "avito-test-pr-service/internal/infrastructure/http/handlers/audit"
+	notificationhandler "avito-test-pr-service/internal/infrastructure/http/handlers/notification"
 	prhandler "avito-test-pr-service/internal/infrastructure/http/handlers/pr"
 	"avito-test-pr-service/internal/infrastructure/http/handlers/team"
 	"avito-test-pr-service/internal/infrastructure/http/handlers/user"
+	vcshandler "avito-test-pr-service/internal/infrastructure/http/handlers/vcs"
 	"avito-test-pr-service/internal/infrastructure/http/middlewares"
 	"avito-test-pr-service/internal/infrastructure/logger"
 	"encoding/json"
@@ -19,18 +23,26 @@ type Router struct {
 	router *chi.Mux
 	log    *logger.Logger
 
-	prService   input.PRInputPort
-	teamService input.TeamInputPort
-	userService input.UserInputPort
+	prService     input.PRInputPort
+	teamService   input.TeamInputPort
+	userService   input.UserInputPort
+	auditService  input.AuditInputPort
+	feedService   input.ReviewFeedInputPort
+	vcsService    input.VCSInputPort
+	notifyService input.NotificationInputPort
 }
 
-func NewRouter(log *logger.Logger, prSvc input.PRInputPort, teamSvc input.TeamInputPort, userSvc input.UserInputPort) *Router {
+func NewRouter(log *logger.Logger, prSvc input.PRInputPort, teamSvc input.TeamInputPort, userSvc input.UserInputPort, auditSvc input.AuditInputPort, feedSvc input.ReviewFeedInputPort, vcsSvc input.VCSInputPort, notifySvc input.NotificationInputPort) *Router {
 	return &Router{
-		router:      chi.NewRouter(),
-		log:         log,
-		prService:   prSvc,
-		teamService: teamSvc,
-		userService: userSvc,
+		router:        chi.NewRouter(),
+		log:           log,
+		prService:     prSvc,
+		teamService:   teamSvc,
+		userService:   userSvc,
+		auditService:  auditSvc,
+		feedService:   feedSvc,
+		vcsService:    vcsSvc,
+		notifyService: notifySvc,
 	}
 }
 
@@ -39,23 +51,43 @@ func (r *Router) Setup(cfg *config.Config) {
 	r.router.Use(chiMiddleware.RealIP)
 	r.router.Use(chiMiddleware.Recoverer)
 	r.router.Use(middlewares.RequestLoggerMiddleware(r.log))
-	r.router.Use(chiMiddleware.Timeout(cfg.HTTPServer.RequestTimeout))
+	r.router.Use(middlewares.AuditContextMiddleware)
 
-	r.router.Get("/ping", func(w http.ResponseWriter, _ *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
-	})
+	userHandler := user.NewUserHandler(r.userService, r.prService, r.feedService, r.log)
+	// Поток SSE живёт, пока клиент подключён, поэтому таймаут запроса к нему не применяется.
+	r.router.Get("/users/reviewFeed", userHandler.ReviewFeed)
+
+	r.router.Group(func(g chi.Router) {
+		g.Use(chiMiddleware.Timeout(cfg.HTTPServer.RequestTimeout))
+
+		g.Get("/ping", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+		})
+
+		g.Mount("/users", r.setupUserRoutes(userHandler))
+		g.Mount("/team", r.setupTeamRoutes())
+		g.Mount("/pullRequest", r.setupPRRoutes())
 
-	r.router.Mount("/users", r.setupUserRoutes())
-	r.router.Mount("/team", r.setupTeamRoutes())
-	r.router.Mount("/pullRequest", r.setupPRRoutes())
+		auditHandler := audithandler.NewAuditHandler(r.auditService, r.log)
+		g.Get("/audit", auditHandler.ListAudit)
+		g.Mount("/admin", r.setupAdminRoutes())
+		g.Mount("/integrations", r.setupIntegrationRoutes(cfg.Integrations))
+	})
 }
 
-func (r *Router) setupUserRoutes() http.Handler {
-	h := user.NewUserHandler(r.userService, r.prService, r.log)
+func (r *Router) setupUserRoutes(h *user.UserHandler) http.Handler {
 	sub := chi.NewRouter()
 	sub.Post("/setIsActive", h.SetIsActive)
 	sub.Get("/getReview", h.GetReviews)
+	sub.Get("/list", h.ListUsers)
+	sub.Post("/tags", h.SetTags)
+	sub.Get("/tags", h.GetTags)
+	sub.Post("/email", h.SetEmail)
+	sub.Get("/email", h.GetEmail)
+	notifyHandler := notificationhandler.NewNotificationHandler(r.notifyService, r.log)
+	sub.Post("/notifications", notifyHandler.SetPreferences)
+	sub.Get("/notifications", notifyHandler.GetPreferences)
 	return sub
 }
 
@@ -64,15 +96,48 @@ func (r *Router) setupTeamRoutes() http.Handler {
 	sub := chi.NewRouter()
 	sub.Post("/add", h.AddTeam)
 	sub.Get("/get", h.GetTeam)
+	sub.Get("/list", h.ListTeams)
+	sub.Put("/sync", h.SyncTeam)
+	sub.Post("/codeOwners", h.SetCodeOwners)
+	sub.Get("/codeOwners", h.GetCodeOwners)
+	sub.Post("/selectionStrategy", h.SetSelectionStrategy)
+	sub.Get("/selectionStrategy", h.GetSelectionStrategy)
+	sub.Post("/reviewerConstraints", h.SetReviewerConstraints)
+	sub.Get("/reviewerConstraints", h.GetReviewerConstraints)
 	return sub
 }
 
 func (r *Router) setupPRRoutes() http.Handler {
-	h := prhandler.NewPRHandler(r.prService, r.log)
+	h := prhandler.NewPRHandler(r.prService, r.userService, r.log)
 	sub := chi.NewRouter()
 	sub.Post("/create", h.CreatePR)
+	sub.Post("/batchCreate", h.BatchCreate)
 	sub.Post("/merge", h.MergePR)
 	sub.Post("/reassign", h.Reassign)
+	sub.Post("/addReviewer", h.AddReviewer)
+	sub.Post("/removeReviewer", h.RemoveReviewer)
+	sub.Get("/get", h.GetPR)
+	sub.Get("/list", h.ListPRs)
+	sub.Get("/history", h.GetHistory)
+	sub.Get("/explain", h.Explain)
+	return sub
+}
+
+func (r *Router) setupAdminRoutes() http.Handler {
+	h := adminhandler.NewAdminHandler(r.teamService, r.log)
+	sub := chi.NewRouter()
+	sub.Get("/export", h.Export)
+	sub.Post("/import", h.Import)
+	return sub
+}
+
+func (r *Router) setupIntegrationRoutes(cfg config.Integrations) http.Handler {
+	h := vcshandler.NewVCSHandler(r.vcsService, cfg, r.log)
+	sub := chi.NewRouter()
+	sub.Post("/github/webhook", h.GitHubWebhook)
+	sub.Post("/gitlab/webhook", h.GitLabWebhook)
+	sub.Post("/identities", h.SetIdentities)
+	sub.Get("/identities", h.ListIdentities)
 	return sub
 }
 
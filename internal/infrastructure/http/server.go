@@ -15,23 +15,31 @@ type Server struct {
 	router  *Router
 	server  *http.Server
 
-	prService   input.PRInputPort
-	teamService input.TeamInputPort
-	userService input.UserInputPort
+	prService     input.PRInputPort
+	teamService   input.TeamInputPort
+	userService   input.UserInputPort
+	auditService  input.AuditInputPort
+	feedService   input.ReviewFeedInputPort
+	vcsService    input.VCSInputPort
+	notifyService input.NotificationInputPort
 }
 
-func NewServer(address string, log *logger.Logger, prSvc input.PRInputPort, teamSvc input.TeamInputPort, userSvc input.UserInputPort) *Server {
+func NewServer(address string, log *logger.Logger, prSvc input.PRInputPort, teamSvc input.TeamInputPort, userSvc input.UserInputPort, auditSvc input.AuditInputPort, feedSvc input.ReviewFeedInputPort, vcsSvc input.VCSInputPort, notifySvc input.NotificationInputPort) *Server {
 	return &Server{
-		address:     address,
-		log:         log,
-		prService:   prSvc,
-		teamService: teamSvc,
-		userService: userSvc,
+		address:       address,
+		log:           log,
+		prService:     prSvc,
+		teamService:   teamSvc,
+		userService:   userSvc,
+		auditService:  auditSvc,
+		feedService:   feedSvc,
+		vcsService:    vcsSvc,
+		notifyService: notifySvc,
 	}
 }
 
 func (s *Server) Run(cfg *config.Config) error {
-	s.router = NewRouter(s.log, s.prService, s.teamService, s.userService)
+	s.router = NewRouter(s.log, s.prService, s.teamService, s.userService, s.auditService, s.feedService, s.vcsService, s.notifyService)
 	s.router.Setup(cfg)
 
 	s.server = &http.Server{
@@ -0,0 +1,169 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	netmail "net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"avito-test-pr-service/internal/domain/models"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTP — минимальный SMTP-сервер: принимает PLAIN-аутентификацию и письма,
+// адресату reject@example.com отвечает 550.
+type fakeSMTP struct {
+	ln net.Listener
+
+	mu       sync.Mutex
+	auth     string
+	from     string
+	rcpt     []string
+	messages []string
+}
+
+func startFakeSMTP(t *testing.T) *fakeSMTP {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	f := &fakeSMTP{ln: ln}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { _ = ln.Close() })
+	return f
+}
+
+func (f *fakeSMTP) port() int { return f.ln.Addr().(*net.TCPAddr).Port }
+
+func (f *fakeSMTP) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 fake ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd, arg, _ := strings.Cut(line, " ")
+		f.mu.Lock()
+		switch strings.ToUpper(cmd) {
+		case "EHLO":
+			_ = tp.PrintfLine("250-fake")
+			_ = tp.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			raw, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(arg, "PLAIN "))
+			f.auth = string(raw)
+			_ = tp.PrintfLine("235 ok")
+		case "MAIL":
+			f.from = arg
+			_ = tp.PrintfLine("250 ok")
+		case "RCPT":
+			if strings.Contains(arg, "reject@") {
+				_ = tp.PrintfLine("550 no such user")
+				break
+			}
+			f.rcpt = append(f.rcpt, arg)
+			_ = tp.PrintfLine("250 ok")
+		case "DATA":
+			_ = tp.PrintfLine("354 go ahead")
+			data, err := io.ReadAll(tp.DotReader())
+			if err != nil {
+				f.mu.Unlock()
+				return
+			}
+			f.messages = append(f.messages, string(data))
+			_ = tp.PrintfLine("250 queued")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			f.mu.Unlock()
+			return
+		default:
+			_ = tp.PrintfLine("250 ok")
+		}
+		f.mu.Unlock()
+	}
+}
+
+func TestSMTPSender_Multipart(t *testing.T) {
+	srv := startFakeSMTP(t)
+	s := NewSMTPSender(SMTPOptions{Host: "127.0.0.1", Port: srv.port(), Username: "bot", Password: "secret", From: "pr-service@example.com"})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.Send(ctx, &models.EmailMessage{
+		To:      "bob@example.com",
+		Subject: "Ревью: Add search",
+		Text:    "You were assigned to review Add search.",
+		HTML:    "<p>You were assigned to review <b>Add search</b>.</p>",
+	})
+	require.NoError(t, err)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	require.Equal(t, "\x00bot\x00secret", srv.auth)
+	require.Equal(t, "FROM:<pr-service@example.com>", srv.from)
+	require.Equal(t, []string{"TO:<bob@example.com>"}, srv.rcpt)
+	require.Len(t, srv.messages, 1)
+
+	msg, err := netmail.ReadMessage(bufio.NewReader(strings.NewReader(srv.messages[0])))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Ревью: Add search", subject)
+	require.True(t, strings.HasSuffix(msg.Header.Get("Message-ID"), "@example.com>"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	var parts []string
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(p)
+		require.NoError(t, err)
+		parts = append(parts, p.Header.Get("Content-Type")+"|"+string(body))
+	}
+	require.Equal(t, []string{
+		"text/plain; charset=utf-8|You were assigned to review Add search.",
+		"text/html; charset=utf-8|<p>You were assigned to review <b>Add search</b>.</p>",
+	}, parts)
+}
+
+func TestSMTPSender_Errors(t *testing.T) {
+	srv := startFakeSMTP(t)
+	s := NewSMTPSender(SMTPOptions{Host: "127.0.0.1", Port: srv.port(), From: "pr-service@example.com"})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.Send(ctx, &models.EmailMessage{To: "reject@example.com", Subject: "x", Text: "plain only"})
+	require.ErrorContains(t, err, "550")
+
+	require.NoError(t, s.Send(ctx, &models.EmailMessage{To: "bob@example.com", Subject: "x", Text: "plain only"}))
+	srv.mu.Lock()
+	require.Contains(t, srv.messages[0], "Content-Type: text/plain; charset=utf-8")
+	require.Empty(t, srv.auth)
+	srv.mu.Unlock()
+
+	closed := NewSMTPSender(SMTPOptions{Host: "127.0.0.1", Port: 1, From: "pr-service@example.com"})
+	require.Error(t, closed.Send(ctx, &models.EmailMessage{To: "bob@example.com", Text: "x"}))
+}
@@ -0,0 +1,117 @@
+package audit_repository
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	ports "avito-test-pr-service/internal/domain/ports/output"
+	audit_port "avito-test-pr-service/internal/domain/ports/output/audit"
+	"avito-test-pr-service/internal/infrastructure/persistence/postgres"
+	"avito-test-pr-service/internal/utils"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type AuditRepository struct {
+	querier postgres.Querier
+	log     ports.Logger
+}
+
+func NewAuditRepository(querier postgres.Querier, log ports.Logger) audit_port.AuditRepository {
+	return &AuditRepository{querier: querier, log: log}
+}
+
+func (r *AuditRepository) Append(ctx context.Context, entry *models.AuditEntry) error {
+	if entry.Action == "" || entry.EntityType == "" || entry.EntityID == "" {
+		return utils.ErrInvalidArgument
+	}
+	const q = `
+		INSERT INTO audit_log (actor, request_id, action, entity_type, entity_id, before, after, created_at)
+		VALUES (@actor, @request_id, @action, @entity_type, @entity_id, @before, @after, now())
+		RETURNING id, created_at;
+	`
+	args := pgx.NamedArgs{
+		"actor":       entry.Actor,
+		"request_id":  entry.RequestID,
+		"action":      entry.Action,
+		"entity_type": entry.EntityType,
+		"entity_id":   entry.EntityID,
+		"before":      nullableJSON(entry.Before),
+		"after":       nullableJSON(entry.After),
+	}
+	row := r.querier.QueryRow(ctx, q, args)
+	if err := row.Scan(&entry.ID, &entry.CreatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			r.log.Error("Append audit pg error", "code", pgErr.Code, "action", entry.Action, "entity_id", entry.EntityID, "err", pgErr)
+		}
+		r.log.Error("Append audit failed", "action", entry.Action, "entity_id", entry.EntityID, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *AuditRepository) List(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	query := `SELECT id, actor, request_id, action, entity_type, entity_id, before, after, created_at FROM audit_log`
+
+	var whereClauses []string
+	args := pgx.NamedArgs{}
+	if filter.EntityType != "" {
+		whereClauses = append(whereClauses, "entity_type = @entity_type")
+		args["entity_type"] = filter.EntityType
+	}
+	if filter.EntityID != "" {
+		whereClauses = append(whereClauses, "entity_id = @entity_id")
+		args["entity_id"] = filter.EntityID
+	}
+	if filter.Actor != "" {
+		whereClauses = append(whereClauses, "actor = @actor")
+		args["actor"] = filter.Actor
+	}
+	if filter.From != nil {
+		whereClauses = append(whereClauses, "created_at >= @from")
+		args["from"] = *filter.From
+	}
+	if filter.To != nil {
+		whereClauses = append(whereClauses, "created_at < @to")
+		args["to"] = *filter.To
+	}
+	if len(whereClauses) > 0 {
+		query += " WHERE " + strings.Join(whereClauses, " AND ")
+	}
+	query += " ORDER BY created_at DESC, id DESC LIMIT @limit OFFSET @offset;"
+	args["limit"] = filter.Limit
+	args["offset"] = filter.Offset
+
+	rows, err := r.querier.Query(ctx, query, args)
+	if err != nil {
+		r.log.Error("List audit query failed", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	var res []*models.AuditEntry
+	for rows.Next() {
+		var e models.AuditEntry
+		var before, after []byte
+		if err := rows.Scan(&e.ID, &e.Actor, &e.RequestID, &e.Action, &e.EntityType, &e.EntityID, &before, &after, &e.CreatedAt); err != nil {
+			r.log.Error("List audit scan failed", "err", err)
+			return nil, err
+		}
+		e.Before = before
+		e.After = after
+		res = append(res, &e)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
+func nullableJSON(raw []byte) any {
+	if len(raw) == 0 {
+		return nil
+	}
+	return string(raw)
+}
@@ -7,6 +7,7 @@ import (
 	"avito-test-pr-service/internal/infrastructure/persistence/postgres"
 	"avito-test-pr-service/internal/utils"
 	"context"
+	"encoding/json"
 	"errors"
 	"strings"
 	"time"
@@ -29,12 +30,20 @@ func (r *PRRepository) CreatePR(ctx context.Context, pr *models.PullRequest) err
 		return utils.ErrInvalidArgument
 	}
 	const insertPR = `
-		INSERT INTO prs (id, title, author_id, status, created_at, updated_at)
-		VALUES (@id, @title, @author_id, 'OPEN', now(), now())
-		RETURNING id, title, author_id, status, created_at, merged_at, updated_at;
+		INSERT INTO prs (id, title, author_id, status, changed_files, labels, created_at, updated_at)
+		VALUES (@id, @title, @author_id, 'OPEN', @changed_files, @labels, now(), now())
+		RETURNING id, title, author_id, status, created_at, merged_at, updated_at, version;
 	`
-	row := r.querier.QueryRow(ctx, insertPR, pgx.NamedArgs{"id": pr.ID, "title": pr.Title, "author_id": pr.AuthorID})
-	if err := row.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.UpdatedAt); err != nil {
+	changedFiles := pr.ChangedFiles
+	if changedFiles == nil {
+		changedFiles = []string{}
+	}
+	labels := pr.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	row := r.querier.QueryRow(ctx, insertPR, pgx.NamedArgs{"id": pr.ID, "title": pr.Title, "author_id": pr.AuthorID, "changed_files": changedFiles, "labels": labels})
+	if err := row.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.UpdatedAt, &pr.Version); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			switch pgErr.Code {
@@ -50,15 +59,137 @@ func (r *PRRepository) CreatePR(ctx context.Context, pr *models.PullRequest) err
 		r.log.Error("CreatePR failed", "pr_id", pr.ID, "err", err)
 		return err
 	}
-	for _, reviewerID := range pr.ReviewerIDs {
-		if reviewerID == "" {
+	return r.insertReviewers(ctx, pr.ID, pr.ReviewerIDs)
+}
+
+// insertReviewers назначает ревьюверов нового PR одним запросом.
+func (r *PRRepository) insertReviewers(ctx context.Context, prID string, reviewerIDs []string) error {
+	reviewerIDs = utils.FilterStrings(reviewerIDs, map[string]struct{}{"": {}})
+	if len(reviewerIDs) == 0 {
+		return nil
+	}
+	if len(reviewerIDs) > 2 {
+		return utils.ErrTooManyReviewers
+	}
+	const q = `
+		INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at)
+		SELECT @pr_id, reviewer_id, now()
+		FROM unnest(@reviewer_ids::text[]) AS reviewer_id;
+	`
+	if _, err := r.querier.Exec(ctx, q, pgx.NamedArgs{"pr_id": prID, "reviewer_ids": reviewerIDs}); err != nil {
+		return r.mapReviewerInsertError(err, prID)
+	}
+	return nil
+}
+
+func (r *PRRepository) mapReviewerInsertError(err error, prID string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505":
+			return utils.ErrReviewerAlreadyAssigned
+		case "23503":
+			if pgErr.ConstraintName == "pr_reviewers_pr_id_fkey" {
+				return utils.ErrPRNotFound
+			}
+			return utils.ErrUserNotFound
+		}
+	}
+	r.log.Error("insert reviewers failed", "pr_id", prID, "err", err)
+	return err
+}
+
+func (r *PRRepository) ExistingPRIDs(ctx context.Context, ids []string) (map[string]struct{}, error) {
+	res := make(map[string]struct{})
+	if len(ids) == 0 {
+		return res, nil
+	}
+	const q = `SELECT id FROM prs WHERE id = ANY(@ids);`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"ids": ids})
+	if err != nil {
+		r.log.Error("ExistingPRIDs query failed", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		res[id] = struct{}{}
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
+// CreatePRs вставляет PR одним pgx.Batch (ON CONFLICT DO NOTHING — конфликт по id не прерывает транзакцию),
+// а ревьюверов созданных PR — через COPY.
+func (r *PRRepository) CreatePRs(ctx context.Context, prs []*models.PullRequest) (map[string]struct{}, error) {
+	created := make(map[string]struct{}, len(prs))
+	if len(prs) == 0 {
+		return created, nil
+	}
+	const insertPR = `
+		INSERT INTO prs (id, title, author_id, status, changed_files, labels, created_at, updated_at)
+		VALUES (@id, @title, @author_id, 'OPEN', @changed_files, @labels, now(), now())
+		ON CONFLICT (id) DO NOTHING
+		RETURNING status, created_at, updated_at, version;
+	`
+	batch := &pgx.Batch{}
+	for _, pr := range prs {
+		changedFiles, labels := pr.ChangedFiles, pr.Labels
+		if changedFiles == nil {
+			changedFiles = []string{}
+		}
+		if labels == nil {
+			labels = []string{}
+		}
+		batch.Queue(insertPR, pgx.NamedArgs{"id": pr.ID, "title": pr.Title, "author_id": pr.AuthorID, "changed_files": changedFiles, "labels": labels})
+	}
+	br := r.querier.SendBatch(ctx, batch)
+	for _, pr := range prs {
+		err := br.QueryRow().Scan(&pr.Status, &pr.CreatedAt, &pr.UpdatedAt, &pr.Version)
+		if errors.Is(err, pgx.ErrNoRows) {
 			continue
 		}
-		if err := r.AddReviewer(ctx, pr.ID, reviewerID); err != nil {
-			return err
+		if err != nil {
+			_ = br.Close()
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+				return nil, utils.ErrUserNotFound
+			}
+			r.log.Error("CreatePRs insert failed", "pr_id", pr.ID, "err", err)
+			return nil, err
 		}
+		created[pr.ID] = struct{}{}
 	}
-	return nil
+	if err := br.Close(); err != nil {
+		r.log.Error("CreatePRs batch close failed", "err", err)
+		return nil, err
+	}
+
+	var rows [][]any
+	now := time.Now()
+	for _, pr := range prs {
+		if _, ok := created[pr.ID]; !ok {
+			continue
+		}
+		if len(pr.ReviewerIDs) > 2 {
+			return nil, utils.ErrTooManyReviewers
+		}
+		for _, reviewerID := range pr.ReviewerIDs {
+			rows = append(rows, []any{pr.ID, reviewerID, now})
+		}
+	}
+	if len(rows) == 0 {
+		return created, nil
+	}
+	if _, err := r.querier.CopyFrom(ctx, pgx.Identifier{"pr_reviewers"}, []string{"pr_id", "reviewer_id", "assigned_at"}, pgx.CopyFromRows(rows)); err != nil {
+		return nil, r.mapReviewerInsertError(err, "")
+	}
+	return created, nil
 }
 
 func (r *PRRepository) loadReviewers(ctx context.Context, prID string) ([]string, error) {
@@ -91,13 +222,13 @@ func (r *PRRepository) loadReviewers(ctx context.Context, prID string) ([]string
 
 func (r *PRRepository) GetPRByID(ctx context.Context, id string) (*models.PullRequest, error) {
 	const q = `
-		SELECT id, title, author_id, status, created_at, merged_at, updated_at
+		SELECT id, title, author_id, status, changed_files, labels, created_at, merged_at, updated_at, version
 		FROM prs
 		WHERE id = @id;
 	`
 	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"id": id})
 	var pr models.PullRequest
-	if err := row.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.UpdatedAt); err != nil {
+	if err := row.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.ChangedFiles, &pr.Labels, &pr.CreatedAt, &pr.MergedAt, &pr.UpdatedAt, &pr.Version); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, utils.ErrPRNotFound
 		}
@@ -119,14 +250,14 @@ func (r *PRRepository) GetPRByID(ctx context.Context, id string) (*models.PullRe
 
 func (r *PRRepository) LockPRByID(ctx context.Context, id string) (*models.PullRequest, error) {
 	const q = `
-		SELECT id, title, author_id, status, created_at, merged_at, updated_at
+		SELECT id, title, author_id, status, changed_files, labels, created_at, merged_at, updated_at, version
 		FROM prs
 		WHERE id = @id
 		FOR UPDATE;
 	`
 	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"id": id})
 	var pr models.PullRequest
-	if err := row.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.UpdatedAt); err != nil {
+	if err := row.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.ChangedFiles, &pr.Labels, &pr.CreatedAt, &pr.MergedAt, &pr.UpdatedAt, &pr.Version); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, utils.ErrPRNotFound
 		}
@@ -164,6 +295,39 @@ func (r *PRRepository) CountReviewersByPRID(ctx context.Context, prID string) (i
 	return c, nil
 }
 
+func (r *PRRepository) CountOpenReviewsByReviewers(ctx context.Context, reviewerIDs []string) (map[string]int, error) {
+	res := make(map[string]int, len(reviewerIDs))
+	if len(reviewerIDs) == 0 {
+		return res, nil
+	}
+	const q = `
+		SELECT r.reviewer_id, COUNT(*)
+		FROM pr_reviewers r
+		JOIN prs p ON p.id = r.pr_id
+		WHERE r.reviewer_id = ANY(@ids) AND p.status = 'OPEN'
+		GROUP BY r.reviewer_id;
+	`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"ids": reviewerIDs})
+	if err != nil {
+		r.log.Error("CountOpenReviewsByReviewers query failed", "count", len(reviewerIDs), "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		var c int
+		if err := rows.Scan(&id, &c); err != nil {
+			r.log.Error("CountOpenReviewsByReviewers scan failed", "err", err)
+			return nil, err
+		}
+		res[id] = c
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
 func (r *PRRepository) AddReviewer(ctx context.Context, prID string, reviewerID string) error {
 	count, err := r.CountReviewersByPRID(ctx, prID)
 	if err != nil {
@@ -172,10 +336,17 @@ func (r *PRRepository) AddReviewer(ctx context.Context, prID string, reviewerID
 	if count >= 2 {
 		return utils.ErrTooManyReviewers
 	}
+	// Смена состава ревьюверов меняет версию PR.
 	const q = `
-		INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at)
-		VALUES (@pr_id, @reviewer_id, now())
-		RETURNING pr_id;
+		WITH ins AS (
+			INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at)
+			VALUES (@pr_id, @reviewer_id, now())
+			RETURNING pr_id
+		)
+		UPDATE prs SET version = version + 1
+		FROM ins
+		WHERE prs.id = ins.pr_id
+		RETURNING prs.id;
 	`
 	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"pr_id": prID, "reviewer_id": reviewerID})
 	var returnedPR string
@@ -207,9 +378,15 @@ func (r *PRRepository) AddReviewer(ctx context.Context, prID string, reviewerID
 
 func (r *PRRepository) RemoveReviewer(ctx context.Context, prID string, reviewerID string) error {
 	const q = `
-		DELETE FROM pr_reviewers
-		WHERE pr_id = @pr_id AND reviewer_id = @reviewer_id
-		RETURNING pr_id;
+		WITH del AS (
+			DELETE FROM pr_reviewers
+			WHERE pr_id = @pr_id AND reviewer_id = @reviewer_id
+			RETURNING pr_id
+		)
+		UPDATE prs SET version = version + 1
+		FROM del
+		WHERE prs.id = del.pr_id
+		RETURNING prs.id;
 	`
 	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"pr_id": prID, "reviewer_id": reviewerID})
 	var returnedPR string
@@ -228,14 +405,15 @@ func (r *PRRepository) RemoveReviewer(ctx context.Context, prID string, reviewer
 }
 
 func (r *PRRepository) UpdateStatus(ctx context.Context, prID string, status models.PRStatus, mergedAt *time.Time) error {
-	if status != models.PRStatusOPEN && status != models.PRStatusMERGED {
+	if !status.IsValid() {
 		return utils.ErrInvalidStatus
 	}
 	const q = `
 		UPDATE prs
 		SET status = @status,
 			merged_at = COALESCE(@merged_at, merged_at),
-			updated_at = now()
+			updated_at = now(),
+			version = version + 1
 		WHERE id = @id AND status != 'MERGED'
 		RETURNING id;
 	`
@@ -267,7 +445,7 @@ func (r *PRRepository) UpdateStatus(ctx context.Context, prID string, status mod
 
 func (r *PRRepository) ListPRsByReviewer(ctx context.Context, reviewerID string, status *models.PRStatus) ([]*models.PullRequest, error) {
 
-	base := `SELECT p.id, p.title, p.author_id, p.status, p.created_at, p.merged_at, p.updated_at,
+	base := `SELECT p.id, p.title, p.author_id, p.status, p.created_at, p.merged_at, p.updated_at, p.version,
 		COALESCE(array_agg(r_all.reviewer_id ORDER BY r_all.assigned_at) FILTER (WHERE r_all.reviewer_id IS NOT NULL), '{}') AS reviewers
 		FROM prs p
 		JOIN pr_reviewers r_filter ON p.id = r_filter.pr_id AND r_filter.reviewer_id = @reviewer_id
@@ -281,7 +459,7 @@ func (r *PRRepository) ListPRsByReviewer(ctx context.Context, reviewerID string,
 	if len(whereClauses) > 0 {
 		query += " WHERE " + strings.Join(whereClauses, " AND ")
 	}
-	query += ` GROUP BY p.id, p.title, p.author_id, p.status, p.created_at, p.merged_at, p.updated_at
+	query += ` GROUP BY p.id, p.title, p.author_id, p.status, p.created_at, p.merged_at, p.updated_at, p.version
 		ORDER BY p.created_at DESC;`
 
 	args := pgx.NamedArgs{"reviewer_id": reviewerID}
@@ -302,7 +480,7 @@ func (r *PRRepository) ListPRsByReviewer(ctx context.Context, reviewerID string,
 	for rows.Next() {
 		var pr models.PullRequest
 		var reviewerIDs []string
-		if err := rows.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.UpdatedAt, &reviewerIDs); err != nil {
+		if err := rows.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.UpdatedAt, &pr.Version, &reviewerIDs); err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) && pgErr.Code == "22P02" {
 				return nil, utils.ErrInvalidArgument
@@ -318,3 +496,404 @@ func (r *PRRepository) ListPRsByReviewer(ctx context.Context, reviewerID string,
 	}
 	return res, nil
 }
+
+func (r *PRRepository) AppendReviewerHistory(ctx context.Context, entry *models.ReviewerHistoryEntry) error {
+	if entry.PRID == "" || entry.ReviewerID == "" || entry.Event == "" || entry.Reason == "" {
+		return utils.ErrInvalidArgument
+	}
+	const q = `
+		INSERT INTO pr_reviewer_history (pr_id, reviewer_id, event, reason, actor, created_at)
+		VALUES (@pr_id, @reviewer_id, @event, @reason, @actor, now())
+		RETURNING id, created_at;
+	`
+	args := pgx.NamedArgs{
+		"pr_id":       entry.PRID,
+		"reviewer_id": entry.ReviewerID,
+		"event":       entry.Event,
+		"reason":      entry.Reason,
+		"actor":       entry.Actor,
+	}
+	row := r.querier.QueryRow(ctx, q, args)
+	if err := row.Scan(&entry.ID, &entry.CreatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			switch pgErr.ConstraintName {
+			case "pr_reviewer_history_pr_id_fkey":
+				return utils.ErrPRNotFound
+			case "pr_reviewer_history_reviewer_id_fkey":
+				return utils.ErrUserNotFound
+			}
+		}
+		r.log.Error("AppendReviewerHistory failed", "pr_id", entry.PRID, "reviewer_id", entry.ReviewerID, "err", err)
+		return err
+	}
+	return nil
+}
+
+// AppendReviewerHistoryBatch записывает события истории через COPY одним запросом.
+func (r *PRRepository) AppendReviewerHistoryBatch(ctx context.Context, entries []*models.ReviewerHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	now := time.Now()
+	rows := make([][]any, 0, len(entries))
+	for _, e := range entries {
+		if e.PRID == "" || e.ReviewerID == "" || e.Event == "" || e.Reason == "" {
+			return utils.ErrInvalidArgument
+		}
+		rows = append(rows, []any{e.PRID, e.ReviewerID, string(e.Event), string(e.Reason), e.Actor, now})
+	}
+	cols := []string{"pr_id", "reviewer_id", "event", "reason", "actor", "created_at"}
+	if _, err := r.querier.CopyFrom(ctx, pgx.Identifier{"pr_reviewer_history"}, cols, pgx.CopyFromRows(rows)); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return utils.ErrUserNotFound
+		}
+		r.log.Error("AppendReviewerHistoryBatch failed", "count", len(entries), "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *PRRepository) ListReviewerHistory(ctx context.Context, prID string) ([]*models.ReviewerHistoryEntry, error) {
+	const q = `
+		SELECT id, pr_id, reviewer_id, event, reason, actor, created_at
+		FROM pr_reviewer_history
+		WHERE pr_id = @pr_id
+		ORDER BY id;
+	`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"pr_id": prID})
+	if err != nil {
+		r.log.Error("ListReviewerHistory query failed", "pr_id", prID, "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	var res []*models.ReviewerHistoryEntry
+	for rows.Next() {
+		var e models.ReviewerHistoryEntry
+		if err := rows.Scan(&e.ID, &e.PRID, &e.ReviewerID, &e.Event, &e.Reason, &e.Actor, &e.CreatedAt); err != nil {
+			r.log.Error("ListReviewerHistory scan failed", "pr_id", prID, "err", err)
+			return nil, err
+		}
+		res = append(res, &e)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
+func (r *PRRepository) AppendSelectionExplanation(ctx context.Context, explanation *models.SelectionExplanation) error {
+	if explanation.PRID == "" || explanation.Reason == "" {
+		return utils.ErrInvalidArgument
+	}
+	payload, err := json.Marshal(explanation)
+	if err != nil {
+		return err
+	}
+	const q = `
+		INSERT INTO pr_selection_explanations (pr_id, reason, explanation, created_at)
+		VALUES (@pr_id, @reason, @explanation, now())
+		RETURNING id, created_at;
+	`
+	args := pgx.NamedArgs{
+		"pr_id":       explanation.PRID,
+		"reason":      explanation.Reason,
+		"explanation": payload,
+	}
+	if err := r.querier.QueryRow(ctx, q, args).Scan(&explanation.ID, &explanation.CreatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return utils.ErrPRNotFound
+		}
+		r.log.Error("AppendSelectionExplanation failed", "pr_id", explanation.PRID, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *PRRepository) ListSelectionExplanations(ctx context.Context, prID string) ([]*models.SelectionExplanation, error) {
+	const q = `
+		SELECT id, explanation, created_at
+		FROM pr_selection_explanations
+		WHERE pr_id = @pr_id
+		ORDER BY id;
+	`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"pr_id": prID})
+	if err != nil {
+		r.log.Error("ListSelectionExplanations query failed", "pr_id", prID, "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	res := make([]*models.SelectionExplanation, 0)
+	for rows.Next() {
+		var (
+			e       models.SelectionExplanation
+			id      int64
+			payload []byte
+			created time.Time
+		)
+		if err := rows.Scan(&id, &payload, &created); err != nil {
+			r.log.Error("ListSelectionExplanations scan failed", "pr_id", prID, "err", err)
+			return nil, err
+		}
+		if err := json.Unmarshal(payload, &e); err != nil {
+			r.log.Error("ListSelectionExplanations decode failed", "pr_id", prID, "id", id, "err", err)
+			return nil, err
+		}
+		e.ID, e.CreatedAt = id, created
+		res = append(res, &e)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
+func (r *PRRepository) ListPRs(ctx context.Context, filter models.PRFilter) ([]*models.PullRequest, int, error) {
+	var whereClauses []string
+	args := pgx.NamedArgs{"limit": filter.Limit, "offset": filter.Offset}
+	if filter.AuthorID != "" {
+		whereClauses = append(whereClauses, "p.author_id = @author_id")
+		args["author_id"] = filter.AuthorID
+	}
+	if filter.TeamName != "" {
+		whereClauses = append(whereClauses, `p.author_id IN (
+			SELECT tm.user_id FROM team_members tm JOIN teams t ON t.id = tm.team_id WHERE t.name = @team_name)`)
+		args["team_name"] = filter.TeamName
+	}
+	if filter.Status != nil {
+		whereClauses = append(whereClauses, "p.status = @status")
+		args["status"] = *filter.Status
+	}
+	if filter.ReviewerID != "" {
+		whereClauses = append(whereClauses, "EXISTS (SELECT 1 FROM pr_reviewers r_filter WHERE r_filter.pr_id = p.id AND r_filter.reviewer_id = @reviewer_id)")
+		args["reviewer_id"] = filter.ReviewerID
+	}
+	where := ""
+	if len(whereClauses) > 0 {
+		where = " WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	// Всего считается отдельным запросом: оконный COUNT над страницей даёт 0, если offset за последней строкой.
+	var total int
+	if err := r.querier.QueryRow(ctx, "SELECT COUNT(*) FROM prs p"+where, args).Scan(&total); err != nil {
+		r.log.Error("ListPRs count failed", "err", err)
+		return nil, 0, err
+	}
+
+	query := `SELECT p.id, p.title, p.author_id, p.status, p.created_at, p.merged_at, p.updated_at, p.version,
+		COALESCE(array_agg(r_all.reviewer_id ORDER BY r_all.assigned_at) FILTER (WHERE r_all.reviewer_id IS NOT NULL), '{}') AS reviewers
+		FROM prs p
+		LEFT JOIN pr_reviewers r_all ON p.id = r_all.pr_id` + where + `
+		GROUP BY p.id, p.title, p.author_id, p.status, p.created_at, p.merged_at, p.updated_at, p.version
+		ORDER BY p.created_at DESC, p.id
+		LIMIT @limit OFFSET @offset;`
+
+	rows, err := r.querier.Query(ctx, query, args)
+	if err != nil {
+		r.log.Error("ListPRs query failed", "err", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var res []*models.PullRequest
+	for rows.Next() {
+		var pr models.PullRequest
+		var reviewerIDs []string
+		if err := rows.Scan(&pr.ID, &pr.Title, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.UpdatedAt, &pr.Version, &reviewerIDs); err != nil {
+			r.log.Error("ListPRs scan failed", "err", err)
+			return nil, 0, err
+		}
+		pr.ReviewerIDs = reviewerIDs
+		res = append(res, &pr)
+	}
+	if rows.Err() != nil {
+		return nil, 0, rows.Err()
+	}
+	return res, total, nil
+}
+
+// feedLockKey — ключ advisory-блокировки, под которой выдаются id событий ленты.
+const feedLockKey int64 = 0x72657669657766
+
+// AppendFeedEvents записывает события ленты. id выдаются под транзакционной advisory-блокировкой,
+// которая держится до коммита: поэтому порядок id совпадает с порядком коммитов, и клиент,
+// продолжающий ленту с Last-Event-ID, не пропустит событие с меньшим id, закоммиченное позже.
+func (r *PRRepository) AppendFeedEvents(ctx context.Context, events []*models.ReviewFeedEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if _, err := r.querier.Exec(ctx, `SELECT pg_advisory_xact_lock(@key)`, pgx.NamedArgs{"key": feedLockKey}); err != nil {
+		r.log.Error("AppendFeedEvents lock failed", "err", err)
+		return err
+	}
+	const q = `
+		INSERT INTO review_feed_events (user_id, pr_id, event, reason, actor, created_at)
+		VALUES (@user_id, @pr_id, @event, @reason, @actor, now())
+		RETURNING id, created_at;
+	`
+	batch := &pgx.Batch{}
+	for _, e := range events {
+		if e.UserID == "" || e.PRID == "" || e.Type == "" {
+			return utils.ErrInvalidArgument
+		}
+		batch.Queue(q, pgx.NamedArgs{"user_id": e.UserID, "pr_id": e.PRID, "event": string(e.Type), "reason": string(e.Reason), "actor": e.Actor})
+	}
+	br := r.querier.SendBatch(ctx, batch)
+	for _, e := range events {
+		if err := br.QueryRow().Scan(&e.ID, &e.CreatedAt); err != nil {
+			_ = br.Close()
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+				return utils.ErrUserNotFound
+			}
+			r.log.Error("AppendFeedEvents insert failed", "user_id", e.UserID, "pr_id", e.PRID, "err", err)
+			return err
+		}
+	}
+	if err := br.Close(); err != nil {
+		r.log.Error("AppendFeedEvents batch close failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *PRRepository) ListFeedEvents(ctx context.Context, userID string, afterID int64, limit int) ([]*models.ReviewFeedEvent, error) {
+	const q = `
+		SELECT e.id, e.user_id, e.event, e.pr_id, p.title, e.reason, e.actor, e.created_at
+		FROM review_feed_events e
+		JOIN prs p ON p.id = e.pr_id
+		WHERE e.user_id = @user_id AND e.id > @after_id
+		ORDER BY e.id
+		LIMIT @limit;
+	`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"user_id": userID, "after_id": afterID, "limit": limit})
+	if err != nil {
+		r.log.Error("ListFeedEvents query failed", "user_id", userID, "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	var res []*models.ReviewFeedEvent
+	for rows.Next() {
+		var e models.ReviewFeedEvent
+		if err := rows.Scan(&e.ID, &e.UserID, &e.Type, &e.PRID, &e.PRTitle, &e.Reason, &e.Actor, &e.CreatedAt); err != nil {
+			r.log.Error("ListFeedEvents scan failed", "user_id", userID, "err", err)
+			return nil, err
+		}
+		res = append(res, &e)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
+func (r *PRRepository) SetVCSLink(ctx context.Context, link *models.VCSPullRequestLink) error {
+	const q = `
+		INSERT INTO vcs_pull_requests (pr_id, provider, repository, number, updated_at)
+		VALUES (@pr_id, @provider, @repository, @number, now())
+		ON CONFLICT (pr_id) DO UPDATE
+		SET provider = EXCLUDED.provider, repository = EXCLUDED.repository, number = EXCLUDED.number, updated_at = now();
+	`
+	args := pgx.NamedArgs{"pr_id": link.PRID, "provider": link.Provider, "repository": link.Repository, "number": link.Number}
+	if _, err := r.querier.Exec(ctx, q, args); err != nil {
+		r.log.Error("SetVCSLink failed", "pr_id", link.PRID, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *PRRepository) GetVCSLink(ctx context.Context, prID string) (*models.VCSPullRequestLink, error) {
+	const q = `
+		SELECT pr_id, provider, repository, number
+		FROM vcs_pull_requests
+		WHERE pr_id = @pr_id;
+	`
+	var link models.VCSPullRequestLink
+	err := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"pr_id": prID}).Scan(&link.PRID, &link.Provider, &link.Repository, &link.Number)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrVCSLinkNotFound
+		}
+		r.log.Error("GetVCSLink failed", "pr_id", prID, "err", err)
+		return nil, err
+	}
+	return &link, nil
+}
+
+func (r *PRRepository) AddDigestEvents(ctx context.Context, events []*models.ReviewFeedEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	userIDs := make([]string, 0, len(events))
+	eventIDs := make([]int64, 0, len(events))
+	for _, e := range events {
+		userIDs = append(userIDs, e.UserID)
+		eventIDs = append(eventIDs, e.ID)
+	}
+	const q = `
+		INSERT INTO notification_digest (user_id, event_id)
+		SELECT unnest(@user_ids::text[]), unnest(@event_ids::bigint[])
+		ON CONFLICT DO NOTHING;
+	`
+	if _, err := r.querier.Exec(ctx, q, pgx.NamedArgs{"user_ids": userIDs, "event_ids": eventIDs}); err != nil {
+		r.log.Error("AddDigestEvents failed", "events", len(events), "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *PRRepository) ListDigestEvents(ctx context.Context) ([]*models.ReviewFeedEvent, error) {
+	const q = `
+		SELECT e.id, e.user_id, e.event, e.pr_id, p.title, e.reason, e.actor, e.created_at
+		FROM notification_digest d
+		JOIN review_feed_events e ON e.id = d.event_id
+		JOIN prs p ON p.id = e.pr_id
+		ORDER BY d.user_id, e.id;
+	`
+	rows, err := r.querier.Query(ctx, q)
+	if err != nil {
+		r.log.Error("ListDigestEvents query failed", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	var res []*models.ReviewFeedEvent
+	for rows.Next() {
+		var e models.ReviewFeedEvent
+		if err := rows.Scan(&e.ID, &e.UserID, &e.Type, &e.PRID, &e.PRTitle, &e.Reason, &e.Actor, &e.CreatedAt); err != nil {
+			r.log.Error("ListDigestEvents scan failed", "err", err)
+			return nil, err
+		}
+		res = append(res, &e)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
+func (r *PRRepository) DeleteDigestEvents(ctx context.Context, userID string, throughID int64) error {
+	const q = `
+		DELETE FROM notification_digest
+		WHERE user_id = @user_id AND event_id <= @through_id;
+	`
+	if _, err := r.querier.Exec(ctx, q, pgx.NamedArgs{"user_id": userID, "through_id": throughID}); err != nil {
+		r.log.Error("DeleteDigestEvents failed", "user_id", userID, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *PRRepository) ClaimDigestDay(ctx context.Context, day string) (bool, error) {
+	const q = `
+		INSERT INTO notification_digest_runs (day)
+		VALUES (@day::date)
+		ON CONFLICT (day) DO NOTHING;
+	`
+	tag, err := r.querier.Exec(ctx, q, pgx.NamedArgs{"day": day})
+	if err != nil {
+		r.log.Error("ClaimDigestDay failed", "day", day, "err", err)
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
@@ -7,6 +7,7 @@ import (
 	"avito-test-pr-service/internal/infrastructure/persistence/postgres"
 	"avito-test-pr-service/internal/utils"
 	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -34,13 +35,14 @@ func (r *TeamRepository) CreateTeam(ctx context.Context, team *models.Team) erro
 	const q = `
 		INSERT INTO teams (id, name, created_at, updated_at)
 		VALUES (@id, @name, now(), now())
-		RETURNING id, name, created_at, updated_at;
+		RETURNING id, name, created_at, updated_at, version;
 	`
 	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"id": team.ID, "name": team.Name})
 	var createdID uuid.UUID
 	var createdName string
 	var createdAt, updatedAt time.Time
-	if err := row.Scan(&createdID, &createdName, &createdAt, &updatedAt); err != nil {
+	var version int64
+	if err := row.Scan(&createdID, &createdName, &createdAt, &updatedAt, &version); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique violation on name
 			r.log.Error("CreateTeam unique violation", "code", pgErr.Code, "constraint", pgErr.ConstraintName, "team_name", team.Name, "err", pgErr)
@@ -56,18 +58,19 @@ func (r *TeamRepository) CreateTeam(ctx context.Context, team *models.Team) erro
 	team.Name = createdName
 	team.CreatedAt = createdAt
 	team.UpdatedAt = updatedAt
+	team.Version = version
 	return nil
 }
 
 func (r *TeamRepository) GetTeamByID(ctx context.Context, id uuid.UUID) (*models.Team, error) {
 	const q = `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, selection_strategy, selection_params, created_at, updated_at, version
 		FROM teams
 		WHERE id = @id;
 	`
 	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"id": id})
 	var t models.Team
-	if err := row.Scan(&t.ID, &t.Name, &t.CreatedAt, &t.UpdatedAt); err != nil {
+	if err := row.Scan(&t.ID, &t.Name, &t.SelectionStrategy, &t.SelectionParams, &t.CreatedAt, &t.UpdatedAt, &t.Version); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, utils.ErrTeamNotFound
 		}
@@ -79,13 +82,13 @@ func (r *TeamRepository) GetTeamByID(ctx context.Context, id uuid.UUID) (*models
 
 func (r *TeamRepository) GetTeamByName(ctx context.Context, name string) (*models.Team, error) {
 	const q = `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, selection_strategy, selection_params, created_at, updated_at, version
 		FROM teams
 		WHERE name = @name;
 	`
 	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"name": name})
 	var t models.Team
-	if err := row.Scan(&t.ID, &t.Name, &t.CreatedAt, &t.UpdatedAt); err != nil {
+	if err := row.Scan(&t.ID, &t.Name, &t.SelectionStrategy, &t.SelectionParams, &t.CreatedAt, &t.UpdatedAt, &t.Version); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, utils.ErrTeamNotFound
 		}
@@ -95,9 +98,29 @@ func (r *TeamRepository) GetTeamByName(ctx context.Context, name string) (*model
 	return &t, nil
 }
 
+// LockTeamByName возвращает команду и блокирует её строку до конца транзакции.
+func (r *TeamRepository) LockTeamByName(ctx context.Context, name string) (*models.Team, error) {
+	const q = `
+		SELECT id, name, selection_strategy, selection_params, created_at, updated_at, version
+		FROM teams
+		WHERE name = @name
+		FOR UPDATE;
+	`
+	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"name": name})
+	var t models.Team
+	if err := row.Scan(&t.ID, &t.Name, &t.SelectionStrategy, &t.SelectionParams, &t.CreatedAt, &t.UpdatedAt, &t.Version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrTeamNotFound
+		}
+		r.log.Error("LockTeamByName failed", "team_name", name, "err", err)
+		return nil, err
+	}
+	return &t, nil
+}
+
 func (r *TeamRepository) ListTeams(ctx context.Context) ([]*models.Team, error) {
 	const q = `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, selection_strategy, selection_params, created_at, updated_at, version
 		FROM teams;
 	`
 	rows, err := r.querier.Query(ctx, q)
@@ -110,7 +133,7 @@ func (r *TeamRepository) ListTeams(ctx context.Context) ([]*models.Team, error)
 	var res []*models.Team
 	for rows.Next() {
 		var t models.Team
-		if err := rows.Scan(&t.ID, &t.Name, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		if err := rows.Scan(&t.ID, &t.Name, &t.SelectionStrategy, &t.SelectionParams, &t.CreatedAt, &t.UpdatedAt, &t.Version); err != nil {
 			r.log.Error("ListTeams scan failed", "err", err)
 			return nil, err
 		}
@@ -122,11 +145,41 @@ func (r *TeamRepository) ListTeams(ctx context.Context) ([]*models.Team, error)
 	return res, nil
 }
 
+func (r *TeamRepository) UpdateSelectionStrategy(ctx context.Context, teamID uuid.UUID, strategy string, params json.RawMessage) error {
+	if len(params) == 0 {
+		params = json.RawMessage(`{}`)
+	}
+	const q = `
+		UPDATE teams
+		SET selection_strategy = @strategy,
+			selection_params = @params,
+			updated_at = now(),
+			version = version + 1
+		WHERE id = @id;
+	`
+	tag, err := r.querier.Exec(ctx, q, pgx.NamedArgs{"id": teamID, "strategy": strategy, "params": params})
+	if err != nil {
+		r.log.Error("UpdateSelectionStrategy failed", "team_id", teamID, "strategy", strategy, "err", err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrTeamNotFound
+	}
+	return nil
+}
+
 func (r *TeamRepository) AddMember(ctx context.Context, teamID uuid.UUID, userID string) error {
+	// Изменение состава меняет версию команды; уже состоящий в команде участник её не трогает.
 	const q = `
-		INSERT INTO team_members (team_id, user_id)
-		VALUES (@team_id, @user_id)
-		ON CONFLICT DO NOTHING;
+		WITH ins AS (
+			INSERT INTO team_members (team_id, user_id)
+			VALUES (@team_id, @user_id)
+			ON CONFLICT DO NOTHING
+			RETURNING team_id
+		)
+		UPDATE teams SET version = version + 1
+		FROM ins
+		WHERE teams.id = ins.team_id;
 	`
 	tag, err := r.querier.Exec(ctx, q, pgx.NamedArgs{"team_id": teamID, "user_id": userID})
 	if err != nil {
@@ -160,9 +213,15 @@ func (r *TeamRepository) AddMember(ctx context.Context, teamID uuid.UUID, userID
 
 func (r *TeamRepository) RemoveMember(ctx context.Context, teamID uuid.UUID, userID string) error {
 	const q = `
-		DELETE FROM team_members
-		WHERE team_id = @team_id AND user_id = @user_id
-		RETURNING team_id;
+		WITH del AS (
+			DELETE FROM team_members
+			WHERE team_id = @team_id AND user_id = @user_id
+			RETURNING team_id
+		)
+		UPDATE teams SET version = version + 1
+		FROM del
+		WHERE teams.id = del.team_id
+		RETURNING teams.id;
 	`
 	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"team_id": teamID, "user_id": userID})
 	var returnedTeamID uuid.UUID
@@ -179,3 +238,178 @@ func (r *TeamRepository) RemoveMember(ctx context.Context, teamID uuid.UUID, use
 	}
 	return nil
 }
+
+func (r *TeamRepository) ReplaceCodeOwners(ctx context.Context, teamID uuid.UUID, rules []models.CodeOwnerRule) error {
+	if err := r.bumpVersion(ctx, teamID); err != nil {
+		return err
+	}
+	const del = `DELETE FROM team_code_owners WHERE team_id = @team_id;`
+	if _, err := r.querier.Exec(ctx, del, pgx.NamedArgs{"team_id": teamID}); err != nil {
+		r.log.Error("ReplaceCodeOwners delete failed", "team_id", teamID, "err", err)
+		return err
+	}
+	const ins = `
+		INSERT INTO team_code_owners (team_id, position, pattern, owner_ids)
+		VALUES (@team_id, @position, @pattern, @owner_ids);
+	`
+	for i, rule := range rules {
+		args := pgx.NamedArgs{"team_id": teamID, "position": i, "pattern": rule.Pattern, "owner_ids": rule.OwnerIDs}
+		if _, err := r.querier.Exec(ctx, ins, args); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+				return utils.ErrTeamNotFound
+			}
+			r.log.Error("ReplaceCodeOwners insert failed", "team_id", teamID, "pattern", rule.Pattern, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// bumpVersion увеличивает версию команды при замене её настроек.
+func (r *TeamRepository) bumpVersion(ctx context.Context, teamID uuid.UUID) error {
+	const q = `UPDATE teams SET version = version + 1, updated_at = now() WHERE id = @id;`
+	tag, err := r.querier.Exec(ctx, q, pgx.NamedArgs{"id": teamID})
+	if err != nil {
+		r.log.Error("bump team version failed", "team_id", teamID, "err", err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrTeamNotFound
+	}
+	return nil
+}
+
+func (r *TeamRepository) ListCodeOwners(ctx context.Context, teamID uuid.UUID) ([]models.CodeOwnerRule, error) {
+	const q = `
+		SELECT pattern, owner_ids
+		FROM team_code_owners
+		WHERE team_id = @team_id
+		ORDER BY position;
+	`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"team_id": teamID})
+	if err != nil {
+		r.log.Error("ListCodeOwners query failed", "team_id", teamID, "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	var res []models.CodeOwnerRule
+	for rows.Next() {
+		var rule models.CodeOwnerRule
+		if err := rows.Scan(&rule.Pattern, &rule.OwnerIDs); err != nil {
+			r.log.Error("ListCodeOwners scan failed", "team_id", teamID, "err", err)
+			return nil, err
+		}
+		res = append(res, rule)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
+func (r *TeamRepository) ReplaceReviewerConstraints(ctx context.Context, teamID uuid.UUID, constraints []models.ReviewerConstraint) error {
+	if err := r.bumpVersion(ctx, teamID); err != nil {
+		return err
+	}
+	const del = `DELETE FROM team_reviewer_constraints WHERE team_id = @team_id;`
+	if _, err := r.querier.Exec(ctx, del, pgx.NamedArgs{"team_id": teamID}); err != nil {
+		r.log.Error("ReplaceReviewerConstraints delete failed", "team_id", teamID, "err", err)
+		return err
+	}
+	const ins = `
+		INSERT INTO team_reviewer_constraints (team_id, position, kind, author_ids, reviewer_ids)
+		VALUES (@team_id, @position, @kind, @author_ids, @reviewer_ids);
+	`
+	for i, c := range constraints {
+		authorIDs := c.AuthorIDs
+		if authorIDs == nil {
+			authorIDs = []string{}
+		}
+		args := pgx.NamedArgs{"team_id": teamID, "position": i, "kind": c.Kind, "author_ids": authorIDs, "reviewer_ids": c.ReviewerIDs}
+		if _, err := r.querier.Exec(ctx, ins, args); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+				return utils.ErrTeamNotFound
+			}
+			r.log.Error("ReplaceReviewerConstraints insert failed", "team_id", teamID, "kind", c.Kind, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *TeamRepository) ListReviewerConstraints(ctx context.Context, teamID uuid.UUID) ([]models.ReviewerConstraint, error) {
+	const q = `
+		SELECT kind, author_ids, reviewer_ids
+		FROM team_reviewer_constraints
+		WHERE team_id = @team_id
+		ORDER BY position;
+	`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"team_id": teamID})
+	if err != nil {
+		r.log.Error("ListReviewerConstraints query failed", "team_id", teamID, "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	var res []models.ReviewerConstraint
+	for rows.Next() {
+		var c models.ReviewerConstraint
+		if err := rows.Scan(&c.Kind, &c.AuthorIDs, &c.ReviewerIDs); err != nil {
+			r.log.Error("ListReviewerConstraints scan failed", "team_id", teamID, "err", err)
+			return nil, err
+		}
+		res = append(res, c)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
+// LockRotationCursor создаёт курсор ротации команды при отсутствии и блокирует его до конца транзакции.
+func (r *TeamRepository) LockRotationCursor(ctx context.Context, teamID uuid.UUID) (string, error) {
+	const ensure = `
+		INSERT INTO team_rotation_cursors (team_id)
+		VALUES (@team_id)
+		ON CONFLICT (team_id) DO NOTHING;
+	`
+	if _, err := r.querier.Exec(ctx, ensure, pgx.NamedArgs{"team_id": teamID}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return "", utils.ErrTeamNotFound
+		}
+		r.log.Error("LockRotationCursor ensure failed", "team_id", teamID, "err", err)
+		return "", err
+	}
+	const q = `
+		SELECT last_user_id
+		FROM team_rotation_cursors
+		WHERE team_id = @team_id
+		FOR UPDATE;
+	`
+	var last string
+	if err := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"team_id": teamID}).Scan(&last); err != nil {
+		r.log.Error("LockRotationCursor select failed", "team_id", teamID, "err", err)
+		return "", err
+	}
+	return last, nil
+}
+
+func (r *TeamRepository) SetRotationCursor(ctx context.Context, teamID uuid.UUID, userID string) error {
+	const q = `
+		UPDATE team_rotation_cursors
+		SET last_user_id = @user_id,
+			updated_at = now()
+		WHERE team_id = @team_id;
+	`
+	tag, err := r.querier.Exec(ctx, q, pgx.NamedArgs{"team_id": teamID, "user_id": userID})
+	if err != nil {
+		r.log.Error("SetRotationCursor failed", "team_id", teamID, "user_id", userID, "err", err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrTeamNotFound
+	}
+	return nil
+}
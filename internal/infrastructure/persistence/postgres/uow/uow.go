@@ -2,32 +2,104 @@ package uow
 
 import (
 	ports "avito-test-pr-service/internal/domain/ports/output"
+	audit_port "avito-test-pr-service/internal/domain/ports/output/audit"
 	pr_port "avito-test-pr-service/internal/domain/ports/output/pr"
 	team_port "avito-test-pr-service/internal/domain/ports/output/team"
 	user_port "avito-test-pr-service/internal/domain/ports/output/user"
 
 	"avito-test-pr-service/internal/domain/ports/output/uow"
+	audit_repo "avito-test-pr-service/internal/infrastructure/persistence/postgres/audit"
 	pr_repo "avito-test-pr-service/internal/infrastructure/persistence/postgres/pr"
 	team_repo "avito-test-pr-service/internal/infrastructure/persistence/postgres/team"
 	user_repo "avito-test-pr-service/internal/infrastructure/persistence/postgres/user"
 	"context"
 	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const (
+	defaultMaxAttempts = 10
+	retryBaseDelay     = 10 * time.Millisecond
+	retryMaxDelay      = 500 * time.Millisecond
+
+	codeSerializationFailure = "40001"
+	codeDeadlockDetected     = "40P01"
+)
+
+// replicaLagQuery возвращает отставание реплики в секундах; на основном сервере и на догнавшей реплике — 0.
+const replicaLagQuery = `
+	SELECT COALESCE(
+		CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		     ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())
+		END, 0)::float8;
+`
+
+var errReplicaStale = errors.New("replica is too stale")
+
+// ReplicaOptions — пул реплики для чтений через BeginRead. MaxStaleness — допустимое отставание,
+// ноль отключает проверку.
+type ReplicaOptions struct {
+	Pool         *pgxpool.Pool
+	MaxStaleness time.Duration
+}
+
 type PostgresUnitOfWork struct {
-	pool *pgxpool.Pool
-	log  ports.Logger
+	pool    *pgxpool.Pool
+	replica ReplicaOptions
+	log     ports.Logger
 }
 
 func NewPostgresUOW(pool *pgxpool.Pool, log ports.Logger) uow.UnitOfWork {
 	return &PostgresUnitOfWork{pool: pool, log: log}
 }
 
+// NewPostgresUOWWithReplica — UnitOfWork, который отправляет чтения BeginRead на реплику.
+func NewPostgresUOWWithReplica(pool *pgxpool.Pool, replica ReplicaOptions, log ports.Logger) uow.UnitOfWork {
+	return &PostgresUnitOfWork{pool: pool, replica: replica, log: log}
+}
+
 func (puow *PostgresUnitOfWork) Begin(ctx context.Context) (uow.Transaction, error) {
-	tx, err := puow.pool.Begin(ctx)
+	return puow.beginTx(ctx, pgx.TxOptions{})
+}
+
+func (puow *PostgresUnitOfWork) BeginRead(ctx context.Context) (uow.Transaction, error) {
+	if puow.replica.Pool != nil {
+		tx, err := puow.beginReplica(ctx)
+		if err == nil {
+			return tx, nil
+		}
+		puow.log.Warn("replica read unavailable, using primary", "err", err)
+	}
+	return puow.beginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+}
+
+func (puow *PostgresUnitOfWork) beginReplica(ctx context.Context) (uow.Transaction, error) {
+	tx, err := puow.replica.Pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	if puow.replica.MaxStaleness > 0 {
+		var lagSeconds float64
+		if err := tx.QueryRow(ctx, replicaLagQuery).Scan(&lagSeconds); err != nil {
+			_ = tx.Rollback(ctx)
+			return nil, err
+		}
+		if lag := time.Duration(lagSeconds * float64(time.Second)); lag > puow.replica.MaxStaleness {
+			_ = tx.Rollback(ctx)
+			return nil, fmt.Errorf("%w: lag %s", errReplicaStale, lag.Round(time.Millisecond))
+		}
+	}
+	return &PostgresTransaction{tx: tx, log: puow.log}, nil
+}
+
+func (puow *PostgresUnitOfWork) beginTx(ctx context.Context, opts pgx.TxOptions) (uow.Transaction, error) {
+	tx, err := puow.pool.BeginTx(ctx, opts)
 	if err != nil {
 		puow.log.Error("transaction begin failed", "err", err)
 		return nil, err
@@ -35,6 +107,80 @@ func (puow *PostgresUnitOfWork) Begin(ctx context.Context) (uow.Transaction, err
 	return &PostgresTransaction{tx: tx, log: puow.log}, nil
 }
 
+func (puow *PostgresUnitOfWork) Do(ctx context.Context, opts uow.TxOptions, fn func(tx uow.Transaction) error) error {
+	attempts := opts.MaxAttempts
+	if attempts <= 0 {
+		attempts = defaultMaxAttempts
+	}
+	for attempt := 1; ; attempt++ {
+		err := puow.attempt(ctx, opts, attempt, fn)
+		if err == nil || !isRetryable(err) || attempt >= attempts {
+			return err
+		}
+		delay := retryDelay(attempt)
+		puow.log.Warn("transaction retry", "err", err, "attempt", attempt, "retry_in", delay.String())
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (puow *PostgresUnitOfWork) attempt(ctx context.Context, opts uow.TxOptions, attempt int, fn func(tx uow.Transaction) error) error {
+	var (
+		t   uow.Transaction
+		err error
+	)
+	// повтор после конфликта на реплике (например, с восстановлением WAL) идёт на основной пул
+	if opts.ReadOnly && opts.Replica && attempt == 1 {
+		t, err = puow.BeginRead(ctx)
+	} else {
+		txOpts := pgx.TxOptions{IsoLevel: pgx.TxIsoLevel(opts.Isolation)}
+		if opts.ReadOnly {
+			txOpts.AccessMode = pgx.ReadOnly
+		}
+		t, err = puow.beginTx(ctx, txOpts)
+	}
+	if err != nil {
+		return err
+	}
+	if err := fn(t); err != nil {
+		_ = t.Rollback(ctx)
+		return err
+	}
+	// фиксировать в read-only транзакции нечего
+	if opts.ReadOnly {
+		return t.Rollback(ctx)
+	}
+	if err := t.Commit(ctx); err != nil {
+		_ = t.Rollback(ctx)
+		return err
+	}
+	return nil
+}
+
+// isRetryable сообщает, что транзакцию можно безопасно повторить: конфликт сериализации или дедлок.
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == codeSerializationFailure || pgErr.Code == codeDeadlockDetected
+}
+
+// retryDelay возвращает паузу перед следующей попыткой: экспоненциальный рост с джиттером в [d/2, d].
+func retryDelay(attempt int) time.Duration {
+	d := retryBaseDelay << min(attempt-1, 16)
+	if d > retryMaxDelay {
+		d = retryMaxDelay
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
 type PostgresTransaction struct {
 	tx  pgx.Tx
 	log ports.Logger
@@ -67,3 +213,7 @@ func (t *PostgresTransaction) TeamRepository() team_port.TeamRepository {
 func (t *PostgresTransaction) PRRepository() pr_port.PRRepository {
 	return pr_repo.NewPRRepository(t.tx, t.log)
 }
+
+func (t *PostgresTransaction) AuditRepository() audit_port.AuditRepository {
+	return audit_repo.NewAuditRepository(t.tx, t.log)
+}
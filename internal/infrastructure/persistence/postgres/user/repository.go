@@ -50,13 +50,14 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *models.User) erro
 
 func (r *UserRepository) GetUserByID(ctx context.Context, id string) (*models.User, error) {
 	const q = `
-		SELECT id, name, is_active, created_at, updated_at
-		FROM users
-		WHERE id = @id;
+		SELECT u.id, u.name, u.email, u.is_active, u.created_at, u.updated_at,
+			COALESCE((SELECT array_agg(ut.tag ORDER BY ut.tag) FROM user_tags ut WHERE ut.user_id = u.id), '{}')
+		FROM users u
+		WHERE u.id = @id;
 	`
 	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"id": id})
 	var u models.User
-	if err := row.Scan(&u.ID, &u.Name, &u.IsActive, &u.CreatedAt, &u.UpdatedAt); err != nil {
+	if err := row.Scan(&u.ID, &u.Name, &u.Email, &u.IsActive, &u.CreatedAt, &u.UpdatedAt, &u.Tags); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, utils.ErrUserNotFound
 		}
@@ -104,7 +105,7 @@ func (r *UserRepository) UpdateUserActive(ctx context.Context, id string, isActi
 
 func (r *UserRepository) ListUsers(ctx context.Context) ([]*models.User, error) {
 	const q = `
-		SELECT id, name, is_active, created_at, updated_at
+		SELECT id, name, email, is_active, created_at, updated_at
 		FROM users;
 	`
 	rows, err := r.querier.Query(ctx, q)
@@ -120,7 +121,7 @@ func (r *UserRepository) ListUsers(ctx context.Context) ([]*models.User, error)
 	var res []*models.User
 	for rows.Next() {
 		var u models.User
-		if err := rows.Scan(&u.ID, &u.Name, &u.IsActive, &u.CreatedAt, &u.UpdatedAt); err != nil {
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.IsActive, &u.CreatedAt, &u.UpdatedAt); err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) {
 				r.log.Error("ListUsers pg scan error", "code", pgErr.Code, "constraint", pgErr.ConstraintName, "err", pgErr)
@@ -225,7 +226,7 @@ func (r *UserRepository) UpdateUserName(ctx context.Context, id string, name str
 
 func (r *UserRepository) ListMembersByTeamID(ctx context.Context, teamID uuid.UUID) ([]*models.User, error) {
 	const q = `
-		SELECT u.id, u.name, u.is_active, u.created_at, u.updated_at
+		SELECT u.id, u.name, u.email, u.is_active, u.created_at, u.updated_at
 		FROM users u
 		JOIN team_members tm ON u.id = tm.user_id
 		WHERE tm.team_id = @team_id;
@@ -244,7 +245,7 @@ func (r *UserRepository) ListMembersByTeamID(ctx context.Context, teamID uuid.UU
 	var res []*models.User
 	for rows.Next() {
 		var u models.User
-		if err := rows.Scan(&u.ID, &u.Name, &u.IsActive, &u.CreatedAt, &u.UpdatedAt); err != nil {
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.IsActive, &u.CreatedAt, &u.UpdatedAt); err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) {
 				r.log.Error("ListMembersByTeamID pg scan error", "code", pgErr.Code, "constraint", pgErr.ConstraintName, "team_id", teamID, "err", pgErr)
@@ -259,3 +260,257 @@ func (r *UserRepository) ListMembersByTeamID(ctx context.Context, teamID uuid.UU
 	}
 	return res, nil
 }
+
+func (r *UserRepository) ListUsersByIDs(ctx context.Context, ids []string) ([]*models.User, error) {
+	if len(ids) == 0 {
+		return []*models.User{}, nil
+	}
+	const q = `
+		SELECT u.id, u.name, u.email, u.is_active, u.created_at, u.updated_at,
+			COALESCE((SELECT array_agg(ut.tag ORDER BY ut.tag) FROM user_tags ut WHERE ut.user_id = u.id), '{}')
+		FROM users u
+		WHERE u.id = ANY(@ids);
+	`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"ids": ids})
+	if err != nil {
+		r.log.Error("ListUsersByIDs query failed", "count", len(ids), "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	res := make([]*models.User, 0, len(ids))
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.IsActive, &u.CreatedAt, &u.UpdatedAt, &u.Tags); err != nil {
+			r.log.Error("ListUsersByIDs scan failed", "err", err)
+			return nil, err
+		}
+		res = append(res, &u)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
+
+func (r *UserRepository) SetUserTags(ctx context.Context, userID string, tags []string) error {
+	const del = `
+		DELETE FROM user_tags
+		WHERE user_id = @user_id;
+	`
+	if _, err := r.querier.Exec(ctx, del, pgx.NamedArgs{"user_id": userID}); err != nil {
+		r.log.Error("SetUserTags delete failed", "user_id", userID, "err", err)
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	const ins = `
+		INSERT INTO user_tags (user_id, tag)
+		SELECT @user_id, unnest(@tags::text[]);
+	`
+	if _, err := r.querier.Exec(ctx, ins, pgx.NamedArgs{"user_id": userID, "tags": tags}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return utils.ErrUserNotFound
+		}
+		r.log.Error("SetUserTags insert failed", "user_id", userID, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *UserRepository) GetVCSIdentity(ctx context.Context, provider models.VCSProvider, login string) (*models.VCSIdentity, error) {
+	const q = `
+		SELECT provider, login, user_id
+		FROM vcs_identities
+		WHERE provider = @provider AND login = @login;
+	`
+	row := r.querier.QueryRow(ctx, q, pgx.NamedArgs{"provider": provider, "login": login})
+	var id models.VCSIdentity
+	if err := row.Scan(&id.Provider, &id.Login, &id.UserID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrVCSIdentityNotFound
+		}
+		r.log.Error("GetVCSIdentity failed", "provider", provider, "login", login, "err", err)
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (r *UserRepository) SetVCSIdentity(ctx context.Context, identity *models.VCSIdentity) error {
+	const q = `
+		INSERT INTO vcs_identities (provider, login, user_id, updated_at)
+		VALUES (@provider, @login, @user_id, now())
+		ON CONFLICT (provider, login) DO UPDATE
+		SET user_id = EXCLUDED.user_id, updated_at = now();
+	`
+	args := pgx.NamedArgs{"provider": identity.Provider, "login": identity.Login, "user_id": identity.UserID}
+	if _, err := r.querier.Exec(ctx, q, args); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return utils.ErrUserNotFound
+		}
+		r.log.Error("SetVCSIdentity failed", "provider", identity.Provider, "login", identity.Login, "err", err)
+		return err
+	}
+	return nil
+}
+
+// ListVCSIdentities возвращает сопоставления логинов; пустой provider — по всем VCS.
+func (r *UserRepository) ListVCSIdentities(ctx context.Context, provider models.VCSProvider) ([]*models.VCSIdentity, error) {
+	const q = `
+		SELECT provider, login, user_id
+		FROM vcs_identities
+		WHERE @provider::text = '' OR provider = @provider
+		ORDER BY provider, login;
+	`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"provider": string(provider)})
+	if err != nil {
+		r.log.Error("ListVCSIdentities failed", "provider", provider, "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	res := make([]*models.VCSIdentity, 0)
+	for rows.Next() {
+		var id models.VCSIdentity
+		if err := rows.Scan(&id.Provider, &id.Login, &id.UserID); err != nil {
+			r.log.Error("ListVCSIdentities scan failed", "err", err)
+			return nil, err
+		}
+		res = append(res, &id)
+	}
+	return res, rows.Err()
+}
+
+// GetVCSLogins возвращает по одному логину на пользователя (первый по алфавиту, если их несколько).
+func (r *UserRepository) GetVCSLogins(ctx context.Context, provider models.VCSProvider, userIDs []string) (map[string]string, error) {
+	res := make(map[string]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return res, nil
+	}
+	const q = `
+		SELECT user_id, min(login)
+		FROM vcs_identities
+		WHERE provider = @provider AND user_id = ANY(@ids)
+		GROUP BY user_id;
+	`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"provider": provider, "ids": userIDs})
+	if err != nil {
+		r.log.Error("GetVCSLogins failed", "provider", provider, "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var userID, login string
+		if err := rows.Scan(&userID, &login); err != nil {
+			r.log.Error("GetVCSLogins scan failed", "err", err)
+			return nil, err
+		}
+		res[userID] = login
+	}
+	return res, rows.Err()
+}
+
+func (r *UserRepository) GetNotificationPreferences(ctx context.Context, userIDs []string) (map[string]*models.NotificationPreferences, error) {
+	res := make(map[string]*models.NotificationPreferences, len(userIDs))
+	if len(userIDs) == 0 {
+		return res, nil
+	}
+	const q = `
+		SELECT user_id, chat_handle, delivery, email_delivery, kinds
+		FROM notification_preferences
+		WHERE user_id = ANY(@ids);
+	`
+	rows, err := r.querier.Query(ctx, q, pgx.NamedArgs{"ids": userIDs})
+	if err != nil {
+		r.log.Error("GetNotificationPreferences failed", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var p models.NotificationPreferences
+		var kinds []string
+		if err := rows.Scan(&p.UserID, &p.ChatHandle, &p.Delivery, &p.EmailDelivery, &kinds); err != nil {
+			r.log.Error("GetNotificationPreferences scan failed", "err", err)
+			return nil, err
+		}
+		p.Kinds = make([]models.NotificationKind, 0, len(kinds))
+		for _, k := range kinds {
+			p.Kinds = append(p.Kinds, models.NotificationKind(k))
+		}
+		res[p.UserID] = &p
+	}
+	return res, rows.Err()
+}
+
+func (r *UserRepository) SetNotificationPreferences(ctx context.Context, prefs *models.NotificationPreferences) error {
+	kinds := make([]string, 0, len(prefs.Kinds))
+	for _, k := range prefs.Kinds {
+		kinds = append(kinds, string(k))
+	}
+	const q = `
+		INSERT INTO notification_preferences (user_id, chat_handle, delivery, email_delivery, kinds, updated_at)
+		VALUES (@user_id, @chat_handle, @delivery, @email_delivery, @kinds, now())
+		ON CONFLICT (user_id) DO UPDATE
+		SET chat_handle = EXCLUDED.chat_handle, delivery = EXCLUDED.delivery, email_delivery = EXCLUDED.email_delivery,
+			kinds = EXCLUDED.kinds, updated_at = now();
+	`
+	args := pgx.NamedArgs{"user_id": prefs.UserID, "chat_handle": prefs.ChatHandle, "delivery": string(prefs.Delivery),
+		"email_delivery": string(prefs.EmailDelivery), "kinds": kinds}
+	if _, err := r.querier.Exec(ctx, q, args); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return utils.ErrUserNotFound
+		}
+		r.log.Error("SetNotificationPreferences failed", "user_id", prefs.UserID, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *UserRepository) SetUserEmail(ctx context.Context, id string, email string) error {
+	const q = `
+		UPDATE users
+		SET email = @email,
+			updated_at = now()
+		WHERE id = @id;
+	`
+	tag, err := r.querier.Exec(ctx, q, pgx.NamedArgs{"id": id, "email": email})
+	if err != nil {
+		r.log.Error("SetUserEmail failed", "user_id", id, "err", err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UserRepository) ListEmailDigestRecipients(ctx context.Context) ([]*models.User, error) {
+	const q = `
+		SELECT u.id, u.name, u.email, u.is_active, u.created_at, u.updated_at
+		FROM users u
+		JOIN notification_preferences np ON np.user_id = u.id
+		WHERE np.email_delivery = 'digest' AND u.email <> '' AND u.is_active
+		ORDER BY u.id;
+	`
+	rows, err := r.querier.Query(ctx, q)
+	if err != nil {
+		r.log.Error("ListEmailDigestRecipients query failed", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+	var res []*models.User
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.IsActive, &u.CreatedAt, &u.UpdatedAt); err != nil {
+			r.log.Error("ListEmailDigestRecipients scan failed", "err", err)
+			return nil, err
+		}
+		res = append(res, &u)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return res, nil
+}
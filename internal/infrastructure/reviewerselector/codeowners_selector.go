@@ -0,0 +1,88 @@
+package reviewerselector
+
+import (
+	"context"
+
+	"avito-test-pr-service/internal/domain/models"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/internal/utils"
+)
+
+var _ services.RotationSelector = (*CodeOwnersSelector)(nil)
+
+// CodeOwnersSelector отдаёт слоты владельцам затронутых путей (по правилам CODEOWNERS команды),
+// оставшиеся слоты заполняет fallback-стратегия.
+type CodeOwnersSelector struct {
+	fallback services.ReviewerSelector
+}
+
+func NewCodeOwnersSelector(fallback services.ReviewerSelector) services.ReviewerSelector {
+	return &CodeOwnersSelector{fallback: fallback}
+}
+
+func (s *CodeOwnersSelector) Select(ctx context.Context, tx uow.Transaction, req services.SelectionRequest) (*services.Selection, error) {
+	if req.Count <= 0 || len(req.Candidates) == 0 {
+		return &services.Selection{Strategy: StrategyCodeOwners}, nil
+	}
+	if len(req.FilePaths) == 0 {
+		return s.withFallback(ctx, tx, req, nil, nil)
+	}
+	rules, err := tx.TeamRepository().ListCodeOwners(ctx, req.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	owners, counts := services.MatchCodeOwnersWithCounts(rules, req.FilePaths)
+	var picked []string
+	var ownerScores []models.CandidateScore
+	for _, owner := range owners {
+		if !utils.ContainsString(req.Candidates, owner) {
+			continue
+		}
+		selected := len(picked) < req.Count
+		if selected {
+			picked = append(picked, owner)
+		}
+		ownerScores = append(ownerScores, models.CandidateScore{
+			UserID:   owner,
+			Score:    float64(counts[owner]),
+			Factors:  map[string]float64{"owned_paths": float64(counts[owner])},
+			Selected: selected,
+		})
+	}
+	return s.withFallback(ctx, tx, req, picked, ownerScores)
+}
+
+// withFallback дозаполняет оставшиеся после владельцев слоты fallback-стратегией и склеивает объяснения.
+func (s *CodeOwnersSelector) withFallback(ctx context.Context, tx uow.Transaction, req services.SelectionRequest, picked []string, ownerScores []models.CandidateScore) (*services.Selection, error) {
+	res := &services.Selection{ReviewerIDs: picked, Scores: ownerScores}
+	exclude := make(map[string]struct{}, len(ownerScores))
+	for _, sc := range ownerScores {
+		exclude[sc.UserID] = struct{}{}
+	}
+	rest := req
+	rest.Candidates = utils.FilterStrings(req.Candidates, exclude)
+	rest.Count = req.Count - len(picked)
+	if rest.Count <= 0 || len(rest.Candidates) == 0 {
+		res.Strategy = StrategyCodeOwners
+		return res, nil
+	}
+	extra, err := s.fallback.Select(ctx, tx, rest)
+	if err != nil {
+		return nil, err
+	}
+	res.Strategy = StrategyCodeOwners + "+" + extra.Strategy
+	res.ReviewerIDs = append(res.ReviewerIDs, extra.ReviewerIDs...)
+	res.Scores = append(res.Scores, extra.Scores...)
+	return res, nil
+}
+
+// Advance передаёт сдвиг курсора fallback-стратегии, если у неё есть очередь.
+func (s *CodeOwnersSelector) Advance(ctx context.Context, tx uow.Transaction, req services.SelectionRequest, selected []string) error {
+	rotation, ok := s.fallback.(services.RotationSelector)
+	if !ok {
+		return nil
+	}
+	return rotation.Advance(ctx, tx, req, selected)
+}
@@ -0,0 +1,96 @@
+package reviewerselector
+
+import (
+	"context"
+	"errors"
+	rand "math/rand/v2"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeOwnersSelector_Select(t *testing.T) {
+	ctx := context.Background()
+	teamID := uuid.New()
+	rules := []models.CodeOwnerRule{
+		{Pattern: "*", OwnerIDs: []string{"lead"}},
+		{Pattern: "/internal/db/", OwnerIDs: []string{"dba", "inactive"}},
+	}
+
+	tests := []struct {
+		name       string
+		req        services.SelectionRequest
+		rules      []models.CodeOwnerRule
+		rulesErr   error
+		noLookup   bool
+		wantOwners []string
+		wantLen    int
+		wantErr    error
+	}{
+		{
+			name:       "owner first, random fills the rest",
+			req:        services.SelectionRequest{TeamID: teamID, Candidates: []string{"dba", "a", "b"}, Count: 2, FilePaths: []string{"internal/db/conn.go"}},
+			rules:      rules,
+			wantOwners: []string{"dba"},
+			wantLen:    2,
+		},
+		{
+			name:       "owners fill all slots",
+			req:        services.SelectionRequest{TeamID: teamID, Candidates: []string{"a", "lead", "dba"}, Count: 2, FilePaths: []string{"internal/db/conn.go", "main.go"}},
+			rules:      rules,
+			wantOwners: []string{"dba", "lead"},
+			wantLen:    2,
+		},
+		{
+			name:    "owners outside candidates ignored",
+			req:     services.SelectionRequest{TeamID: teamID, Candidates: []string{"a"}, Count: 2, FilePaths: []string{"internal/db/conn.go"}},
+			rules:   rules,
+			wantLen: 1,
+		},
+		{
+			name:     "no paths -> fallback only",
+			req:      services.SelectionRequest{TeamID: teamID, Candidates: []string{"a", "b", "c"}, Count: 2},
+			noLookup: true,
+			wantLen:  2,
+		},
+		{
+			name:     "rules lookup error",
+			req:      services.SelectionRequest{TeamID: teamID, Candidates: []string{"a"}, Count: 1, FilePaths: []string{"x"}},
+			rulesErr: errors.New("db down"),
+			wantErr:  errors.New("db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := mocks.NewTransaction(t)
+			if !tt.noLookup {
+				teamRepo := mocks.NewTeamRepository(t)
+				tx.EXPECT().TeamRepository().Return(teamRepo)
+				teamRepo.EXPECT().ListCodeOwners(ctx, teamID).Return(tt.rules, tt.rulesErr)
+			}
+			sel := NewCodeOwnersSelector(NewRandomReviewerSelectorWithRand(rand.New(rand.NewPCG(1, 2))))
+			res, err := sel.Select(ctx, tx, tt.req)
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			got := res.ReviewerIDs
+			require.Len(t, got, tt.wantLen)
+			require.ElementsMatch(t, tt.wantOwners, got[:len(tt.wantOwners)])
+			seen := make(map[string]struct{}, len(got))
+			for _, id := range got {
+				require.Contains(t, tt.req.Candidates, id)
+				_, dup := seen[id]
+				require.False(t, dup, "duplicate reviewer returned")
+				seen[id] = struct{}{}
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package reviewerselector
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	"avito-test-pr-service/internal/domain/services"
+)
+
+// Имена стратегий в реестре; значение хранится в teams.selection_strategy.
+const (
+	StrategyRandom     = "random"
+	StrategyWeighted   = "weighted"
+	StrategyRoundRobin = "roundrobin"
+	StrategyCodeOwners = "codeowners"
+)
+
+// CodeOwnersParams — параметры стратегии codeowners: стратегия для слотов, не занятых владельцами.
+type CodeOwnersParams struct {
+	Fallback       string          `json:"fallback"`
+	FallbackParams json.RawMessage `json:"fallback_params,omitempty"`
+}
+
+func RandomFactory(params json.RawMessage) (services.ReviewerSelector, error) {
+	if err := decodeParams(params, &struct{}{}); err != nil {
+		return nil, err
+	}
+	return NewRandomReviewerSelector(), nil
+}
+
+func RoundRobinFactory(params json.RawMessage) (services.ReviewerSelector, error) {
+	if err := decodeParams(params, &struct{}{}); err != nil {
+		return nil, err
+	}
+	return NewRoundRobinSelector(), nil
+}
+
+// WeightedFactory накладывает переданные параметры на DefaultWeightedParams.
+func WeightedFactory(params json.RawMessage) (services.ReviewerSelector, error) {
+	p := DefaultWeightedParams()
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	if p.TagWeight < 0 || p.LoadPenalty < 0 || p.Jitter < 0 {
+		return nil, errors.New("weights must be non-negative")
+	}
+	return NewWeightedSelector(p), nil
+}
+
+// CodeOwnersFactory строит codeowners поверх fallback-стратегии из того же реестра (по умолчанию random;
+// weighted команда включает явно через fallback).
+func CodeOwnersFactory(reg services.SelectorResolver) services.SelectorFactory {
+	return func(params json.RawMessage) (services.ReviewerSelector, error) {
+		p := CodeOwnersParams{Fallback: StrategyRandom}
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		if p.Fallback == StrategyCodeOwners {
+			return nil, errors.New("fallback must differ from codeowners")
+		}
+		fallback, err := reg.Resolve(p.Fallback, p.FallbackParams)
+		if err != nil {
+			return nil, err
+		}
+		return NewCodeOwnersSelector(fallback), nil
+	}
+}
+
+// decodeParams строго разбирает JSON-параметры: неизвестные поля — ошибка, пустые параметры и null допустимы.
+func decodeParams(raw json.RawMessage, dst any) error {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(trimmed))
+	dec.DisallowUnknownFields()
+	return dec.Decode(dst)
+}
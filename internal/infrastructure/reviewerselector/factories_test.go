@@ -0,0 +1,59 @@
+package reviewerselector
+
+import (
+	"encoding/json"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/internal/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactories(t *testing.T) {
+	reg := services.NewSelectorRegistry(StrategyCodeOwners)
+	reg.Register(StrategyRandom, RandomFactory)
+	reg.Register(StrategyWeighted, WeightedFactory)
+	reg.Register(StrategyRoundRobin, RoundRobinFactory)
+	reg.Register(StrategyCodeOwners, CodeOwnersFactory(reg))
+
+	tests := []struct {
+		name     string
+		strategy string
+		params   string
+		wantType any
+		wantErr  error
+	}{
+		{name: "default is codeowners", wantType: &CodeOwnersSelector{}},
+		{name: "random", strategy: StrategyRandom, wantType: &RandomReviewerSelector{}},
+		{name: "roundrobin", strategy: StrategyRoundRobin, params: `null`, wantType: &RoundRobinSelector{}},
+		{name: "weighted partial params", strategy: StrategyWeighted, params: `{"jitter":0}`, wantType: &WeightedSelector{}},
+		{name: "weighted negative weight", strategy: StrategyWeighted, params: `{"load_penalty":-1}`, wantErr: utils.ErrInvalidStrategyParams},
+		{name: "weighted unknown field", strategy: StrategyWeighted, params: `{"speed":1}`, wantErr: utils.ErrInvalidStrategyParams},
+		{name: "codeowners over roundrobin", strategy: StrategyCodeOwners, params: `{"fallback":"roundrobin"}`, wantType: &CodeOwnersSelector{}},
+		{name: "codeowners unknown fallback", strategy: StrategyCodeOwners, params: `{"fallback":"magic"}`, wantErr: utils.ErrUnknownStrategy},
+		{name: "codeowners self fallback", strategy: StrategyCodeOwners, params: `{"fallback":"codeowners"}`, wantErr: utils.ErrInvalidStrategyParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel, err := reg.Resolve(tt.strategy, json.RawMessage(tt.params))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, tt.wantType, sel)
+		})
+	}
+
+	sel, err := reg.Resolve(StrategyCodeOwners, nil)
+	require.NoError(t, err)
+	require.IsType(t, &RandomReviewerSelector{}, sel.(*CodeOwnersSelector).fallback)
+	sel, err = reg.Resolve(StrategyCodeOwners, json.RawMessage(`{"fallback":"weighted"}`))
+	require.NoError(t, err)
+	require.IsType(t, &WeightedSelector{}, sel.(*CodeOwnersSelector).fallback)
+
+	sel, err = reg.Resolve(StrategyWeighted, json.RawMessage(`{"jitter":0}`))
+	require.NoError(t, err)
+	require.Equal(t, WeightedParams{TagWeight: 1.0, LoadPenalty: 0.3, Jitter: 0}, sel.(*WeightedSelector).params)
+}
@@ -1,11 +1,14 @@
 package reviewerselector
 
 import (
+	"context"
 	"sync"
 	"time"
 
 	rand "math/rand/v2"
 
+	"avito-test-pr-service/internal/domain/models"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
 	"avito-test-pr-service/internal/domain/services"
 )
 
@@ -29,11 +32,24 @@ func NewRandomReviewerSelectorWithRand(r *rand.Rand) services.ReviewerSelector {
 	return &RandomReviewerSelector{rnd: r}
 }
 
-func (s *RandomReviewerSelector) Select(candidates []string, count int) []string {
-	if count <= 0 || len(candidates) == 0 {
-		return nil
+func (s *RandomReviewerSelector) Select(_ context.Context, _ uow.Transaction, req services.SelectionRequest) (*services.Selection, error) {
+	if req.Count <= 0 || len(req.Candidates) == 0 {
+		return &services.Selection{Strategy: StrategyRandom}, nil
 	}
+	// скор — позиция в случайной перестановке: первые Count позиций и есть выбранные
+	shuffled := s.shuffle(req.Candidates)
+	scores := make([]models.CandidateScore, 0, len(shuffled))
+	for i, id := range shuffled {
+		scores = append(scores, models.CandidateScore{
+			UserID:  id,
+			Score:   float64(len(shuffled) - i),
+			Factors: map[string]float64{"shuffle_rank": float64(i + 1)},
+		})
+	}
+	return services.TopScored(StrategyRandom, scores, req.Count), nil
+}
 
+func (s *RandomReviewerSelector) shuffle(candidates []string) []string {
 	shuffled := append([]string(nil), candidates...)
 	if len(shuffled) > 1 {
 		s.mu.Lock()
@@ -41,12 +57,6 @@ func (s *RandomReviewerSelector) Select(candidates []string, count int) []string
 		s.rnd.Shuffle(len(shuffled), func(i, j int) {
 			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
 		})
-
 	}
-
-	if count > len(shuffled) {
-		count = len(shuffled)
-	}
-
-	return append([]string(nil), shuffled[:count]...)
+	return shuffled
 }
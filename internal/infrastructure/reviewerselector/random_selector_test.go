@@ -1,9 +1,12 @@
 package reviewerselector
 
 import (
+	"context"
 	rand "math/rand/v2"
 	"testing"
 
+	"avito-test-pr-service/internal/domain/services"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,7 +29,9 @@ func TestRandomReviewerSelector_Select(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			selector := NewRandomReviewerSelectorWithRand(rand.New(rand.NewPCG(tt.seed, tt.seed>>1|1)))
-			result := selector.Select(tt.candidates, tt.count)
+			sel, err := selector.Select(context.Background(), nil, services.SelectionRequest{Candidates: tt.candidates, Count: tt.count})
+			require.NoError(t, err)
+			result := sel.ReviewerIDs
 
 			if tt.expectLen == 0 && tt.count > 0 && len(tt.candidates) == 0 {
 				require.Nil(t, result)
@@ -59,6 +64,8 @@ func TestRandomReviewerSelector_Select(t *testing.T) {
 
 func TestNewRandomReviewerSelectorWithRand_NilFallback(t *testing.T) {
 	selector := NewRandomReviewerSelectorWithRand(nil)
-	result := selector.Select([]string{"x"}, 1)
-	require.Len(t, result, 1)
+	sel, err := selector.Select(context.Background(), nil, services.SelectionRequest{Candidates: []string{"x"}, Count: 1})
+	require.NoError(t, err)
+	require.Len(t, sel.ReviewerIDs, 1)
+	require.Equal(t, "random", sel.Strategy)
 }
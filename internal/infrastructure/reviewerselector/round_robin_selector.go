@@ -0,0 +1,82 @@
+package reviewerselector
+
+import (
+	"context"
+
+	"avito-test-pr-service/internal/domain/models"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/domain/services"
+
+	"github.com/google/uuid"
+)
+
+var _ services.RotationSelector = (*RoundRobinSelector)(nil)
+
+// RoundRobinSelector назначает ревьюверов строго по очереди участников команды.
+// Курсор хранится в БД и блокируется в транзакции вызывающего сервиса, поэтому
+// параллельные CreatePR/ReassignReviewer одной команды не выбирают одного и того же человека.
+type RoundRobinSelector struct{}
+
+func NewRoundRobinSelector() services.ReviewerSelector {
+	return &RoundRobinSelector{}
+}
+
+func (s *RoundRobinSelector) Select(ctx context.Context, tx uow.Transaction, req services.SelectionRequest) (*services.Selection, error) {
+	if req.Count <= 0 || len(req.Candidates) == 0 {
+		return &services.Selection{Strategy: StrategyRoundRobin}, nil
+	}
+	cursor, ids, err := s.queue(ctx, tx, req.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	// скор — минус расстояние от курсора в очереди: ближайшие по очереди выбираются первыми
+	order := services.RotationOrder(ids, cursor, req.Candidates)
+	scores := make([]models.CandidateScore, 0, len(order))
+	for i, id := range order {
+		scores = append(scores, models.CandidateScore{
+			UserID:  id,
+			Score:   -float64(i),
+			Factors: map[string]float64{"queue_position": float64(i + 1)},
+		})
+	}
+	sel := services.TopScored(StrategyRoundRobin, scores, req.Count)
+	if req.HoldRotation {
+		return sel, nil
+	}
+	if n := len(sel.ReviewerIDs); n > 0 && sel.ReviewerIDs[n-1] != cursor {
+		if err := tx.TeamRepository().SetRotationCursor(ctx, req.TeamID, sel.ReviewerIDs[n-1]); err != nil {
+			return nil, err
+		}
+	}
+	return sel, nil
+}
+
+// Advance ставит курсор на самого дальнего по очереди из selected — как после выбора одним вызовом Select.
+func (s *RoundRobinSelector) Advance(ctx context.Context, tx uow.Transaction, req services.SelectionRequest, selected []string) error {
+	cursor, ids, err := s.queue(ctx, tx, req.TeamID)
+	if err != nil {
+		return err
+	}
+	order := services.RotationOrder(ids, cursor, selected)
+	if n := len(order); n > 0 && order[n-1] != cursor {
+		return tx.TeamRepository().SetRotationCursor(ctx, req.TeamID, order[n-1])
+	}
+	return nil
+}
+
+// queue блокирует курсор команды и возвращает его вместе с участниками очереди.
+func (s *RoundRobinSelector) queue(ctx context.Context, tx uow.Transaction, teamID uuid.UUID) (string, []string, error) {
+	cursor, err := tx.TeamRepository().LockRotationCursor(ctx, teamID)
+	if err != nil {
+		return "", nil, err
+	}
+	members, err := tx.UserRepository().ListMembersByTeamID(ctx, teamID)
+	if err != nil {
+		return "", nil, err
+	}
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.ID)
+	}
+	return cursor, ids, nil
+}
@@ -0,0 +1,82 @@
+package reviewerselector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundRobinSelector_Select(t *testing.T) {
+	ctx := context.Background()
+	teamID := uuid.New()
+	members := []*models.User{{ID: "u3"}, {ID: "u1"}, {ID: "u2"}, {ID: "u4"}}
+
+	tests := []struct {
+		name       string
+		cursor     string
+		candidates []string
+		count      int
+		want       []string
+		wantCursor string
+		lockErr    error
+		wantErr    error
+	}{
+		{name: "advances cursor", cursor: "u1", candidates: []string{"u2", "u3", "u4"}, count: 2, want: []string{"u2", "u3"}, wantCursor: "u3"},
+		{name: "wraps and skips author", cursor: "u3", candidates: []string{"u1", "u2", "u4"}, count: 2, want: []string{"u4", "u1"}, wantCursor: "u1"},
+		{name: "lock error", candidates: []string{"u1"}, count: 1, lockErr: errors.New("db down"), wantErr: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := mocks.NewTransaction(t)
+			teamRepo := mocks.NewTeamRepository(t)
+			tx.EXPECT().TeamRepository().Return(teamRepo)
+			teamRepo.EXPECT().LockRotationCursor(ctx, teamID).Return(tt.cursor, tt.lockErr)
+			if tt.lockErr == nil {
+				userRepo := mocks.NewUserRepository(t)
+				tx.EXPECT().UserRepository().Return(userRepo)
+				userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(members, nil)
+				teamRepo.EXPECT().SetRotationCursor(ctx, teamID, tt.wantCursor).Return(nil)
+			}
+			got, err := NewRoundRobinSelector().Select(ctx, tx, services.SelectionRequest{TeamID: teamID, Candidates: tt.candidates, Count: tt.count})
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.ReviewerIDs)
+			require.Len(t, got.Scores, len(tt.candidates))
+		})
+	}
+}
+
+func TestRoundRobinSelector_HoldAndAdvance(t *testing.T) {
+	ctx := context.Background()
+	teamID := uuid.New()
+	members := []*models.User{{ID: "u1"}, {ID: "u2"}, {ID: "u3"}, {ID: "u4"}}
+	req := services.SelectionRequest{TeamID: teamID, Candidates: []string{"u2", "u3", "u4"}, Count: 1, HoldRotation: true}
+
+	tx := mocks.NewTransaction(t)
+	teamRepo := mocks.NewTeamRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	tx.EXPECT().TeamRepository().Return(teamRepo)
+	tx.EXPECT().UserRepository().Return(userRepo)
+	teamRepo.EXPECT().LockRotationCursor(ctx, teamID).Return("u1", nil).Times(2)
+	userRepo.EXPECT().ListMembersByTeamID(ctx, teamID).Return(members, nil).Times(2)
+
+	// этап с HoldRotation курсор не трогает: SetRotationCursor ожидается только от Advance
+	sel := NewRoundRobinSelector().(services.RotationSelector)
+	got, err := sel.Select(ctx, tx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, got.ReviewerIDs)
+
+	teamRepo.EXPECT().SetRotationCursor(ctx, teamID, "u4").Return(nil).Once()
+	require.NoError(t, sel.Advance(ctx, tx, req, []string{"u4", "u2"}))
+}
@@ -0,0 +1,97 @@
+package reviewerselector
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	rand "math/rand/v2"
+
+	"avito-test-pr-service/internal/domain/models"
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/domain/services"
+)
+
+var _ services.ReviewerSelector = (*WeightedSelector)(nil)
+
+// WeightedParams задаёт веса скоринга: TagWeight за каждый общий тег с метками PR,
+// LoadPenalty за каждое открытое ревью кандидата, Jitter — амплитуда случайной добавки.
+type WeightedParams struct {
+	TagWeight   float64 `json:"tag_weight"`
+	LoadPenalty float64 `json:"load_penalty"`
+	Jitter      float64 `json:"jitter"`
+}
+
+func DefaultWeightedParams() WeightedParams {
+	return WeightedParams{TagWeight: 1.0, LoadPenalty: 0.3, Jitter: 0.5}
+}
+
+// WeightedSelector выбирает кандидатов с наибольшим скором по пересечению тегов и текущей нагрузке.
+type WeightedSelector struct {
+	params WeightedParams
+	rnd    *rand.Rand
+	mu     sync.Mutex
+}
+
+func NewWeightedSelector(params WeightedParams) services.ReviewerSelector {
+	seed := uint64(time.Now().UnixNano())
+	return NewWeightedSelectorWithRand(params, rand.New(rand.NewPCG(seed, seed>>1|1)))
+}
+
+func NewWeightedSelectorWithRand(params WeightedParams, r *rand.Rand) services.ReviewerSelector {
+	if r == nil {
+		seed := uint64(time.Now().UnixNano())
+		r = rand.New(rand.NewPCG(seed, seed>>1|1))
+	}
+	return &WeightedSelector{params: params, rnd: r}
+}
+
+func (s *WeightedSelector) Select(ctx context.Context, tx uow.Transaction, req services.SelectionRequest) (*services.Selection, error) {
+	if req.Count <= 0 || len(req.Candidates) == 0 {
+		return &services.Selection{Strategy: StrategyWeighted}, nil
+	}
+	users, err := tx.UserRepository().ListUsersByIDs(ctx, req.Candidates)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string][]string, len(users))
+	for _, u := range users {
+		tags[u.ID] = u.Tags
+	}
+	load, err := tx.PRRepository().CountOpenReviewsByReviewers(ctx, req.Candidates)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.PendingLoad) > 0 {
+		merged := make(map[string]int, len(load)+len(req.PendingLoad))
+		for id, n := range load {
+			merged[id] = n
+		}
+		for id, n := range req.PendingLoad {
+			merged[id] += n
+		}
+		load = merged
+	}
+	return services.TopScored(StrategyWeighted, s.score(req.Candidates, req.Labels, tags, load), req.Count), nil
+}
+
+func (s *WeightedSelector) score(candidates, labels []string, tags map[string][]string, load map[string]int) []models.CandidateScore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	scores := make([]models.CandidateScore, 0, len(candidates))
+	for _, id := range candidates {
+		overlap := float64(services.TagOverlap(labels, tags[id]))
+		open := float64(load[id])
+		jitter := s.rnd.Float64() * s.params.Jitter
+		scores = append(scores, models.CandidateScore{
+			UserID: id,
+			Score:  overlap*s.params.TagWeight - open*s.params.LoadPenalty + jitter,
+			Factors: map[string]float64{
+				"tag_overlap":  overlap,
+				"open_reviews": open,
+				"jitter":       jitter,
+			},
+		})
+	}
+	return scores
+}
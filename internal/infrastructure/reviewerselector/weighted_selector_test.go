@@ -0,0 +1,114 @@
+package reviewerselector
+
+import (
+	"context"
+	"errors"
+	rand "math/rand/v2"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedSelector_Select(t *testing.T) {
+	ctx := context.Background()
+	users := []*models.User{
+		{ID: "expert", Tags: []string{"go", "postgres"}},
+		{ID: "busy-expert", Tags: []string{"go", "postgres"}},
+		{ID: "frontend", Tags: []string{"frontend"}},
+		{ID: "idle"},
+	}
+	candidates := []string{"expert", "busy-expert", "frontend", "idle"}
+
+	tests := []struct {
+		name    string
+		params  WeightedParams
+		labels  []string
+		load    map[string]int
+		count   int
+		want    []string
+		usersEr error
+		wantErr error
+	}{
+		{
+			name:   "tag overlap wins",
+			params: WeightedParams{TagWeight: 1, LoadPenalty: 0.3},
+			labels: []string{"go", "postgres"},
+			load:   map[string]int{},
+			count:  2,
+			want:   []string{"expert", "busy-expert"},
+		},
+		{
+			name:   "load penalty demotes busy expert",
+			params: WeightedParams{TagWeight: 1, LoadPenalty: 1},
+			labels: []string{"go", "postgres"},
+			load:   map[string]int{"busy-expert": 3},
+			count:  2,
+			want:   []string{"expert", "frontend"},
+		},
+		{
+			name:   "no labels -> least loaded",
+			params: WeightedParams{TagWeight: 1, LoadPenalty: 1},
+			load:   map[string]int{"expert": 2, "busy-expert": 5, "frontend": 1},
+			count:  1,
+			want:   []string{"idle"},
+		},
+		{
+			name:    "users lookup error",
+			params:  DefaultWeightedParams(),
+			count:   1,
+			usersEr: errors.New("db down"),
+			wantErr: errors.New("db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := mocks.NewTransaction(t)
+			userRepo := mocks.NewUserRepository(t)
+			tx.EXPECT().UserRepository().Return(userRepo)
+			if tt.usersEr != nil {
+				userRepo.EXPECT().ListUsersByIDs(ctx, candidates).Return(nil, tt.usersEr)
+			} else {
+				userRepo.EXPECT().ListUsersByIDs(ctx, candidates).Return(users, nil)
+				prRepo := mocks.NewPRRepository(t)
+				tx.EXPECT().PRRepository().Return(prRepo)
+				prRepo.EXPECT().CountOpenReviewsByReviewers(ctx, candidates).Return(tt.load, nil)
+			}
+			sel := NewWeightedSelectorWithRand(tt.params, rand.New(rand.NewPCG(1, 2)))
+			got, err := sel.Select(ctx, tx, services.SelectionRequest{Candidates: candidates, Count: tt.count, Labels: tt.labels})
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.want, got.ReviewerIDs)
+			require.Len(t, got.Scores, len(candidates))
+		})
+	}
+}
+
+func TestWeightedSelector_JitterSpreadsTies(t *testing.T) {
+	ctx := context.Background()
+	candidates := []string{"a", "b", "c"}
+	tx := mocks.NewTransaction(t)
+	userRepo := mocks.NewUserRepository(t)
+	prRepo := mocks.NewPRRepository(t)
+	tx.EXPECT().UserRepository().Return(userRepo)
+	tx.EXPECT().PRRepository().Return(prRepo)
+	userRepo.EXPECT().ListUsersByIDs(ctx, candidates).Return([]*models.User{{ID: "a"}, {ID: "b"}, {ID: "c"}}, nil)
+	prRepo.EXPECT().CountOpenReviewsByReviewers(ctx, candidates).Return(map[string]int{}, nil)
+
+	sel := NewWeightedSelectorWithRand(DefaultWeightedParams(), rand.New(rand.NewPCG(7, 9)))
+	seen := map[string]struct{}{}
+	for i := 0; i < 30; i++ {
+		got, err := sel.Select(ctx, tx, services.SelectionRequest{Candidates: candidates, Count: 1})
+		require.NoError(t, err)
+		require.Len(t, got.ReviewerIDs, 1)
+		seen[got.ReviewerIDs[0]] = struct{}{}
+	}
+	require.Greater(t, len(seen), 1, "jitter should vary picks among equal candidates")
+}
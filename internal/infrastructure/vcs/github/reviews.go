@@ -0,0 +1,80 @@
+package github
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/vcs"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	DefaultAPIURL = "https://api.github.com"
+	apiVersion    = "2022-11-28"
+)
+
+// ReviewRequester запрашивает и снимает ревью через REST API GitHub
+// (POST/DELETE /repos/{owner}/{repo}/pulls/{number}/requested_reviewers).
+type ReviewRequester struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func NewReviewRequester(baseURL, token string, client *http.Client) *ReviewRequester {
+	if baseURL == "" {
+		baseURL = DefaultAPIURL
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &ReviewRequester{baseURL: strings.TrimRight(baseURL, "/"), token: token, client: client}
+}
+
+func (r *ReviewRequester) RequestReviewers(ctx context.Context, link *models.VCSPullRequestLink, logins []string) error {
+	return r.requestedReviewers(ctx, http.MethodPost, link, logins)
+}
+
+func (r *ReviewRequester) RemoveReviewers(ctx context.Context, link *models.VCSPullRequestLink, logins []string) error {
+	return r.requestedReviewers(ctx, http.MethodDelete, link, logins)
+}
+
+func (r *ReviewRequester) requestedReviewers(ctx context.Context, method string, link *models.VCSPullRequestLink, logins []string) error {
+	if len(logins) == 0 {
+		return nil
+	}
+	owner, repo, ok := strings.Cut(link.Repository, "/")
+	if !ok {
+		return fmt.Errorf("github repository %q is not owner/repo", link.Repository)
+	}
+	body, err := json.Marshal(struct {
+		Reviewers []string `json:"reviewers"`
+	}{logins})
+	if err != nil {
+		return err
+	}
+	u := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/requested_reviewers", r.baseURL, url.PathEscape(owner), url.PathEscape(repo), link.Number)
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", apiVersion)
+	req.Header.Set("Authorization", "Bearer "+r.token)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	return vcs.CheckResponse(resp)
+}
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewRequester(t *testing.T) {
+	type call struct {
+		method, path, auth string
+		reviewers          []string
+	}
+	var calls []call
+	status := http.StatusCreated
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Reviewers []string `json:"reviewers"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, call{r.Method, r.URL.Path, r.Header.Get("Authorization"), body.Reviewers})
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"message":"Reviews may only be requested from collaborators."}`))
+	}))
+	defer srv.Close()
+
+	rr := NewReviewRequester(srv.URL, "tkn", srv.Client())
+	link := &models.VCSPullRequestLink{Provider: models.VCSProviderGitHub, Repository: "acme/pr-service", Number: 42}
+	ctx := context.Background()
+
+	require.NoError(t, rr.RequestReviewers(ctx, link, []string{"bob-reviews"}))
+	status = http.StatusOK
+	require.NoError(t, rr.RemoveReviewers(ctx, link, []string{"bob-reviews"}))
+	require.NoError(t, rr.RequestReviewers(ctx, link, nil))
+	require.Equal(t, []call{
+		{http.MethodPost, "/repos/acme/pr-service/pulls/42/requested_reviewers", "Bearer tkn", []string{"bob-reviews"}},
+		{http.MethodDelete, "/repos/acme/pr-service/pulls/42/requested_reviewers", "Bearer tkn", []string{"bob-reviews"}},
+	}, calls)
+
+	status = http.StatusUnprocessableEntity
+	err := rr.RequestReviewers(ctx, link, []string{"stranger"})
+	require.ErrorIs(t, err, utils.ErrVCSRejected)
+	require.Contains(t, err.Error(), "collaborators")
+
+	status = http.StatusBadGateway
+	err = rr.RequestReviewers(ctx, link, []string{"bob-reviews"})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, utils.ErrVCSRejected)
+}
@@ -0,0 +1,124 @@
+// Package github — адаптер GitHub: проверка подписи вебхуков и разбор событий pull_request.
+package github
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Заголовки доставки вебхука GitHub.
+const (
+	SignatureHeader = "X-Hub-Signature-256"
+	EventHeader     = "X-GitHub-Event"
+	DeliveryHeader  = "X-GitHub-Delivery"
+)
+
+// Типы событий из EventHeader, которые обрабатывает сервис.
+const (
+	EventPing        = "ping"
+	EventPullRequest = "pull_request"
+)
+
+// VerifySignature сверяет X-Hub-Signature-256 («sha256=<hex>») с HMAC-SHA256 тела на секрете вебхука.
+// С пустым секретом подпись не принимается.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	if secret == "" {
+		return false
+	}
+	hexSum, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(hexSum)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// Sign возвращает значение X-Hub-Signature-256 для тела; используется в тестах и инструментах.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// PRID — идентификатор PR сервиса для PR GitHub. Используется числовой id репозитория,
+// поэтому идентификатор не меняется при переименовании или переносе репозитория.
+func PRID(repositoryID int64, number int) string {
+	return fmt.Sprintf("gh-%d-%d", repositoryID, number)
+}
+
+type pullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		Labels []struct {
+			Name string `json:"name"`
+		} `json:"labels"`
+	} `json:"pull_request"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Sender struct {
+		Login string `json:"login"`
+	} `json:"sender"`
+}
+
+// ParsePullRequestEvent разбирает тело события pull_request. opened, reopened и closed переводятся
+// в действия сервиса (closed с merged=true — мерж); для остальных действий возвращается событие
+// с исходным действием, которое сервис пропускает.
+func ParsePullRequestEvent(body []byte) (*models.VCSPullRequestEvent, error) {
+	var p pullRequestPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, utils.ErrInvalidJSON
+	}
+	number := p.PullRequest.Number
+	if number == 0 {
+		number = p.Number
+	}
+	if p.Action == "" || p.Repository.ID == 0 || number == 0 {
+		return nil, utils.ErrInvalidArgument
+	}
+
+	event := &models.VCSPullRequestEvent{
+		Provider:    models.VCSProviderGitHub,
+		Action:      models.VCSPullRequestAction(p.Action),
+		PRID:        PRID(p.Repository.ID, number),
+		Title:       p.PullRequest.Title,
+		AuthorLogin: p.PullRequest.User.Login,
+		Sender:      p.Sender.Login,
+		Repository:  p.Repository.FullName,
+		Number:      number,
+	}
+	switch p.Action {
+	case "opened":
+		event.Action = models.VCSActionOpened
+	case "reopened":
+		event.Action = models.VCSActionReopened
+	case "closed":
+		event.Action = models.VCSActionClosed
+		if p.PullRequest.Merged {
+			event.Action = models.VCSActionMerged
+		}
+	}
+	for _, l := range p.PullRequest.Labels {
+		event.Labels = append(event.Labels, l.Name)
+	}
+	return event, nil
+}
@@ -0,0 +1,68 @@
+package github
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := fixture(t, "pull_request_opened.json")
+	sig := Sign("s3cret", body)
+
+	require.True(t, VerifySignature("s3cret", body, sig))
+	require.False(t, VerifySignature("other", body, sig))
+	require.False(t, VerifySignature("s3cret", append([]byte(" "), body...), sig))
+	require.False(t, VerifySignature("s3cret", body, sig[len("sha256="):]))
+	require.False(t, VerifySignature("s3cret", body, "sha256=zz"))
+	require.False(t, VerifySignature("", body, Sign("", body)))
+}
+
+func TestParsePullRequestEvent(t *testing.T) {
+	tests := []struct {
+		fixture string
+		action  models.VCSPullRequestAction
+		sender  string
+	}{
+		{"pull_request_opened.json", models.VCSActionOpened, "Octo-Alice"},
+		{"pull_request_closed_merged.json", models.VCSActionMerged, "bob-reviews"},
+		{"pull_request_closed.json", models.VCSActionClosed, "Octo-Alice"},
+		{"pull_request_reopened.json", models.VCSActionReopened, "Octo-Alice"},
+		{"pull_request_synchronize.json", "synchronize", "Octo-Alice"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			event, err := ParsePullRequestEvent(fixture(t, tt.fixture))
+			require.NoError(t, err)
+			require.Equal(t, models.VCSProviderGitHub, event.Provider)
+			require.Equal(t, tt.action, event.Action)
+			require.Equal(t, "gh-582347311-42", event.PRID)
+			require.Equal(t, "acme/pr-service", event.Repository)
+			require.Equal(t, 42, event.Number)
+			require.Equal(t, "Add search index", event.Title)
+			require.Equal(t, "Octo-Alice", event.AuthorLogin)
+			require.Equal(t, tt.sender, event.Sender)
+			require.Equal(t, []string{"go", "good first issue"}, event.Labels)
+		})
+	}
+}
+
+func TestParsePullRequestEvent_Invalid(t *testing.T) {
+	_, err := ParsePullRequestEvent([]byte(`{`))
+	require.ErrorIs(t, err, utils.ErrInvalidJSON)
+
+	_, err = ParsePullRequestEvent(fixture(t, "ping.json"))
+	require.ErrorIs(t, err, utils.ErrInvalidArgument)
+}
@@ -0,0 +1,175 @@
+package gitlab
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/vcs"
+	"avito-test-pr-service/internal/utils"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const DefaultAPIURL = "https://gitlab.com"
+
+// ReviewRequester меняет ревьюверов merge request через REST API GitLab. API принимает полный
+// список reviewer_ids, поэтому текущие ревьюверы сначала читаются из MR, а логины переводятся
+// в числовые id пользователей (с кэшем на время жизни процесса).
+type ReviewRequester struct {
+	baseURL string
+	token   string
+	client  *http.Client
+
+	mu      sync.Mutex
+	userIDs map[string]int64
+}
+
+func NewReviewRequester(baseURL, token string, client *http.Client) *ReviewRequester {
+	if baseURL == "" {
+		baseURL = DefaultAPIURL
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &ReviewRequester{baseURL: strings.TrimRight(baseURL, "/"), token: token, client: client, userIDs: make(map[string]int64)}
+}
+
+type user struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+func (r *ReviewRequester) RequestReviewers(ctx context.Context, link *models.VCSPullRequestLink, logins []string) error {
+	if len(logins) == 0 {
+		return nil
+	}
+	current, err := r.reviewers(ctx, link)
+	if err != nil {
+		return err
+	}
+	ids := make([]int64, 0, len(current)+len(logins))
+	seen := make(map[int64]bool, len(current)+len(logins))
+	for _, u := range current {
+		ids, seen[u.ID] = append(ids, u.ID), true
+	}
+	added := false
+	for _, login := range logins {
+		id, err := r.userID(ctx, login)
+		if err != nil {
+			return err
+		}
+		if !seen[id] {
+			ids, seen[id], added = append(ids, id), true, true
+		}
+	}
+	if !added {
+		return nil
+	}
+	return r.setReviewers(ctx, link, ids)
+}
+
+func (r *ReviewRequester) RemoveReviewers(ctx context.Context, link *models.VCSPullRequestLink, logins []string) error {
+	if len(logins) == 0 {
+		return nil
+	}
+	remove := make(map[string]bool, len(logins))
+	for _, l := range logins {
+		remove[strings.ToLower(l)] = true
+	}
+	current, err := r.reviewers(ctx, link)
+	if err != nil {
+		return err
+	}
+	ids := make([]int64, 0, len(current))
+	for _, u := range current {
+		if !remove[strings.ToLower(u.Username)] {
+			ids = append(ids, u.ID)
+		}
+	}
+	if len(ids) == len(current) {
+		return nil
+	}
+	return r.setReviewers(ctx, link, ids)
+}
+
+func (r *ReviewRequester) mergeRequestURL(link *models.VCSPullRequestLink) string {
+	return fmt.Sprintf("%s/api/v4/projects/%s/merge_requests/%d", r.baseURL, url.PathEscape(link.Repository), link.Number)
+}
+
+func (r *ReviewRequester) reviewers(ctx context.Context, link *models.VCSPullRequestLink) ([]user, error) {
+	var mr struct {
+		Reviewers []user `json:"reviewers"`
+	}
+	if err := r.do(ctx, http.MethodGet, r.mergeRequestURL(link), nil, &mr); err != nil {
+		return nil, err
+	}
+	return mr.Reviewers, nil
+}
+
+func (r *ReviewRequester) setReviewers(ctx context.Context, link *models.VCSPullRequestLink, ids []int64) error {
+	body := struct {
+		ReviewerIDs []int64 `json:"reviewer_ids"`
+	}{ids}
+	return r.do(ctx, http.MethodPut, r.mergeRequestURL(link), body, nil)
+}
+
+func (r *ReviewRequester) userID(ctx context.Context, login string) (int64, error) {
+	login = strings.ToLower(login)
+	r.mu.Lock()
+	id, ok := r.userIDs[login]
+	r.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+	var users []user
+	if err := r.do(ctx, http.MethodGet, r.baseURL+"/api/v4/users?username="+url.QueryEscape(login), nil, &users); err != nil {
+		return 0, err
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("%w: gitlab user %s not found", utils.ErrVCSRejected, login)
+	}
+	r.mu.Lock()
+	r.userIDs[login] = users[0].ID
+	r.mu.Unlock()
+	return users[0].ID, nil
+}
+
+func (r *ReviewRequester) do(ctx context.Context, method, u string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		raw, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(raw)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("PRIVATE-TOKEN", r.token)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if err := vcs.CheckResponse(resp); err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
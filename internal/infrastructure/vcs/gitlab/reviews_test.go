@@ -0,0 +1,90 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGitLab — минимальный API GitLab: пользователи и ревьюверы одного MR.
+type fakeGitLab struct {
+	users       map[string]int64
+	reviewers   []user
+	puts        [][]int64
+	userLookups atomic.Int32
+}
+
+func (f *fakeGitLab) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("PRIVATE-TOKEN") != "tkn" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v4/users":
+		f.userLookups.Add(1)
+		name := r.URL.Query().Get("username")
+		res := []user{}
+		if id, ok := f.users[name]; ok {
+			res = append(res, user{ID: id, Username: name})
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	case r.URL.Path == "/api/v4/projects/278964/merge_requests/17" && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(map[string]any{"iid": 17, "reviewers": f.reviewers})
+	case r.URL.Path == "/api/v4/projects/278964/merge_requests/17" && r.Method == http.MethodPut:
+		var body struct {
+			ReviewerIDs []int64 `json:"reviewer_ids"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.puts = append(f.puts, body.ReviewerIDs)
+		f.reviewers = f.reviewers[:0]
+		for _, id := range body.ReviewerIDs {
+			for name, uid := range f.users {
+				if uid == id {
+					f.reviewers = append(f.reviewers, user{ID: id, Username: name})
+				}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"iid": 17})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestReviewRequester(t *testing.T) {
+	fake := &fakeGitLab{users: map[string]int64{"alice.dev": 4101, "bob.reviews": 4102, "carol": 4103}}
+	fake.reviewers = []user{{ID: 4103, Username: "carol"}}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	rr := NewReviewRequester(srv.URL, "tkn", srv.Client())
+	link := &models.VCSPullRequestLink{Provider: models.VCSProviderGitLab, Repository: "278964", Number: 17}
+	ctx := context.Background()
+
+	// Ревьювер, добавленный в GitLab вручную (carol), сохраняется.
+	require.NoError(t, rr.RequestReviewers(ctx, link, []string{"Bob.Reviews"}))
+	require.Equal(t, [][]int64{{4103, 4102}}, fake.puts)
+
+	// Повторный запрос уже назначенного ревьювера не меняет MR и не ищет пользователя заново.
+	require.NoError(t, rr.RequestReviewers(ctx, link, []string{"bob.reviews"}))
+	require.Len(t, fake.puts, 1)
+	require.EqualValues(t, 1, fake.userLookups.Load())
+
+	require.NoError(t, rr.RemoveReviewers(ctx, link, []string{"bob.reviews"}))
+	require.Equal(t, []int64{4103}, fake.puts[1])
+	require.NoError(t, rr.RemoveReviewers(ctx, link, []string{"bob.reviews"}))
+	require.Len(t, fake.puts, 2)
+
+	err := rr.RequestReviewers(ctx, link, []string{"ghost"})
+	require.ErrorIs(t, err, utils.ErrVCSRejected)
+
+	err = NewReviewRequester(srv.URL, "wrong", srv.Client()).RequestReviewers(ctx, link, []string{"carol"})
+	require.ErrorIs(t, err, utils.ErrVCSRejected)
+}
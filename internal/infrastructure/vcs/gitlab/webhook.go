@@ -0,0 +1,136 @@
+// Package gitlab — адаптер GitLab: проверка токена вебхуков и разбор событий merge request.
+package gitlab
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Заголовки доставки вебхука GitLab.
+const (
+	TokenHeader    = "X-Gitlab-Token"
+	EventHeader    = "X-Gitlab-Event"
+	DeliveryHeader = "X-Gitlab-Event-UUID"
+)
+
+// EventMergeRequest — значение EventHeader для событий merge request.
+const EventMergeRequest = "Merge Request Hook"
+
+// VerifyToken сравнивает X-Gitlab-Token с секретом вебхука за постоянное время.
+// С пустым секретом токен не принимается.
+func VerifyToken(secret, token string) bool {
+	if secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
+
+// PRID — идентификатор PR сервиса для merge request GitLab: числовой id проекта и iid MR.
+func PRID(projectID int64, iid int) string {
+	return fmt.Sprintf("gl-%d-%d", projectID, iid)
+}
+
+type boolChange struct {
+	Previous *bool `json:"previous"`
+	Current  *bool `json:"current"`
+}
+
+type mergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		ID       int64  `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		ID                int64  `json:"id"`
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID            int    `json:"iid"`
+		Title          string `json:"title"`
+		AuthorID       int64  `json:"author_id"`
+		Action         string `json:"action"`
+		Draft          bool   `json:"draft"`
+		WorkInProgress bool   `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Labels []struct {
+		Title string `json:"title"`
+	} `json:"labels"`
+	Changes struct {
+		Draft          *boolChange `json:"draft"`
+		WorkInProgress *boolChange `json:"work_in_progress"`
+	} `json:"changes"`
+}
+
+// ParseMergeRequestEvent разбирает тело события merge request. open, merge, close и reopen переводятся
+// в действия сервиса; update со сменой признака draft — в draft или ready; остальные действия
+// возвращаются как есть, и сервис их пропускает.
+//
+// В событии GitLab нет логина автора MR, только его числовой id; логин берётся из user, если событие
+// вызвал сам автор, иначе автор остаётся пустым и сопоставить его нельзя.
+func ParseMergeRequestEvent(body []byte) (*models.VCSPullRequestEvent, error) {
+	var p mergeRequestPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, utils.ErrInvalidJSON
+	}
+	attrs := p.ObjectAttributes
+	if p.ObjectKind != "merge_request" || attrs.Action == "" || p.Project.ID == 0 || attrs.IID == 0 {
+		return nil, utils.ErrInvalidArgument
+	}
+
+	draft := attrs.Draft || attrs.WorkInProgress
+	event := &models.VCSPullRequestEvent{
+		Provider: models.VCSProviderGitLab,
+		Action:   models.VCSPullRequestAction(attrs.Action),
+		PRID:     PRID(p.Project.ID, attrs.IID),
+		Title:    attrs.Title,
+		Draft:    draft,
+		Sender:   p.User.Username,
+		// Числовой id проекта, в отличие от пути, не меняется при переименовании.
+		Repository: strconv.FormatInt(p.Project.ID, 10),
+		Number:     attrs.IID,
+	}
+	if p.User.ID != 0 && p.User.ID == attrs.AuthorID {
+		event.AuthorLogin = p.User.Username
+	}
+	switch attrs.Action {
+	case "open":
+		event.Action = models.VCSActionOpened
+	case "reopen":
+		event.Action = models.VCSActionReopened
+		if draft {
+			event.Action = models.VCSActionDraft
+		}
+	case "merge":
+		event.Action = models.VCSActionMerged
+	case "close":
+		event.Action = models.VCSActionClosed
+	case "update":
+		if toggled, ok := draftToggle(p.Changes.Draft, p.Changes.WorkInProgress); ok {
+			event.Action = models.VCSActionReady
+			if toggled {
+				event.Action = models.VCSActionDraft
+			}
+		}
+	}
+	for _, l := range p.Labels {
+		event.Labels = append(event.Labels, l.Title)
+	}
+	return event, nil
+}
+
+// draftToggle возвращает новое значение draft, если update его поменял. Старые версии GitLab
+// присылают work_in_progress вместо draft.
+func draftToggle(changes ...*boolChange) (bool, bool) {
+	for _, c := range changes {
+		if c == nil || c.Previous == nil || c.Current == nil || *c.Previous == *c.Current {
+			continue
+		}
+		return *c.Current, true
+	}
+	return false, false
+}
@@ -0,0 +1,69 @@
+package gitlab
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func TestVerifyToken(t *testing.T) {
+	require.True(t, VerifyToken("s3cret", "s3cret"))
+	require.False(t, VerifyToken("s3cret", "s3cre"))
+	require.False(t, VerifyToken("s3cret", ""))
+	require.False(t, VerifyToken("", ""))
+}
+
+func TestParseMergeRequestEvent(t *testing.T) {
+	tests := []struct {
+		fixture string
+		action  models.VCSPullRequestAction
+		author  string
+		sender  string
+		draft   bool
+	}{
+		{"merge_request_open.json", models.VCSActionOpened, "Alice.Dev", "Alice.Dev", false},
+		{"merge_request_open_draft.json", models.VCSActionOpened, "Alice.Dev", "Alice.Dev", true},
+		{"merge_request_update_draft.json", models.VCSActionDraft, "Alice.Dev", "Alice.Dev", true},
+		{"merge_request_update_ready.json", models.VCSActionReady, "Alice.Dev", "Alice.Dev", false},
+		{"merge_request_update_title.json", "update", "Alice.Dev", "Alice.Dev", false},
+		// Закрывает и мержит не автор: логин автора из события GitLab не узнать.
+		{"merge_request_close.json", models.VCSActionClosed, "", "bob.reviews", false},
+		{"merge_request_reopen.json", models.VCSActionReopened, "", "bob.reviews", false},
+		{"merge_request_merge.json", models.VCSActionMerged, "", "bob.reviews", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			event, err := ParseMergeRequestEvent(fixture(t, tt.fixture))
+			require.NoError(t, err)
+			require.Equal(t, models.VCSProviderGitLab, event.Provider)
+			require.Equal(t, tt.action, event.Action)
+			require.Equal(t, "gl-278964-17", event.PRID)
+			require.Equal(t, "278964", event.Repository)
+			require.Equal(t, 17, event.Number)
+			require.Equal(t, tt.author, event.AuthorLogin)
+			require.Equal(t, tt.sender, event.Sender)
+			require.Equal(t, tt.draft, event.Draft)
+			require.Equal(t, []string{"go", "needs review"}, event.Labels)
+		})
+	}
+}
+
+func TestParseMergeRequestEvent_Invalid(t *testing.T) {
+	_, err := ParseMergeRequestEvent([]byte(`{`))
+	require.ErrorIs(t, err, utils.ErrInvalidJSON)
+
+	_, err = ParseMergeRequestEvent([]byte(`{"object_kind":"push","project":{"id":1}}`))
+	require.ErrorIs(t, err, utils.ErrInvalidArgument)
+}
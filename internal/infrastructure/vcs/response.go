@@ -0,0 +1,31 @@
+// Package vcs — общие части REST-адаптеров VCS.
+package vcs
+
+import (
+	"avito-test-pr-service/internal/utils"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBody — сколько байт тела ответа с ошибкой попадает в текст ошибки.
+const maxErrorBody = 512
+
+// CheckResponse переводит неуспешный ответ API VCS в ошибку. 429, 5xx и исчерпанный лимит запросов
+// (403 с X-RateLimit-Remaining: 0) считаются временными; остальные 4xx оборачивают utils.ErrVCSRejected.
+func CheckResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	msg := strings.TrimSpace(string(body))
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode >= http.StatusInternalServerError,
+		resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0":
+		return fmt.Errorf("vcs responded %d: %s", resp.StatusCode, msg)
+	default:
+		return fmt.Errorf("%w: %d: %s", utils.ErrVCSRejected, resp.StatusCode, msg)
+	}
+}
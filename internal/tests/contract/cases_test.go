@@ -0,0 +1,734 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/utils"
+	"avito-test-pr-service/pkg/client"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	exampleTime = time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	mergedTime  = time.Date(2025, 10, 24, 12, 34, 56, 0, time.UTC)
+)
+
+func openPR(reviewers ...string) *models.PullRequest {
+	return &models.PullRequest{
+		ID:          "pr-1001",
+		Title:       "Add search",
+		AuthorID:    "u1",
+		Status:      models.PRStatusOPEN,
+		ReviewerIDs: reviewers,
+		CreatedAt:   exampleTime,
+	}
+}
+
+func digestPrefs() *models.NotificationPreferences {
+	return &models.NotificationPreferences{
+		UserID: "u2", ChatHandle: "bob.reviews", Delivery: models.NotificationDeliveryDigest, EmailDelivery: models.NotificationDeliveryImmediate,
+		Kinds: []models.NotificationKind{models.NotificationAssigned, models.NotificationSLABreached},
+	}
+}
+
+func users(ids ...string) []*models.User {
+	names := map[string]string{"u1": "Alice", "u2": "Bob", "u3": "Carol", "u5": "Eve"}
+	out := make([]*models.User, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, &models.User{ID: id, Name: names[id], IsActive: true})
+	}
+	return out
+}
+
+// reassignConflict — 409 переназначения с примером name из спецификации.
+func reassignConflict(name string, err error) contractCase {
+	return contractCase{
+		name: name, method: http.MethodPost, path: "/pullRequest/reassign",
+		setup: func(p *ports) {
+			p.pr.EXPECT().ReassignReviewer(mock.Anything, "pr-1001", "u2", "", models.AssignmentReason(""), mock.Anything).Return(nil, err)
+		},
+		status: http.StatusConflict, example: name,
+	}
+}
+
+func contractCases() []contractCase {
+	return []contractCase{
+		{
+			name: "ok", method: http.MethodGet, path: "/ping",
+			status: http.StatusOK,
+		},
+
+		// команды
+		{
+			name: "created", method: http.MethodPost, path: "/team/add",
+			setup: func(p *ports) {
+				p.team.EXPECT().CreateTeamWithMembers(mock.Anything, "payments", mock.Anything).
+					Return(&models.Team{ID: uuid.New(), Name: "payments"}, users("u1", "u2"), nil)
+			},
+			status: http.StatusCreated, sdk: &client.TeamResponse{},
+		},
+		{
+			name: "different roster", method: http.MethodPost, path: "/team/add",
+			setup: func(p *ports) {
+				p.team.EXPECT().CreateTeamWithMembers(mock.Anything, "payments", mock.Anything).Return(nil, nil, utils.ErrAlreadyExists)
+			},
+			status: http.StatusConflict,
+		},
+		{
+			name: "found", method: http.MethodGet, path: "/team/get", query: "team_name=backend",
+			setup: func(p *ports) {
+				id := uuid.New()
+				p.team.EXPECT().GetTeamByName(mock.Anything, "backend").Return(&models.Team{ID: id, Name: "backend"}, nil)
+				p.user.EXPECT().ListMembersByTeamID(mock.Anything, id.String()).Return(users("u1", "u2"), nil)
+			},
+			status: http.StatusOK, sdk: &client.Team{},
+		},
+		{
+			name: "not found", method: http.MethodGet, path: "/team/get", query: "team_name=missing",
+			setup: func(p *ports) {
+				p.team.EXPECT().GetTeamByName(mock.Anything, "missing").Return(nil, utils.ErrTeamNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/team/list",
+			setup: func(p *ports) {
+				p.team.EXPECT().ListTeams(mock.Anything).Return([]*models.Team{
+					{ID: uuid.New(), Name: "backend", SelectionStrategy: "weighted"},
+					{ID: uuid.New(), Name: "frontend"},
+				}, nil)
+			},
+			status: http.StatusOK, sdk: &client.TeamListResponse{},
+		},
+		{
+			name: "applied", method: http.MethodPut, path: "/team/sync",
+			setup: func(p *ports) {
+				diff := models.NewRosterDiff()
+				diff.CreateUsers = []models.RosterMember{{UserID: "u3", Username: "Carol"}}
+				diff.AddMemberships = []models.Membership{{TeamName: "payments", UserID: "u3"}}
+				diff.RemoveMemberships = []models.Membership{{TeamName: "payments", UserID: "u2"}}
+				p.team.EXPECT().SyncTeam(mock.Anything, "payments", mock.Anything, false, mock.Anything).Return(&models.TeamSync{Diff: diff, Releases: []models.ReviewRelease{
+					{PRID: "pr-1001", ReviewerID: "u2", Outcome: models.ReleaseOutcomeReassigned, ReplacedBy: "u1"},
+				}}, nil)
+			},
+			status: http.StatusOK, sdk: &client.SyncResult{},
+		},
+		{
+			name: "dry run", method: http.MethodPut, path: "/team/sync", query: "dry_run=true",
+			setup: func(p *ports) {
+				p.team.EXPECT().SyncTeam(mock.Anything, "payments", mock.Anything, true, mock.Anything).Return(&models.TeamSync{Diff: models.NewRosterDiff(), Releases: []models.ReviewRelease{}}, nil)
+			},
+			status: http.StatusOK, sdk: &client.SyncResult{},
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/team/codeOwners", query: "team_name=backend",
+			setup: func(p *ports) {
+				p.team.EXPECT().GetCodeOwners(mock.Anything, "backend").Return([]models.CodeOwnerRule{
+					{Pattern: "*", OwnerIDs: []string{"u1"}},
+				}, nil)
+			},
+			status: http.StatusOK, sdk: &client.CodeOwners{},
+		},
+		{
+			name: "ok", method: http.MethodPost, path: "/team/codeOwners",
+			setup: func(p *ports) {
+				p.team.EXPECT().SetCodeOwners(mock.Anything, "backend", "*  u1\n/internal/db/ u2 u3\n", mock.Anything).Return([]models.CodeOwnerRule{
+					{Pattern: "*", OwnerIDs: []string{"u1"}},
+					{Pattern: "/internal/db/", OwnerIDs: []string{"u2", "u3"}},
+				}, int64(4), nil)
+			},
+			status: http.StatusOK, sdk: &client.CodeOwners{},
+		},
+		{
+			name: "team not found", method: http.MethodPost, path: "/team/codeOwners",
+			setup: func(p *ports) {
+				p.team.EXPECT().SetCodeOwners(mock.Anything, "backend", mock.Anything, mock.Anything).Return(nil, int64(0), utils.ErrTeamNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/team/selectionStrategy", query: "team_name=backend",
+			setup: func(p *ports) {
+				p.team.EXPECT().GetTeamByName(mock.Anything, "backend").Return(&models.Team{
+					ID: uuid.New(), Name: "backend", SelectionStrategy: "weighted", SelectionParams: json.RawMessage(`{"load_penalty":0.5}`),
+				}, nil)
+			},
+			status: http.StatusOK, sdk: &client.SelectionStrategy{},
+		},
+		{
+			name: "ok", method: http.MethodPost, path: "/team/selectionStrategy",
+			setup: func(p *ports) {
+				p.team.EXPECT().SetSelectionStrategy(mock.Anything, "backend", "codeowners", mock.Anything, mock.Anything).Return(&models.Team{
+					ID: uuid.New(), Name: "backend", SelectionStrategy: "codeowners", SelectionParams: json.RawMessage(`{"fallback":"roundrobin"}`),
+				}, nil)
+			},
+			status: http.StatusOK, sdk: &client.SelectionStrategy{},
+		},
+		{
+			name: "stale etag", method: http.MethodPost, path: "/team/selectionStrategy",
+			header: map[string]string{"If-Match": `"1"`},
+			setup: func(p *ports) {
+				p.team.EXPECT().SetSelectionStrategy(mock.Anything, "backend", "codeowners", mock.Anything, mock.Anything).Return(nil, utils.ErrPreconditionFailed)
+			},
+			status: http.StatusPreconditionFailed,
+		},
+		{
+			name: "unknown strategy", method: http.MethodPost, path: "/team/selectionStrategy",
+			body: `{"team_name":"backend","strategy":"lottery"}`,
+			setup: func(p *ports) {
+				p.team.EXPECT().SetSelectionStrategy(mock.Anything, "backend", "lottery", mock.Anything, mock.Anything).Return(nil, utils.ErrUnknownStrategy)
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "empty", method: http.MethodGet, path: "/team/reviewerConstraints", query: "team_name=backend",
+			setup: func(p *ports) {
+				p.team.EXPECT().GetReviewerConstraints(mock.Anything, "backend").Return([]models.ReviewerConstraint{}, nil)
+			},
+			status: http.StatusOK, sdk: &client.ReviewerConstraints{},
+		},
+		{
+			name: "ok", method: http.MethodPost, path: "/team/reviewerConstraints",
+			setup: func(p *ports) {
+				p.team.EXPECT().SetReviewerConstraints(mock.Anything, "backend", mock.Anything, mock.Anything).RunAndReturn(
+					func(_ context.Context, _ string, in []models.ReviewerConstraint, _ *utils.IfMatch) ([]models.ReviewerConstraint, int64, error) {
+						return in, 4, nil
+					})
+			},
+			status: http.StatusOK, sdk: &client.ReviewerConstraints{},
+		},
+
+		// пользователи
+		{
+			name: "deactivated", method: http.MethodPost, path: "/users/setIsActive",
+			setup: func(p *ports) {
+				p.user.EXPECT().UpdateUserActive(mock.Anything, "u2", false).Return(nil)
+				p.user.EXPECT().GetUser(mock.Anything, "u2").Return(&models.User{ID: "u2", Name: "Bob"}, nil)
+				p.user.EXPECT().GetUserTeamName(mock.Anything, "u2").Return("backend", nil)
+			},
+			status: http.StatusOK, sdk: &client.UserResponse{},
+		},
+		{
+			name: "not found", method: http.MethodPost, path: "/users/setIsActive",
+			setup: func(p *ports) {
+				p.user.EXPECT().UpdateUserActive(mock.Anything, "u2", false).Return(utils.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/users/list",
+			setup: func(p *ports) {
+				p.user.EXPECT().ListUsers(mock.Anything).Return([]*models.User{
+					{ID: "u2", Name: "Bob"},
+					{ID: "u1", Name: "Alice", IsActive: true},
+				}, nil)
+			},
+			status: http.StatusOK, sdk: &client.UserListResponse{},
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/users/tags", query: "user_id=u2",
+			setup: func(p *ports) {
+				p.user.EXPECT().GetUser(mock.Anything, "u2").Return(&models.User{ID: "u2", Name: "Bob", Tags: []string{"go", "postgres"}}, nil)
+			},
+			status: http.StatusOK, sdk: &client.UserTags{},
+		},
+		{
+			name: "normalized", method: http.MethodPost, path: "/users/tags",
+			setup: func(p *ports) {
+				p.user.EXPECT().SetUserTags(mock.Anything, "u2", []string{"Go", "postgres"}).
+					Return(&models.User{ID: "u2", Name: "Bob", Tags: []string{"go", "postgres"}}, nil)
+			},
+			status: http.StatusOK, sdk: &client.UserTags{},
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/users/email", query: "user_id=u2",
+			setup: func(p *ports) {
+				p.user.EXPECT().GetUser(mock.Anything, "u2").Return(&models.User{ID: "u2", Name: "Bob", Email: "bob@example.com"}, nil)
+			},
+			status: http.StatusOK, sdk: &client.UserEmail{},
+		},
+		{
+			name: "trimmed", method: http.MethodPost, path: "/users/email",
+			setup: func(p *ports) {
+				p.user.EXPECT().SetUserEmail(mock.Anything, "u2", " bob@example.com ").
+					Return(&models.User{ID: "u2", Name: "Bob", Email: "bob@example.com"}, nil)
+			},
+			status: http.StatusOK, sdk: &client.UserEmail{},
+		},
+		{
+			name: "invalid email", method: http.MethodPost, path: "/users/email",
+			setup: func(p *ports) {
+				p.user.EXPECT().SetUserEmail(mock.Anything, mock.Anything, mock.Anything).Return(nil, utils.ErrInvalidEmail)
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "unknown user", method: http.MethodGet, path: "/users/email", query: "user_id=ghost",
+			setup: func(p *ports) {
+				p.user.EXPECT().GetUser(mock.Anything, "ghost").Return(nil, utils.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/users/notifications", query: "user_id=u2",
+			setup: func(p *ports) {
+				p.notify.EXPECT().GetPreferences(mock.Anything, "u2").Return(digestPrefs(), nil)
+			},
+			status: http.StatusOK, sdk: &client.NotificationPreferences{},
+		},
+		{
+			name: "unknown user", method: http.MethodGet, path: "/users/notifications", query: "user_id=ghost",
+			setup: func(p *ports) {
+				p.notify.EXPECT().GetPreferences(mock.Anything, "ghost").Return(nil, utils.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "digest", method: http.MethodPost, path: "/users/notifications",
+			setup: func(p *ports) {
+				p.notify.EXPECT().SetPreferences(mock.Anything, &models.NotificationPreferences{
+					UserID: "u2", ChatHandle: "@bob.reviews", Delivery: models.NotificationDeliveryDigest, EmailDelivery: models.NotificationDeliveryImmediate,
+					Kinds: []models.NotificationKind{models.NotificationAssigned, models.NotificationSLABreached},
+				}).Return(digestPrefs(), nil)
+			},
+			status: http.StatusOK, sdk: &client.NotificationPreferences{},
+		},
+		{
+			name: "invalid delivery", method: http.MethodPost, path: "/users/notifications",
+			setup: func(p *ports) {
+				p.notify.EXPECT().SetPreferences(mock.Anything, mock.Anything).Return(nil, utils.ErrInvalidDelivery)
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "unknown user", method: http.MethodPost, path: "/users/notifications",
+			setup: func(p *ports) {
+				p.notify.EXPECT().SetPreferences(mock.Anything, mock.Anything).Return(nil, utils.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/users/getReview", query: "user_id=u2",
+			setup: func(p *ports) {
+				p.pr.EXPECT().ListPRsByAssignee(mock.Anything, "u2", (*models.PRStatus)(nil)).Return([]*models.PullRequest{openPR("u2")}, nil)
+			},
+			status: http.StatusOK, sdk: &client.UserReviews{},
+		},
+		{
+			name: "stream", method: http.MethodGet, path: "/users/reviewFeed", query: "user_id=u2",
+			setup: func(p *ports) {
+				live := make(chan *models.ReviewFeedEvent)
+				close(live)
+				p.feed.EXPECT().Subscribe("u2").Return(live, func() {})
+				p.feed.EXPECT().ListEvents(mock.Anything, "u2", int64(0), mock.Anything).Return([]*models.ReviewFeedEvent{{
+					ID: 42, UserID: "u2", Type: models.ReviewFeedAssigned, PRID: "pr-1001", PRTitle: "Add search",
+					Reason: models.AssignmentReasonInitial, CreatedAt: time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC),
+				}}, nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "user not found", method: http.MethodGet, path: "/users/reviewFeed", query: "user_id=ghost",
+			setup: func(p *ports) {
+				p.feed.EXPECT().Subscribe("ghost").Return(make(chan *models.ReviewFeedEvent), func() {})
+				p.feed.EXPECT().ListEvents(mock.Anything, "ghost", int64(0), mock.Anything).Return(nil, utils.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+
+		// PR
+		{
+			name: "created", method: http.MethodPost, path: "/pullRequest/create",
+			setup: func(p *ports) {
+				p.pr.EXPECT().CreatePR(mock.Anything, "pr-1001", "u1", "Add search", []string{"internal/search/index.go"}, []string{"go", "search"}).
+					Return(openPR("u2", "u3"), nil)
+			},
+			status: http.StatusCreated, sdk: &client.PRResponse{},
+		},
+		{
+			name: "exists", method: http.MethodPost, path: "/pullRequest/create",
+			setup: func(p *ports) {
+				p.pr.EXPECT().CreatePR(mock.Anything, "pr-1001", "u1", "Add search", mock.Anything, mock.Anything).Return(nil, utils.ErrPRExists)
+			},
+			status: http.StatusConflict, example: "exists",
+		},
+		{
+			name: "constraint", method: http.MethodPost, path: "/pullRequest/create",
+			setup: func(p *ports) {
+				p.pr.EXPECT().CreatePR(mock.Anything, "pr-1001", "u1", "Add search", mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("%w: none of [u7, u8] can be assigned", utils.ErrConstraintsUnsatisfied))
+			},
+			status: http.StatusConflict, example: "constraint",
+		},
+		{
+			name: "author not found", method: http.MethodPost, path: "/pullRequest/create",
+			setup: func(p *ports) {
+				p.pr.EXPECT().CreatePR(mock.Anything, "pr-1001", "u1", "Add search", mock.Anything, mock.Anything).Return(nil, utils.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "partial", method: http.MethodPost, path: "/pullRequest/batchCreate",
+			setup: func(p *ports) {
+				created := &models.PullRequest{ID: "pr-2001", Title: "Import A", AuthorID: "u1", Status: models.PRStatusOPEN, ReviewerIDs: []string{"u3", "u4"}, CreatedAt: exampleTime}
+				p.pr.EXPECT().BatchCreatePRs(mock.Anything, mock.Anything).Return([]*models.BatchPRResult{
+					{PullRequestID: "pr-2001", Status: models.BatchStatusCreated, PR: created},
+					{PullRequestID: "pr-2002", Status: models.BatchStatusError, Err: fmt.Errorf("%w: u2", utils.ErrReviewerNotEligible)},
+				}, nil)
+			},
+			status: http.StatusOK, sdk: &client.BatchCreateResult{},
+		},
+		{
+			name: "merged", method: http.MethodPost, path: "/pullRequest/merge",
+			setup: func(p *ports) {
+				pr := openPR("u2", "u3")
+				pr.Status, pr.MergedAt = models.PRStatusMERGED, &mergedTime
+				p.pr.EXPECT().MergePR(mock.Anything, "pr-1001", mock.Anything).Return(pr, nil)
+			},
+			status: http.StatusOK, sdk: &client.PRResponse{},
+		},
+		{
+			name: "not found", method: http.MethodPost, path: "/pullRequest/merge",
+			setup: func(p *ports) {
+				p.pr.EXPECT().MergePR(mock.Anything, "pr-1001", mock.Anything).Return(nil, utils.ErrPRNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "closed", method: http.MethodPost, path: "/pullRequest/merge",
+			setup: func(p *ports) {
+				p.pr.EXPECT().MergePR(mock.Anything, "pr-1001", mock.Anything).Return(nil, utils.ErrPRClosed)
+			},
+			status: http.StatusConflict,
+		},
+		{
+			name: "stale etag", method: http.MethodPost, path: "/pullRequest/merge",
+			header: map[string]string{"If-Match": `"2"`},
+			setup: func(p *ports) {
+				// Условие из заголовка передаётся сервису аргументом.
+				stale := mock.MatchedBy(func(m *utils.IfMatch) bool {
+					return m.Check(2) == nil && m.Check(3) != nil
+				})
+				p.pr.EXPECT().MergePR(mock.Anything, "pr-1001", stale).Return(nil, utils.ErrPreconditionFailed)
+			},
+			status: http.StatusPreconditionFailed,
+		},
+		{
+			name: "replaced", method: http.MethodPost, path: "/pullRequest/reassign",
+			setup: func(p *ports) {
+				p.pr.EXPECT().ReassignReviewer(mock.Anything, "pr-1001", "u2", "", models.AssignmentReason(""), mock.Anything).Return(openPR("u3", "u5"), nil)
+			},
+			status: http.StatusOK, sdk: &client.ReassignResult{},
+		},
+		{
+			name: "invalid reason", method: http.MethodPost, path: "/pullRequest/reassign",
+			body: `{"pull_request_id":"pr-1001","old_user_id":"u2","reason":"boredom"}`,
+			setup: func(p *ports) {
+				p.pr.EXPECT().ReassignReviewer(mock.Anything, "pr-1001", "u2", "", models.AssignmentReason("boredom"), mock.Anything).Return(nil, utils.ErrInvalidReason)
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "not found", method: http.MethodPost, path: "/pullRequest/reassign",
+			setup: func(p *ports) {
+				p.pr.EXPECT().ReassignReviewer(mock.Anything, "pr-1001", "u2", "", models.AssignmentReason(""), mock.Anything).Return(nil, utils.ErrPRNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		reassignConflict("merged", utils.ErrAlreadyMerged),
+		reassignConflict("notAssigned", utils.ErrReviewerNotAssigned),
+		reassignConflict("noCandidate", utils.ErrNoReplacementCandidates),
+		reassignConflict("notEligible", utils.ErrReviewerNotEligible),
+		reassignConflict("alreadyAssigned", utils.ErrReviewerAlreadyAssigned),
+		reassignConflict("constraint", fmt.Errorf("%w: none of [u7] would remain assigned", utils.ErrConstraintsUnsatisfied)),
+		{
+			name: "added", method: http.MethodPost, path: "/pullRequest/addReviewer",
+			setup: func(p *ports) {
+				p.pr.EXPECT().AddReviewer(mock.Anything, "pr-1001", "u3", mock.Anything).Return(openPR("u2", "u3"), nil)
+			},
+			status: http.StatusOK, sdk: &client.PRResponse{},
+		},
+		{
+			name: "too many", method: http.MethodPost, path: "/pullRequest/addReviewer",
+			setup: func(p *ports) {
+				p.pr.EXPECT().AddReviewer(mock.Anything, "pr-1001", "u3", mock.Anything).Return(nil, utils.ErrTooManyReviewers)
+			},
+			status: http.StatusConflict,
+		},
+		{
+			name: "removed", method: http.MethodPost, path: "/pullRequest/removeReviewer",
+			setup: func(p *ports) {
+				p.pr.EXPECT().RemoveReviewer(mock.Anything, "pr-1001", "u2", mock.Anything).Return(openPR(), nil)
+			},
+			status: http.StatusOK, sdk: &client.PRResponse{},
+		},
+		{
+			name: "not assigned", method: http.MethodPost, path: "/pullRequest/removeReviewer",
+			setup: func(p *ports) {
+				p.pr.EXPECT().RemoveReviewer(mock.Anything, "pr-1001", "u2", mock.Anything).Return(nil, utils.ErrReviewerNotAssigned)
+			},
+			status: http.StatusConflict,
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/pullRequest/history", query: "pull_request_id=pr-1001",
+			setup: func(p *ports) {
+				later := exampleTime.Add(time.Hour)
+				p.pr.EXPECT().GetReviewerHistory(mock.Anything, "pr-1001").Return([]*models.ReviewerHistoryEntry{
+					{PRID: "pr-1001", ReviewerID: "u2", Event: models.ReviewerEventAssigned, Reason: models.AssignmentReasonInitial, CreatedAt: exampleTime},
+					{PRID: "pr-1001", ReviewerID: "u2", Event: models.ReviewerEventUnassigned, Reason: models.AssignmentReasonDeactivation, Actor: "alice", CreatedAt: later},
+					{PRID: "pr-1001", ReviewerID: "u5", Event: models.ReviewerEventAssigned, Reason: models.AssignmentReasonDeactivation, Actor: "alice", CreatedAt: later},
+				}, nil)
+			},
+			status: http.StatusOK, sdk: &client.ReviewerHistory{},
+		},
+		{
+			name: "not found", method: http.MethodGet, path: "/pullRequest/history", query: "pull_request_id=pr-404",
+			setup: func(p *ports) {
+				p.pr.EXPECT().GetReviewerHistory(mock.Anything, "pr-404").Return(nil, utils.ErrPRNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/pullRequest/explain", query: "pull_request_id=pr-1001",
+			setup: func(p *ports) {
+				p.pr.EXPECT().ExplainSelection(mock.Anything, "pr-1001").Return([]*models.SelectionExplanation{{
+					PRID:     "pr-1001",
+					Reason:   models.AssignmentReasonInitial,
+					Strategy: "codeowners+weighted",
+					Slots:    2,
+					Pool:     []string{"u2", "u3"},
+					Excluded: []models.ExcludedCandidate{
+						{UserID: "u1", Reason: models.ExclusionReasonAuthor},
+						{UserID: "u4", Reason: models.ExclusionReasonInactive},
+					},
+					Scores: []models.CandidateScore{
+						{UserID: "u2", Score: 1, Factors: map[string]float64{"owned_paths": 1}, Selected: true},
+						{UserID: "u3", Score: 0.42, Factors: map[string]float64{"tag_overlap": 0, "open_reviews": 0, "jitter": 0.42}, Selected: true},
+					},
+					Selected:  []string{"u2", "u3"},
+					CreatedAt: exampleTime,
+				}}, nil)
+			},
+			status: http.StatusOK, sdk: &client.Explanation{},
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/pullRequest/get", query: "pull_request_id=pr-1001",
+			setup: func(p *ports) {
+				p.pr.EXPECT().GetPR(mock.Anything, "pr-1001").Return(openPR("u2"), nil)
+				p.user.EXPECT().ListUsersByIDs(mock.Anything, []string{"u2"}).Return(users("u2"), nil)
+			},
+			status: http.StatusOK, sdk: &client.PRResponse{},
+		},
+		{
+			name: "not found", method: http.MethodGet, path: "/pullRequest/get", query: "pull_request_id=pr-404",
+			setup: func(p *ports) {
+				p.pr.EXPECT().GetPR(mock.Anything, "pr-404").Return(nil, utils.ErrPRNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "filtered", method: http.MethodGet, path: "/pullRequest/list", query: "status=OPEN&limit=10",
+			setup: func(p *ports) {
+				p.pr.EXPECT().ListPRs(mock.Anything, mock.Anything).Return(&models.PRPage{PullRequests: []*models.PullRequest{openPR("u2", "u3")}, Total: 1, Limit: 10}, nil)
+				p.user.EXPECT().ListUsersByIDs(mock.Anything, []string{"u2", "u3"}).Return(users("u2", "u3"), nil)
+			},
+			status: http.StatusOK, sdk: &client.PRPage{},
+		},
+		{
+			name: "invalid status", method: http.MethodGet, path: "/pullRequest/list", query: "status=DRAFT",
+			status: http.StatusBadRequest,
+		},
+
+		// аудит и администрирование
+		{
+			name: "ok", method: http.MethodGet, path: "/audit", query: "entity_type=pull_request&entity_id=pr-1001",
+			setup: func(p *ports) {
+				p.audit.EXPECT().ListEntries(mock.Anything, mock.Anything).Return([]*models.AuditEntry{{
+					ID:         42,
+					Actor:      "alice",
+					RequestID:  "host/abcd-000001",
+					Action:     models.AuditActionPRMerge,
+					EntityType: models.AuditEntityPR,
+					EntityID:   "pr-1001",
+					Before:     json.RawMessage(`{"id":"pr-1001","status":"OPEN"}`),
+					After:      json.RawMessage(`{"id":"pr-1001","status":"MERGED"}`),
+					CreatedAt:  mergedTime,
+				}}, nil)
+			},
+			status: http.StatusOK, sdk: &client.AuditPage{},
+		},
+		{
+			name: "constraints action", method: http.MethodGet, path: "/audit", query: "entity_type=team",
+			setup: func(p *ports) {
+				p.audit.EXPECT().ListEntries(mock.Anything, mock.Anything).Return([]*models.AuditEntry{{
+					ID: 43, Actor: "alice", RequestID: "host/abcd-000002",
+					Action: models.AuditActionTeamConstraints, EntityType: models.AuditEntityTeam, EntityID: "backend",
+					After: json.RawMessage(`{"constraints":[]}`), CreatedAt: mergedTime,
+				}}, nil)
+			},
+			status: http.StatusOK, noExample: true, sdk: &client.AuditPage{},
+		},
+		{
+			name: "invalid from", method: http.MethodGet, path: "/audit", query: "from=yesterday",
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "json", method: http.MethodGet, path: "/admin/export",
+			setup: func(p *ports) {
+				p.team.EXPECT().ExportRoster(mock.Anything).Return(exampleRoster(), nil)
+			},
+			status: http.StatusOK, sdk: &client.Roster{},
+		},
+		{
+			name: "yaml", method: http.MethodGet, path: "/admin/export", query: "format=yaml",
+			setup: func(p *ports) {
+				p.team.EXPECT().ExportRoster(mock.Anything).Return(exampleRoster(), nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "csv", method: http.MethodGet, path: "/admin/export", query: "format=csv",
+			setup: func(p *ports) {
+				p.team.EXPECT().ExportRoster(mock.Anything).Return(exampleRoster(), nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "unknown format", method: http.MethodGet, path: "/admin/export", query: "format=xml",
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "csv dry run", method: http.MethodPost, path: "/admin/import", query: "dry_run=true", contentType: "text/csv",
+			setup: func(p *ports) {
+				diff := models.NewRosterDiff()
+				diff.DeactivateUsers = []string{"u2"}
+				p.team.EXPECT().ImportRoster(mock.Anything, mock.Anything, true).Return(diff, nil)
+			},
+			status: http.StatusOK, sdk: &client.ImportResult{},
+		},
+		{
+			name: "json with settings", method: http.MethodPost, path: "/admin/import",
+			body: `{"teams":[{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}],` +
+				`"settings":{"selection_strategy":"weighted","code_owners":[],"reviewer_constraints":[]}}]}`,
+			setup: func(p *ports) {
+				diff := models.NewRosterDiff()
+				diff.UpdateSettings = []models.SettingsChange{{TeamName: "backend", Fields: []string{models.SettingSelectionStrategy}}}
+				p.team.EXPECT().ImportRoster(mock.Anything, mock.Anything, false).Return(diff, nil)
+			},
+			status: http.StatusOK, sdk: &client.ImportResult{},
+		},
+		{
+			name: "unknown owner", method: http.MethodPost, path: "/admin/import", contentType: "text/csv",
+			setup: func(p *ports) {
+				p.team.EXPECT().ImportRoster(mock.Anything, mock.Anything, false).Return(nil, utils.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+
+		// интеграции VCS
+		{
+			name: "created", method: http.MethodPost, path: "/integrations/github/webhook",
+			setup: func(p *ports) {
+				p.vcs.EXPECT().HandlePullRequestEvent(mock.Anything, &models.VCSPullRequestEvent{
+					Provider: models.VCSProviderGitHub, Action: models.VCSActionOpened, PRID: "gh-582347311-42",
+					Repository: "acme/pr-service", Number: 42,
+					Title: "Add search index", AuthorLogin: "Octo-Alice", Labels: []string{"go"}, Sender: "Octo-Alice",
+				}).Return(&models.VCSEventResult{Outcome: models.VCSOutcomeCreated, PR: openPR("u2")}, nil)
+			},
+			status: http.StatusOK, example: "created",
+		},
+		{
+			name: "ignored", method: http.MethodPost, path: "/integrations/github/webhook",
+			setup: func(p *ports) {
+				p.vcs.EXPECT().HandlePullRequestEvent(mock.Anything, mock.Anything).
+					Return(&models.VCSEventResult{Outcome: models.VCSOutcomeIgnored, Reason: "author Octo-Alice is not mapped to a user"}, nil)
+			},
+			status: http.StatusOK, example: "ignored",
+		},
+		{
+			name: "bad signature", method: http.MethodPost, path: "/integrations/github/webhook", unsigned: true,
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "author without team", method: http.MethodPost, path: "/integrations/github/webhook",
+			setup: func(p *ports) {
+				p.vcs.EXPECT().HandlePullRequestEvent(mock.Anything, mock.Anything).Return(nil, utils.ErrUserNoTeam)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "ready for review", method: http.MethodPost, path: "/integrations/gitlab/webhook",
+			setup: func(p *ports) {
+				p.vcs.EXPECT().HandlePullRequestEvent(mock.Anything, &models.VCSPullRequestEvent{
+					Provider: models.VCSProviderGitLab, Action: models.VCSActionReady, PRID: "gl-278964-17",
+					Repository: "278964", Number: 17,
+					Title: "Cache team settings", AuthorLogin: "Alice.Dev", Labels: []string{"go"}, Sender: "Alice.Dev",
+				}).Return(&models.VCSEventResult{Outcome: models.VCSOutcomeReopened, PR: openPR("u2")}, nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "bad token", method: http.MethodPost, path: "/integrations/gitlab/webhook", unsigned: true,
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "merged PR", method: http.MethodPost, path: "/integrations/gitlab/webhook",
+			setup: func(p *ports) {
+				p.vcs.EXPECT().HandlePullRequestEvent(mock.Anything, mock.Anything).Return(nil, utils.ErrAlreadyMerged)
+			},
+			status: http.StatusConflict,
+		},
+		{
+			name: "ok", method: http.MethodPost, path: "/integrations/identities",
+			setup: func(p *ports) {
+				p.vcs.EXPECT().SetIdentities(mock.Anything, []*models.VCSIdentity{{Provider: models.VCSProviderGitHub, Login: "Octo-Alice", UserID: "u1"}}).
+					Return([]*models.VCSIdentity{{Provider: models.VCSProviderGitHub, Login: "octo-alice", UserID: "u1"}}, nil)
+			},
+			status: http.StatusOK, sdk: &client.VCSIdentities{},
+		},
+		{
+			name: "unknown user", method: http.MethodPost, path: "/integrations/identities",
+			setup: func(p *ports) {
+				p.vcs.EXPECT().SetIdentities(mock.Anything, mock.Anything).Return(nil, utils.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "ok", method: http.MethodGet, path: "/integrations/identities", query: "provider=github",
+			setup: func(p *ports) {
+				p.vcs.EXPECT().ListIdentities(mock.Anything, models.VCSProviderGitHub).
+					Return([]*models.VCSIdentity{{Provider: models.VCSProviderGitHub, Login: "octo-alice", UserID: "u1"}}, nil)
+			},
+			status: http.StatusOK, sdk: &client.VCSIdentities{},
+		},
+		{
+			name: "unknown provider", method: http.MethodGet, path: "/integrations/identities", query: "provider=bitbucket",
+			setup: func(p *ports) {
+				p.vcs.EXPECT().ListIdentities(mock.Anything, models.VCSProvider("bitbucket")).Return(nil, utils.ErrInvalidProvider)
+			},
+			status: http.StatusBadRequest,
+		},
+	}
+}
+
+func exampleRoster() *models.Roster {
+	return &models.Roster{Teams: []models.RosterTeam{{
+		Name: "backend",
+		Members: []models.RosterMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob"},
+		},
+		Settings: &models.TeamSettings{
+			SelectionStrategy:   "weighted",
+			CodeOwners:          []models.CodeOwnerRule{{Pattern: "*", OwnerIDs: []string{"u1"}}},
+			ReviewerConstraints: []models.ReviewerConstraint{},
+		},
+	}}}
+}
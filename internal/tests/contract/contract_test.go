@@ -0,0 +1,343 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/vcs/github"
+	"avito-test-pr-service/internal/infrastructure/vcs/gitlab"
+	"avito-test-pr-service/mocks"
+
+	"github.com/go-chi/chi/v5"
+	"gopkg.in/yaml.v3"
+)
+
+// ports — моки входных портов, за которыми стоит роутер в контрактных тестах.
+type ports struct {
+	pr     *mocks.PRInputPort
+	team   *mocks.TeamInputPort
+	user   *mocks.UserInputPort
+	audit  *mocks.AuditInputPort
+	feed   *mocks.ReviewFeedInputPort
+	vcs    *mocks.VCSInputPort
+	notify *mocks.NotificationInputPort
+}
+
+func newPorts(t *testing.T) *ports {
+	return &ports{
+		pr:     mocks.NewPRInputPort(t),
+		team:   mocks.NewTeamInputPort(t),
+		user:   mocks.NewUserInputPort(t),
+		audit:  mocks.NewAuditInputPort(t),
+		feed:   mocks.NewReviewFeedInputPort(t),
+		vcs:    mocks.NewVCSInputPort(t),
+		notify: mocks.NewNotificationInputPort(t),
+	}
+}
+
+func newRouter(p *ports) http.Handler {
+	log := &logger.Logger{Logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	r := apihttp.NewRouter(log, p.pr, p.team, p.user, p.audit, p.feed, p.vcs, p.notify)
+	r.Setup(&config.Config{
+		HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second},
+		Integrations: config.Integrations{
+			GitHub: config.GitHubIntegration{WebhookSecret: webhookSecret},
+			GitLab: config.GitLabIntegration{WebhookSecret: webhookSecret},
+		},
+	})
+	return r.GetRouter()
+}
+
+const (
+	webhookSecret = "contract-secret"
+	githubWebhook = "/integrations/github/webhook"
+	gitlabWebhook = "/integrations/gitlab/webhook"
+)
+
+// signWebhook подписывает запросы к вебхукам VCS, чтобы они проходили проверку секрета и доходили до разбора тела.
+func signWebhook(req *http.Request, body string) {
+	switch req.URL.Path {
+	case githubWebhook:
+		req.Header.Set(github.EventHeader, github.EventPullRequest)
+		req.Header.Set(github.SignatureHeader, github.Sign(webhookSecret, []byte(body)))
+	case gitlabWebhook:
+		req.Header.Set(gitlab.EventHeader, gitlab.EventMergeRequest)
+		req.Header.Set(gitlab.TokenHeader, webhookSecret)
+	}
+}
+
+func loadSpec(t *testing.T) *Spec {
+	t.Helper()
+	spec, err := LoadSpec()
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+	return spec
+}
+
+// checkResponse сверяет ответ с документированным статусом и схемой; возвращает декодированное тело (для JSON).
+func checkResponse(t *testing.T, spec *Spec, method, path string, rec *httptest.ResponseRecorder) any {
+	t.Helper()
+	op := spec.Operation(method, path)
+	status := strconv.Itoa(rec.Code)
+	resp, ok := op.Responses[status]
+	if !ok {
+		t.Fatalf("%s %s: status %s is not documented; body: %s", method, path, status, rec.Body.String())
+	}
+	for name, h := range spec.ResponseHeaders(resp) {
+		if h.Required && rec.Header().Get(name) == "" {
+			t.Errorf("%s %s %s: required header %s is missing", method, path, status, name)
+		}
+	}
+	ct := strings.TrimSpace(strings.Split(rec.Header().Get("Content-Type"), ";")[0])
+	mt, ok := resp.Content[ct]
+	if !ok {
+		t.Fatalf("%s %s %s: content type %q is not documented", method, path, status, ct)
+	}
+	var body any
+	switch ct {
+	case "application/json":
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			t.Fatalf("%s %s: invalid JSON: %v", method, path, err)
+		}
+	case "application/yaml":
+		var doc any
+		if err := yaml.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+			t.Fatalf("%s %s: invalid YAML: %v", method, path, err)
+		}
+		body = normalize(doc)
+	default:
+		body = rec.Body.String()
+	}
+	for _, e := range spec.Validate(body, mt.Schema) {
+		t.Errorf("%s %s %s: %s", method, path, status, e)
+	}
+	return body
+}
+
+func TestContract_ExamplesMatchSchemas(t *testing.T) {
+	spec := loadSpec(t)
+	for _, ex := range spec.Examples() {
+		for _, e := range spec.Validate(ex.Value, ex.Schema) {
+			t.Errorf("%s: %s", ex.ID, e)
+		}
+	}
+}
+
+// TestContract_RoutesDocumented — каждый маршрут роутера описан в спецификации, и наоборот.
+func TestContract_RoutesDocumented(t *testing.T) {
+	spec := loadSpec(t)
+	documented := make(map[string]bool)
+	for _, op := range spec.Operations() {
+		documented[op] = true
+	}
+	mux, ok := newRouter(newPorts(t)).(*chi.Mux)
+	if !ok {
+		t.Fatal("router is not a chi.Mux")
+	}
+	var undocumented []string
+	err := chi.Walk(mux, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		key := method + " " + strings.TrimSuffix(strings.ReplaceAll(route, "/*/", "/"), "/")
+		if !documented[key] {
+			undocumented = append(undocumented, key)
+		}
+		delete(documented, key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walk: %v", err)
+	}
+	sort.Strings(undocumented)
+	for _, key := range undocumented {
+		t.Errorf("route %s is not in the spec", key)
+	}
+	for key := range documented {
+		t.Errorf("operation %s is documented but not routed", key)
+	}
+}
+
+// TestContract_BadRequestsDocumented отправляет каждой операции битое тело или запрос без обязательных
+// query-параметров и требует, чтобы ответ 400 был описан в спецификации.
+func TestContract_BadRequestsDocumented(t *testing.T) {
+	spec := loadSpec(t)
+	for _, key := range spec.Operations() {
+		method, path, _ := strings.Cut(key, " ")
+		op := spec.Operation(method, path)
+		var req *http.Request
+		switch {
+		case op.RequestBody != nil && op.RequestBody.Content["application/json"] != nil:
+			req = httptest.NewRequest(method, path, strings.NewReader("{"))
+			req.Header.Set("Content-Type", "application/json")
+			signWebhook(req, "{")
+		case hasRequiredQuery(spec, op):
+			req = httptest.NewRequest(method, path, nil)
+		default:
+			continue
+		}
+		t.Run(key, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			newRouter(newPorts(t)).ServeHTTP(rec, req)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("want 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+			body := checkResponse(t, spec, method, path, rec)
+			if code := errorCode(body); code != "BAD_REQUEST" {
+				t.Errorf("want BAD_REQUEST, got %q", code)
+			}
+		})
+	}
+}
+
+func hasRequiredQuery(spec *Spec, op *Operation) bool {
+	for _, p := range spec.Parameters(op) {
+		if p.In == "query" && p.Required {
+			return true
+		}
+	}
+	return false
+}
+
+func errorCode(body any) string {
+	obj, _ := body.(map[string]any)
+	errObj, _ := obj["error"].(map[string]any)
+	code, _ := errObj["code"].(string)
+	return code
+}
+
+// contractCase — один прогон операции через роутер. Тело запроса по умолчанию берётся из примера спецификации.
+type contractCase struct {
+	name        string
+	method      string
+	path        string
+	query       string
+	body        string // пусто — пример запроса из спецификации
+	contentType string // пусто — application/json
+	setup       func(p *ports)
+	status      int
+	// example — имя примера ответа из examples; пусто — единственный example, если он описан.
+	example string
+	// noExample — сценарий отличается от описанного в примере ответа, сверяется только схема.
+	noExample bool
+	// sdk — тип из pkg/client, в который ответ должен декодироваться без неизвестных полей.
+	sdk any
+	// unsigned — не подписывать запрос к вебхуку.
+	unsigned bool
+	// header — дополнительные заголовки запроса.
+	header map[string]string
+}
+
+func TestContract_Replay(t *testing.T) {
+	spec := loadSpec(t)
+	byID := make(map[string]Example)
+	for _, ex := range spec.Examples() {
+		byID[ex.ID] = ex
+	}
+	used := make(map[string]bool)
+	covered := make(map[string]bool)
+
+	for _, tc := range contractCases() {
+		key := tc.method + " " + tc.path
+		covered[key] = true
+		t.Run(key+" "+tc.name, func(t *testing.T) {
+			op := spec.Operation(tc.method, tc.path)
+			if op == nil {
+				t.Fatalf("operation %s is not in the spec", key)
+			}
+			ct := tc.contentType
+			if ct == "" {
+				ct = "application/json"
+			}
+			body := tc.body
+			if body == "" && op.RequestBody != nil {
+				id := key + " request " + ct
+				ex, ok := byID[id]
+				if !ok {
+					t.Fatalf("no request example %q; set body explicitly", id)
+				}
+				used[id] = true
+				if s, ok := ex.Value.(string); ok {
+					body = s
+				} else {
+					raw, _ := json.Marshal(ex.Value)
+					body = string(raw)
+				}
+			}
+
+			p := newPorts(t)
+			if tc.setup != nil {
+				tc.setup(p)
+			}
+			target := tc.path
+			if tc.query != "" {
+				target += "?" + tc.query
+			}
+			req := httptest.NewRequest(tc.method, target, strings.NewReader(body))
+			if body != "" {
+				req.Header.Set("Content-Type", ct)
+			}
+			for name, value := range tc.header {
+				req.Header.Set(name, value)
+			}
+			if !tc.unsigned {
+				signWebhook(req, body)
+			}
+			rec := httptest.NewRecorder()
+			newRouter(p).ServeHTTP(rec, req)
+
+			if rec.Code != tc.status {
+				t.Fatalf("want %d, got %d: %s", tc.status, rec.Code, rec.Body.String())
+			}
+			got := checkResponse(t, spec, tc.method, tc.path, rec)
+
+			respCT := strings.TrimSpace(strings.Split(rec.Header().Get("Content-Type"), ";")[0])
+			id := key + " " + strconv.Itoa(tc.status) + " " + respCT
+			if tc.example != "" {
+				id += " " + tc.example
+			}
+			if ex, ok := byID[id]; ok && !tc.noExample {
+				used[id] = true
+				want := ex.Value
+				if tc.status >= http.StatusBadRequest {
+					// Тексты ошибок описательные: сверяется только код.
+					want = map[string]any{"error": map[string]any{"code": errorCode(want)}}
+				}
+				for _, e := range Contains(want, got) {
+					t.Errorf("example %s: %s", id, e)
+				}
+			} else if tc.example != "" {
+				t.Fatalf("no response example %q", id)
+			}
+
+			if tc.sdk != nil {
+				dec := json.NewDecoder(bytes.NewReader(rec.Body.Bytes()))
+				dec.DisallowUnknownFields()
+				if err := dec.Decode(tc.sdk); err != nil {
+					t.Errorf("pkg/client %T does not match the response: %v", tc.sdk, err)
+				}
+			}
+		})
+	}
+
+	for _, ex := range spec.Examples() {
+		if !used[ex.ID] {
+			t.Errorf("example %s is not replayed by any case", ex.ID)
+		}
+	}
+	for _, key := range spec.Operations() {
+		if !covered[key] {
+			t.Errorf("operation %s has no contract case", key)
+		}
+	}
+}
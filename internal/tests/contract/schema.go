@@ -0,0 +1,195 @@
+package contract
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Validate проверяет значение, декодированное encoding/json, по схеме. Проверка строгая: у объектов
+// с описанными properties поля, которых нет в схеме, считаются ошибкой, если additionalProperties не разрешает их явно.
+func (s *Spec) Validate(v any, schema *Schema) []string {
+	var errs []string
+	s.validate(v, schema, "$", &errs)
+	return errs
+}
+
+func (s *Spec) resolve(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = s.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+	}
+	return schema
+}
+
+func (s *Spec) validate(v any, schema *Schema, path string, errs *[]string) {
+	fail := func(format string, args ...any) {
+		*errs = append(*errs, path+": "+fmt.Sprintf(format, args...))
+	}
+	schema = s.resolve(schema)
+	if schema == nil {
+		fail("unresolved schema")
+		return
+	}
+	if v == nil {
+		if !schema.Nullable && schema.Type != "" {
+			fail("null is not allowed")
+		}
+		return
+	}
+	if len(schema.Enum) > 0 && !inEnum(v, schema.Enum) {
+		fail("value %v is not in enum %v", v, schema.Enum)
+	}
+
+	switch schema.Type {
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			fail("want object, got %T", v)
+			return
+		}
+		s.validateObject(obj, schema, path, errs)
+	case "array":
+		arr, ok := v.([]any)
+		if !ok {
+			fail("want array, got %T", v)
+			return
+		}
+		if schema.MinItems != nil && len(arr) < *schema.MinItems {
+			fail("want at least %d items, got %d", *schema.MinItems, len(arr))
+		}
+		if schema.MaxItems != nil && len(arr) > *schema.MaxItems {
+			fail("want at most %d items, got %d", *schema.MaxItems, len(arr))
+		}
+		if schema.Items != nil {
+			for i, item := range arr {
+				s.validate(item, schema.Items, fmt.Sprintf("%s[%d]", path, i), errs)
+			}
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			fail("want string, got %T", v)
+			return
+		}
+		if schema.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339Nano, str); err != nil {
+				fail("want date-time, got %q", str)
+			}
+		}
+	case "integer", "number":
+		n, ok := v.(float64)
+		if !ok {
+			fail("want %s, got %T", schema.Type, v)
+			return
+		}
+		if schema.Type == "integer" && n != math.Trunc(n) {
+			fail("want integer, got %v", n)
+		}
+		if schema.Minimum != nil && n < *schema.Minimum {
+			fail("%v is less than minimum %v", n, *schema.Minimum)
+		}
+		if schema.Maximum != nil && n > *schema.Maximum {
+			fail("%v is greater than maximum %v", n, *schema.Maximum)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			fail("want boolean, got %T", v)
+		}
+	}
+}
+
+func (s *Spec) validateObject(obj map[string]any, schema *Schema, path string, errs *[]string) {
+	for _, name := range schema.Required {
+		if _, ok := obj[name]; !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: missing required field %q", path, name))
+		}
+	}
+	extra, allowExtra := s.additionalProperties(schema)
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		child := path + "." + k
+		switch prop, ok := schema.Properties[k]; {
+		case ok:
+			s.validate(obj[k], prop, child, errs)
+		case extra != nil:
+			s.validate(obj[k], extra, child, errs)
+		case !allowExtra:
+			*errs = append(*errs, fmt.Sprintf("%s: field is not in the spec", child))
+		}
+	}
+}
+
+// additionalProperties возвращает схему дополнительных полей и признак, что произвольные поля разрешены.
+// Объект без properties и без additionalProperties — произвольный (например, params стратегии).
+func (s *Spec) additionalProperties(schema *Schema) (*Schema, bool) {
+	node := schema.AdditionalProperties
+	if node == nil {
+		return nil, len(schema.Properties) == 0
+	}
+	if node.Kind == yaml.ScalarNode {
+		return nil, node.Value == "true"
+	}
+	extra := &Schema{}
+	if err := node.Decode(extra); err != nil {
+		return nil, false
+	}
+	return extra, true
+}
+
+func inEnum(v any, enum []any) bool {
+	for _, e := range enum {
+		if reflect.DeepEqual(normalize(e), v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains проверяет, что got содержит все поля want с теми же значениями; лишние поля объектов got допустимы,
+// массивы сравниваются поэлементно и должны совпадать по длине.
+func Contains(want, got any) []string {
+	var errs []string
+	contains(want, got, "$", &errs)
+	return errs
+}
+
+func contains(want, got any, path string, errs *[]string) {
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: want object, got %T", path, got))
+			return
+		}
+		for k, wv := range w {
+			gv, ok := g[k]
+			if !ok {
+				*errs = append(*errs, fmt.Sprintf("%s.%s: missing in response", path, k))
+				continue
+			}
+			contains(wv, gv, path+"."+k, errs)
+		}
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(w) {
+			*errs = append(*errs, fmt.Sprintf("%s: want %v, got %v", path, want, got))
+			return
+		}
+		for i := range w {
+			contains(w[i], g[i], fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	default:
+		if !reflect.DeepEqual(want, got) {
+			*errs = append(*errs, fmt.Sprintf("%s: want %v, got %v", path, want, got))
+		}
+	}
+}
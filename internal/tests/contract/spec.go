@@ -0,0 +1,200 @@
+// Package contract сверяет HTTP API с docs/openapi.yml: документированные примеры прогоняются через
+// настоящий роутер, а ответы проверяются по схемам спецификации в строгом режиме.
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec — подмножество OpenAPI 3.0, которое используется в docs/openapi.yml.
+type Spec struct {
+	Paths      map[string]map[string]*Operation `yaml:"paths"`
+	Components struct {
+		Schemas    map[string]*Schema    `yaml:"schemas"`
+		Parameters map[string]*Parameter `yaml:"parameters"`
+		Headers    map[string]*Header    `yaml:"headers"`
+	} `yaml:"components"`
+}
+
+type Operation struct {
+	Parameters  []*Parameter         `yaml:"parameters"`
+	RequestBody *Body                `yaml:"requestBody"`
+	Responses   map[string]*Response `yaml:"responses"`
+}
+
+type Parameter struct {
+	Ref      string  `yaml:"$ref"`
+	Name     string  `yaml:"name"`
+	In       string  `yaml:"in"`
+	Required bool    `yaml:"required"`
+	Schema   *Schema `yaml:"schema"`
+}
+
+type Body struct {
+	Required bool                  `yaml:"required"`
+	Content  map[string]*MediaType `yaml:"content"`
+}
+
+type Response struct {
+	Description string                `yaml:"description"`
+	Headers     map[string]*Header    `yaml:"headers"`
+	Content     map[string]*MediaType `yaml:"content"`
+}
+
+type Header struct {
+	Ref      string  `yaml:"$ref"`
+	Required bool    `yaml:"required"`
+	Schema   *Schema `yaml:"schema"`
+}
+
+type MediaType struct {
+	Schema   *Schema `yaml:"schema"`
+	Example  any     `yaml:"example"`
+	Examples map[string]struct {
+		Value any `yaml:"value"`
+	} `yaml:"examples"`
+}
+
+type Schema struct {
+	Ref                  string             `yaml:"$ref"`
+	Type                 string             `yaml:"type"`
+	Format               string             `yaml:"format"`
+	Enum                 []any              `yaml:"enum"`
+	Nullable             bool               `yaml:"nullable"`
+	Required             []string           `yaml:"required"`
+	Properties           map[string]*Schema `yaml:"properties"`
+	AdditionalProperties *yaml.Node         `yaml:"additionalProperties"`
+	Items                *Schema            `yaml:"items"`
+	MinItems             *int               `yaml:"minItems"`
+	MaxItems             *int               `yaml:"maxItems"`
+	Minimum              *float64           `yaml:"minimum"`
+	Maximum              *float64           `yaml:"maximum"`
+}
+
+// Example — документированный пример тела запроса или ответа.
+type Example struct {
+	// ID однозначно называет пример, например "POST /pullRequest/reassign 409 application/json merged".
+	ID          string
+	Method      string
+	Path        string
+	Status      string // пусто для примеров запроса
+	ContentType string
+	Schema      *Schema
+	Value       any
+}
+
+// specPath ищет docs/openapi.yml относительно этого файла, чтобы тесты не зависели от рабочего каталога.
+func specPath() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "docs", "openapi.yml")
+}
+
+func LoadSpec() (*Spec, error) {
+	raw, err := os.ReadFile(specPath())
+	if err != nil {
+		return nil, err
+	}
+	spec := &Spec{}
+	if err := yaml.Unmarshal(raw, spec); err != nil {
+		return nil, fmt.Errorf("parse openapi: %w", err)
+	}
+	return spec, nil
+}
+
+func (s *Spec) Operation(method, path string) *Operation {
+	return s.Paths[path][strings.ToLower(method)]
+}
+
+// Operations возвращает пары "METHOD path" в детерминированном порядке.
+func (s *Spec) Operations() []string {
+	var ops []string
+	for path, item := range s.Paths {
+		for method := range item {
+			ops = append(ops, strings.ToUpper(method)+" "+path)
+		}
+	}
+	sort.Strings(ops)
+	return ops
+}
+
+func (s *Spec) Parameters(op *Operation) []*Parameter {
+	out := make([]*Parameter, 0, len(op.Parameters))
+	for _, p := range op.Parameters {
+		if p.Ref != "" {
+			p = s.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+// ResponseHeaders возвращает заголовки ответа с раскрытыми ссылками на components/headers.
+func (s *Spec) ResponseHeaders(resp *Response) map[string]*Header {
+	out := make(map[string]*Header, len(resp.Headers))
+	for name, h := range resp.Headers {
+		if h.Ref != "" {
+			h = s.Components.Headers[strings.TrimPrefix(h.Ref, "#/components/headers/")]
+		}
+		out[name] = h
+	}
+	return out
+}
+
+// Examples перечисляет все примеры запросов и ответов спецификации.
+func (s *Spec) Examples() []Example {
+	var out []Example
+	for _, key := range s.Operations() {
+		method, path, _ := strings.Cut(key, " ")
+		op := s.Operation(method, path)
+		if op.RequestBody != nil {
+			for ct, mt := range op.RequestBody.Content {
+				out = append(out, mediaExamples(key+" request "+ct, method, path, "", ct, mt)...)
+			}
+		}
+		for status, resp := range op.Responses {
+			for ct, mt := range resp.Content {
+				out = append(out, mediaExamples(key+" "+status+" "+ct, method, path, status, ct, mt)...)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+func mediaExamples(id, method, path, status, ct string, mt *MediaType) []Example {
+	base := Example{Method: method, Path: path, Status: status, ContentType: ct, Schema: mt.Schema}
+	var out []Example
+	if mt.Example != nil {
+		e := base
+		e.ID, e.Value = id, normalize(mt.Example)
+		out = append(out, e)
+	}
+	for name, ex := range mt.Examples {
+		e := base
+		e.ID, e.Value = id+" "+name, normalize(ex.Value)
+		out = append(out, e)
+	}
+	return out
+}
+
+// normalize приводит значение из YAML к виду, который даёт encoding/json (float64, map[string]any).
+func normalize(v any) any {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("example is not JSON-compatible: %v", err))
+	}
+	var out any
+	_ = json.Unmarshal(raw, &out)
+	return out
+}
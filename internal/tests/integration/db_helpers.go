@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/internal/infrastructure/reviewerselector"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -14,7 +16,7 @@ import (
 
 func TruncateAll(ctx context.Context, pool *pgxpool.Pool) error {
 	_, err := pool.Exec(ctx, `
-		TRUNCATE TABLE pr_reviewers, team_members, prs, users, teams RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE audit_log, notification_digest, notification_digest_runs, notification_preferences, review_feed_events, vcs_identities, vcs_pull_requests, pr_selection_explanations, pr_reviewer_history, pr_reviewers, team_code_owners, team_reviewer_constraints, team_members, user_tags, team_rotation_cursors, prs, users, teams RESTART IDENTITY CASCADE;
 	`)
 	return err
 }
@@ -170,3 +172,22 @@ func UpdateUsersActive(ctx context.Context, pool *pgxpool.Pool, userIDs []string
 	_, err := pool.Exec(ctx, `UPDATE users SET is_active=$2, updated_at=now() WHERE id = ANY($1)`, userIDs, active)
 	return err
 }
+
+func CountAuditEntries(ctx context.Context, pool *pgxpool.Pool, action models.AuditAction, entityID string) (int, error) {
+	row := pool.QueryRow(ctx, `SELECT COUNT(*) FROM audit_log WHERE action=$1 AND entity_id=$2`, action, entityID)
+	var cnt int
+	if err := row.Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
+
+// newSelectorRegistry собирает реестр стратегий так же, как cmd/server/main.go.
+func newSelectorRegistry() *services.SelectorRegistry {
+	reg := services.NewSelectorRegistry(reviewerselector.StrategyCodeOwners)
+	reg.Register(reviewerselector.StrategyRandom, reviewerselector.RandomFactory)
+	reg.Register(reviewerselector.StrategyWeighted, reviewerselector.WeightedFactory)
+	reg.Register(reviewerselector.StrategyRoundRobin, reviewerselector.RoundRobinFactory)
+	reg.Register(reviewerselector.StrategyCodeOwners, reviewerselector.CodeOwnersFactory(reg))
+	return reg
+}
@@ -0,0 +1,139 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAdminRoster_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	var coreID string
+	seed := func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		coreID = insertTeamHTTP(t, "core")
+		for _, id := range []string{"u1", "u2"} {
+			insertUserHTTP(t, id, "name-"+id, true)
+			addMemberHTTP(t, coreID, id)
+		}
+	}
+	importRoster := func(t *testing.T, query, contentType, body string) (int, models.RosterDiff) {
+		resp, err := http.Post(baseURL+"/admin/import"+query, contentType, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var out struct {
+			Diff models.RosterDiff `json:"diff"`
+		}
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return resp.StatusCode, out.Diff
+	}
+	const csvRoster = "team_name,user_id,username,is_active\n" +
+		"core,u1,Alice,true\n" +
+		"core,u3,Carol,true\n" +
+		"infra,u3,Carol,true\n"
+
+	t.Run("dry run reports diff without changes", func(t *testing.T) {
+		seed(t)
+		status, diff := importRoster(t, "?dry_run=true", "text/csv", csvRoster)
+		if status != http.StatusOK {
+			t.Fatalf("want 200 got %d", status)
+		}
+		if len(diff.CreateUsers) != 1 || diff.CreateUsers[0].UserID != "u3" || len(diff.CreateTeams) != 1 || diff.CreateTeams[0] != "infra" {
+			t.Fatalf("unexpected diff %+v", diff)
+		}
+		if len(diff.RenameUsers) != 1 || diff.RenameUsers[0].To != "Alice" || len(diff.RemoveMemberships) != 1 || diff.RemoveMemberships[0].UserID != "u2" {
+			t.Fatalf("unexpected diff %+v", diff)
+		}
+		if u, err := GetUser(testCtx, pgC.Pool, "u3"); err == nil && u != nil {
+			t.Fatalf("dry run created user u3")
+		}
+		members, err := GetTeamMemberIDs(testCtx, pgC.Pool, uuid.MustParse(coreID))
+		if err != nil || !EqualStringSets(members, []string{"u1", "u2"}) {
+			t.Fatalf("dry run changed members: %v %v", members, err)
+		}
+	})
+
+	t.Run("apply then re-import is a no-op", func(t *testing.T) {
+		seed(t)
+		if status, _ := importRoster(t, "", "text/csv", csvRoster); status != http.StatusOK {
+			t.Fatalf("want 200 got %d", status)
+		}
+		members, err := GetTeamMemberIDs(testCtx, pgC.Pool, uuid.MustParse(coreID))
+		if err != nil || !EqualStringSets(members, []string{"u1", "u3"}) {
+			t.Fatalf("unexpected members: %v %v", members, err)
+		}
+		if u, err := GetUser(testCtx, pgC.Pool, "u1"); err != nil || u.Name != "Alice" {
+			t.Fatalf("u1 not renamed: %+v %v", u, err)
+		}
+		_, diff := importRoster(t, "?dry_run=true", "text/csv", csvRoster)
+		if len(diff.AddMemberships)+len(diff.RemoveMemberships)+len(diff.CreateUsers)+len(diff.RenameUsers) != 0 {
+			t.Fatalf("expected empty diff, got %+v", diff)
+		}
+	})
+
+	t.Run("export yaml round-trips through import", func(t *testing.T) {
+		seed(t)
+		setResp, err := postJSONPR(baseURL, "/team/selectionStrategy", map[string]any{"team_name": "core", "strategy": "roundrobin"})
+		if err != nil {
+			t.Fatalf("set strategy: %v", err)
+		}
+		_ = setResp.Body.Close()
+		if setResp.StatusCode != http.StatusOK {
+			t.Fatalf("set strategy: want 200 got %d", setResp.StatusCode)
+		}
+		resp, err := http.Get(baseURL + "/admin/export?format=yaml")
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), "selection_strategy: roundrobin") {
+			t.Fatalf("unexpected export %d:\n%s", resp.StatusCode, body)
+		}
+		status, diff := importRoster(t, "?format=yaml", "", string(body))
+		if status != http.StatusOK || len(diff.UpdateSettings) != 0 || len(diff.AddMemberships) != 0 {
+			t.Fatalf("re-import of export should be empty: %d %+v", status, diff)
+		}
+	})
+
+	t.Run("invalid rows -> 400 and nothing applied", func(t *testing.T) {
+		seed(t)
+		status, _ := importRoster(t, "", "text/csv", csvRoster+"infra,u3,Other,true\n")
+		if status != http.StatusBadRequest {
+			t.Fatalf("want 400 got %d", status)
+		}
+		if u, err := GetUser(testCtx, pgC.Pool, "u3"); err == nil && u != nil {
+			t.Fatalf("partial import created user u3")
+		}
+	})
+}
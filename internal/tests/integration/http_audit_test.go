@@ -0,0 +1,165 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/application/audit"
+	"avito-test-pr-service/internal/domain/models"
+	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/http/middlewares"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func buildAuditService() input.AuditInputPort {
+	log := logger.New("test")
+	return audit.NewService(uow.NewPostgresUOW(pgC.Pool, log), log)
+}
+
+type auditListResponse struct {
+	Entries []struct {
+		Actor      string          `json:"actor"`
+		RequestID  string          `json:"request_id"`
+		Action     string          `json:"action"`
+		EntityType string          `json:"entity_type"`
+		EntityID   string          `json:"entity_id"`
+		Before     json.RawMessage `json:"before"`
+		After      json.RawMessage `json:"after"`
+	} `json:"entries"`
+}
+
+func TestAuditHandlers_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	postAs := func(actor, path string, body any) *http.Response {
+		b, _ := json.Marshal(body)
+		req, err := http.NewRequest(http.MethodPost, baseURL+path, bytes.NewReader(b))
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(middlewares.ActorHeader, actor)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("post %s: %v", path, err)
+		}
+		return resp
+	}
+
+	getAudit := func(query string) auditListResponse {
+		resp, err := http.Get(baseURL + "/audit?" + query)
+		if err != nil {
+			t.Fatalf("get audit: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("want 200 got %d", resp.StatusCode)
+		}
+		var out auditListResponse
+		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return out
+	}
+
+	t.Run("mutations are audited with actor and request id", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		resp := postAs("admin", "/team/add", map[string]any{
+			"team_name": "core",
+			"members":   []map[string]any{{"user_id": "u1", "username": "alice", "is_active": true}, {"user_id": "u2", "username": "bob", "is_active": true}},
+		})
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("team add want 201 got %d", resp.StatusCode)
+		}
+		resp = postAs("alice", "/pullRequest/create", map[string]any{"pull_request_id": "pr-1", "pull_request_name": "feat", "author_id": "u1"})
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("pr create want 201 got %d", resp.StatusCode)
+		}
+		resp = postAs("alice", "/pullRequest/merge", map[string]any{"pull_request_id": "pr-1"})
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("merge want 200 got %d", resp.StatusCode)
+		}
+
+		out := getAudit("entity_type=pull_request&entity_id=pr-1")
+		if len(out.Entries) != 2 {
+			t.Fatalf("want 2 pr entries got %d", len(out.Entries))
+		}
+		if out.Entries[0].Action != string(models.AuditActionPRMerge) || out.Entries[1].Action != string(models.AuditActionPRCreate) {
+			t.Fatalf("unexpected order/actions %+v", out.Entries)
+		}
+		for _, e := range out.Entries {
+			if e.Actor != "alice" || e.RequestID == "" {
+				t.Fatalf("missing actor/request id: %+v", e)
+			}
+		}
+		if len(out.Entries[0].Before) == 0 || len(out.Entries[0].After) == 0 {
+			t.Fatalf("merge entry must carry before/after")
+		}
+
+		byActor := getAudit("actor=admin")
+		if len(byActor.Entries) == 0 {
+			t.Fatalf("expected admin entries")
+		}
+		for _, e := range byActor.Entries {
+			if e.Actor != "admin" {
+				t.Fatalf("actor filter leaked %+v", e)
+			}
+		}
+	})
+
+	t.Run("idempotent merge is not audited twice", func(t *testing.T) {
+		resp := postAs("alice", "/pullRequest/merge", map[string]any{"pull_request_id": "pr-1"})
+		_ = resp.Body.Close()
+		cnt, err := CountAuditEntries(testCtx, pgC.Pool, models.AuditActionPRMerge, "pr-1")
+		if err != nil {
+			t.Fatalf("count: %v", err)
+		}
+		if cnt != 1 {
+			t.Fatalf("want 1 merge entry got %d", cnt)
+		}
+	})
+
+	t.Run("time filter and bad params", func(t *testing.T) {
+		future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		out := getAudit("from=" + future)
+		if len(out.Entries) != 0 {
+			t.Fatalf("want no entries from the future, got %d", len(out.Entries))
+		}
+		resp, err := http.Get(baseURL + "/audit?from=yesterday")
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("want 400 got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("audit log is append-only", func(t *testing.T) {
+		if _, err := pgC.Pool.Exec(testCtx, `DELETE FROM audit_log`); err == nil {
+			t.Fatalf("expected delete to be rejected")
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/pkg/client"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestClient_HTTPIntegration прогоняет pkg/client против настоящего роутера.
+func TestClient_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+	if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	c := client.New(server.URL, client.WithActor("admin"))
+
+	if err := c.Ping(testCtx); err != nil {
+		t.Fatalf("ping: %v", err)
+	}
+	_, err := c.AddTeam(testCtx, client.Team{TeamName: "core", Members: []client.TeamMember{
+		{UserID: "u2", Username: "Bob", IsActive: true},
+		{UserID: "u1", Username: "Alice", IsActive: true},
+		{UserID: "u3", Username: "Carol", IsActive: false},
+	}})
+	if err != nil {
+		t.Fatalf("add team: %v", err)
+	}
+	if _, err := c.AddTeam(testCtx, client.Team{TeamName: "ops", Members: []client.TeamMember{}}); err != nil {
+		t.Fatalf("add team: %v", err)
+	}
+
+	teams, err := c.ListTeams(testCtx)
+	if err != nil || len(teams) != 2 || teams[0].TeamName != "core" || teams[1].TeamName != "ops" {
+		t.Fatalf("unexpected teams %+v %v", teams, err)
+	}
+	users, err := c.ListUsers(testCtx)
+	if err != nil || len(users) != 3 || users[0].UserID != "u1" || users[2].IsActive {
+		t.Fatalf("unexpected users %+v %v", users, err)
+	}
+
+	pr, err := c.CreatePR(testCtx, client.CreatePRRequest{PullRequestID: "pr-1", PullRequestName: "Fix", AuthorID: "u1"})
+	if err != nil || pr.Status != client.StatusOpen || len(pr.AssignedReviewers) != 1 || pr.AssignedReviewers[0] != "u2" {
+		t.Fatalf("unexpected pr %+v %v", pr, err)
+	}
+	page, err := c.ListPRs(testCtx, client.ListPRsParams{ReviewerID: "u2", Status: client.StatusOpen})
+	if err != nil || page.Total != 1 || page.PullRequests[0].PullRequestID != "pr-1" {
+		t.Fatalf("unexpected page %+v %v", page, err)
+	}
+	if _, err := c.CreatePR(testCtx, client.CreatePRRequest{PullRequestID: "pr-1", PullRequestName: "Fix", AuthorID: "u1"}); !client.IsCode(err, "PR_EXISTS") {
+		t.Fatalf("want PR_EXISTS, got %v", err)
+	}
+	if _, err := c.GetTeam(testCtx, "missing"); !client.IsCode(err, "NOT_FOUND") {
+		t.Fatalf("want NOT_FOUND, got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/application/pr"
+	"avito-test-pr-service/internal/application/team"
+	"avito-test-pr-service/internal/application/user"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"avito-test-pr-service/internal/infrastructure/reviewerselector"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCodeOwners_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	log := logger.New("test")
+	u := uow.NewPostgresUOW(pgC.Pool, log)
+	selector := reviewerselector.NewCodeOwnersSelector(reviewerselector.NewRandomReviewerSelector())
+	r := apihttp.NewRouter(log, pr.NewService(u, services.SingleSelector(selector), feedBroker, log), team.NewService(u, newSelectorRegistry(), pr.NewReviewReleaser(services.SingleSelector(selector), feedBroker, log), log), user.NewService(u, log), buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	seed := func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		teamID := insertTeamHTTP(t, "core")
+		for _, id := range []string{"u1", "u2", "u3", "u4", "u5"} {
+			insertUserHTTP(t, id, "name-"+id, id != "u5")
+			addMemberHTTP(t, teamID, id)
+		}
+	}
+
+	t.Run("upload rules and read them back", func(t *testing.T) {
+		seed(t)
+		resp, err := postJSONPR(baseURL, "/team/codeOwners", map[string]any{"team_name": "core", "content": "* u2\n/internal/db/ @u4 u5\n"})
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("want 200 got %d", resp.StatusCode)
+		}
+		getResp, err := http.Get(baseURL + "/team/codeOwners?team_name=core")
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		defer func() { _ = getResp.Body.Close() }()
+		var out struct {
+			Rules []struct {
+				Pattern string   `json:"pattern"`
+				Owners  []string `json:"owners"`
+			} `json:"rules"`
+		}
+		if err := json.NewDecoder(getResp.Body).Decode(&out); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if len(out.Rules) != 2 || out.Rules[1].Pattern != "/internal/db/" || !EqualStringSets(out.Rules[1].Owners, []string{"u4", "u5"}) {
+			t.Fatalf("unexpected rules %+v", out.Rules)
+		}
+	})
+
+	t.Run("malformed rules -> 400", func(t *testing.T) {
+		seed(t)
+		resp, err := postJSONPR(baseURL, "/team/codeOwners", map[string]any{"team_name": "core", "content": "*.go\n"})
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("want 400 got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("create prefers active owners of touched paths", func(t *testing.T) {
+		seed(t)
+		resp, err := postJSONPR(baseURL, "/team/codeOwners", map[string]any{"team_name": "core", "content": "/internal/db/ u4 u5\n"})
+		if err != nil {
+			t.Fatalf("post rules: %v", err)
+		}
+		_ = resp.Body.Close()
+
+		resp, err = postJSONPR(baseURL, "/pullRequest/create", map[string]any{
+			"pull_request_id":   "pr-co",
+			"pull_request_name": "db",
+			"author_id":         "u1",
+			"changed_files":     []string{"internal/db/conn.go"},
+		})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("want 201 got %d", resp.StatusCode)
+		}
+		var out struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+				ChangedFiles      []string `json:"changed_files"`
+			} `json:"pr"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		// u5 — владелец, но неактивен; u4 обязан попасть, второй слот — случайный
+		if len(out.PR.AssignedReviewers) != 2 || out.PR.AssignedReviewers[0] != "u4" {
+			t.Fatalf("unexpected reviewers %v", out.PR.AssignedReviewers)
+		}
+		for _, id := range out.PR.AssignedReviewers {
+			if id == "u5" || id == "u1" {
+				t.Fatalf("ineligible reviewer %s assigned", id)
+			}
+		}
+		if len(out.PR.ChangedFiles) != 1 {
+			t.Fatalf("changed files not returned: %v", out.PR.ChangedFiles)
+		}
+	})
+}
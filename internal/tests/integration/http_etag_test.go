@@ -0,0 +1,141 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/pkg/client"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestETag_HTTPIntegration проверяет оптимистичную блокировку: изменение с устаревшим If-Match отклоняется 412.
+func TestETag_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+	if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	r.Setup(&config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}})
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	c := client.New(server.URL)
+
+	_, err := c.AddTeam(testCtx, client.Team{TeamName: "core", Members: []client.TeamMember{
+		{UserID: "u1", Username: "Alice", IsActive: true},
+		{UserID: "u2", Username: "Bob", IsActive: true},
+		{UserID: "u3", Username: "Carol", IsActive: true},
+		{UserID: "u4", Username: "Dan", IsActive: true},
+	}})
+	if err != nil {
+		t.Fatalf("add team: %v", err)
+	}
+	if _, err := c.CreatePR(testCtx, client.CreatePRRequest{PullRequestID: "pr-1", PullRequestName: "Fix", AuthorID: "u1"}); err != nil {
+		t.Fatalf("create pr: %v", err)
+	}
+
+	t.Run("pull request", func(t *testing.T) {
+		pr, err := c.GetPR(testCtx, "pr-1")
+		if err != nil || pr.ETag == "" {
+			t.Fatalf("get pr: %+v %v", pr, err)
+		}
+		stale := pr.ETag
+		// Первый клиент переназначает ревьювера, второй пытается сделать то же по старой версии.
+		res, err := c.ReassignPR(client.WithIfMatch(testCtx, stale), client.ReassignRequest{PullRequestID: "pr-1", OldUserID: pr.AssignedReviewers[0]})
+		if err != nil {
+			t.Fatalf("reassign: %v", err)
+		}
+		if res.PR.ETag == "" || res.PR.ETag == stale {
+			t.Fatalf("etag did not change: %q -> %q", stale, res.PR.ETag)
+		}
+		_, err = c.ReassignPR(client.WithIfMatch(testCtx, stale), client.ReassignRequest{PullRequestID: "pr-1", OldUserID: res.PR.AssignedReviewers[0]})
+		if !client.IsCode(err, client.CodePreconditionFailed) {
+			t.Fatalf("want PRECONDITION_FAILED, got %v", err)
+		}
+		if _, err := c.MergePR(client.WithIfMatch(testCtx, stale), "pr-1"); !client.IsCode(err, client.CodePreconditionFailed) {
+			t.Fatalf("want PRECONDITION_FAILED, got %v", err)
+		}
+		merged, err := c.MergePR(client.WithIfMatch(testCtx, res.PR.ETag), "pr-1")
+		if err != nil || merged.Status != client.StatusMerged {
+			t.Fatalf("merge: %+v %v", merged, err)
+		}
+		// Без If-Match проверка не выполняется.
+		if _, err := c.MergePR(testCtx, "pr-1"); err != nil {
+			t.Fatalf("merge without If-Match: %v", err)
+		}
+	})
+
+	t.Run("team", func(t *testing.T) {
+		team, err := c.GetTeam(testCtx, "core")
+		if err != nil || team.ETag == "" {
+			t.Fatalf("get team: %+v %v", team, err)
+		}
+		s, err := c.SetSelectionStrategy(client.WithIfMatch(testCtx, team.ETag), client.SelectionStrategy{TeamName: "core", Strategy: "roundrobin"})
+		if err != nil {
+			t.Fatalf("set strategy: %v", err)
+		}
+		if s.ETag == team.ETag {
+			t.Fatalf("etag did not change: %q", s.ETag)
+		}
+		if _, err := c.SetCodeOwners(client.WithIfMatch(testCtx, team.ETag), "core", "* u2\n"); !client.IsCode(err, client.CodePreconditionFailed) {
+			t.Fatalf("want PRECONDITION_FAILED, got %v", err)
+		}
+		owners, err := c.SetCodeOwners(client.WithIfMatch(testCtx, s.ETag), "core", "* u2\n")
+		if err != nil {
+			t.Fatalf("set code owners: %v", err)
+		}
+		if owners.ETag == "" || owners.ETag == s.ETag {
+			t.Fatalf("code owners etag did not change: %q -> %q", s.ETag, owners.ETag)
+		}
+		constraints, err := c.SetReviewerConstraints(client.WithIfMatch(testCtx, owners.ETag), client.ReviewerConstraints{TeamName: "core"})
+		if err != nil {
+			t.Fatalf("set constraints: %v", err)
+		}
+		if constraints.ETag == "" || constraints.ETag == owners.ETag {
+			t.Fatalf("constraints etag did not change: %q -> %q", owners.ETag, constraints.ETag)
+		}
+		// /team/add не описывает If-Match: повтор того же состава проходит с любым заголовком.
+		replay := client.Team{TeamName: "core", Members: []client.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: true},
+			{UserID: "u3", Username: "Carol", IsActive: true},
+			{UserID: "u4", Username: "Dan", IsActive: true},
+		}}
+		if _, err := c.AddTeam(client.WithIfMatch(testCtx, s.ETag), replay); err != nil {
+			t.Fatalf("add team replay with stale If-Match: %v", err)
+		}
+		sync := client.Team{TeamName: "core", Members: []client.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}}
+		if _, err := c.SyncTeam(client.WithIfMatch(testCtx, s.ETag), sync, true); !client.IsCode(err, client.CodePreconditionFailed) {
+			t.Fatalf("want PRECONDITION_FAILED, got %v", err)
+		}
+		if _, err := c.SyncTeam(client.WithIfMatch(testCtx, "*"), client.Team{TeamName: "missing"}, true); !client.IsCode(err, client.CodePreconditionFailed) {
+			t.Fatalf("want PRECONDITION_FAILED for a missing team, got %v", err)
+		}
+	})
+
+	t.Run("team sync with valid If-Match releases reviews", func(t *testing.T) {
+		pr, err := c.CreatePR(testCtx, client.CreatePRRequest{PullRequestID: "pr-2", PullRequestName: "Feat", AuthorID: "u1"})
+		if err != nil || len(pr.AssignedReviewers) == 0 {
+			t.Fatalf("create pr: %+v %v", pr, err)
+		}
+		team, err := c.GetTeam(testCtx, "core")
+		if err != nil {
+			t.Fatalf("get team: %v", err)
+		}
+		// ETag команды не совпадает с версией PR, но сверяется только с командой.
+		sync := client.Team{TeamName: "core", Members: []client.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}}
+		res, err := c.SyncTeam(client.WithIfMatch(testCtx, team.ETag), sync, false)
+		if err != nil {
+			t.Fatalf("sync: %v", err)
+		}
+		if len(res.Releases) != len(pr.AssignedReviewers) {
+			t.Fatalf("unexpected releases %+v", res.Releases)
+		}
+	})
+}
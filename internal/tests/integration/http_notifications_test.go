@@ -0,0 +1,104 @@
+package integration
+
+import (
+	notificationapp "avito-test-pr-service/internal/application/notification"
+	"avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func buildNotificationService() input.NotificationInputPort {
+	log := logger.New("test")
+	return notificationapp.NewService(uow.NewPostgresUOW(pgC.Pool, log), log)
+}
+
+type preferencesResponse struct {
+	UserID     string   `json:"user_id"`
+	ChatHandle string   `json:"chat_handle"`
+	Delivery   string   `json:"delivery"`
+	Kinds      []string `json:"kinds"`
+}
+
+func TestNotificationPreferences_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+	userSvc, prSvc, teamSvc := buildServices()
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	r.Setup(&config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}})
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	get := func(t *testing.T, userID string) (int, preferencesResponse) {
+		resp, err := http.Get(baseURL + "/users/notifications?user_id=" + userID)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var out preferencesResponse
+		_ = json.NewDecoder(resp.Body).Decode(&out)
+		return resp.StatusCode, out
+	}
+
+	if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+	insertUserHTTP(t, "u1", "alice", true)
+
+	t.Run("defaults before the user sets anything", func(t *testing.T) {
+		status, out := get(t, "u1")
+		if status != http.StatusOK || out.ChatHandle != "" || out.Delivery != "immediate" || len(out.Kinds) != 4 {
+			t.Fatalf("unexpected defaults %d %+v", status, out)
+		}
+	})
+
+	t.Run("set, read back and audit", func(t *testing.T) {
+		resp, err := postJSONPR(baseURL, "/users/notifications", map[string]any{
+			"user_id": "u1", "chat_handle": "@alice.dev", "delivery": "digest", "kinds": []string{"assigned", "sla_breached", "assigned"},
+		})
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("want 200 got %d", resp.StatusCode)
+		}
+		status, out := get(t, "u1")
+		if status != http.StatusOK || out.ChatHandle != "alice.dev" || out.Delivery != "digest" || !EqualStringSets(out.Kinds, []string{"assigned", "sla_breached"}) {
+			t.Fatalf("unexpected preferences %d %+v", status, out)
+		}
+		var n int
+		if err := pgC.Pool.QueryRow(testCtx, `SELECT count(*) FROM audit_log WHERE action = 'user.notifications_set' AND entity_id = 'u1'`).Scan(&n); err != nil || n != 1 {
+			t.Fatalf("want 1 audit entry, got %d (%v)", n, err)
+		}
+	})
+
+	t.Run("invalid input -> 400, unknown user -> 404", func(t *testing.T) {
+		for _, body := range []map[string]any{
+			{"user_id": "u1", "delivery": "hourly"},
+			{"user_id": "u1", "kinds": []string{"commented"}},
+			{"user_id": "u1", "chat_handle": "alice dev"},
+		} {
+			resp, err := postJSONPR(baseURL, "/users/notifications", body)
+			if err != nil {
+				t.Fatalf("post: %v", err)
+			}
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("%v: want 400 got %d", body, resp.StatusCode)
+			}
+		}
+		if status, _ := get(t, "ghost"); status != http.StatusNotFound {
+			t.Fatalf("want 404 got %d", status)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type prBatchResponse struct {
+	Results []struct {
+		PullRequestID string `json:"pull_request_id"`
+		Status        string `json:"status"`
+		PR            *struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+		Error *struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	} `json:"results"`
+	Created int `json:"created"`
+	Exists  int `json:"exists"`
+	Failed  int `json:"failed"`
+}
+
+func TestPRBatchCreate_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	batch := func(t *testing.T, items []map[string]any) (int, prBatchResponse) {
+		resp, err := postJSONPR(baseURL, "/pullRequest/batchCreate", map[string]any{"pull_requests": items})
+		if err != nil {
+			t.Fatalf("batch: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var out prBatchResponse
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return resp.StatusCode, out
+	}
+
+	t.Run("mixed batch reports per-item results", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		teamID := insertTeamHTTP(t, "core")
+		for _, id := range []string{"u1", "u2", "u3"} {
+			insertUserHTTP(t, id, "name-"+id, true)
+			addMemberHTTP(t, teamID, id)
+		}
+		resp, err := postJSONPR(baseURL, "/pullRequest/create", map[string]any{"pull_request_id": "pr-old", "pull_request_name": "t", "author_id": "u1"})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		_ = resp.Body.Close()
+
+		status, out := batch(t, []map[string]any{
+			{"pull_request_id": "pr-old", "pull_request_name": "t", "author_id": "u1"},
+			{"pull_request_id": "pr-auto", "pull_request_name": "t", "author_id": "u1"},
+			{"pull_request_id": "pr-preset", "pull_request_name": "t", "author_id": "u1", "reviewers": []string{"u3"}},
+			{"pull_request_id": "pr-none", "pull_request_name": "t", "author_id": "u1", "reviewers": []string{}},
+			{"pull_request_id": "pr-ghost", "pull_request_name": "t", "author_id": "ghost"},
+			{"pull_request_id": "pr-self", "pull_request_name": "t", "author_id": "u1", "reviewers": []string{"u1"}},
+		})
+		if status != http.StatusOK {
+			t.Fatalf("want 200 got %d", status)
+		}
+		if out.Created != 3 || out.Exists != 1 || out.Failed != 2 {
+			t.Fatalf("unexpected summary %+v", out)
+		}
+		wantStatus := []string{"exists", "created", "created", "created", "error", "error"}
+		for i, st := range wantStatus {
+			if out.Results[i].Status != st {
+				t.Fatalf("item %d: want %s got %+v", i, st, out.Results[i])
+			}
+		}
+		if len(out.Results[1].PR.AssignedReviewers) != 2 {
+			t.Fatalf("auto item should get 2 reviewers: %+v", out.Results[1].PR)
+		}
+		if !EqualStringSets(out.Results[2].PR.AssignedReviewers, []string{"u3"}) || len(out.Results[3].PR.AssignedReviewers) != 0 {
+			t.Fatalf("preset reviewers not kept: %+v %+v", out.Results[2].PR, out.Results[3].PR)
+		}
+		if out.Results[4].Error.Code != "NOT_FOUND" || out.Results[5].Error.Code != "NOT_ELIGIBLE" {
+			t.Fatalf("unexpected item errors %+v %+v", out.Results[4].Error, out.Results[5].Error)
+		}
+
+		var n int
+		if err := pgC.Pool.QueryRow(testCtx, `SELECT count(*) FROM pr_reviewer_history WHERE pr_id IN ('pr-auto', 'pr-preset') AND reason = 'initial'`).Scan(&n); err != nil {
+			t.Fatalf("count history: %v", err)
+		}
+		if n != 3 {
+			t.Fatalf("want 3 history rows got %d", n)
+		}
+	})
+
+	t.Run("large batch", func(t *testing.T) {
+		items := make([]map[string]any, 0, 300)
+		for i := 0; i < 300; i++ {
+			items = append(items, map[string]any{"pull_request_id": fmt.Sprintf("pr-bulk-%d", i), "pull_request_name": "t", "author_id": "u2"})
+		}
+		status, out := batch(t, items)
+		if status != http.StatusOK || out.Created != 300 {
+			t.Fatalf("want 300 created got %d %+v", status, out.Created)
+		}
+	})
+
+	t.Run("empty batch -> 400", func(t *testing.T) {
+		status, _ := batch(t, []map[string]any{})
+		if status != http.StatusBadRequest {
+			t.Fatalf("want 400 got %d", status)
+		}
+	})
+}
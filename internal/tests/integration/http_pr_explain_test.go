@@ -0,0 +1,128 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type prExplainResponse struct {
+	PullRequestID string `json:"pull_request_id"`
+	Selections    []struct {
+		Reason   string   `json:"reason"`
+		Strategy string   `json:"strategy"`
+		Slots    int      `json:"slots"`
+		Pool     []string `json:"pool"`
+		Excluded []struct {
+			UserID string `json:"user_id"`
+			Reason string `json:"reason"`
+		} `json:"excluded"`
+		Scores []struct {
+			UserID   string  `json:"user_id"`
+			Score    float64 `json:"score"`
+			Selected bool    `json:"selected"`
+		} `json:"scores"`
+		Selected []string `json:"selected"`
+	} `json:"selections"`
+}
+
+func TestPRExplain_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	getExplain := func(t *testing.T, prID string) (int, prExplainResponse) {
+		resp, err := http.Get(baseURL + "/pullRequest/explain?pull_request_id=" + prID)
+		if err != nil {
+			t.Fatalf("get explain: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var out prExplainResponse
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return resp.StatusCode, out
+	}
+
+	t.Run("create and reassign are explained", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		insertUserHTTP(t, "u1", "author", true)
+		insertUserHTTP(t, "u2", "rev", true)
+		insertUserHTTP(t, "u3", "off", false)
+		teamID := insertTeamHTTP(t, "core")
+		addMemberHTTP(t, teamID, "u1")
+		addMemberHTTP(t, teamID, "u2")
+		addMemberHTTP(t, teamID, "u3")
+		resp, err := postJSONPR(baseURL, "/pullRequest/create", map[string]any{"pull_request_id": "pr-x", "pull_request_name": "t", "author_id": "u1"})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		_ = resp.Body.Close()
+
+		insertUserHTTP(t, "u4", "rev2", true)
+		addMemberHTTP(t, teamID, "u4")
+		resp, err = postJSONPR(baseURL, "/pullRequest/reassign", map[string]any{"pull_request_id": "pr-x", "old_user_id": "u2"})
+		if err != nil {
+			t.Fatalf("reassign: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("reassign want 200 got %d", resp.StatusCode)
+		}
+
+		status, out := getExplain(t, "pr-x")
+		if status != http.StatusOK {
+			t.Fatalf("explain want 200 got %d", status)
+		}
+		if len(out.Selections) != 2 {
+			t.Fatalf("want 2 selections got %+v", out.Selections)
+		}
+		initial := out.Selections[0]
+		if initial.Reason != "initial" || initial.Slots != 2 || initial.Strategy == "" {
+			t.Fatalf("unexpected initial selection %+v", initial)
+		}
+		if !EqualStringSets(initial.Pool, []string{"u2"}) || !EqualStringSets(initial.Selected, []string{"u2"}) {
+			t.Fatalf("unexpected initial pool/selected %+v", initial)
+		}
+		reasons := map[string]string{}
+		for _, e := range initial.Excluded {
+			reasons[e.UserID] = e.Reason
+		}
+		if reasons["u1"] != "author" || reasons["u3"] != "inactive" {
+			t.Fatalf("unexpected exclusions %+v", initial.Excluded)
+		}
+		if len(initial.Scores) != 1 || !initial.Scores[0].Selected {
+			t.Fatalf("unexpected scores %+v", initial.Scores)
+		}
+
+		reassign := out.Selections[1]
+		if reassign.Reason != "manual_reassign" || reassign.Slots != 1 || !EqualStringSets(reassign.Selected, []string{"u4"}) {
+			t.Fatalf("unexpected reassign selection %+v", reassign)
+		}
+	})
+
+	t.Run("unknown pr -> 404", func(t *testing.T) {
+		status, _ := getExplain(t, "missing")
+		if status != http.StatusNotFound {
+			t.Fatalf("want 404 got %d", status)
+		}
+	})
+}
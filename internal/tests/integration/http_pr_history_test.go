@@ -0,0 +1,113 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type prHistoryResponse struct {
+	PullRequestID string `json:"pull_request_id"`
+	History       []struct {
+		ReviewerID string `json:"reviewer_id"`
+		Event      string `json:"event"`
+		Reason     string `json:"reason"`
+	} `json:"history"`
+}
+
+func TestPRHistory_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	getHistory := func(t *testing.T, prID string) (int, prHistoryResponse) {
+		resp, err := http.Get(baseURL + "/pullRequest/history?pull_request_id=" + prID)
+		if err != nil {
+			t.Fatalf("get history: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var out prHistoryResponse
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return resp.StatusCode, out
+	}
+
+	t.Run("reassign with reason keeps full timeline", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		insertUserHTTP(t, "u1", "author", true)
+		insertUserHTTP(t, "u2", "rev", true)
+		teamID := insertTeamHTTP(t, "core")
+		addMemberHTTP(t, teamID, "u1")
+		addMemberHTTP(t, teamID, "u2")
+		resp, err := postJSONPR(baseURL, "/pullRequest/create", map[string]any{"pull_request_id": "pr-h", "pull_request_name": "t", "author_id": "u1"})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		_ = resp.Body.Close()
+		insertUserHTTP(t, "u3", "rev2", true)
+		addMemberHTTP(t, teamID, "u3")
+
+		resp, err = postJSONPR(baseURL, "/pullRequest/reassign", map[string]any{"pull_request_id": "pr-h", "old_user_id": "u2", "reason": "deactivation"})
+		if err != nil {
+			t.Fatalf("reassign: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("reassign want 200 got %d", resp.StatusCode)
+		}
+
+		status, out := getHistory(t, "pr-h")
+		if status != http.StatusOK {
+			t.Fatalf("history want 200 got %d", status)
+		}
+		if len(out.History) != 3 {
+			t.Fatalf("want 3 history entries got %+v", out.History)
+		}
+		if out.History[0].ReviewerID != "u2" || out.History[0].Event != "ASSIGNED" || out.History[0].Reason != "initial" {
+			t.Fatalf("unexpected first entry %+v", out.History[0])
+		}
+		if out.History[1].ReviewerID != "u2" || out.History[1].Event != "UNASSIGNED" || out.History[1].Reason != "deactivation" {
+			t.Fatalf("unexpected second entry %+v", out.History[1])
+		}
+		if out.History[2].ReviewerID != "u3" || out.History[2].Event != "ASSIGNED" {
+			t.Fatalf("unexpected third entry %+v", out.History[2])
+		}
+	})
+
+	t.Run("invalid reason -> 400", func(t *testing.T) {
+		resp, err := postJSONPR(baseURL, "/pullRequest/reassign", map[string]any{"pull_request_id": "pr-h", "old_user_id": "u3", "reason": "bored"})
+		if err != nil {
+			t.Fatalf("reassign: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("want 400 got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("unknown pr -> 404", func(t *testing.T) {
+		status, _ := getHistory(t, "missing")
+		if status != http.StatusNotFound {
+			t.Fatalf("want 404 got %d", status)
+		}
+	})
+}
@@ -0,0 +1,163 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type prReviewerView struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type prView struct {
+	PullRequestID     string           `json:"pull_request_id"`
+	AuthorID          string           `json:"author_id"`
+	Status            string           `json:"status"`
+	AssignedReviewers []string         `json:"assigned_reviewers"`
+	Reviewers         []prReviewerView `json:"reviewers"`
+}
+
+type prListResponse struct {
+	PullRequests []prView `json:"pull_requests"`
+	Total        int      `json:"total"`
+	Limit        int      `json:"limit"`
+	Offset       int      `json:"offset"`
+}
+
+func TestPRGetAndList_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	getJSON := func(t *testing.T, path string, out any) int {
+		resp, err := http.Get(baseURL + path)
+		if err != nil {
+			t.Fatalf("get %s: %v", path, err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode == http.StatusOK && out != nil {
+			if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return resp.StatusCode
+	}
+
+	seed := func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		insertUserHTTP(t, "u1", "alice", true)
+		insertUserHTTP(t, "u2", "bob", false)
+		insertUserHTTP(t, "u3", "carol", true)
+		insertUserHTTP(t, "u4", "dave", true)
+		core := insertTeamHTTP(t, "core")
+		addMemberHTTP(t, core, "u1")
+		addMemberHTTP(t, core, "u2")
+		mobile := insertTeamHTTP(t, "mobile")
+		addMemberHTTP(t, mobile, "u3")
+		addMemberHTTP(t, mobile, "u4")
+		if err := InsertPR(testCtx, pgC.Pool, "pr-1", "one", "u1"); err != nil {
+			t.Fatalf("insert pr-1: %v", err)
+		}
+		if err := AddPRReviewer(testCtx, pgC.Pool, "pr-1", "u2"); err != nil {
+			t.Fatalf("add reviewer: %v", err)
+		}
+		if err := InsertPR(testCtx, pgC.Pool, "pr-2", "two", "u3"); err != nil {
+			t.Fatalf("insert pr-2: %v", err)
+		}
+		if err := AddPRReviewer(testCtx, pgC.Pool, "pr-2", "u4"); err != nil {
+			t.Fatalf("add reviewer: %v", err)
+		}
+		if err := InsertPR(testCtx, pgC.Pool, "pr-3", "three", "u1"); err != nil {
+			t.Fatalf("insert pr-3: %v", err)
+		}
+	}
+
+	t.Run("get returns enriched reviewers", func(t *testing.T) {
+		seed(t)
+		var out struct {
+			PR prView `json:"pr"`
+		}
+		if status := getJSON(t, "/pullRequest/get?pull_request_id=pr-1", &out); status != http.StatusOK {
+			t.Fatalf("want 200 got %d", status)
+		}
+		if len(out.PR.Reviewers) != 1 || out.PR.Reviewers[0].Username != "bob" || out.PR.Reviewers[0].IsActive {
+			t.Fatalf("unexpected reviewers %+v", out.PR.Reviewers)
+		}
+	})
+
+	t.Run("get unknown pr -> 404", func(t *testing.T) {
+		seed(t)
+		if status := getJSON(t, "/pullRequest/get?pull_request_id=absent", nil); status != http.StatusNotFound {
+			t.Fatalf("want 404 got %d", status)
+		}
+	})
+
+	t.Run("list by team", func(t *testing.T) {
+		seed(t)
+		var out prListResponse
+		if status := getJSON(t, "/pullRequest/list?team_name=core", &out); status != http.StatusOK {
+			t.Fatalf("want 200 got %d", status)
+		}
+		ids := make([]string, 0, len(out.PullRequests))
+		for _, p := range out.PullRequests {
+			ids = append(ids, p.PullRequestID)
+		}
+		if out.Total != 2 || !EqualStringSets(ids, []string{"pr-1", "pr-3"}) {
+			t.Fatalf("unexpected list total=%d ids=%v", out.Total, ids)
+		}
+	})
+
+	t.Run("list by reviewer", func(t *testing.T) {
+		seed(t)
+		var out prListResponse
+		if status := getJSON(t, "/pullRequest/list?reviewer_id=u4", &out); status != http.StatusOK {
+			t.Fatalf("want 200 got %d", status)
+		}
+		if out.Total != 1 || out.PullRequests[0].PullRequestID != "pr-2" || out.PullRequests[0].Reviewers[0].Username != "dave" {
+			t.Fatalf("unexpected list %+v", out)
+		}
+	})
+
+	t.Run("list paginates", func(t *testing.T) {
+		seed(t)
+		var out prListResponse
+		if status := getJSON(t, "/pullRequest/list?limit=1&offset=1", &out); status != http.StatusOK {
+			t.Fatalf("want 200 got %d", status)
+		}
+		if out.Total != 3 || len(out.PullRequests) != 1 || out.Limit != 1 || out.Offset != 1 {
+			t.Fatalf("unexpected page %+v", out)
+		}
+	})
+
+	t.Run("list unknown team -> 404", func(t *testing.T) {
+		seed(t)
+		if status := getJSON(t, "/pullRequest/list?team_name=absent", nil); status != http.StatusNotFound {
+			t.Fatalf("want 404 got %d", status)
+		}
+	})
+
+	t.Run("list bad status -> 400", func(t *testing.T) {
+		if status := getJSON(t, "/pullRequest/list?status=DRAFT", nil); status != http.StatusBadRequest {
+			t.Fatalf("want 400 got %d", status)
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestPRManualReviewers_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	type errorBody struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+
+	// seed создаёт команду core: автор u1, активные u2..u4, неактивный u5 и PR pr-m с ревьювером u2.
+	seed := func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		insertUserHTTP(t, "u1", "author", true)
+		insertUserHTTP(t, "u2", "r2", true)
+		insertUserHTTP(t, "u3", "r3", true)
+		insertUserHTTP(t, "u4", "r4", true)
+		insertUserHTTP(t, "u5", "r5", false)
+		teamID := insertTeamHTTP(t, "core")
+		for _, id := range []string{"u1", "u2", "u3", "u4", "u5"} {
+			addMemberHTTP(t, teamID, id)
+		}
+		if err := InsertPR(testCtx, pgC.Pool, "pr-m", "manual", "u1"); err != nil {
+			t.Fatalf("insert pr: %v", err)
+		}
+		if err := AddPRReviewer(testCtx, pgC.Pool, "pr-m", "u2"); err != nil {
+			t.Fatalf("add reviewer: %v", err)
+		}
+	}
+
+	post := func(t *testing.T, path string, body map[string]any) (int, string) {
+		resp, err := postJSONPR(baseURL, path, body)
+		if err != nil {
+			t.Fatalf("post %s: %v", path, err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var eb errorBody
+		if resp.StatusCode != http.StatusOK {
+			_ = json.NewDecoder(resp.Body).Decode(&eb)
+		}
+		return resp.StatusCode, eb.Error.Code
+	}
+
+	t.Run("reassign to explicit user", func(t *testing.T) {
+		seed(t)
+		resp, err := postJSONPR(baseURL, "/pullRequest/reassign", map[string]any{"pull_request_id": "pr-m", "old_user_id": "u2", "new_user_id": "u4"})
+		if err != nil {
+			t.Fatalf("reassign: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("want 200 got %d", resp.StatusCode)
+		}
+		var out struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+			ReplacedBy string `json:"replaced_by"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if out.ReplacedBy != "u4" || !EqualStringSets(out.PR.AssignedReviewers, []string{"u4"}) {
+			t.Fatalf("unexpected response %+v", out)
+		}
+	})
+
+	t.Run("reassign to inactive user -> 409 NOT_ELIGIBLE", func(t *testing.T) {
+		seed(t)
+		status, code := post(t, "/pullRequest/reassign", map[string]any{"pull_request_id": "pr-m", "old_user_id": "u2", "new_user_id": "u5"})
+		if status != http.StatusConflict || code != "NOT_ELIGIBLE" {
+			t.Fatalf("want 409 NOT_ELIGIBLE got %d %s", status, code)
+		}
+	})
+
+	t.Run("add then hit cap", func(t *testing.T) {
+		seed(t)
+		if status, _ := post(t, "/pullRequest/addReviewer", map[string]any{"pull_request_id": "pr-m", "user_id": "u3"}); status != http.StatusOK {
+			t.Fatalf("add want 200 got %d", status)
+		}
+		status, code := post(t, "/pullRequest/addReviewer", map[string]any{"pull_request_id": "pr-m", "user_id": "u4"})
+		if status != http.StatusConflict || code != "TOO_MANY_REVIEWERS" {
+			t.Fatalf("want 409 TOO_MANY_REVIEWERS got %d %s", status, code)
+		}
+	})
+
+	t.Run("add already assigned -> 409", func(t *testing.T) {
+		seed(t)
+		status, code := post(t, "/pullRequest/addReviewer", map[string]any{"pull_request_id": "pr-m", "user_id": "u2"})
+		if status != http.StatusConflict || code != "ALREADY_ASSIGNED" {
+			t.Fatalf("want 409 ALREADY_ASSIGNED got %d %s", status, code)
+		}
+	})
+
+	t.Run("remove reviewer keeps history", func(t *testing.T) {
+		seed(t)
+		if status, _ := post(t, "/pullRequest/removeReviewer", map[string]any{"pull_request_id": "pr-m", "user_id": "u2"}); status != http.StatusOK {
+			t.Fatalf("remove want 200 got %d", status)
+		}
+		var n int
+		if err := pgC.Pool.QueryRow(testCtx, `SELECT count(*) FROM pr_reviewer_history WHERE pr_id='pr-m' AND reviewer_id='u2' AND event='UNASSIGNED' AND reason='manual'`).Scan(&n); err != nil {
+			t.Fatalf("count history: %v", err)
+		}
+		if n != 1 {
+			t.Fatalf("want 1 history row got %d", n)
+		}
+		status, code := post(t, "/pullRequest/removeReviewer", map[string]any{"pull_request_id": "pr-m", "user_id": "u2"})
+		if status != http.StatusConflict || code != "NOT_ASSIGNED" {
+			t.Fatalf("want 409 NOT_ASSIGNED got %d %s", status, code)
+		}
+	})
+}
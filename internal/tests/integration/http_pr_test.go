@@ -5,6 +5,7 @@ import (
 	"avito-test-pr-service/internal/application/team"
 	"avito-test-pr-service/internal/application/user"
 	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/domain/services"
 	"avito-test-pr-service/internal/infrastructure/config"
 	apihttp "avito-test-pr-service/internal/infrastructure/http"
 	"avito-test-pr-service/internal/infrastructure/logger"
@@ -22,8 +23,8 @@ func buildPRDeps(t *testing.T) (input.PRInputPort, input.TeamInputPort, input.Us
 	log := logger.New("test")
 	u := uow.NewPostgresUOW(pgC.Pool, log)
 	selector := reviewerselector.NewRandomReviewerSelector()
-	prSvc := pr.NewService(u, selector, log)
-	teamSvc := team.NewService(u, log)
+	prSvc := pr.NewService(u, services.SingleSelector(selector), feedBroker, log)
+	teamSvc := team.NewService(u, newSelectorRegistry(), pr.NewReviewReleaser(services.SingleSelector(selector), feedBroker, log), log)
 	userSvc := user.NewService(u, log)
 	return prSvc, teamSvc, userSvc
 }
@@ -63,7 +64,7 @@ func TestPRHandlers_HTTPIntegration(t *testing.T) {
 
 	prSvc, teamSvc, userSvc := buildPRDeps(t)
 	log := logger.New("test")
-	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc)
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
 	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
 	r.Setup(cfg)
 	server := httptest.NewServer(r.GetRouter())
@@ -0,0 +1,157 @@
+package integration
+
+import (
+	feedapp "avito-test-pr-service/internal/application/feed"
+	"avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/config"
+	"avito-test-pr-service/internal/infrastructure/feedbroker"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// feedBroker общий для всех сервисов PR в интеграционных тестах, чтобы лента видела их события.
+var feedBroker = feedbroker.NewBroker(feedbroker.DefaultBuffer)
+
+func buildFeedService() input.ReviewFeedInputPort {
+	log := logger.New("test")
+	return feedapp.NewService(uow.NewPostgresUOW(pgC.Pool, log), feedBroker, log)
+}
+
+type sseEvent struct {
+	ID   string
+	Type string
+	Data string
+}
+
+// readEvents читает из потока n событий, пропуская комментарии и поле retry.
+func readEvents(t *testing.T, sc *bufio.Scanner, n int) []sseEvent {
+	t.Helper()
+	var res []sseEvent
+	var cur sseEvent
+	for len(res) < n && sc.Scan() {
+		line := sc.Text()
+		switch {
+		case line == "":
+			if cur.ID != "" {
+				res = append(res, cur)
+			}
+			cur = sseEvent{}
+		case strings.HasPrefix(line, "id: "):
+			cur.ID = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			cur.Type = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			cur.Data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+	if len(res) < n {
+		t.Fatalf("stream ended after %d of %d events: %v", len(res), n, sc.Err())
+	}
+	return res
+}
+
+func openFeed(t *testing.T, baseURL, userID, lastEventID string) (*http.Response, context.CancelFunc) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(testCtx, 10*time.Second)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/users/reviewFeed?user_id="+userID, nil)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		cancel()
+		t.Fatalf("open feed: %v", err)
+	}
+	return resp, cancel
+}
+
+func TestReviewFeed_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	// Таймаут меньше времени жизни потока: на /users/reviewFeed он не должен действовать.
+	r.Setup(&config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 500 * time.Millisecond}})
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	t.Run("live events and resume", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		insertUserHTTP(t, "u1", "author", true)
+		insertUserHTTP(t, "u2", "rev", true)
+		teamID := insertTeamHTTP(t, "core")
+		addMemberHTTP(t, teamID, "u1")
+		addMemberHTTP(t, teamID, "u2")
+
+		resp, cancel := openFeed(t, baseURL, "u2", "")
+		if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+			t.Fatalf("want 200 text/event-stream, got %d %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+		sc := bufio.NewScanner(resp.Body)
+
+		created, err := postJSONPR(baseURL, "/pullRequest/create", map[string]any{"pull_request_id": "pr-1", "pull_request_name": "title", "author_id": "u1"})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		_ = created.Body.Close()
+		time.Sleep(time.Second)
+		merged, err := postJSONPR(baseURL, "/pullRequest/merge", map[string]any{"pull_request_id": "pr-1"})
+		if err != nil {
+			t.Fatalf("merge: %v", err)
+		}
+		_ = merged.Body.Close()
+
+		events := readEvents(t, sc, 2)
+		cancel()
+		_ = resp.Body.Close()
+		if events[0].Type != "assigned" || events[1].Type != "merged" {
+			t.Fatalf("unexpected events %+v", events)
+		}
+		if !strings.Contains(events[0].Data, `"pull_request_id":"pr-1"`) {
+			t.Fatalf("unexpected payload %s", events[0].Data)
+		}
+
+		resp, cancel = openFeed(t, baseURL, "u2", events[0].ID)
+		defer cancel()
+		defer func() { _ = resp.Body.Close() }()
+		replayed := readEvents(t, bufio.NewScanner(resp.Body), 1)
+		if replayed[0].ID != events[1].ID || replayed[0].Type != "merged" {
+			t.Fatalf("resume after %s: got %+v", events[0].ID, replayed[0])
+		}
+	})
+
+	t.Run("unknown user -> 404", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		resp, cancel := openFeed(t, baseURL, "ghost", "")
+		defer cancel()
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("want 404, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("bad Last-Event-ID -> 400", func(t *testing.T) {
+		resp, cancel := openFeed(t, baseURL, "u2", "abc")
+		defer cancel()
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("want 400, got %d", resp.StatusCode)
+		}
+	})
+}
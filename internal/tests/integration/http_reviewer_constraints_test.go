@@ -0,0 +1,152 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestReviewerConstraints_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	seed := func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		teamID := insertTeamHTTP(t, "core")
+		for _, id := range []string{"junior", "senior", "friend", "u1", "u2"} {
+			insertUserHTTP(t, id, "name-"+id, true)
+			addMemberHTTP(t, teamID, id)
+		}
+	}
+	setConstraints := func(t *testing.T, body map[string]any) int {
+		resp, err := postJSONPR(baseURL, "/team/reviewerConstraints", body)
+		if err != nil {
+			t.Fatalf("post constraints: %v", err)
+		}
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+	createPR := func(t *testing.T, id string) (int, []string) {
+		resp, err := postJSONPR(baseURL, "/pullRequest/create", map[string]any{"pull_request_id": id, "pull_request_name": "t", "author_id": "junior"})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var out struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		if resp.StatusCode == http.StatusCreated {
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return resp.StatusCode, out.PR.AssignedReviewers
+	}
+
+	t.Run("senior always assigned and friend never", func(t *testing.T) {
+		seed(t)
+		status := setConstraints(t, map[string]any{"team_name": "core", "constraints": []map[string]any{
+			{"kind": "must_include_one_of", "author_ids": []string{"junior"}, "reviewer_ids": []string{"senior"}},
+			{"kind": "never_pair", "author_ids": []string{"junior"}, "reviewer_ids": []string{"friend"}},
+		}})
+		if status != http.StatusOK {
+			t.Fatalf("set constraints want 200 got %d", status)
+		}
+		for i := 0; i < 5; i++ {
+			status, reviewers := createPR(t, fmt.Sprintf("pr-%d", i))
+			if status != http.StatusCreated {
+				t.Fatalf("create want 201 got %d", status)
+			}
+			if len(reviewers) != 2 || !containsID(reviewers, "senior") || containsID(reviewers, "friend") {
+				t.Fatalf("constraints violated: %v", reviewers)
+			}
+		}
+
+		getResp, err := http.Get(baseURL + "/team/reviewerConstraints?team_name=core")
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		var got struct {
+			Constraints []struct {
+				Kind string `json:"kind"`
+			} `json:"constraints"`
+		}
+		if err := json.NewDecoder(getResp.Body).Decode(&got); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		_ = getResp.Body.Close()
+		if len(got.Constraints) != 2 || got.Constraints[0].Kind != "must_include_one_of" {
+			t.Fatalf("unexpected constraints %+v", got.Constraints)
+		}
+	})
+
+	t.Run("reassigning the only senior to a non-senior -> 409", func(t *testing.T) {
+		resp, err := postJSONPR(baseURL, "/pullRequest/reassign", map[string]any{"pull_request_id": "pr-0", "old_user_id": "senior", "new_user_id": "u1"})
+		if err != nil {
+			t.Fatalf("reassign: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusConflict {
+			t.Fatalf("want 409 got %d", resp.StatusCode)
+		}
+		var errResp struct {
+			Error struct {
+				Code string `json:"code"`
+			} `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errResp)
+		if errResp.Error.Code != "CONSTRAINT_UNSATISFIED" {
+			t.Fatalf("unexpected code %q", errResp.Error.Code)
+		}
+	})
+
+	t.Run("unsatisfiable create -> 409", func(t *testing.T) {
+		resp, err := postJSONPR(baseURL, "/users/setIsActive", map[string]any{"user_id": "senior", "is_active": false})
+		if err != nil {
+			t.Fatalf("deactivate: %v", err)
+		}
+		_ = resp.Body.Close()
+		status, _ := createPR(t, "pr-x")
+		if status != http.StatusConflict {
+			t.Fatalf("want 409 got %d", status)
+		}
+	})
+
+	t.Run("invalid constraint -> 400", func(t *testing.T) {
+		status := setConstraints(t, map[string]any{"team_name": "core", "constraints": []map[string]any{
+			{"kind": "never_pair", "reviewer_ids": []string{"friend"}},
+		}})
+		if status != http.StatusBadRequest {
+			t.Fatalf("want 400 got %d", status)
+		}
+	})
+}
+
+func containsID(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
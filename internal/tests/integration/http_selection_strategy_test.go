@@ -0,0 +1,112 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/application/pr"
+	"avito-test-pr-service/internal/application/team"
+	"avito-test-pr-service/internal/application/user"
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSelectionStrategy_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	log := logger.New("test")
+	u := uow.NewPostgresUOW(pgC.Pool, log)
+	selectors := newSelectorRegistry()
+	r := apihttp.NewRouter(log, pr.NewService(u, selectors, feedBroker, log), team.NewService(u, selectors, pr.NewReviewReleaser(selectors, feedBroker, log), log), user.NewService(u, log), buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	seed := func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		teamID := insertTeamHTTP(t, "core")
+		for _, id := range []string{"u1", "u2", "u3", "u4"} {
+			insertUserHTTP(t, id, "name-"+id, true)
+			addMemberHTTP(t, teamID, id)
+		}
+	}
+
+	t.Run("roundrobin team rotates reviewers", func(t *testing.T) {
+		seed(t)
+		resp, err := postJSONPR(baseURL, "/team/selectionStrategy", map[string]any{"team_name": "core", "strategy": "roundrobin"})
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("want 200 got %d", resp.StatusCode)
+		}
+
+		getResp, err := http.Get(baseURL + "/team/selectionStrategy?team_name=core")
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		var got struct {
+			Strategy string `json:"strategy"`
+		}
+		if err := json.NewDecoder(getResp.Body).Decode(&got); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		_ = getResp.Body.Close()
+		if got.Strategy != "roundrobin" {
+			t.Fatalf("unexpected strategy %q", got.Strategy)
+		}
+
+		want := [][]string{{"u2", "u3"}, {"u4", "u2"}}
+		for i, exp := range want {
+			resp, err := postJSONPR(baseURL, "/pullRequest/create", map[string]any{
+				"pull_request_id":   fmt.Sprintf("pr-%d", i),
+				"pull_request_name": "t",
+				"author_id":         "u1",
+			})
+			if err != nil {
+				t.Fatalf("post: %v", err)
+			}
+			var out struct {
+				PR struct {
+					Reviewers []string `json:"assigned_reviewers"`
+				} `json:"pr"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			_ = resp.Body.Close()
+			if len(out.PR.Reviewers) != 2 || out.PR.Reviewers[0] != exp[0] || out.PR.Reviewers[1] != exp[1] {
+				t.Fatalf("pr %d: want %v got %v", i, exp, out.PR.Reviewers)
+			}
+		}
+	})
+
+	t.Run("unknown strategy and bad params -> 400", func(t *testing.T) {
+		seed(t)
+		for _, body := range []map[string]any{
+			{"team_name": "core", "strategy": "magic"},
+			{"team_name": "core", "strategy": "weighted", "params": map[string]any{"speed": 1}},
+		} {
+			resp, err := postJSONPR(baseURL, "/team/selectionStrategy", body)
+			if err != nil {
+				t.Fatalf("post: %v", err)
+			}
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("want 400 got %d for %v", resp.StatusCode, body)
+			}
+		}
+	})
+}
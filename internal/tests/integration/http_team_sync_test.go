@@ -0,0 +1,177 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTeamSync_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	type syncResponse struct {
+		Diff struct {
+			CreateTeams       []string `json:"create_teams"`
+			CreateUsers       []any    `json:"create_users"`
+			DeactivateUsers   []string `json:"deactivate_users"`
+			AddMemberships    []any    `json:"add_memberships"`
+			RemoveMemberships []any    `json:"remove_memberships"`
+		} `json:"diff"`
+		Releases []struct {
+			PullRequestID string `json:"pull_request_id"`
+			UserID        string `json:"user_id"`
+			Outcome       string `json:"outcome"`
+			ReplacedBy    string `json:"replaced_by"`
+		} `json:"releases"`
+	}
+	syncTeam := func(t *testing.T, body map[string]any) syncResponse {
+		b, _ := json.Marshal(body)
+		req, _ := http.NewRequest(http.MethodPut, baseURL+"/team/sync", bytes.NewReader(b))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("put: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("want 200 got %d", resp.StatusCode)
+		}
+		var out syncResponse
+		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return out
+	}
+	member := func(id string, active bool) map[string]any {
+		return map[string]any{"user_id": id, "username": "name-" + id, "is_active": active}
+	}
+
+	t.Run("creates missing team and is idempotent", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		body := map[string]any{"team_name": "core", "members": []map[string]any{member("u1", true), member("u2", true)}}
+		first := syncTeam(t, body)
+		if len(first.Diff.CreateTeams) != 1 || len(first.Diff.CreateUsers) != 2 || len(first.Diff.AddMemberships) != 2 {
+			t.Fatalf("unexpected diff %+v", first.Diff)
+		}
+		second := syncTeam(t, body)
+		if len(second.Diff.CreateTeams)+len(second.Diff.CreateUsers)+len(second.Diff.AddMemberships)+len(second.Diff.RemoveMemberships) != 0 {
+			t.Fatalf("repeated sync should be a no-op, got %+v", second.Diff)
+		}
+	})
+
+	t.Run("removed member's open reviews are reassigned", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		teamID := insertTeamHTTP(t, "core")
+		for _, id := range []string{"author", "u1", "gone"} {
+			insertUserHTTP(t, id, "name-"+id, true)
+			addMemberHTTP(t, teamID, id)
+		}
+		if err := InsertPR(testCtx, pgC.Pool, "pr-1", "feat", "author"); err != nil {
+			t.Fatalf("insert pr: %v", err)
+		}
+		if err := AddPRReviewer(testCtx, pgC.Pool, "pr-1", "gone"); err != nil {
+			t.Fatalf("add reviewer: %v", err)
+		}
+
+		out := syncTeam(t, map[string]any{"team_name": "core", "members": []map[string]any{member("author", true), member("u1", false)}})
+		if len(out.Diff.RemoveMemberships) != 1 || len(out.Diff.DeactivateUsers) != 1 {
+			t.Fatalf("unexpected diff %+v", out.Diff)
+		}
+		// u1 деактивирован, других кандидатов нет — ревьювер снимается без замены.
+		if len(out.Releases) != 1 || out.Releases[0].PullRequestID != "pr-1" || out.Releases[0].Outcome != "unassigned" {
+			t.Fatalf("unexpected releases %+v", out.Releases)
+		}
+		reviewers, err := GetPRReviewers(testCtx, pgC.Pool, "pr-1")
+		if err != nil || len(reviewers) != 0 {
+			t.Fatalf("unexpected reviewers %v %v", reviewers, err)
+		}
+		members, err := GetTeamMemberIDs(testCtx, pgC.Pool, uuid.MustParse(teamID))
+		if err != nil || !EqualStringSets(members, []string{"author", "u1"}) {
+			t.Fatalf("unexpected members %v %v", members, err)
+		}
+	})
+
+	t.Run("replacement picked from remaining members", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		teamID := insertTeamHTTP(t, "core")
+		for _, id := range []string{"author", "u1", "gone"} {
+			insertUserHTTP(t, id, "name-"+id, true)
+			addMemberHTTP(t, teamID, id)
+		}
+		if err := InsertPR(testCtx, pgC.Pool, "pr-2", "feat", "author"); err != nil {
+			t.Fatalf("insert pr: %v", err)
+		}
+		if err := AddPRReviewer(testCtx, pgC.Pool, "pr-2", "gone"); err != nil {
+			t.Fatalf("add reviewer: %v", err)
+		}
+
+		out := syncTeam(t, map[string]any{"team_name": "core", "members": []map[string]any{member("author", true), member("u1", true)}})
+		if len(out.Releases) != 1 || out.Releases[0].Outcome != "reassigned" || out.Releases[0].ReplacedBy != "u1" {
+			t.Fatalf("unexpected releases %+v", out.Releases)
+		}
+		reviewers, err := GetPRReviewers(testCtx, pgC.Pool, "pr-2")
+		if err != nil || !EqualStringSets(reviewers, []string{"u1"}) {
+			t.Fatalf("unexpected reviewers %v %v", reviewers, err)
+		}
+	})
+
+	t.Run("reviews in other teams are kept", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		coreID := insertTeamHTTP(t, "core")
+		infraID := insertTeamHTTP(t, "infra")
+		for _, id := range []string{"author", "gone", "infra-author"} {
+			insertUserHTTP(t, id, "name-"+id, true)
+		}
+		addMemberHTTP(t, coreID, "author")
+		addMemberHTTP(t, coreID, "gone")
+		addMemberHTTP(t, infraID, "gone")
+		addMemberHTTP(t, infraID, "infra-author")
+		if err := InsertPR(testCtx, pgC.Pool, "pr-core", "feat", "author"); err != nil {
+			t.Fatalf("insert pr: %v", err)
+		}
+		if err := InsertPR(testCtx, pgC.Pool, "pr-infra", "feat", "infra-author"); err != nil {
+			t.Fatalf("insert pr: %v", err)
+		}
+		for _, pr := range []string{"pr-core", "pr-infra"} {
+			if err := AddPRReviewer(testCtx, pgC.Pool, pr, "gone"); err != nil {
+				t.Fatalf("add reviewer: %v", err)
+			}
+		}
+
+		out := syncTeam(t, map[string]any{"team_name": "core", "members": []map[string]any{member("author", true)}})
+		if len(out.Releases) != 1 || out.Releases[0].PullRequestID != "pr-core" {
+			t.Fatalf("unexpected releases %+v", out.Releases)
+		}
+		reviewers, err := GetPRReviewers(testCtx, pgC.Pool, "pr-infra")
+		if err != nil || !EqualStringSets(reviewers, []string{"gone"}) {
+			t.Fatalf("review in infra must stay: %v %v", reviewers, err)
+		}
+	})
+}
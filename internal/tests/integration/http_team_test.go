@@ -5,6 +5,7 @@ import (
 	"avito-test-pr-service/internal/application/team"
 	"avito-test-pr-service/internal/application/user"
 	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/domain/services"
 	"avito-test-pr-service/internal/infrastructure/config"
 	apihttp "avito-test-pr-service/internal/infrastructure/http"
 	"avito-test-pr-service/internal/infrastructure/logger"
@@ -21,10 +22,10 @@ import (
 func buildTeamDeps(t *testing.T) (input.TeamInputPort, input.UserInputPort, input.PRInputPort) {
 	log := logger.New("test")
 	u := uow.NewPostgresUOW(pgC.Pool, log)
-	teamSvc := team.NewService(u, log)
-	userSvc := user.NewService(u, log)
 	selector := reviewerselector.NewRandomReviewerSelector()
-	prSvc := pr.NewService(u, selector, log)
+	teamSvc := team.NewService(u, newSelectorRegistry(), pr.NewReviewReleaser(services.SingleSelector(selector), feedBroker, log), log)
+	userSvc := user.NewService(u, log)
+	prSvc := pr.NewService(u, services.SingleSelector(selector), feedBroker, log)
 	return teamSvc, userSvc, prSvc
 }
 
@@ -35,7 +36,7 @@ func TestTeamHandlers_HTTPIntegration(t *testing.T) {
 
 	teamSvc, userSvc, prSvc := buildTeamDeps(t)
 	log := logger.New("test")
-	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc)
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
 	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
 	r.Setup(cfg)
 	server := httptest.NewServer(r.GetRouter())
@@ -101,31 +102,33 @@ func TestTeamHandlers_HTTPIntegration(t *testing.T) {
 		}
 	})
 
-	t.Run("AddTeam duplicate -> 409", func(t *testing.T) {
+	t.Run("AddTeam repeated payload is idempotent, different payload -> 409", func(t *testing.T) {
 		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
 			t.Fatalf("truncate: %v", err)
 		}
 		req := map[string]any{"team_name": "core", "members": []map[string]any{{"user_id": "u1", "username": "alice"}}}
-		resp1, err := postJSON("/team/add", req)
-		if err != nil {
-			t.Fatalf("post1: %v", err)
-		}
-		defer func() {
-			if err := resp1.Body.Close(); err != nil {
-				t.Fatalf("close resp1 body: %v", err)
+		for i := 0; i < 2; i++ {
+			resp, err := postJSON("/team/add", req)
+			if err != nil {
+				t.Fatalf("post%d: %v", i+1, err)
 			}
-		}()
-		resp2, err := postJSON("/team/add", req)
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusCreated {
+				t.Fatalf("post%d: want 201 got %d", i+1, resp.StatusCode)
+			}
+		}
+		changed := map[string]any{"team_name": "core", "members": []map[string]any{{"user_id": "u2", "username": "bob"}}}
+		resp, err := postJSON("/team/add", changed)
 		if err != nil {
-			t.Fatalf("post2: %v", err)
+			t.Fatalf("post3: %v", err)
 		}
 		defer func() {
-			if err := resp2.Body.Close(); err != nil {
-				t.Fatalf("close resp2 body: %v", err)
+			if err := resp.Body.Close(); err != nil {
+				t.Fatalf("close resp body: %v", err)
 			}
 		}()
-		if resp2.StatusCode != http.StatusConflict {
-			t.Fatalf("want 409 got %d", resp2.StatusCode)
+		if resp.StatusCode != http.StatusConflict {
+			t.Fatalf("want 409 got %d", resp.StatusCode)
 		}
 	})
 
@@ -0,0 +1,70 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestUserEmail_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+	userSvc, prSvc, teamSvc := buildServices()
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	r.Setup(&config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}})
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+	insertUserHTTP(t, "u1", "alice", true)
+
+	post := func(t *testing.T, body map[string]any) int {
+		resp, err := postJSONPR(baseURL, "/users/email", body)
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	t.Run("set, read back and audit", func(t *testing.T) {
+		if status := post(t, map[string]any{"user_id": "u1", "email": " alice@example.com "}); status != http.StatusOK {
+			t.Fatalf("want 200 got %d", status)
+		}
+		resp, err := http.Get(baseURL + "/users/email?user_id=u1")
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var out struct {
+			UserID string `json:"user_id"`
+			Email  string `json:"email"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil || out.Email != "alice@example.com" {
+			t.Fatalf("unexpected email %+v %v", out, err)
+		}
+		var n int
+		if err := pgC.Pool.QueryRow(testCtx, `SELECT count(*) FROM audit_log WHERE action = 'user.set_email' AND entity_id = 'u1'`).Scan(&n); err != nil || n != 1 {
+			t.Fatalf("want 1 audit entry, got %d %v", n, err)
+		}
+	})
+
+	t.Run("invalid address and unknown user", func(t *testing.T) {
+		if status := post(t, map[string]any{"user_id": "u1", "email": "alice"}); status != http.StatusBadRequest {
+			t.Fatalf("want 400 got %d", status)
+		}
+		if status := post(t, map[string]any{"user_id": "ghost", "email": "ghost@example.com"}); status != http.StatusNotFound {
+			t.Fatalf("want 404 got %d", status)
+		}
+	})
+}
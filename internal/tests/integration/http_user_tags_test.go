@@ -0,0 +1,128 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/application/pr"
+	"avito-test-pr-service/internal/application/team"
+	"avito-test-pr-service/internal/application/user"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"avito-test-pr-service/internal/infrastructure/reviewerselector"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestUserTags_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	log := logger.New("test")
+	u := uow.NewPostgresUOW(pgC.Pool, log)
+	selector := reviewerselector.NewWeightedSelector(reviewerselector.WeightedParams{TagWeight: 1, LoadPenalty: 0.1})
+	r := apihttp.NewRouter(log, pr.NewService(u, services.SingleSelector(selector), feedBroker, log), team.NewService(u, newSelectorRegistry(), pr.NewReviewReleaser(services.SingleSelector(selector), feedBroker, log), log), user.NewService(u, log), buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
+	r.Setup(cfg)
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	seed := func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		teamID := insertTeamHTTP(t, "core")
+		for _, id := range []string{"u1", "u2", "u3", "u4"} {
+			insertUserHTTP(t, id, "name-"+id, true)
+			addMemberHTTP(t, teamID, id)
+		}
+	}
+
+	t.Run("set and get tags", func(t *testing.T) {
+		seed(t)
+		resp, err := postJSONPR(baseURL, "/users/tags", map[string]any{"user_id": "u2", "tags": []string{"Go", "postgres", "go"}})
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("want 200 got %d", resp.StatusCode)
+		}
+		getResp, err := http.Get(baseURL + "/users/tags?user_id=u2")
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		defer func() { _ = getResp.Body.Close() }()
+		var out struct {
+			UserID string   `json:"user_id"`
+			Tags   []string `json:"tags"`
+		}
+		if err := json.NewDecoder(getResp.Body).Decode(&out); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if out.UserID != "u2" || !EqualStringSets(out.Tags, []string{"go", "postgres"}) {
+			t.Fatalf("unexpected tags %+v", out)
+		}
+	})
+
+	t.Run("invalid tag -> 400, unknown user -> 404", func(t *testing.T) {
+		seed(t)
+		resp, err := postJSONPR(baseURL, "/users/tags", map[string]any{"user_id": "u2", "tags": []string{"front end"}})
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("want 400 got %d", resp.StatusCode)
+		}
+		resp, err = postJSONPR(baseURL, "/users/tags", map[string]any{"user_id": "ghost", "tags": []string{"go"}})
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("want 404 got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("labelled PR goes to tagged experts", func(t *testing.T) {
+		seed(t)
+		for _, id := range []string{"u2", "u3"} {
+			resp, err := postJSONPR(baseURL, "/users/tags", map[string]any{"user_id": id, "tags": []string{"postgres"}})
+			if err != nil {
+				t.Fatalf("post: %v", err)
+			}
+			_ = resp.Body.Close()
+		}
+		resp, err := postJSONPR(baseURL, "/pullRequest/create", map[string]any{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "migrate",
+			"author_id":         "u1",
+			"labels":            []string{"Postgres"},
+		})
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("want 201 got %d", resp.StatusCode)
+		}
+		var out struct {
+			PR struct {
+				Labels    []string `json:"labels"`
+				Reviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if !EqualStringSets(out.PR.Labels, []string{"postgres"}) || !EqualStringSets(out.PR.Reviewers, []string{"u2", "u3"}) {
+			t.Fatalf("unexpected pr %+v", out.PR)
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"avito-test-pr-service/internal/application/team"
 	"avito-test-pr-service/internal/application/user"
 	input "avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/domain/services"
 	"avito-test-pr-service/internal/infrastructure/config"
 	apihttp "avito-test-pr-service/internal/infrastructure/http" // переименован, чтобы не конфликтовать с net/http
 	"avito-test-pr-service/internal/infrastructure/logger"
@@ -23,8 +24,8 @@ func buildServices() (input.UserInputPort, input.PRInputPort, input.TeamInputPor
 	u := uow.NewPostgresUOW(pgC.Pool, log)
 	userSvc := user.NewService(u, log)
 	selector := reviewerselector.NewRandomReviewerSelector()
-	prSvc := pr.NewService(u, selector, log)
-	teamSvc := team.NewService(u, log)
+	prSvc := pr.NewService(u, services.SingleSelector(selector), feedBroker, log)
+	teamSvc := team.NewService(u, newSelectorRegistry(), pr.NewReviewReleaser(services.SingleSelector(selector), feedBroker, log), log)
 	return userSvc, prSvc, teamSvc
 }
 
@@ -35,7 +36,7 @@ func TestUserHandlers_HTTPIntegration(t *testing.T) {
 
 	userSvc, prSvc, teamSvc := buildServices()
 	log := logger.New("test")
-	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc)
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
 	cfg := &config.Config{HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second}}
 	r.Setup(cfg)
 	server := httptest.NewServer(r.GetRouter())
@@ -177,7 +178,7 @@ func TestUserHandlers_HTTPIntegration(t *testing.T) {
 		}
 	})
 
-	t.Run("GetReviews empty user_id -> internal (service invalid arg)", func(t *testing.T) {
+	t.Run("GetReviews empty user_id -> 400", func(t *testing.T) {
 		resp, err := http.Get(baseURL + "/users/getReview?user_id=")
 		if err != nil {
 			t.Fatalf("http get: %v", err)
@@ -187,8 +188,8 @@ func TestUserHandlers_HTTPIntegration(t *testing.T) {
 				t.Fatalf("resp.Body.Close: %v", err)
 			}
 		}()
-		if resp.StatusCode != http.StatusInternalServerError {
-			t.Fatalf("want 500 got %d", resp.StatusCode)
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("want 400 got %d", resp.StatusCode)
 		}
 	})
 
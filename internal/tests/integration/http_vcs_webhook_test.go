@@ -0,0 +1,193 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/application/pr"
+	vcsapp "avito-test-pr-service/internal/application/vcs"
+	"avito-test-pr-service/internal/domain/ports/input"
+	"avito-test-pr-service/internal/infrastructure/config"
+	apihttp "avito-test-pr-service/internal/infrastructure/http"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"avito-test-pr-service/internal/infrastructure/vcs/github"
+	"avito-test-pr-service/internal/infrastructure/vcs/gitlab"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const webhookSecret = "integration-secret"
+
+func buildVCSService() input.VCSInputPort {
+	log := logger.New("test")
+	u := uow.NewPostgresUOW(pgC.Pool, log)
+	return vcsapp.NewService(u, pr.NewService(u, newSelectorRegistry(), feedBroker, log), log)
+}
+
+type webhookResult struct {
+	Outcome       string `json:"outcome"`
+	PullRequestID string `json:"pull_request_id"`
+	Status        string `json:"status"`
+	Reason        string `json:"reason"`
+}
+
+func TestVCSWebhooks_HTTPIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+
+	prSvc, teamSvc, userSvc := buildPRDeps(t)
+	log := logger.New("test")
+	r := apihttp.NewRouter(log, prSvc, teamSvc, userSvc, buildAuditService(), buildFeedService(), buildVCSService(), buildNotificationService())
+	r.Setup(&config.Config{
+		HTTPServer: config.HTTPServer{RequestTimeout: 5 * time.Second},
+		Integrations: config.Integrations{
+			GitHub: config.GitHubIntegration{WebhookSecret: webhookSecret},
+			GitLab: config.GitLabIntegration{WebhookSecret: webhookSecret},
+		},
+	})
+	server := httptest.NewServer(r.GetRouter())
+	defer server.Close()
+	baseURL := server.URL
+
+	deliver := func(t *testing.T, fixture string) (int, webhookResult) {
+		t.Helper()
+		provider := "github"
+		if strings.HasPrefix(fixture, "merge_request_") {
+			provider = "gitlab"
+		}
+		body, err := os.ReadFile(filepath.Join("..", "..", "infrastructure", "vcs", provider, "testdata", fixture))
+		if err != nil {
+			t.Fatalf("read fixture: %v", err)
+		}
+		req, _ := http.NewRequest(http.MethodPost, baseURL+"/integrations/"+provider+"/webhook", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if provider == "gitlab" {
+			req.Header.Set(gitlab.EventHeader, gitlab.EventMergeRequest)
+			req.Header.Set(gitlab.TokenHeader, webhookSecret)
+		} else {
+			req.Header.Set(github.EventHeader, github.EventPullRequest)
+			req.Header.Set(github.SignatureHeader, github.Sign(webhookSecret, body))
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("deliver %s: %v", fixture, err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var out webhookResult
+		_ = json.NewDecoder(resp.Body).Decode(&out)
+		return resp.StatusCode, out
+	}
+
+	t.Run("pull request lifecycle", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		insertUserHTTP(t, "u1", "alice", true)
+		insertUserHTTP(t, "u2", "bob", true)
+		teamID := insertTeamHTTP(t, "core")
+		addMemberHTTP(t, teamID, "u1")
+		addMemberHTTP(t, teamID, "u2")
+
+		code, res := deliver(t, "pull_request_opened.json")
+		if code != http.StatusOK || res.Outcome != "ignored" {
+			t.Fatalf("unmapped author: want ignored, got %d %+v", code, res)
+		}
+
+		resp, err := postJSONPR(baseURL, "/integrations/identities", map[string]any{
+			"identities": []map[string]string{{"provider": "github", "login": "Octo-Alice", "user_id": "u1"}},
+		})
+		if err != nil || resp.StatusCode != http.StatusOK {
+			t.Fatalf("set identities: %v %v", err, resp)
+		}
+		_ = resp.Body.Close()
+
+		steps := []struct {
+			fixture string
+			outcome string
+			status  string
+		}{
+			{"pull_request_opened.json", "created", "OPEN"},
+			{"pull_request_opened.json", "exists", "OPEN"},
+			{"pull_request_closed.json", "closed", "CLOSED"},
+			{"pull_request_reopened.json", "reopened", "OPEN"},
+			{"pull_request_synchronize.json", "ignored", ""},
+			{"pull_request_closed_merged.json", "merged", "MERGED"},
+		}
+		for _, s := range steps {
+			code, res := deliver(t, s.fixture)
+			if code != http.StatusOK || res.Outcome != s.outcome || res.Status != s.status {
+				t.Fatalf("%s: want %s/%s, got %d %+v", s.fixture, s.outcome, s.status, code, res)
+			}
+		}
+		reviewers, err := GetPRReviewers(testCtx, pgC.Pool, "gh-582347311-42")
+		if err != nil || len(reviewers) != 1 || reviewers[0] != "u2" {
+			t.Fatalf("reviewers: %v %v", reviewers, err)
+		}
+	})
+
+	t.Run("gitlab merge request with draft toggles", func(t *testing.T) {
+		if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		insertUserHTTP(t, "u1", "alice", true)
+		insertUserHTTP(t, "u2", "bob", true)
+		teamID := insertTeamHTTP(t, "core")
+		addMemberHTTP(t, teamID, "u1")
+		addMemberHTTP(t, teamID, "u2")
+		resp, err := postJSONPR(baseURL, "/integrations/identities", map[string]any{
+			"identities": []map[string]string{{"provider": "gitlab", "login": "Alice.Dev", "user_id": "u1"}},
+		})
+		if err != nil || resp.StatusCode != http.StatusOK {
+			t.Fatalf("set identities: %v %v", err, resp)
+		}
+		_ = resp.Body.Close()
+
+		steps := []struct {
+			fixture string
+			outcome string
+			status  string
+		}{
+			{"merge_request_open_draft.json", "ignored", ""},
+			{"merge_request_update_ready.json", "created", "OPEN"},
+			{"merge_request_open.json", "exists", "OPEN"},
+			{"merge_request_update_draft.json", "closed", "CLOSED"},
+			{"merge_request_reopen.json", "reopened", "OPEN"},
+			{"merge_request_update_title.json", "ignored", ""},
+			{"merge_request_merge.json", "merged", "MERGED"},
+			{"merge_request_close.json", "", ""},
+		}
+		for _, s := range steps {
+			code, res := deliver(t, s.fixture)
+			if s.outcome == "" {
+				// Закрытие смерженного MR — конфликт.
+				if code != http.StatusConflict {
+					t.Fatalf("%s: want 409, got %d %+v", s.fixture, code, res)
+				}
+				continue
+			}
+			if code != http.StatusOK || res.Outcome != s.outcome || res.Status != s.status {
+				t.Fatalf("%s: want %s/%s, got %d %+v", s.fixture, s.outcome, s.status, code, res)
+			}
+		}
+	})
+
+	t.Run("bad signature -> 401", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, baseURL+"/integrations/github/webhook", bytes.NewReader([]byte(`{}`)))
+		req.Header.Set(github.EventHeader, github.EventPullRequest)
+		req.Header.Set(github.SignatureHeader, github.Sign("wrong", []byte(`{}`)))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Fatalf("want 401, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -270,6 +270,47 @@ func TestPRRepository_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("version grows on every change", func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		for _, id := range []string{"u-author", "u-r1", "u-r2"} {
+			if err := InsertUser(ctx, pgC.Pool, id, id, true); err != nil {
+				t.Fatalf("insert user: %v", err)
+			}
+		}
+		pr := &models.PullRequest{ID: "pr-1", Title: "feature", AuthorID: "u-author", ReviewerIDs: []string{"u-r1"}}
+		if err := repo.CreatePR(ctx, pr); err != nil {
+			t.Fatalf("CreatePR: %v", err)
+		}
+		if pr.Version != 1 {
+			t.Fatalf("expected version 1 got %d", pr.Version)
+		}
+		if err := repo.AddReviewer(ctx, "pr-1", "u-r2"); err != nil {
+			t.Fatalf("AddReviewer: %v", err)
+		}
+		if err := repo.RemoveReviewer(ctx, "pr-1", "u-r1"); err != nil {
+			t.Fatalf("RemoveReviewer: %v", err)
+		}
+		if err := repo.UpdateStatus(ctx, "pr-1", models.PRStatusMERGED, nil); err != nil {
+			t.Fatalf("UpdateStatus: %v", err)
+		}
+		got, err := repo.GetPRByID(ctx, "pr-1")
+		if err != nil {
+			t.Fatalf("GetPRByID: %v", err)
+		}
+		if got.Version != 4 {
+			t.Fatalf("expected version 4 got %d", got.Version)
+		}
+		// Неудачное изменение версию не трогает.
+		if err := repo.RemoveReviewer(ctx, "pr-1", "u-r1"); err != utils.ErrReviewerNotAssigned {
+			t.Fatalf("expected ErrReviewerNotAssigned got %v", err)
+		}
+		if got, _ := repo.GetPRByID(ctx, "pr-1"); got.Version != 4 {
+			t.Fatalf("expected version 4 got %d", got.Version)
+		}
+	})
+
 	t.Run("UpdateStatus merge success", func(t *testing.T) {
 		if err := TruncateAll(ctx, pgC.Pool); err != nil {
 			t.Fatalf("truncate: %v", err)
@@ -390,4 +431,166 @@ func TestPRRepository_Integration(t *testing.T) {
 			t.Fatalf("expected 0 got %d", len(list))
 		}
 	})
+
+	t.Run("ListPRs total past the last page", func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		if err := InsertUser(ctx, pgC.Pool, "u-author", "author", true); err != nil {
+			t.Fatalf("insert user: %v", err)
+		}
+		for _, id := range []string{"pr-1", "pr-2", "pr-3"} {
+			if err := repo.CreatePR(ctx, &models.PullRequest{ID: id, Title: id, AuthorID: "u-author"}); err != nil {
+				t.Fatalf("CreatePR %s: %v", id, err)
+			}
+		}
+		page, total, err := repo.ListPRs(ctx, models.PRFilter{AuthorID: "u-author", Limit: 2, Offset: 1})
+		if err != nil || len(page) != 2 || total != 3 {
+			t.Fatalf("unexpected page %d total %d err %v", len(page), total, err)
+		}
+		page, total, err = repo.ListPRs(ctx, models.PRFilter{AuthorID: "u-author", Limit: 2, Offset: 10})
+		if err != nil || len(page) != 0 || total != 3 {
+			t.Fatalf("offset past the end: page %d total %d err %v", len(page), total, err)
+		}
+	})
+
+	t.Run("VCS link upsert and lookup", func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		if _, err := repo.GetVCSLink(ctx, "gh-1-42"); err != utils.ErrVCSLinkNotFound {
+			t.Fatalf("want ErrVCSLinkNotFound, got %v", err)
+		}
+		// Связь сохраняется и до создания PR.
+		link := &models.VCSPullRequestLink{PRID: "gh-1-42", Provider: models.VCSProviderGitHub, Repository: "acme/old-name", Number: 42}
+		if err := repo.SetVCSLink(ctx, link); err != nil {
+			t.Fatalf("SetVCSLink: %v", err)
+		}
+		link.Repository = "acme/pr-service"
+		if err := repo.SetVCSLink(ctx, link); err != nil {
+			t.Fatalf("SetVCSLink update: %v", err)
+		}
+		got, err := repo.GetVCSLink(ctx, "gh-1-42")
+		if err != nil || *got != *link {
+			t.Fatalf("GetVCSLink: %+v %v", got, err)
+		}
+	})
+
+	t.Run("digest queue", func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		for _, id := range []string{"u-author", "u-r1", "u-r2"} {
+			if err := InsertUser(ctx, pgC.Pool, id, id, true); err != nil {
+				t.Fatalf("insert user: %v", err)
+			}
+		}
+		if err := repo.CreatePR(ctx, &models.PullRequest{ID: "pr-1", Title: "f1", AuthorID: "u-author"}); err != nil {
+			t.Fatalf("CreatePR: %v", err)
+		}
+		events := []*models.ReviewFeedEvent{
+			{UserID: "u-r2", Type: models.ReviewFeedAssigned, PRID: "pr-1"},
+			{UserID: "u-r1", Type: models.ReviewFeedAssigned, PRID: "pr-1"},
+			{UserID: "u-r1", Type: models.ReviewFeedMerged, PRID: "pr-1"},
+		}
+		if err := repo.AppendFeedEvents(ctx, events); err != nil {
+			t.Fatalf("AppendFeedEvents: %v", err)
+		}
+		if err := repo.AddDigestEvents(ctx, events); err != nil {
+			t.Fatalf("AddDigestEvents: %v", err)
+		}
+		// Повторная постановка того же события не дублирует его.
+		if err := repo.AddDigestEvents(ctx, events[1:2]); err != nil {
+			t.Fatalf("AddDigestEvents repeat: %v", err)
+		}
+		got, err := repo.ListDigestEvents(ctx)
+		if err != nil {
+			t.Fatalf("ListDigestEvents: %v", err)
+		}
+		if len(got) != 3 || got[0].ID != events[1].ID || got[1].ID != events[2].ID || got[2].UserID != "u-r2" || got[0].PRTitle != "f1" {
+			t.Fatalf("unexpected digest order %+v", got)
+		}
+		if err := repo.DeleteDigestEvents(ctx, "u-r1", events[2].ID); err != nil {
+			t.Fatalf("DeleteDigestEvents: %v", err)
+		}
+		got, err = repo.ListDigestEvents(ctx)
+		if err != nil || len(got) != 1 || got[0].UserID != "u-r2" {
+			t.Fatalf("after delete: %+v %v", got, err)
+		}
+	})
+
+	t.Run("digest day is claimed once", func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		for i, want := range []bool{true, false} {
+			claimed, err := repo.ClaimDigestDay(ctx, "2026-10-18")
+			if err != nil || claimed != want {
+				t.Fatalf("ClaimDigestDay #%d: %v %v", i, claimed, err)
+			}
+		}
+		if claimed, err := repo.ClaimDigestDay(ctx, "2026-10-19"); err != nil || !claimed {
+			t.Fatalf("ClaimDigestDay next day: %v %v", claimed, err)
+		}
+	})
+
+	t.Run("feed event ids follow commit order", func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		for _, id := range []string{"u-author", "u-r1"} {
+			if err := InsertUser(ctx, pgC.Pool, id, id, true); err != nil {
+				t.Fatalf("insert user: %v", err)
+			}
+		}
+		if err := repo.CreatePR(ctx, &models.PullRequest{ID: "pr-1", Title: "f1", AuthorID: "u-author"}); err != nil {
+			t.Fatalf("CreatePR: %v", err)
+		}
+		// Транзакция A пишет событие первой, но коммитится после того, как B попытается закоммитить своё.
+		txA, err := pgC.Pool.Begin(ctx)
+		if err != nil {
+			t.Fatalf("begin A: %v", err)
+		}
+		defer func() { _ = txA.Rollback(ctx) }()
+		evA := &models.ReviewFeedEvent{UserID: "u-r1", Type: models.ReviewFeedAssigned, PRID: "pr-1"}
+		if err := prrepo.NewPRRepository(txA, log).AppendFeedEvents(ctx, []*models.ReviewFeedEvent{evA}); err != nil {
+			t.Fatalf("AppendFeedEvents A: %v", err)
+		}
+
+		evB := &models.ReviewFeedEvent{UserID: "u-r1", Type: models.ReviewFeedMerged, PRID: "pr-1"}
+		doneB := make(chan error, 1)
+		go func() {
+			txB, err := pgC.Pool.Begin(ctx)
+			if err != nil {
+				doneB <- err
+				return
+			}
+			defer func() { _ = txB.Rollback(ctx) }()
+			if err := prrepo.NewPRRepository(txB, log).AppendFeedEvents(ctx, []*models.ReviewFeedEvent{evB}); err != nil {
+				doneB <- err
+				return
+			}
+			doneB <- txB.Commit(ctx)
+		}()
+
+		select {
+		case err := <-doneB:
+			t.Fatalf("B committed while A still holds a lower id: %v", err)
+		case <-time.After(300 * time.Millisecond):
+		}
+		if err := txA.Commit(ctx); err != nil {
+			t.Fatalf("commit A: %v", err)
+		}
+		if err := <-doneB; err != nil {
+			t.Fatalf("B: %v", err)
+		}
+		if evB.ID <= evA.ID {
+			t.Fatalf("ids not in commit order: A=%d B=%d", evA.ID, evB.ID)
+		}
+		// Клиент, видевший только A, продолжает с его id и получает B.
+		got, err := repo.ListFeedEvents(ctx, "u-r1", evA.ID, 10)
+		if err != nil || len(got) != 1 || got[0].ID != evB.ID {
+			t.Fatalf("resume after A: %+v %v", got, err)
+		}
+	})
 }
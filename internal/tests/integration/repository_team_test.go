@@ -133,6 +133,50 @@ func TestTeamRepository_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("version grows on member and settings changes", func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate failed: %v", err)
+		}
+		if err := InsertUser(ctx, pgC.Pool, "u1", "Alice", true); err != nil {
+			t.Fatalf("insert user: %v", err)
+		}
+		team := &models.Team{Name: "core"}
+		if err := repo.CreateTeam(ctx, team); err != nil {
+			t.Fatalf("CreateTeam: %v", err)
+		}
+		if team.Version != 1 {
+			t.Fatalf("expected version 1, got %d", team.Version)
+		}
+		if err := repo.AddMember(ctx, team.ID, "u1"); err != nil {
+			t.Fatalf("AddMember: %v", err)
+		}
+		if err := repo.AddMember(ctx, team.ID, "u1"); err != utils.ErrAlreadyExists {
+			t.Fatalf("expected ErrAlreadyExists, got %v", err)
+		}
+		if err := repo.UpdateSelectionStrategy(ctx, team.ID, "roundrobin", nil); err != nil {
+			t.Fatalf("UpdateSelectionStrategy: %v", err)
+		}
+		if err := repo.ReplaceCodeOwners(ctx, team.ID, nil); err != nil {
+			t.Fatalf("ReplaceCodeOwners: %v", err)
+		}
+		if err := repo.RemoveMember(ctx, team.ID, "u1"); err != nil {
+			t.Fatalf("RemoveMember: %v", err)
+		}
+		got, err := repo.LockTeamByName(ctx, "core")
+		if err != nil {
+			t.Fatalf("LockTeamByName: %v", err)
+		}
+		if got.Version != 5 {
+			t.Fatalf("expected version 5, got %d", got.Version)
+		}
+		if _, err := repo.LockTeamByName(ctx, "missing"); err != utils.ErrTeamNotFound {
+			t.Fatalf("expected ErrTeamNotFound, got %v", err)
+		}
+		if err := repo.ReplaceReviewerConstraints(ctx, uuid.New(), nil); err != utils.ErrTeamNotFound {
+			t.Fatalf("expected ErrTeamNotFound, got %v", err)
+		}
+	})
+
 	t.Run("AddMember success", func(t *testing.T) {
 		if err := TruncateAll(ctx, pgC.Pool); err != nil {
 			t.Fatalf("truncate failed: %v", err)
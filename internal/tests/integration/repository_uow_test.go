@@ -0,0 +1,256 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/domain/ports/output/uow"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	pguow "avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func TestUnitOfWorkDo_Integration(t *testing.T) {
+	ctx := testCtx
+	u := pguow.NewPostgresUOW(pgC.Pool, logger.New("test"))
+
+	countTeams := func(t *testing.T) int {
+		var n int
+		if err := pgC.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM teams`).Scan(&n); err != nil {
+			t.Fatalf("count: %v", err)
+		}
+		return n
+	}
+	truncate := func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+	}
+
+	t.Run("retries serialization failure and commits", func(t *testing.T) {
+		truncate(t)
+		var attempts int
+		err := u.Do(ctx, uow.TxOptions{Isolation: uow.Serializable}, func(tx uow.Transaction) error {
+			attempts++
+			if err := tx.TeamRepository().CreateTeam(ctx, &models.Team{ID: uuid.New(), Name: "core"}); err != nil {
+				return err
+			}
+			if attempts == 1 {
+				return &pgconn.PgError{Code: "40001"}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+		if attempts != 2 {
+			t.Fatalf("attempts = %d, want 2", attempts)
+		}
+		if n := countTeams(t); n != 1 {
+			t.Fatalf("teams = %d, want 1: failed attempt must be rolled back", n)
+		}
+	})
+
+	t.Run("gives up after MaxAttempts", func(t *testing.T) {
+		var attempts int
+		err := u.Do(ctx, uow.TxOptions{MaxAttempts: 3}, func(tx uow.Transaction) error {
+			attempts++
+			return &pgconn.PgError{Code: "40P01"}
+		})
+		var pgErr *pgconn.PgError
+		if !errors.As(err, &pgErr) || pgErr.Code != "40P01" {
+			t.Fatalf("expected deadlock error, got %v", err)
+		}
+		if attempts != 3 {
+			t.Fatalf("attempts = %d, want 3", attempts)
+		}
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		boom := errors.New("boom")
+		var attempts int
+		err := u.Do(ctx, uow.TxOptions{}, func(tx uow.Transaction) error {
+			attempts++
+			return boom
+		})
+		if !errors.Is(err, boom) || attempts != 1 {
+			t.Fatalf("err = %v, attempts = %d", err, attempts)
+		}
+	})
+
+	t.Run("read-only rejects writes", func(t *testing.T) {
+		truncate(t)
+		err := u.Do(ctx, uow.TxOptions{ReadOnly: true}, func(tx uow.Transaction) error {
+			return tx.TeamRepository().CreateTeam(ctx, &models.Team{ID: uuid.New(), Name: "core"})
+		})
+		var pgErr *pgconn.PgError
+		if !errors.As(err, &pgErr) || pgErr.Code != "25006" {
+			t.Fatalf("expected read_only_sql_transaction, got %v", err)
+		}
+		if n := countTeams(t); n != 0 {
+			t.Fatalf("teams = %d, want 0", n)
+		}
+	})
+
+	t.Run("write skew under serializable is retried", func(t *testing.T) {
+		truncate(t)
+		var attempts atomic.Int32
+		readA, readB := make(chan struct{}), make(chan struct{})
+		// Обе транзакции читают teams до вставки друг друга: одна из них получает 40001 и повторяется.
+		run := func(name string, mine, other chan struct{}) error {
+			first := true
+			return u.Do(ctx, uow.TxOptions{Isolation: uow.Serializable}, func(tx uow.Transaction) error {
+				attempts.Add(1)
+				repo := tx.TeamRepository()
+				if _, err := repo.ListTeams(ctx); err != nil {
+					return err
+				}
+				if first {
+					first = false
+					close(mine)
+					<-other
+				}
+				return repo.CreateTeam(ctx, &models.Team{ID: uuid.New(), Name: name})
+			})
+		}
+		var wg sync.WaitGroup
+		errs := make(chan error, 2)
+		wg.Add(2)
+		go func() { defer wg.Done(); errs <- run("a", readA, readB) }()
+		go func() { defer wg.Done(); errs <- run("b", readB, readA) }()
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			if err != nil {
+				t.Fatalf("Do: %v", err)
+			}
+		}
+		if got := attempts.Load(); got != 3 {
+			t.Fatalf("attempts = %d, want 3", got)
+		}
+		if n := countTeams(t); n != 2 {
+			t.Fatalf("teams = %d, want 2", n)
+		}
+	})
+}
+
+func TestUnitOfWorkBeginRead_Integration(t *testing.T) {
+	ctx := testCtx
+	log := logger.New("test")
+
+	// Роль реплики играет отдельная база: по содержимому видно, какой пул обслужил чтение.
+	replicaDSN := strings.Replace(pgC.DSN, "/prservice?", "/prservice_replica?", 1)
+	if _, err := pgC.Pool.Exec(ctx, `DROP DATABASE IF EXISTS prservice_replica`); err != nil {
+		t.Fatalf("drop replica db: %v", err)
+	}
+	if _, err := pgC.Pool.Exec(ctx, `CREATE DATABASE prservice_replica`); err != nil {
+		t.Fatalf("create replica db: %v", err)
+	}
+	if err := ApplyMigrations(ctx, replicaDSN); err != nil {
+		t.Fatalf("migrate replica: %v", err)
+	}
+	replica, err := pgxpool.New(ctx, replicaDSN)
+	if err != nil {
+		t.Fatalf("replica pool: %v", err)
+	}
+	defer replica.Close()
+
+	if err := TruncateAll(ctx, pgC.Pool); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+	if _, err := InsertTeam(ctx, pgC.Pool, "primary"); err != nil {
+		t.Fatalf("insert primary team: %v", err)
+	}
+	if _, err := InsertTeam(ctx, replica, "replica"); err != nil {
+		t.Fatalf("insert replica team: %v", err)
+	}
+
+	teamNames := func(t *testing.T, tx uow.Transaction) string {
+		teams, err := tx.TeamRepository().ListTeams(ctx)
+		if err != nil {
+			t.Fatalf("ListTeams: %v", err)
+		}
+		names := make([]string, 0, len(teams))
+		for _, team := range teams {
+			names = append(names, team.Name)
+		}
+		return strings.Join(names, ",")
+	}
+
+	u := pguow.NewPostgresUOWWithReplica(pgC.Pool, pguow.ReplicaOptions{Pool: replica, MaxStaleness: time.Second}, log)
+
+	t.Run("BeginRead uses replica", func(t *testing.T) {
+		tx, err := u.BeginRead(ctx)
+		if err != nil {
+			t.Fatalf("BeginRead: %v", err)
+		}
+		defer func() { _ = tx.Rollback(ctx) }()
+		if got := teamNames(t, tx); got != "replica" {
+			t.Fatalf("teams = %q, want replica", got)
+		}
+	})
+
+	t.Run("BeginRead is read-only", func(t *testing.T) {
+		tx, err := u.BeginRead(ctx)
+		if err != nil {
+			t.Fatalf("BeginRead: %v", err)
+		}
+		defer func() { _ = tx.Rollback(ctx) }()
+		err = tx.TeamRepository().CreateTeam(ctx, &models.Team{ID: uuid.New(), Name: "core"})
+		var pgErr *pgconn.PgError
+		if !errors.As(err, &pgErr) || pgErr.Code != "25006" {
+			t.Fatalf("expected read_only_sql_transaction, got %v", err)
+		}
+	})
+
+	t.Run("Do routes only Replica reads to replica", func(t *testing.T) {
+		var got string
+		if err := u.Do(ctx, uow.TxOptions{ReadOnly: true, Replica: true}, func(tx uow.Transaction) error {
+			got = teamNames(t, tx)
+			return nil
+		}); err != nil || got != "replica" {
+			t.Fatalf("replica read = %q, %v", got, err)
+		}
+		if err := u.Do(ctx, uow.TxOptions{ReadOnly: true}, func(tx uow.Transaction) error {
+			got = teamNames(t, tx)
+			return nil
+		}); err != nil || got != "primary" {
+			t.Fatalf("primary read = %q, %v", got, err)
+		}
+	})
+
+	t.Run("falls back to primary when replica is down", func(t *testing.T) {
+		down, err := pgxpool.New(ctx, replicaDSN)
+		if err != nil {
+			t.Fatalf("pool: %v", err)
+		}
+		down.Close()
+		u := pguow.NewPostgresUOWWithReplica(pgC.Pool, pguow.ReplicaOptions{Pool: down, MaxStaleness: time.Second}, log)
+		tx, err := u.BeginRead(ctx)
+		if err != nil {
+			t.Fatalf("BeginRead: %v", err)
+		}
+		defer func() { _ = tx.Rollback(ctx) }()
+		if got := teamNames(t, tx); got != "primary" {
+			t.Fatalf("teams = %q, want primary", got)
+		}
+	})
+
+	t.Run("without replica reads primary", func(t *testing.T) {
+		tx, err := pguow.NewPostgresUOW(pgC.Pool, log).BeginRead(ctx)
+		if err != nil {
+			t.Fatalf("BeginRead: %v", err)
+		}
+		defer func() { _ = tx.Rollback(ctx) }()
+		if got := teamNames(t, tx); got != "primary" {
+			t.Fatalf("teams = %q, want primary", got)
+		}
+	})
+}
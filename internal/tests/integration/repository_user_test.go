@@ -136,4 +136,100 @@ func TestUserRepository_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("GetVCSLogins", func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		for _, id := range []string{"u1", "u2", "u3"} {
+			if err := InsertUser(ctx, pgC.Pool, id, id, true); err != nil {
+				t.Fatalf("insert user: %v", err)
+			}
+		}
+		for _, identity := range []*models.VCSIdentity{
+			{Provider: models.VCSProviderGitHub, Login: "zed", UserID: "u1"},
+			{Provider: models.VCSProviderGitHub, Login: "alice", UserID: "u1"},
+			{Provider: models.VCSProviderGitLab, Login: "bob", UserID: "u2"},
+		} {
+			if err := repo.SetVCSIdentity(ctx, identity); err != nil {
+				t.Fatalf("SetVCSIdentity: %v", err)
+			}
+		}
+		logins, err := repo.GetVCSLogins(ctx, models.VCSProviderGitHub, []string{"u1", "u2", "u3"})
+		if err != nil {
+			t.Fatalf("GetVCSLogins: %v", err)
+		}
+		if len(logins) != 1 || logins["u1"] != "alice" {
+			t.Fatalf("unexpected logins %v", logins)
+		}
+	})
+
+	t.Run("notification preferences", func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		for _, id := range []string{"u1", "u2"} {
+			if err := InsertUser(ctx, pgC.Pool, id, id, true); err != nil {
+				t.Fatalf("insert user: %v", err)
+			}
+		}
+		prefs := &models.NotificationPreferences{UserID: "u1", ChatHandle: "alice", Delivery: models.NotificationDeliveryDigest,
+			EmailDelivery: models.NotificationDeliveryDigest, Kinds: []models.NotificationKind{models.NotificationAssigned}}
+		if err := repo.SetNotificationPreferences(ctx, prefs); err != nil {
+			t.Fatalf("SetNotificationPreferences: %v", err)
+		}
+		prefs.Delivery = models.NotificationDeliveryImmediate
+		if err := repo.SetNotificationPreferences(ctx, prefs); err != nil {
+			t.Fatalf("SetNotificationPreferences update: %v", err)
+		}
+		got, err := repo.GetNotificationPreferences(ctx, []string{"u1", "u2"})
+		if err != nil {
+			t.Fatalf("GetNotificationPreferences: %v", err)
+		}
+		if len(got) != 1 || got["u1"].Delivery != models.NotificationDeliveryImmediate || got["u1"].ChatHandle != "alice" ||
+			got["u1"].EmailDelivery != models.NotificationDeliveryDigest ||
+			len(got["u1"].Kinds) != 1 || got["u1"].Kinds[0] != models.NotificationAssigned {
+			t.Fatalf("unexpected preferences %+v", got)
+		}
+		err = repo.SetNotificationPreferences(ctx, &models.NotificationPreferences{UserID: "ghost", Delivery: models.NotificationDeliveryOff, EmailDelivery: models.NotificationDeliveryOff})
+		if err != utils.ErrUserNotFound {
+			t.Fatalf("want ErrUserNotFound, got %v", err)
+		}
+	})
+
+	t.Run("email and digest recipients", func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		for _, id := range []string{"u1", "u2", "u3"} {
+			if err := InsertUser(ctx, pgC.Pool, id, id, id != "u3"); err != nil {
+				t.Fatalf("insert user: %v", err)
+			}
+		}
+		for _, id := range []string{"u1", "u3"} {
+			if err := repo.SetUserEmail(ctx, id, id+"@example.com"); err != nil {
+				t.Fatalf("SetUserEmail: %v", err)
+			}
+		}
+		if err := repo.SetUserEmail(ctx, "ghost", "ghost@example.com"); err != utils.ErrUserNotFound {
+			t.Fatalf("want ErrUserNotFound, got %v", err)
+		}
+		u, err := repo.GetUserByID(ctx, "u1")
+		if err != nil || u.Email != "u1@example.com" {
+			t.Fatalf("GetUserByID: %+v %v", u, err)
+		}
+		// u2 без адреса, u3 неактивен — дайджест получает только u1.
+		for _, id := range []string{"u1", "u2", "u3"} {
+			p := &models.NotificationPreferences{UserID: id, Delivery: models.NotificationDeliveryOff, EmailDelivery: models.NotificationDeliveryDigest, Kinds: models.NotificationKinds}
+			if err := repo.SetNotificationPreferences(ctx, p); err != nil {
+				t.Fatalf("SetNotificationPreferences: %v", err)
+			}
+		}
+		recipients, err := repo.ListEmailDigestRecipients(ctx)
+		if err != nil {
+			t.Fatalf("ListEmailDigestRecipients: %v", err)
+		}
+		if len(recipients) != 1 || recipients[0].ID != "u1" || recipients[0].Email != "u1@example.com" {
+			t.Fatalf("unexpected recipients %+v", recipients)
+		}
+	})
 }
@@ -0,0 +1,178 @@
+package integration
+
+import (
+	notificationapp "avito-test-pr-service/internal/application/notification"
+	"avito-test-pr-service/internal/application/pr"
+	"avito-test-pr-service/internal/application/user"
+	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/infrastructure/chat"
+	"avito-test-pr-service/internal/infrastructure/feedbroker"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type chatCall struct {
+	Channel string `json:"channel"`
+	Text    string `json:"text"`
+}
+
+// TestNotifier_ChatIntegration: назначение на PR приходит в фейковый вебхук чата сразу
+// пользователю с immediate и одним дайджестом — пользователю с digest.
+func TestNotifier_ChatIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+	if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+	teamID := insertTeamHTTP(t, "core")
+	for _, id := range []string{"u1", "u2", "u3"} {
+		insertUserHTTP(t, id, "name-"+id, true)
+		addMemberHTTP(t, teamID, id)
+	}
+
+	calls := make(chan chatCall, 4)
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var c chatCall
+		_ = json.NewDecoder(r.Body).Decode(&c)
+		calls <- c
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer hook.Close()
+
+	log := logger.New("test")
+	u := uow.NewPostgresUOW(pgC.Pool, log)
+	templates, err := notificationapp.NewTemplates(nil)
+	if err != nil {
+		t.Fatalf("templates: %v", err)
+	}
+	channels := notificationapp.NotifierChannels{Chat: chat.NewWebhookSender(hook.URL, "pr-service", hook.Client()), Templates: templates}
+	notifier := notificationapp.NewNotifier(u, channels, notificationapp.NotifierOptions{DigestAt: 23 * time.Hour}, log)
+	defer notifier.Close()
+	prefsSvc := notificationapp.NewService(u, log)
+	prSvc := pr.NewService(u, newSelectorRegistry(), feedbroker.Fanout{feedBroker, notifier}, log)
+
+	for _, p := range []*models.NotificationPreferences{
+		{UserID: "u2", ChatHandle: "bob", Delivery: models.NotificationDeliveryImmediate},
+		{UserID: "u3", ChatHandle: "carol", Delivery: models.NotificationDeliveryDigest},
+	} {
+		if _, err := prefsSvc.SetPreferences(testCtx, p); err != nil {
+			t.Fatalf("set preferences: %v", err)
+		}
+	}
+	if _, err := prSvc.CreatePR(testCtx, "pr-1", "u1", "Add search index", nil, nil); err != nil {
+		t.Fatalf("create pr: %v", err)
+	}
+
+	select {
+	case c := <-calls:
+		if c.Channel != "@bob" || !strings.Contains(c.Text, "assigned to review *Add search index*") {
+			t.Fatalf("unexpected message %+v", c)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("assignment was not sent to chat")
+	}
+
+	// Событие попадает в дайджест асинхронно, поэтому ждём, пока обработчик его отложит.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var n int
+		if err := pgC.Pool.QueryRow(testCtx, `SELECT count(*) FROM notification_digest WHERE user_id = 'u3'`).Scan(&n); err != nil {
+			t.Fatalf("count digest: %v", err)
+		}
+		if n == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("want 1 pending digest event, got %d", n)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	sent, err := notifier.SendDigests(testCtx)
+	if err != nil || sent != 1 {
+		t.Fatalf("send digests: %d %v", sent, err)
+	}
+	c := <-calls
+	if c.Channel != "@carol" || !strings.HasPrefix(c.Text, "Review digest: 1 update(s)") || !strings.Contains(c.Text, "Add search index") {
+		t.Fatalf("unexpected digest %+v", c)
+	}
+	if sent, err := notifier.SendDigests(testCtx); err != nil || sent != 0 {
+		t.Fatalf("digest must be cleared after sending: %d %v", sent, err)
+	}
+}
+
+type recordingMailer struct {
+	mu   sync.Mutex
+	sent []*models.EmailMessage
+}
+
+func (m *recordingMailer) Send(_ context.Context, msg *models.EmailMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// TestNotifier_EmailDigestIntegration: дайджест на почту перечисляет открытые ревью пользователя
+// со статусом SLA; пользователь без открытых ревью письма не получает.
+func TestNotifier_EmailDigestIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+	if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+	teamID := insertTeamHTTP(t, "core")
+	for _, id := range []string{"u1", "u2"} {
+		insertUserHTTP(t, id, "name-"+id, true)
+		addMemberHTTP(t, teamID, id)
+	}
+
+	log := logger.New("test")
+	u := uow.NewPostgresUOW(pgC.Pool, log)
+	userSvc := user.NewService(u, log)
+	prefsSvc := notificationapp.NewService(u, log)
+	for _, id := range []string{"u1", "u2"} {
+		if _, err := userSvc.SetUserEmail(testCtx, id, id+"@example.com"); err != nil {
+			t.Fatalf("set email: %v", err)
+		}
+		if _, err := prefsSvc.SetPreferences(testCtx, &models.NotificationPreferences{UserID: id, EmailDelivery: models.NotificationDeliveryDigest}); err != nil {
+			t.Fatalf("set preferences: %v", err)
+		}
+	}
+	prSvc := pr.NewService(u, newSelectorRegistry(), feedBroker, log)
+	if _, err := prSvc.CreatePR(testCtx, "pr-1", "u1", "Add search index", nil, nil); err != nil {
+		t.Fatalf("create pr: %v", err)
+	}
+	if _, err := pgC.Pool.Exec(testCtx, `UPDATE prs SET created_at = now() - interval '50 hours' WHERE id = 'pr-1'`); err != nil {
+		t.Fatalf("age pr: %v", err)
+	}
+
+	templates, err := notificationapp.NewEmailTemplates("")
+	if err != nil {
+		t.Fatalf("templates: %v", err)
+	}
+	mailer := &recordingMailer{}
+	channels := notificationapp.NotifierChannels{Mail: mailer, MailTemplates: templates}
+	notifier := notificationapp.NewNotifier(u, channels, notificationapp.NotifierOptions{DigestAt: 23 * time.Hour, ReviewSLA: 48 * time.Hour}, log)
+	defer notifier.Close()
+
+	sent, err := notifier.SendDigests(testCtx)
+	if err != nil || sent != 1 {
+		t.Fatalf("send digests: %d %v", sent, err)
+	}
+	mailer.mu.Lock()
+	defer mailer.mu.Unlock()
+	msg := mailer.sent[0]
+	if msg.To != "u2@example.com" || !strings.Contains(msg.Text, "Add search index (pr-1): waiting 2d") || !strings.Contains(msg.Text, "[breached]") || !strings.Contains(msg.HTML, "breached") {
+		t.Fatalf("unexpected digest %+v", msg)
+	}
+}
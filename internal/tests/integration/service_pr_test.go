@@ -3,6 +3,7 @@ package integration
 import (
 	prapp "avito-test-pr-service/internal/application/pr"
 	"avito-test-pr-service/internal/domain/models"
+	"avito-test-pr-service/internal/domain/services"
 	"avito-test-pr-service/internal/infrastructure/logger"
 	prrepo "avito-test-pr-service/internal/infrastructure/persistence/postgres/pr"
 	pguow "avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
@@ -22,7 +23,7 @@ func newPRService() *prapp.Service {
 	seed := uint64(1)
 	r := rand.New(rand.NewPCG(seed, seed<<1|1))
 	selector := reviewerselector.NewRandomReviewerSelectorWithRand(r)
-	svc := prapp.NewService(u, selector, log)
+	svc := prapp.NewService(u, services.SingleSelector(selector), feedBroker, log)
 	return svc.(*prapp.Service)
 }
 
@@ -57,7 +58,7 @@ func TestPRService_Integration(t *testing.T) {
 				t.Fatalf("add member %s: %v", u, err)
 			}
 		}
-		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title")
+		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title", nil, nil)
 		if err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
@@ -98,7 +99,7 @@ func TestPRService_Integration(t *testing.T) {
 				t.Fatalf("add member %s: %v", u, err)
 			}
 		}
-		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title")
+		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title", nil, nil)
 		if err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
@@ -123,7 +124,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := AddTeamMember(ctx, pgC.Pool, teamID, "u1"); err != nil {
 			t.Fatalf("member: %v", err)
 		}
-		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title")
+		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title", nil, nil)
 		if err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
@@ -138,7 +139,7 @@ func TestPRService_Integration(t *testing.T) {
 			t.Fatalf("truncate: %v", err)
 		}
 		svc := newPRService()
-		_, err := svc.CreatePR(ctx, "pr-1", "missing", "title")
+		_, err := svc.CreatePR(ctx, "pr-1", "missing", "title", nil, nil)
 		if err == nil || !errors.Is(err, utils.ErrUserNotFound) {
 			t.Fatalf("want ErrUserNotFound got %v", err)
 		}
@@ -153,7 +154,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := InsertUser(ctx, pgC.Pool, "u1", "author", true); err != nil {
 			t.Fatalf("u1: %v", err)
 		}
-		_, err := svc.CreatePR(ctx, "pr-1", "u1", "title")
+		_, err := svc.CreatePR(ctx, "pr-1", "u1", "title", nil, nil)
 		if err == nil || !errors.Is(err, utils.ErrUserNoTeam) {
 			t.Fatalf("want ErrUserNoTeam got %v", err)
 		}
@@ -184,7 +185,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := UpdateUsersActive(ctx, pgC.Pool, []string{"u2", "u3"}, false); err != nil {
 			t.Fatalf("deactivate: %v", err)
 		}
-		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title")
+		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title", nil, nil)
 		if err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
@@ -197,7 +198,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := UpdateUserActive(ctx, pgC.Pool, "u3", true); err != nil {
 			t.Fatalf("activate u3: %v", err)
 		}
-		upd, err := svc.ReassignReviewer(ctx, pr.ID, "u2")
+		upd, err := svc.ReassignReviewer(ctx, pr.ID, "u2", "", "", nil)
 		if err != nil {
 			t.Fatalf("Reassign: %v", err)
 		}
@@ -226,11 +227,11 @@ func TestPRService_Integration(t *testing.T) {
 				t.Fatalf("member %s: %v", u, err)
 			}
 		}
-		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title")
+		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title", nil, nil)
 		if err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
-		_, err = svc.ReassignReviewer(ctx, pr.ID, "u2")
+		_, err = svc.ReassignReviewer(ctx, pr.ID, "u2", "", "", nil)
 		if err == nil || !errors.Is(err, utils.ErrNoReplacementCandidates) {
 			t.Fatalf("want ErrNoReplacementCandidates got %v", err)
 		}
@@ -258,7 +259,7 @@ func TestPRService_Integration(t *testing.T) {
 			}
 		}
 		// Явно создаём PR через сервис
-		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title")
+		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title", nil, nil)
 		if err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
@@ -266,7 +267,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := repo.UpdateStatus(ctx, pr.ID, models.PRStatusMERGED, &now); err != nil {
 			t.Fatalf("merge: %v", err)
 		}
-		_, err = svc.ReassignReviewer(ctx, pr.ID, "u2")
+		_, err = svc.ReassignReviewer(ctx, pr.ID, "u2", "", "", nil)
 		if err == nil || !errors.Is(err, utils.ErrAlreadyMerged) {
 			t.Fatalf("want ErrAlreadyMerged got %v", err)
 		}
@@ -296,7 +297,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := repo.CreatePR(ctx, &models.PullRequest{ID: "pr-1", Title: "title", AuthorID: "u1"}); err != nil {
 			t.Fatalf("repo create: %v", err)
 		}
-		_, err = svc.ReassignReviewer(ctx, "pr-1", "u2")
+		_, err = svc.ReassignReviewer(ctx, "pr-1", "u2", "", "", nil)
 		if err == nil || !errors.Is(err, utils.ErrReviewerNotAssigned) {
 			t.Fatalf("want ErrReviewerNotAssigned got %v", err)
 		}
@@ -321,7 +322,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := repo.AddReviewer(ctx, "pr-1", "u2"); err != nil {
 			t.Fatalf("add reviewer: %v", err)
 		}
-		_, err := svc.ReassignReviewer(ctx, "pr-1", "u2")
+		_, err := svc.ReassignReviewer(ctx, "pr-1", "u2", "", "", nil)
 		if err == nil || !errors.Is(err, utils.ErrUserNoTeam) {
 			t.Fatalf("want ErrUserNoTeam got %v", err)
 		}
@@ -343,18 +344,18 @@ func TestPRService_Integration(t *testing.T) {
 		if err := AddTeamMember(ctx, pgC.Pool, teamID, "u1"); err != nil {
 			t.Fatalf("member: %v", err)
 		}
-		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title")
+		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title", nil, nil)
 		if err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
-		m1, err := svc.MergePR(ctx, pr.ID)
+		m1, err := svc.MergePR(ctx, pr.ID, nil)
 		if err != nil {
 			t.Fatalf("merge1: %v", err)
 		}
 		if m1.Status != models.PRStatusMERGED || m1.MergedAt == nil {
 			t.Fatalf("not merged: %+v", m1)
 		}
-		m2, err := svc.MergePR(ctx, pr.ID)
+		m2, err := svc.MergePR(ctx, pr.ID, nil)
 		if err != nil {
 			t.Fatalf("merge2: %v", err)
 		}
@@ -368,7 +369,7 @@ func TestPRService_Integration(t *testing.T) {
 			t.Fatalf("truncate: %v", err)
 		}
 		svc := newPRService()
-		_, err := svc.MergePR(ctx, "missing")
+		_, err := svc.MergePR(ctx, "missing", nil)
 		if err == nil || !errors.Is(err, utils.ErrPRNotFound) {
 			t.Fatalf("want ErrPRNotFound got %v", err)
 		}
@@ -390,7 +391,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := AddTeamMember(ctx, pgC.Pool, teamID, "u1"); err != nil {
 			t.Fatalf("member: %v", err)
 		}
-		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title")
+		pr, err := svc.CreatePR(ctx, "pr-1", "u1", "title", nil, nil)
 		if err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
@@ -398,7 +399,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := repo.UpdateStatus(ctx, pr.ID, models.PRStatusMERGED, &now); err != nil {
 			t.Fatalf("merge: %v", err)
 		}
-		m, err := svc.MergePR(ctx, pr.ID)
+		m, err := svc.MergePR(ctx, pr.ID, nil)
 		if err != nil {
 			t.Fatalf("idempotent merge error: %v", err)
 		}
@@ -423,10 +424,10 @@ func TestPRService_Integration(t *testing.T) {
 		if err := AddTeamMember(ctx, pgC.Pool, teamID, "u1"); err != nil {
 			t.Fatalf("member: %v", err)
 		}
-		if _, err := svc.CreatePR(ctx, "pr-dup", "u1", "t"); err != nil {
+		if _, err := svc.CreatePR(ctx, "pr-dup", "u1", "t", nil, nil); err != nil {
 			t.Fatalf("first create: %v", err)
 		}
-		_, err = svc.CreatePR(ctx, "pr-dup", "u1", "t")
+		_, err = svc.CreatePR(ctx, "pr-dup", "u1", "t", nil, nil)
 		if err == nil || !errors.Is(err, utils.ErrPRExists) {
 			t.Fatalf("want ErrPRExists got %v", err)
 		}
@@ -460,7 +461,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := AddTeamMember(ctx, pgC.Pool, teamID, "u3"); err != nil {
 			t.Fatalf("member: %v", err)
 		}
-		pr, err := svc.CreatePR(ctx, "pr-inactive-author", "u1", "t")
+		pr, err := svc.CreatePR(ctx, "pr-inactive-author", "u1", "t", nil, nil)
 		if err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
@@ -481,7 +482,7 @@ func TestPRService_Integration(t *testing.T) {
 				t.Fatalf("truncate: %v", err)
 			}
 			svc := newPRService()
-			_, err := svc.CreatePR(ctx, tc.prID, tc.authorID, tc.title)
+			_, err := svc.CreatePR(ctx, tc.prID, tc.authorID, tc.title, nil, nil)
 			if err == nil || !errors.Is(err, utils.ErrInvalidArgument) {
 				t.Fatalf("case %s want ErrInvalidArgument got %v", tc.name, err)
 			}
@@ -506,7 +507,7 @@ func TestPRService_Integration(t *testing.T) {
 				t.Fatalf("add member u%d: %v", i, err)
 			}
 		}
-		pr, err := svc.CreatePR(ctx, "pr-many", "u1", "title")
+		pr, err := svc.CreatePR(ctx, "pr-many", "u1", "title", nil, nil)
 		if err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
@@ -528,7 +529,7 @@ func TestPRService_Integration(t *testing.T) {
 				t.Fatalf("truncate: %v", err)
 			}
 			svc := newPRService()
-			_, err := svc.ReassignReviewer(ctx, c.prID, c.old)
+			_, err := svc.ReassignReviewer(ctx, c.prID, c.old, "", "", nil)
 			if err == nil || !errors.Is(err, utils.ErrInvalidArgument) {
 				t.Fatalf("case %d want ErrInvalidArgument got %v", i, err)
 			}
@@ -541,7 +542,7 @@ func TestPRService_Integration(t *testing.T) {
 			t.Fatalf("truncate: %v", err)
 		}
 		svc := newPRService()
-		_, err := svc.MergePR(ctx, "")
+		_, err := svc.MergePR(ctx, "", nil)
 		if err == nil || !errors.Is(err, utils.ErrInvalidArgument) {
 			t.Fatalf("want ErrInvalidArgument got %v", err)
 		}
@@ -563,7 +564,7 @@ func TestPRService_Integration(t *testing.T) {
 		if err := AddTeamMember(ctx, pgC.Pool, teamID, "u1"); err != nil {
 			t.Fatalf("member: %v", err)
 		}
-		if _, err := svc.CreatePR(ctx, "pr-get", "u1", "title"); err != nil {
+		if _, err := svc.CreatePR(ctx, "pr-get", "u1", "title", nil, nil); err != nil {
 			t.Fatalf("CreatePR: %v", err)
 		}
 		got, err := svc.GetPR(ctx, "pr-get")
@@ -622,8 +623,8 @@ func TestPRService_Integration(t *testing.T) {
 		if err := AddTeamMember(ctx, pgC.Pool, teamID, "u2"); err != nil {
 			t.Fatalf("member: %v", err)
 		}
-		prA, _ := svc.CreatePR(ctx, "pr-A", "u1", "A")
-		prB, _ := svc.CreatePR(ctx, "pr-B", "u1", "B")
+		prA, _ := svc.CreatePR(ctx, "pr-A", "u1", "A", nil, nil)
+		prB, _ := svc.CreatePR(ctx, "pr-B", "u1", "B", nil, nil)
 		// u2 may already be assigned by CreatePR, check and add only if not present
 		for _, pr := range []string{prA.ID, prB.ID} {
 			reviewers, err := GetPRReviewers(ctx, pgC.Pool, pr)
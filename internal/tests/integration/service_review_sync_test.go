@@ -0,0 +1,102 @@
+package integration
+
+import (
+	"avito-test-pr-service/internal/application/pr"
+	vcsapp "avito-test-pr-service/internal/application/vcs"
+	"avito-test-pr-service/internal/domain/models"
+	vcs_port "avito-test-pr-service/internal/domain/ports/output/vcs"
+	"avito-test-pr-service/internal/infrastructure/feedbroker"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	"avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"avito-test-pr-service/internal/infrastructure/vcs/github"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type reviewCall struct {
+	Method    string
+	Path      string
+	Reviewers []string
+}
+
+// TestReviewSync_GitHubIntegration: PR из вебхука получает ревьюверов, и их логины уходят в фейковый API GitHub.
+func TestReviewSync_GitHubIntegration(t *testing.T) {
+	if pgC == nil {
+		t.Fatal("postgres not init")
+	}
+	if err := TruncateAll(testCtx, pgC.Pool); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+	insertUserHTTP(t, "u1", "alice", true)
+	insertUserHTTP(t, "u2", "bob", true)
+	teamID := insertTeamHTTP(t, "core")
+	addMemberHTTP(t, teamID, "u1")
+	addMemberHTTP(t, teamID, "u2")
+
+	calls := make(chan reviewCall, 4)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Reviewers []string `json:"reviewers"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		calls <- reviewCall{r.Method, r.URL.Path, body.Reviewers}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer api.Close()
+
+	log := logger.New("test")
+	u := uow.NewPostgresUOW(pgC.Pool, log)
+	requesters := map[models.VCSProvider]vcs_port.ReviewRequester{
+		models.VCSProviderGitHub: github.NewReviewRequester(api.URL, "tkn", api.Client()),
+	}
+	sync := vcsapp.NewReviewSync(u, requesters, vcsapp.ReviewSyncOptions{Backoff: 10 * time.Millisecond}, log)
+	defer sync.Close()
+	prSvc := pr.NewService(u, newSelectorRegistry(), feedbroker.Fanout{feedBroker, sync}, log)
+	vcsSvc := vcsapp.NewService(u, prSvc, log)
+
+	if _, err := vcsSvc.SetIdentities(testCtx, []*models.VCSIdentity{
+		{Provider: models.VCSProviderGitHub, Login: "Octo-Alice", UserID: "u1"},
+		{Provider: models.VCSProviderGitHub, Login: "bob-reviews", UserID: "u2"},
+	}); err != nil {
+		t.Fatalf("set identities: %v", err)
+	}
+	body, err := os.ReadFile(filepath.Join("..", "..", "infrastructure", "vcs", "github", "testdata", "pull_request_opened.json"))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	event, err := github.ParsePullRequestEvent(body)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	res, err := vcsSvc.HandlePullRequestEvent(testCtx, event)
+	if err != nil || res.Outcome != models.VCSOutcomeCreated {
+		t.Fatalf("handle: %+v %v", res, err)
+	}
+
+	select {
+	case c := <-calls:
+		want := reviewCall{http.MethodPost, "/repos/acme/pr-service/pulls/42/requested_reviewers", []string{"bob-reviews"}}
+		if c.Method != want.Method || c.Path != want.Path || len(c.Reviewers) != 1 || c.Reviewers[0] != want.Reviewers[0] {
+			t.Fatalf("want %+v, got %+v", want, c)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("reviewers were not requested in GitHub")
+	}
+
+	if _, err := prSvc.RemoveReviewer(testCtx, event.PRID, "u2", nil); err != nil {
+		t.Fatalf("remove reviewer: %v", err)
+	}
+	select {
+	case c := <-calls:
+		if c.Method != http.MethodDelete || len(c.Reviewers) != 1 || c.Reviewers[0] != "bob-reviews" {
+			t.Fatalf("unexpected call %+v", c)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("review request was not removed in GitHub")
+	}
+}
@@ -0,0 +1,86 @@
+package integration
+
+import (
+	prapp "avito-test-pr-service/internal/application/pr"
+	"avito-test-pr-service/internal/domain/services"
+	"avito-test-pr-service/internal/infrastructure/logger"
+	pguow "avito-test-pr-service/internal/infrastructure/persistence/postgres/uow"
+	"avito-test-pr-service/internal/infrastructure/reviewerselector"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestRoundRobinSelector_Integration(t *testing.T) {
+	ctx := testCtx
+	log := logger.New("test")
+	svc := prapp.NewService(pguow.NewPostgresUOW(pgC.Pool, log), services.SingleSelector(reviewerselector.NewRoundRobinSelector()), feedBroker, log)
+
+	seed := func(t *testing.T) {
+		if err := TruncateAll(ctx, pgC.Pool); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		teamID, err := InsertTeam(ctx, pgC.Pool, "core")
+		if err != nil {
+			t.Fatalf("team: %v", err)
+		}
+		for _, u := range []string{"u1", "u2", "u3", "u4", "u5"} {
+			if err := InsertUser(ctx, pgC.Pool, u, "name-"+u, u != "u4"); err != nil {
+				t.Fatalf("insert %s: %v", u, err)
+			}
+			if err := AddTeamMember(ctx, pgC.Pool, teamID, u); err != nil {
+				t.Fatalf("add member %s: %v", u, err)
+			}
+		}
+	}
+
+	t.Run("sequential PRs rotate skipping author and inactive", func(t *testing.T) {
+		seed(t)
+		want := [][]string{{"u2", "u3"}, {"u5", "u2"}, {"u3", "u5"}}
+		for i, exp := range want {
+			pr, err := svc.CreatePR(ctx, fmt.Sprintf("pr-%d", i), "u1", "title", nil, nil)
+			if err != nil {
+				t.Fatalf("create %d: %v", i, err)
+			}
+			if len(pr.ReviewerIDs) != 2 || pr.ReviewerIDs[0] != exp[0] || pr.ReviewerIDs[1] != exp[1] {
+				t.Fatalf("pr %d: want %v got %v", i, exp, pr.ReviewerIDs)
+			}
+		}
+	})
+
+	t.Run("concurrent PRs share the load evenly", func(t *testing.T) {
+		seed(t)
+		const n = 6
+		var wg sync.WaitGroup
+		errs := make(chan error, n)
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if _, err := svc.CreatePR(ctx, fmt.Sprintf("pr-c%d", i), "u1", "title", nil, nil); err != nil {
+					errs <- err
+				}
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			t.Fatalf("create: %v", err)
+		}
+		rows, err := pgC.Pool.Query(ctx, `SELECT reviewer_id, COUNT(*) FROM pr_reviewers GROUP BY reviewer_id`)
+		if err != nil {
+			t.Fatalf("query: %v", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id string
+			var c int
+			if err := rows.Scan(&id, &c); err != nil {
+				t.Fatalf("scan: %v", err)
+			}
+			if c != n*2/3 {
+				t.Fatalf("reviewer %s got %d reviews, want %d", id, c, n*2/3)
+			}
+		}
+	})
+}
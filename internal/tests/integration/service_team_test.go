@@ -1,6 +1,7 @@
 package integration
 
 import (
+	prapp "avito-test-pr-service/internal/application/pr"
 	teamapp "avito-test-pr-service/internal/application/team"
 	"avito-test-pr-service/internal/domain/models"
 	"avito-test-pr-service/internal/infrastructure/logger"
@@ -15,7 +16,8 @@ import (
 func newTeamService() *teamapp.Service {
 	log := logger.New("test")
 	u := pguow.NewPostgresUOW(pgC.Pool, log)
-	svc := teamapp.NewService(u, log)
+	selectors := newSelectorRegistry()
+	svc := teamapp.NewService(u, selectors, prapp.NewReviewReleaser(selectors, feedBroker, log), log)
 	return svc.(*teamapp.Service)
 }
 
@@ -1,30 +1,102 @@
 package utils
 
-import "errors"
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
 
 var (
-	ErrUserNotFound            = errors.New("user not found")
-	ErrUserExists              = errors.New("user already exists")
-	ErrTeamNotFound            = errors.New("team not found")
-	ErrTeamExists              = errors.New("team already exists")
-	ErrUserNoTeam              = errors.New("user has no team")
-	ErrNotFound                = errors.New("not found")
-	ErrAlreadyExists           = errors.New("already exists")
-	ErrInvalidArgument         = errors.New("invalid argument")
+	ErrUserNotFound            = newDomainError("user not found", http.StatusNotFound, "NOT_FOUND", codes.NotFound)
+	ErrUserExists              = newDomainError("user already exists", http.StatusConflict, "CONFLICT", codes.AlreadyExists)
+	ErrTeamNotFound            = newDomainError("team not found", http.StatusNotFound, "NOT_FOUND", codes.NotFound)
+	ErrTeamExists              = newDomainError("team already exists", http.StatusConflict, "TEAM_EXISTS", codes.AlreadyExists)
+	ErrUserNoTeam              = newDomainError("user has no team", http.StatusNotFound, "NOT_FOUND", codes.NotFound)
+	ErrNotFound                = newDomainError("not found", http.StatusNotFound, "NOT_FOUND", codes.NotFound)
+	ErrAlreadyExists           = newDomainError("already exists", http.StatusConflict, "CONFLICT", codes.AlreadyExists)
+	ErrInvalidArgument         = newDomainError("invalid argument", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
 	ErrInternal                = errors.New("internal error")
-	ErrInvalidJSON             = errors.New("invalid json body")
-	ErrValidationFailed        = errors.New("validation failed")
-	ErrInvalidUserID           = errors.New("invalid user_id")
-	ErrInvalidAuthorID         = errors.New("invalid author_id")
-	ErrInvalidOldUserID        = errors.New("invalid old_user_id")
-	ErrInvalidPRIDFormat       = errors.New("invalid pull_request_id format: allowed [A-Za-z0-9._-], length 1..64")
-	ErrPRIDRequired            = errors.New("pull_request_id is required")
-	ErrAlreadyMerged           = errors.New("pr already merged")
-	ErrPRExists                = errors.New("pr already exists")
-	ErrPRNotFound              = errors.New("pr not found")
-	ErrTooManyReviewers        = errors.New("too many reviewers")
-	ErrReviewerAlreadyAssigned = errors.New("reviewer already assigned")
-	ErrReviewerNotAssigned     = errors.New("reviewer not assigned")
-	ErrInvalidStatus           = errors.New("invalid status")
-	ErrNoReplacementCandidates = errors.New("no replacement candidates")
+	ErrInvalidJSON             = newDomainError("invalid json body", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrValidationFailed        = newDomainError("validation failed", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrInvalidUserID           = newDomainError("invalid user_id", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrInvalidAuthorID         = newDomainError("invalid author_id", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrInvalidOldUserID        = newDomainError("invalid old_user_id", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrInvalidPRIDFormat       = newDomainError("invalid pull_request_id format: allowed [A-Za-z0-9._-], length 1..64", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrPRIDRequired            = newDomainError("pull_request_id is required", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrAlreadyMerged           = newDomainError("pr already merged", http.StatusConflict, "PR_MERGED", codes.FailedPrecondition)
+	ErrPRClosed                = newDomainError("pr closed", http.StatusConflict, "PR_CLOSED", codes.FailedPrecondition)
+	ErrPRExists                = newDomainError("pr already exists", http.StatusConflict, "PR_EXISTS", codes.AlreadyExists)
+	ErrPRNotFound              = newDomainError("pr not found", http.StatusNotFound, "NOT_FOUND", codes.NotFound)
+	ErrTooManyReviewers        = newDomainError("too many reviewers", http.StatusConflict, "TOO_MANY_REVIEWERS", codes.FailedPrecondition)
+	ErrReviewerAlreadyAssigned = newDomainError("reviewer already assigned", http.StatusConflict, "ALREADY_ASSIGNED", codes.FailedPrecondition)
+	ErrReviewerNotAssigned     = newDomainError("reviewer not assigned", http.StatusConflict, "NOT_ASSIGNED", codes.FailedPrecondition)
+	ErrInvalidStatus           = newDomainError("invalid status", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrNoReplacementCandidates = newDomainError("no replacement candidates", http.StatusConflict, "NO_CANDIDATE", codes.FailedPrecondition)
+	ErrInvalidReason           = newDomainError("invalid reason", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrReviewerNotEligible     = newDomainError("reviewer is not an active member of the author's team", http.StatusConflict, "NOT_ELIGIBLE", codes.FailedPrecondition)
+	ErrInvalidCodeOwners       = newDomainError("invalid code owners rules", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrUnknownStrategy         = newDomainError("unknown selection strategy", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrInvalidStrategyParams   = newDomainError("invalid selection strategy params", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrInvalidTag              = newDomainError("invalid tag: allowed [a-z0-9+#._-], length 1..32", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrInvalidConstraint       = newDomainError("invalid reviewer constraint", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrConstraintsUnsatisfied  = newDomainError("reviewer constraints cannot be satisfied", http.StatusConflict, "CONSTRAINT_UNSATISFIED", codes.FailedPrecondition)
+	ErrBatchTooLarge           = newDomainError("batch too large", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrVCSIdentityNotFound     = newDomainError("vcs login is not mapped to a user", http.StatusNotFound, "NOT_FOUND", codes.NotFound)
+	ErrInvalidProvider         = newDomainError("invalid vcs provider: allowed github, gitlab", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrVCSLinkNotFound         = newDomainError("pull request is not linked to a vcs", http.StatusNotFound, "NOT_FOUND", codes.NotFound)
+	ErrVCSRejected             = newDomainError("vcs rejected the request", http.StatusConflict, "CONFLICT", codes.FailedPrecondition)
+	ErrInvalidNotificationKind = newDomainError("invalid notification kind: allowed assigned, reassigned_away, merged, sla_breached", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrInvalidDelivery         = newDomainError("invalid delivery: allowed immediate, digest, off", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrInvalidChatHandle       = newDomainError("invalid chat_handle: allowed [A-Za-z0-9._-], length 1..64", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrInvalidEmail            = newDomainError("invalid email address", http.StatusBadRequest, "BAD_REQUEST", codes.InvalidArgument)
+	ErrPreconditionFailed      = newDomainError("resource version does not match If-Match", http.StatusPreconditionFailed, "PRECONDITION_FAILED", codes.FailedPrecondition)
 )
+
+// ErrorMapping — представление ошибки в HTTP API и gRPC.
+type ErrorMapping struct {
+	HTTPStatus int
+	// Code — код ошибки HTTP API; в gRPC он же передаётся как reason в ErrorInfo.
+	Code     string
+	GRPCCode codes.Code
+}
+
+// internalError — представление ошибок, которых нет в errorTable.
+var internalError = ErrorMapping{http.StatusInternalServerError, "INTERNAL", codes.Internal}
+
+// errorTable — ошибки предметной области и их представление в API; заполняется newDomainError при объявлении
+// ошибки. Всё остальное (ошибки БД, контекста, ErrInternal) считается внутренней ошибкой.
+var errorTable []domainError
+
+type domainError struct {
+	err error
+	ErrorMapping
+}
+
+// newDomainError создаёт ошибку предметной области и записывает её представление в errorTable.
+func newDomainError(msg string, httpStatus int, code string, grpcCode codes.Code) error {
+	err := errors.New(msg)
+	errorTable = append(errorTable, domainError{err, ErrorMapping{httpStatus, code, grpcCode}})
+	return err
+}
+
+func lookupError(err error) (ErrorMapping, bool) {
+	for _, e := range errorTable {
+		if errors.Is(err, e.err) {
+			return e.ErrorMapping, true
+		}
+	}
+	return internalError, false
+}
+
+// MapError возвращает представление err (в том числе обёрнутой) в API; для неизвестных ошибок — INTERNAL.
+func MapError(err error) ErrorMapping {
+	m, _ := lookupError(err)
+	return m
+}
+
+// IsDomainError сообщает, является ли err ошибкой предметной области (в том числе обёрнутой).
+func IsDomainError(err error) bool {
+	_, ok := lookupError(err)
+	return ok
+}
@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorTableCoversAllErrors(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "custom_errors.go", nil, 0)
+	require.NoError(t, err)
+	declared := 0
+	for name, obj := range file.Scope.Objects {
+		if obj.Kind == ast.Var && strings.HasPrefix(name, "Err") {
+			declared++
+		}
+	}
+	// все ошибки файла, кроме ErrInternal, — ошибки предметной области
+	require.Len(t, errorTable, declared-1, "new error must be declared with newDomainError")
+	seen := make(map[error]struct{}, len(errorTable))
+	for _, e := range errorTable {
+		require.NotEqual(t, ErrInternal, e.err)
+		require.NotContains(t, seen, e.err)
+		seen[e.err] = struct{}{}
+		require.Equal(t, e.Code, HTTPCodeConverter(e.HTTPStatus, e.err))
+	}
+	require.False(t, IsDomainError(ErrInternal))
+	require.True(t, IsDomainError(fmt.Errorf("team %q: %w", "core", ErrInvalidTag)))
+}
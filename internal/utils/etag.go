@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// IfMatch — условие заголовка If-Match: «*» или список версий. nil означает, что условия нет.
+type IfMatch struct {
+	any      bool
+	versions []int64
+}
+
+// ETag возвращает сильный ETag для версии ресурса.
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ParseIfMatch разбирает заголовок If-Match; для пустого заголовка возвращает nil.
+// Слабые и нечисловые ETag не совпадают ни с какой версией.
+func ParseIfMatch(header string) *IfMatch {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return nil
+	}
+	cond := &IfMatch{}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			cond.any = true
+			continue
+		}
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+		if v, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64); err == nil {
+			cond.versions = append(cond.versions, v)
+		}
+	}
+	return cond
+}
+
+// Check сверяет версию ресурса с условием. Без условия и при «*» подходит любая версия,
+// иначе возвращается ErrPreconditionFailed.
+func (m *IfMatch) Check(version int64) error {
+	if m == nil || m.any {
+		return nil
+	}
+	for _, v := range m.versions {
+		if v == version {
+			return nil
+		}
+	}
+	return ErrPreconditionFailed
+}
@@ -2,7 +2,6 @@ package utils
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 )
 
@@ -15,20 +14,12 @@ type ErrorResponse struct {
 	Error ErrorDetails `json:"error"`
 }
 
+// HTTPCodeConverter возвращает код ошибки HTTP API для ответа со статусом status: код первой из errs по errorTable,
+// если там у неё тот же статус, иначе общий код статуса.
 func HTTPCodeConverter(status int, errs ...error) string {
-	if status == http.StatusConflict && len(errs) > 0 && errs[0] != nil {
-		err := errs[0]
-		switch {
-		case errors.Is(err, ErrAlreadyMerged):
-			return "PR_MERGED"
-		case errors.Is(err, ErrReviewerNotAssigned):
-			return "NOT_ASSIGNED"
-		case errors.Is(err, ErrNoReplacementCandidates):
-			return "NO_CANDIDATE"
-		case errors.Is(err, ErrPRExists):
-			return "PR_EXISTS"
-		case errors.Is(err, ErrTeamExists):
-			return "TEAM_EXISTS"
+	if len(errs) > 0 && errs[0] != nil {
+		if m, ok := lookupError(errs[0]); ok && m.HTTPStatus == status {
+			return m.Code
 		}
 	}
 	switch status {
@@ -40,6 +31,8 @@ func HTTPCodeConverter(status int, errs ...error) string {
 		return "BAD_REQUEST"
 	case http.StatusUnauthorized:
 		return "UNAUTHORIZED"
+	case http.StatusPreconditionFailed:
+		return "PRECONDITION_FAILED"
 	default:
 		return "INTERNAL"
 	}
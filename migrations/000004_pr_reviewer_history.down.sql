@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pr_reviewer_history_reviewer_id;
+DROP INDEX IF EXISTS idx_pr_reviewer_history_pr_id_id;
+DROP TABLE IF EXISTS pr_reviewer_history;
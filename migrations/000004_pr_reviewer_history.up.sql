@@ -0,0 +1,17 @@
+CREATE TABLE pr_reviewer_history (
+   id BIGSERIAL PRIMARY KEY,
+   pr_id TEXT NOT NULL REFERENCES prs(id) ON DELETE CASCADE,
+   reviewer_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   event TEXT NOT NULL CHECK (event IN ('ASSIGNED', 'UNASSIGNED')),
+   reason TEXT NOT NULL,
+   actor TEXT NOT NULL DEFAULT '',
+   created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_pr_reviewer_history_pr_id_id ON pr_reviewer_history(pr_id, id);
+CREATE INDEX IF NOT EXISTS idx_pr_reviewer_history_reviewer_id ON pr_reviewer_history(reviewer_id);
+
+INSERT INTO pr_reviewer_history (pr_id, reviewer_id, event, reason, created_at)
+SELECT pr_id, reviewer_id, 'ASSIGNED', 'initial', assigned_at
+FROM pr_reviewers
+ORDER BY assigned_at;
@@ -0,0 +1,3 @@
+ALTER TABLE prs DROP COLUMN IF EXISTS changed_files;
+
+DROP TABLE IF EXISTS team_code_owners;
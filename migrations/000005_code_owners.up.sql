@@ -0,0 +1,9 @@
+CREATE TABLE team_code_owners (
+   team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+   position INT NOT NULL,
+   pattern TEXT NOT NULL,
+   owner_ids TEXT[] NOT NULL,
+   PRIMARY KEY (team_id, position)
+);
+
+ALTER TABLE prs ADD COLUMN changed_files TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,4 @@
+ALTER TABLE prs DROP COLUMN IF EXISTS labels;
+
+DROP INDEX IF EXISTS idx_user_tags_tag;
+DROP TABLE IF EXISTS user_tags;
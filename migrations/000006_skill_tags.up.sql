@@ -0,0 +1,9 @@
+CREATE TABLE user_tags (
+   user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   tag TEXT NOT NULL,
+   PRIMARY KEY (user_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_tags_tag ON user_tags(tag);
+
+ALTER TABLE prs ADD COLUMN labels TEXT[] NOT NULL DEFAULT '{}';
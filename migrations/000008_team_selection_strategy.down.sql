@@ -0,0 +1,2 @@
+ALTER TABLE teams DROP COLUMN IF EXISTS selection_params;
+ALTER TABLE teams DROP COLUMN IF EXISTS selection_strategy;
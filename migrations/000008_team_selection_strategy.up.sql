@@ -0,0 +1,2 @@
+ALTER TABLE teams ADD COLUMN selection_strategy TEXT NOT NULL DEFAULT '';
+ALTER TABLE teams ADD COLUMN selection_params JSONB NOT NULL DEFAULT '{}';
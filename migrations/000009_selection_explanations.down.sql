@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_pr_selection_explanations_pr_id_id;
+DROP TABLE IF EXISTS pr_selection_explanations;
@@ -0,0 +1,9 @@
+CREATE TABLE pr_selection_explanations (
+   id BIGSERIAL PRIMARY KEY,
+   pr_id TEXT NOT NULL REFERENCES prs(id) ON DELETE CASCADE,
+   reason TEXT NOT NULL,
+   explanation JSONB NOT NULL,
+   created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_pr_selection_explanations_pr_id_id ON pr_selection_explanations(pr_id, id);
@@ -0,0 +1,8 @@
+CREATE TABLE team_reviewer_constraints (
+   team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+   position INT NOT NULL,
+   kind TEXT NOT NULL,
+   author_ids TEXT[] NOT NULL DEFAULT '{}',
+   reviewer_ids TEXT[] NOT NULL,
+   PRIMARY KEY (team_id, position)
+);
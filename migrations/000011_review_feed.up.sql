@@ -0,0 +1,11 @@
+CREATE TABLE review_feed_events (
+   id BIGSERIAL PRIMARY KEY,
+   user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   pr_id TEXT NOT NULL REFERENCES prs(id) ON DELETE CASCADE,
+   event TEXT NOT NULL CHECK (event IN ('assigned', 'unassigned', 'merged')),
+   reason TEXT NOT NULL DEFAULT '',
+   actor TEXT NOT NULL DEFAULT '',
+   created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_feed_events_user_id_id ON review_feed_events(user_id, id);
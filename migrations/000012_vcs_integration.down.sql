@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS vcs_identities;
+
+UPDATE prs SET status = 'OPEN' WHERE status = 'CLOSED';
+ALTER TABLE prs DROP CONSTRAINT IF EXISTS prs_status_check;
+ALTER TABLE prs ADD CONSTRAINT prs_status_check CHECK (status IN ('OPEN', 'MERGED'));
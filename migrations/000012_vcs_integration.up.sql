@@ -0,0 +1,12 @@
+ALTER TABLE prs DROP CONSTRAINT IF EXISTS prs_status_check;
+ALTER TABLE prs ADD CONSTRAINT prs_status_check CHECK (status IN ('OPEN', 'MERGED', 'CLOSED'));
+
+CREATE TABLE vcs_identities (
+   provider TEXT NOT NULL CHECK (provider IN ('github', 'gitlab')),
+   login TEXT NOT NULL,
+   user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+   PRIMARY KEY (provider, login)
+);
+
+CREATE INDEX IF NOT EXISTS idx_vcs_identities_user_id ON vcs_identities(user_id);
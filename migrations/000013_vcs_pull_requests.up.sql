@@ -0,0 +1,9 @@
+-- Связь PR сервиса с PR/MR в VCS. Ссылка на prs не ставится: связь сохраняется до создания PR,
+-- чтобы синхронизация ревьюверов видела её уже для назначений при создании.
+CREATE TABLE vcs_pull_requests (
+   pr_id TEXT PRIMARY KEY,
+   provider TEXT NOT NULL CHECK (provider IN ('github', 'gitlab')),
+   repository TEXT NOT NULL,
+   number INT NOT NULL CHECK (number > 0),
+   updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS notification_digest;
+DROP TABLE IF EXISTS notification_preferences;
@@ -0,0 +1,13 @@
+CREATE TABLE notification_preferences (
+   user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+   chat_handle TEXT NOT NULL DEFAULT '',
+   delivery TEXT NOT NULL DEFAULT 'immediate' CHECK (delivery IN ('immediate', 'digest', 'off')),
+   kinds TEXT[] NOT NULL DEFAULT '{}',
+   updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE notification_digest (
+   user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   event_id BIGINT NOT NULL REFERENCES review_feed_events(id) ON DELETE CASCADE,
+   PRIMARY KEY (user_id, event_id)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS email_delivery;
+ALTER TABLE users DROP COLUMN IF EXISTS email;
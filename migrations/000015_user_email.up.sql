@@ -0,0 +1,4 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS email_delivery TEXT NOT NULL DEFAULT 'immediate'
+   CHECK (email_delivery IN ('immediate', 'digest', 'off'));
@@ -0,0 +1,4 @@
+CREATE TABLE notification_digest_runs (
+   day DATE PRIMARY KEY,
+   sent_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
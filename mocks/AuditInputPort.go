@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "avito-test-pr-service/internal/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditInputPort is an autogenerated mock type for the AuditInputPort type
+type AuditInputPort struct {
+	mock.Mock
+}
+
+type AuditInputPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditInputPort) EXPECT() *AuditInputPort_Expecter {
+	return &AuditInputPort_Expecter{mock: &_m.Mock}
+}
+
+// ListEntries provides a mock function with given fields: ctx, filter
+func (_m *AuditInputPort) ListEntries(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntries")
+	}
+
+	var r0 []*models.AuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditFilter) ([]*models.AuditEntry, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditFilter) []*models.AuditEntry); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.AuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.AuditFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditInputPort_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
+type AuditInputPort_ListEntries_Call struct {
+	*mock.Call
+}
+
+// ListEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.AuditFilter
+func (_e *AuditInputPort_Expecter) ListEntries(ctx interface{}, filter interface{}) *AuditInputPort_ListEntries_Call {
+	return &AuditInputPort_ListEntries_Call{Call: _e.mock.On("ListEntries", ctx, filter)}
+}
+
+func (_c *AuditInputPort_ListEntries_Call) Run(run func(ctx context.Context, filter models.AuditFilter)) *AuditInputPort_ListEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.AuditFilter))
+	})
+	return _c
+}
+
+func (_c *AuditInputPort_ListEntries_Call) Return(_a0 []*models.AuditEntry, _a1 error) *AuditInputPort_ListEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditInputPort_ListEntries_Call) RunAndReturn(run func(context.Context, models.AuditFilter) ([]*models.AuditEntry, error)) *AuditInputPort_ListEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditInputPort creates a new instance of AuditInputPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditInputPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditInputPort {
+	mock := &AuditInputPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
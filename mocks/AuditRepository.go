@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "avito-test-pr-service/internal/domain/models"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditRepository is an autogenerated mock type for the AuditRepository type
+type AuditRepository struct {
+	mock.Mock
+}
+
+type AuditRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditRepository) EXPECT() *AuditRepository_Expecter {
+	return &AuditRepository_Expecter{mock: &_m.Mock}
+}
+
+// Append provides a mock function with given fields: ctx, entry
+func (_m *AuditRepository) Append(ctx context.Context, entry *models.AuditEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Append")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.AuditEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditRepository_Append_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Append'
+type AuditRepository_Append_Call struct {
+	*mock.Call
+}
+
+// Append is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *models.AuditEntry
+func (_e *AuditRepository_Expecter) Append(ctx interface{}, entry interface{}) *AuditRepository_Append_Call {
+	return &AuditRepository_Append_Call{Call: _e.mock.On("Append", ctx, entry)}
+}
+
+func (_c *AuditRepository_Append_Call) Run(run func(ctx context.Context, entry *models.AuditEntry)) *AuditRepository_Append_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.AuditEntry))
+	})
+	return _c
+}
+
+func (_c *AuditRepository_Append_Call) Return(_a0 error) *AuditRepository_Append_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuditRepository_Append_Call) RunAndReturn(run func(context.Context, *models.AuditEntry) error) *AuditRepository_Append_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, filter
+func (_m *AuditRepository) List(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*models.AuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditFilter) ([]*models.AuditEntry, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditFilter) []*models.AuditEntry); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.AuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.AuditFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type AuditRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.AuditFilter
+func (_e *AuditRepository_Expecter) List(ctx interface{}, filter interface{}) *AuditRepository_List_Call {
+	return &AuditRepository_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *AuditRepository_List_Call) Run(run func(ctx context.Context, filter models.AuditFilter)) *AuditRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.AuditFilter))
+	})
+	return _c
+}
+
+func (_c *AuditRepository_List_Call) Return(_a0 []*models.AuditEntry, _a1 error) *AuditRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditRepository_List_Call) RunAndReturn(run func(context.Context, models.AuditFilter) ([]*models.AuditEntry, error)) *AuditRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditRepository creates a new instance of AuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditRepository {
+	mock := &AuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
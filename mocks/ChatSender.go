@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "avito-test-pr-service/internal/domain/models"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChatSender is an autogenerated mock type for the ChatSender type
+type ChatSender struct {
+	mock.Mock
+}
+
+type ChatSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ChatSender) EXPECT() *ChatSender_Expecter {
+	return &ChatSender_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, msg
+func (_m *ChatSender) Send(ctx context.Context, msg *models.ChatMessage) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ChatMessage) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChatSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type ChatSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *models.ChatMessage
+func (_e *ChatSender_Expecter) Send(ctx interface{}, msg interface{}) *ChatSender_Send_Call {
+	return &ChatSender_Send_Call{Call: _e.mock.On("Send", ctx, msg)}
+}
+
+func (_c *ChatSender_Send_Call) Run(run func(ctx context.Context, msg *models.ChatMessage)) *ChatSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ChatMessage))
+	})
+	return _c
+}
+
+func (_c *ChatSender_Send_Call) Return(_a0 error) *ChatSender_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChatSender_Send_Call) RunAndReturn(run func(context.Context, *models.ChatMessage) error) *ChatSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewChatSender creates a new instance of ChatSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChatSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChatSender {
+	mock := &ChatSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,129 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "avito-test-pr-service/internal/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeedBroker is an autogenerated mock type for the Broker type
+type FeedBroker struct {
+	mock.Mock
+}
+
+type FeedBroker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FeedBroker) EXPECT() *FeedBroker_Expecter {
+	return &FeedBroker_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: events
+func (_m *FeedBroker) Publish(events []*models.ReviewFeedEvent) {
+	_m.Called(events)
+}
+
+// FeedBroker_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type FeedBroker_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - events []*models.ReviewFeedEvent
+func (_e *FeedBroker_Expecter) Publish(events interface{}) *FeedBroker_Publish_Call {
+	return &FeedBroker_Publish_Call{Call: _e.mock.On("Publish", events)}
+}
+
+func (_c *FeedBroker_Publish_Call) Run(run func(events []*models.ReviewFeedEvent)) *FeedBroker_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*models.ReviewFeedEvent))
+	})
+	return _c
+}
+
+func (_c *FeedBroker_Publish_Call) Return() *FeedBroker_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FeedBroker_Publish_Call) RunAndReturn(run func([]*models.ReviewFeedEvent)) *FeedBroker_Publish_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: userID
+func (_m *FeedBroker) Subscribe(userID string) (<-chan *models.ReviewFeedEvent, func()) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan *models.ReviewFeedEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(string) (<-chan *models.ReviewFeedEvent, func())); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) <-chan *models.ReviewFeedEvent); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *models.ReviewFeedEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) func()); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// FeedBroker_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type FeedBroker_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - userID string
+func (_e *FeedBroker_Expecter) Subscribe(userID interface{}) *FeedBroker_Subscribe_Call {
+	return &FeedBroker_Subscribe_Call{Call: _e.mock.On("Subscribe", userID)}
+}
+
+func (_c *FeedBroker_Subscribe_Call) Run(run func(userID string)) *FeedBroker_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *FeedBroker_Subscribe_Call) Return(_a0 <-chan *models.ReviewFeedEvent, _a1 func()) *FeedBroker_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeedBroker_Subscribe_Call) RunAndReturn(run func(string) (<-chan *models.ReviewFeedEvent, func())) *FeedBroker_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFeedBroker creates a new instance of FeedBroker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeedBroker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FeedBroker {
+	mock := &FeedBroker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,69 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "avito-test-pr-service/internal/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeedPublisher is an autogenerated mock type for the Publisher type
+type FeedPublisher struct {
+	mock.Mock
+}
+
+type FeedPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FeedPublisher) EXPECT() *FeedPublisher_Expecter {
+	return &FeedPublisher_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: events
+func (_m *FeedPublisher) Publish(events []*models.ReviewFeedEvent) {
+	_m.Called(events)
+}
+
+// FeedPublisher_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type FeedPublisher_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - events []*models.ReviewFeedEvent
+func (_e *FeedPublisher_Expecter) Publish(events interface{}) *FeedPublisher_Publish_Call {
+	return &FeedPublisher_Publish_Call{Call: _e.mock.On("Publish", events)}
+}
+
+func (_c *FeedPublisher_Publish_Call) Run(run func(events []*models.ReviewFeedEvent)) *FeedPublisher_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*models.ReviewFeedEvent))
+	})
+	return _c
+}
+
+func (_c *FeedPublisher_Publish_Call) Return() *FeedPublisher_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FeedPublisher_Publish_Call) RunAndReturn(run func([]*models.ReviewFeedEvent)) *FeedPublisher_Publish_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewFeedPublisher creates a new instance of FeedPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeedPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FeedPublisher {
+	mock := &FeedPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "avito-test-pr-service/internal/domain/models"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MailSender is an autogenerated mock type for the MailSender type
+type MailSender struct {
+	mock.Mock
+}
+
+type MailSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MailSender) EXPECT() *MailSender_Expecter {
+	return &MailSender_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, msg
+func (_m *MailSender) Send(ctx context.Context, msg *models.EmailMessage) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.EmailMessage) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MailSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MailSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *models.EmailMessage
+func (_e *MailSender_Expecter) Send(ctx interface{}, msg interface{}) *MailSender_Send_Call {
+	return &MailSender_Send_Call{Call: _e.mock.On("Send", ctx, msg)}
+}
+
+func (_c *MailSender_Send_Call) Run(run func(ctx context.Context, msg *models.EmailMessage)) *MailSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.EmailMessage))
+	})
+	return _c
+}
+
+func (_c *MailSender_Send_Call) Return(_a0 error) *MailSender_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MailSender_Send_Call) RunAndReturn(run func(context.Context, *models.EmailMessage) error) *MailSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMailSender creates a new instance of MailSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMailSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MailSender {
+	mock := &MailSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
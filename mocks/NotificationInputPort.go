@@ -0,0 +1,156 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "avito-test-pr-service/internal/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationInputPort is an autogenerated mock type for the NotificationInputPort type
+type NotificationInputPort struct {
+	mock.Mock
+}
+
+type NotificationInputPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotificationInputPort) EXPECT() *NotificationInputPort_Expecter {
+	return &NotificationInputPort_Expecter{mock: &_m.Mock}
+}
+
+// GetPreferences provides a mock function with given fields: ctx, userID
+func (_m *NotificationInputPort) GetPreferences(ctx context.Context, userID string) (*models.NotificationPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreferences")
+	}
+
+	var r0 *models.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.NotificationPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.NotificationPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationInputPort_GetPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreferences'
+type NotificationInputPort_GetPreferences_Call struct {
+	*mock.Call
+}
+
+// GetPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *NotificationInputPort_Expecter) GetPreferences(ctx interface{}, userID interface{}) *NotificationInputPort_GetPreferences_Call {
+	return &NotificationInputPort_GetPreferences_Call{Call: _e.mock.On("GetPreferences", ctx, userID)}
+}
+
+func (_c *NotificationInputPort_GetPreferences_Call) Run(run func(ctx context.Context, userID string)) *NotificationInputPort_GetPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationInputPort_GetPreferences_Call) Return(_a0 *models.NotificationPreferences, _a1 error) *NotificationInputPort_GetPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationInputPort_GetPreferences_Call) RunAndReturn(run func(context.Context, string) (*models.NotificationPreferences, error)) *NotificationInputPort_GetPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPreferences provides a mock function with given fields: ctx, prefs
+func (_m *NotificationInputPort) SetPreferences(ctx context.Context, prefs *models.NotificationPreferences) (*models.NotificationPreferences, error) {
+	ret := _m.Called(ctx, prefs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPreferences")
+	}
+
+	var r0 *models.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.NotificationPreferences) (*models.NotificationPreferences, error)); ok {
+		return rf(ctx, prefs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.NotificationPreferences) *models.NotificationPreferences); ok {
+		r0 = rf(ctx, prefs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.NotificationPreferences) error); ok {
+		r1 = rf(ctx, prefs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationInputPort_SetPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPreferences'
+type NotificationInputPort_SetPreferences_Call struct {
+	*mock.Call
+}
+
+// SetPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefs *models.NotificationPreferences
+func (_e *NotificationInputPort_Expecter) SetPreferences(ctx interface{}, prefs interface{}) *NotificationInputPort_SetPreferences_Call {
+	return &NotificationInputPort_SetPreferences_Call{Call: _e.mock.On("SetPreferences", ctx, prefs)}
+}
+
+func (_c *NotificationInputPort_SetPreferences_Call) Run(run func(ctx context.Context, prefs *models.NotificationPreferences)) *NotificationInputPort_SetPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.NotificationPreferences))
+	})
+	return _c
+}
+
+func (_c *NotificationInputPort_SetPreferences_Call) Return(_a0 *models.NotificationPreferences, _a1 error) *NotificationInputPort_SetPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationInputPort_SetPreferences_Call) RunAndReturn(run func(context.Context, *models.NotificationPreferences) (*models.NotificationPreferences, error)) *NotificationInputPort_SetPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotificationInputPort creates a new instance of NotificationInputPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationInputPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationInputPort {
+	mock := &NotificationInputPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,9 +5,10 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	models "avito-test-pr-service/internal/domain/models"
+	utils "avito-test-pr-service/internal/utils"
+
+	mock "github.com/stretchr/testify/mock"
 )
 
 // PRInputPort is an autogenerated mock type for the PRInputPort type
@@ -23,9 +24,188 @@ func (_m *PRInputPort) EXPECT() *PRInputPort_Expecter {
 	return &PRInputPort_Expecter{mock: &_m.Mock}
 }
 
-// CreatePR provides a mock function with given fields: ctx, prID, authorID, title
-func (_m *PRInputPort) CreatePR(ctx context.Context, prID string, authorID string, title string) (*models.PullRequest, error) {
-	ret := _m.Called(ctx, prID, authorID, title)
+// AddReviewer provides a mock function with given fields: ctx, prID, reviewerID, ifMatch
+func (_m *PRInputPort) AddReviewer(ctx context.Context, prID string, reviewerID string, ifMatch *utils.IfMatch) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, prID, reviewerID, ifMatch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReviewer")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *utils.IfMatch) (*models.PullRequest, error)); ok {
+		return rf(ctx, prID, reviewerID, ifMatch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *utils.IfMatch) *models.PullRequest); ok {
+		r0 = rf(ctx, prID, reviewerID, ifMatch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *utils.IfMatch) error); ok {
+		r1 = rf(ctx, prID, reviewerID, ifMatch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRInputPort_AddReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReviewer'
+type PRInputPort_AddReviewer_Call struct {
+	*mock.Call
+}
+
+// AddReviewer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+//   - reviewerID string
+//   - ifMatch *utils.IfMatch
+func (_e *PRInputPort_Expecter) AddReviewer(ctx interface{}, prID interface{}, reviewerID interface{}, ifMatch interface{}) *PRInputPort_AddReviewer_Call {
+	return &PRInputPort_AddReviewer_Call{Call: _e.mock.On("AddReviewer", ctx, prID, reviewerID, ifMatch)}
+}
+
+func (_c *PRInputPort_AddReviewer_Call) Run(run func(ctx context.Context, prID string, reviewerID string, ifMatch *utils.IfMatch)) *PRInputPort_AddReviewer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*utils.IfMatch))
+	})
+	return _c
+}
+
+func (_c *PRInputPort_AddReviewer_Call) Return(_a0 *models.PullRequest, _a1 error) *PRInputPort_AddReviewer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRInputPort_AddReviewer_Call) RunAndReturn(run func(context.Context, string, string, *utils.IfMatch) (*models.PullRequest, error)) *PRInputPort_AddReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchCreatePRs provides a mock function with given fields: ctx, items
+func (_m *PRInputPort) BatchCreatePRs(ctx context.Context, items []*models.BatchPRItem) ([]*models.BatchPRResult, error) {
+	ret := _m.Called(ctx, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchCreatePRs")
+	}
+
+	var r0 []*models.BatchPRResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.BatchPRItem) ([]*models.BatchPRResult, error)); ok {
+		return rf(ctx, items)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.BatchPRItem) []*models.BatchPRResult); ok {
+		r0 = rf(ctx, items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.BatchPRResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*models.BatchPRItem) error); ok {
+		r1 = rf(ctx, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRInputPort_BatchCreatePRs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCreatePRs'
+type PRInputPort_BatchCreatePRs_Call struct {
+	*mock.Call
+}
+
+// BatchCreatePRs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - items []*models.BatchPRItem
+func (_e *PRInputPort_Expecter) BatchCreatePRs(ctx interface{}, items interface{}) *PRInputPort_BatchCreatePRs_Call {
+	return &PRInputPort_BatchCreatePRs_Call{Call: _e.mock.On("BatchCreatePRs", ctx, items)}
+}
+
+func (_c *PRInputPort_BatchCreatePRs_Call) Run(run func(ctx context.Context, items []*models.BatchPRItem)) *PRInputPort_BatchCreatePRs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.BatchPRItem))
+	})
+	return _c
+}
+
+func (_c *PRInputPort_BatchCreatePRs_Call) Return(_a0 []*models.BatchPRResult, _a1 error) *PRInputPort_BatchCreatePRs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRInputPort_BatchCreatePRs_Call) RunAndReturn(run func(context.Context, []*models.BatchPRItem) ([]*models.BatchPRResult, error)) *PRInputPort_BatchCreatePRs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClosePR provides a mock function with given fields: ctx, prID
+func (_m *PRInputPort) ClosePR(ctx context.Context, prID string) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClosePR")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.PullRequest, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.PullRequest); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRInputPort_ClosePR_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClosePR'
+type PRInputPort_ClosePR_Call struct {
+	*mock.Call
+}
+
+// ClosePR is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PRInputPort_Expecter) ClosePR(ctx interface{}, prID interface{}) *PRInputPort_ClosePR_Call {
+	return &PRInputPort_ClosePR_Call{Call: _e.mock.On("ClosePR", ctx, prID)}
+}
+
+func (_c *PRInputPort_ClosePR_Call) Run(run func(ctx context.Context, prID string)) *PRInputPort_ClosePR_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PRInputPort_ClosePR_Call) Return(_a0 *models.PullRequest, _a1 error) *PRInputPort_ClosePR_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRInputPort_ClosePR_Call) RunAndReturn(run func(context.Context, string) (*models.PullRequest, error)) *PRInputPort_ClosePR_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePR provides a mock function with given fields: ctx, prID, authorID, title, changedFiles, labels
+func (_m *PRInputPort) CreatePR(ctx context.Context, prID string, authorID string, title string, changedFiles []string, labels []string) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, prID, authorID, title, changedFiles, labels)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreatePR")
@@ -33,19 +213,19 @@ func (_m *PRInputPort) CreatePR(ctx context.Context, prID string, authorID strin
 
 	var r0 *models.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.PullRequest, error)); ok {
-		return rf(ctx, prID, authorID, title)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string, []string) (*models.PullRequest, error)); ok {
+		return rf(ctx, prID, authorID, title, changedFiles, labels)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.PullRequest); ok {
-		r0 = rf(ctx, prID, authorID, title)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string, []string) *models.PullRequest); ok {
+		r0 = rf(ctx, prID, authorID, title, changedFiles, labels)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.PullRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, prID, authorID, title)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, []string, []string) error); ok {
+		r1 = rf(ctx, prID, authorID, title, changedFiles, labels)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -63,13 +243,15 @@ type PRInputPort_CreatePR_Call struct {
 //   - prID string
 //   - authorID string
 //   - title string
-func (_e *PRInputPort_Expecter) CreatePR(ctx interface{}, prID interface{}, authorID interface{}, title interface{}) *PRInputPort_CreatePR_Call {
-	return &PRInputPort_CreatePR_Call{Call: _e.mock.On("CreatePR", ctx, prID, authorID, title)}
+//   - changedFiles []string
+//   - labels []string
+func (_e *PRInputPort_Expecter) CreatePR(ctx interface{}, prID interface{}, authorID interface{}, title interface{}, changedFiles interface{}, labels interface{}) *PRInputPort_CreatePR_Call {
+	return &PRInputPort_CreatePR_Call{Call: _e.mock.On("CreatePR", ctx, prID, authorID, title, changedFiles, labels)}
 }
 
-func (_c *PRInputPort_CreatePR_Call) Run(run func(ctx context.Context, prID string, authorID string, title string)) *PRInputPort_CreatePR_Call {
+func (_c *PRInputPort_CreatePR_Call) Run(run func(ctx context.Context, prID string, authorID string, title string, changedFiles []string, labels []string)) *PRInputPort_CreatePR_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].([]string), args[5].([]string))
 	})
 	return _c
 }
@@ -79,7 +261,66 @@ func (_c *PRInputPort_CreatePR_Call) Return(_a0 *models.PullRequest, _a1 error)
 	return _c
 }
 
-func (_c *PRInputPort_CreatePR_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.PullRequest, error)) *PRInputPort_CreatePR_Call {
+func (_c *PRInputPort_CreatePR_Call) RunAndReturn(run func(context.Context, string, string, string, []string, []string) (*models.PullRequest, error)) *PRInputPort_CreatePR_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExplainSelection provides a mock function with given fields: ctx, prID
+func (_m *PRInputPort) ExplainSelection(ctx context.Context, prID string) ([]*models.SelectionExplanation, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExplainSelection")
+	}
+
+	var r0 []*models.SelectionExplanation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.SelectionExplanation, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.SelectionExplanation); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SelectionExplanation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRInputPort_ExplainSelection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExplainSelection'
+type PRInputPort_ExplainSelection_Call struct {
+	*mock.Call
+}
+
+// ExplainSelection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PRInputPort_Expecter) ExplainSelection(ctx interface{}, prID interface{}) *PRInputPort_ExplainSelection_Call {
+	return &PRInputPort_ExplainSelection_Call{Call: _e.mock.On("ExplainSelection", ctx, prID)}
+}
+
+func (_c *PRInputPort_ExplainSelection_Call) Run(run func(ctx context.Context, prID string)) *PRInputPort_ExplainSelection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PRInputPort_ExplainSelection_Call) Return(_a0 []*models.SelectionExplanation, _a1 error) *PRInputPort_ExplainSelection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRInputPort_ExplainSelection_Call) RunAndReturn(run func(context.Context, string) ([]*models.SelectionExplanation, error)) *PRInputPort_ExplainSelection_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -143,6 +384,124 @@ func (_c *PRInputPort_GetPR_Call) RunAndReturn(run func(context.Context, string)
 	return _c
 }
 
+// GetReviewerHistory provides a mock function with given fields: ctx, prID
+func (_m *PRInputPort) GetReviewerHistory(ctx context.Context, prID string) ([]*models.ReviewerHistoryEntry, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewerHistory")
+	}
+
+	var r0 []*models.ReviewerHistoryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.ReviewerHistoryEntry, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.ReviewerHistoryEntry); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ReviewerHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRInputPort_GetReviewerHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReviewerHistory'
+type PRInputPort_GetReviewerHistory_Call struct {
+	*mock.Call
+}
+
+// GetReviewerHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PRInputPort_Expecter) GetReviewerHistory(ctx interface{}, prID interface{}) *PRInputPort_GetReviewerHistory_Call {
+	return &PRInputPort_GetReviewerHistory_Call{Call: _e.mock.On("GetReviewerHistory", ctx, prID)}
+}
+
+func (_c *PRInputPort_GetReviewerHistory_Call) Run(run func(ctx context.Context, prID string)) *PRInputPort_GetReviewerHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PRInputPort_GetReviewerHistory_Call) Return(_a0 []*models.ReviewerHistoryEntry, _a1 error) *PRInputPort_GetReviewerHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRInputPort_GetReviewerHistory_Call) RunAndReturn(run func(context.Context, string) ([]*models.ReviewerHistoryEntry, error)) *PRInputPort_GetReviewerHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPRs provides a mock function with given fields: ctx, filter
+func (_m *PRInputPort) ListPRs(ctx context.Context, filter models.PRFilter) (*models.PRPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPRs")
+	}
+
+	var r0 *models.PRPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.PRFilter) (*models.PRPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.PRFilter) *models.PRPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PRPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.PRFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRInputPort_ListPRs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPRs'
+type PRInputPort_ListPRs_Call struct {
+	*mock.Call
+}
+
+// ListPRs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.PRFilter
+func (_e *PRInputPort_Expecter) ListPRs(ctx interface{}, filter interface{}) *PRInputPort_ListPRs_Call {
+	return &PRInputPort_ListPRs_Call{Call: _e.mock.On("ListPRs", ctx, filter)}
+}
+
+func (_c *PRInputPort_ListPRs_Call) Run(run func(ctx context.Context, filter models.PRFilter)) *PRInputPort_ListPRs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.PRFilter))
+	})
+	return _c
+}
+
+func (_c *PRInputPort_ListPRs_Call) Return(_a0 *models.PRPage, _a1 error) *PRInputPort_ListPRs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRInputPort_ListPRs_Call) RunAndReturn(run func(context.Context, models.PRFilter) (*models.PRPage, error)) *PRInputPort_ListPRs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListPRsByAssignee provides a mock function with given fields: ctx, reviewerID, status
 func (_m *PRInputPort) ListPRsByAssignee(ctx context.Context, reviewerID string, status *models.PRStatus) ([]*models.PullRequest, error) {
 	ret := _m.Called(ctx, reviewerID, status)
@@ -203,9 +562,9 @@ func (_c *PRInputPort_ListPRsByAssignee_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// MergePR provides a mock function with given fields: ctx, prID
-func (_m *PRInputPort) MergePR(ctx context.Context, prID string) (*models.PullRequest, error) {
-	ret := _m.Called(ctx, prID)
+// MergePR provides a mock function with given fields: ctx, prID, ifMatch
+func (_m *PRInputPort) MergePR(ctx context.Context, prID string, ifMatch *utils.IfMatch) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, prID, ifMatch)
 
 	if len(ret) == 0 {
 		panic("no return value specified for MergePR")
@@ -213,19 +572,19 @@ func (_m *PRInputPort) MergePR(ctx context.Context, prID string) (*models.PullRe
 
 	var r0 *models.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.PullRequest, error)); ok {
-		return rf(ctx, prID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *utils.IfMatch) (*models.PullRequest, error)); ok {
+		return rf(ctx, prID, ifMatch)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.PullRequest); ok {
-		r0 = rf(ctx, prID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *utils.IfMatch) *models.PullRequest); ok {
+		r0 = rf(ctx, prID, ifMatch)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.PullRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, prID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *utils.IfMatch) error); ok {
+		r1 = rf(ctx, prID, ifMatch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -241,13 +600,14 @@ type PRInputPort_MergePR_Call struct {
 // MergePR is a helper method to define mock.On call
 //   - ctx context.Context
 //   - prID string
-func (_e *PRInputPort_Expecter) MergePR(ctx interface{}, prID interface{}) *PRInputPort_MergePR_Call {
-	return &PRInputPort_MergePR_Call{Call: _e.mock.On("MergePR", ctx, prID)}
+//   - ifMatch *utils.IfMatch
+func (_e *PRInputPort_Expecter) MergePR(ctx interface{}, prID interface{}, ifMatch interface{}) *PRInputPort_MergePR_Call {
+	return &PRInputPort_MergePR_Call{Call: _e.mock.On("MergePR", ctx, prID, ifMatch)}
 }
 
-func (_c *PRInputPort_MergePR_Call) Run(run func(ctx context.Context, prID string)) *PRInputPort_MergePR_Call {
+func (_c *PRInputPort_MergePR_Call) Run(run func(ctx context.Context, prID string, ifMatch *utils.IfMatch)) *PRInputPort_MergePR_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(*utils.IfMatch))
 	})
 	return _c
 }
@@ -257,14 +617,14 @@ func (_c *PRInputPort_MergePR_Call) Return(_a0 *models.PullRequest, _a1 error) *
 	return _c
 }
 
-func (_c *PRInputPort_MergePR_Call) RunAndReturn(run func(context.Context, string) (*models.PullRequest, error)) *PRInputPort_MergePR_Call {
+func (_c *PRInputPort_MergePR_Call) RunAndReturn(run func(context.Context, string, *utils.IfMatch) (*models.PullRequest, error)) *PRInputPort_MergePR_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReassignReviewer provides a mock function with given fields: ctx, prID, oldReviewerID
-func (_m *PRInputPort) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (*models.PullRequest, error) {
-	ret := _m.Called(ctx, prID, oldReviewerID)
+// ReassignReviewer provides a mock function with given fields: ctx, prID, oldReviewerID, newReviewerID, reason, ifMatch
+func (_m *PRInputPort) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason models.AssignmentReason, ifMatch *utils.IfMatch) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, prID, oldReviewerID, newReviewerID, reason, ifMatch)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReassignReviewer")
@@ -272,19 +632,19 @@ func (_m *PRInputPort) ReassignReviewer(ctx context.Context, prID string, oldRev
 
 	var r0 *models.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.PullRequest, error)); ok {
-		return rf(ctx, prID, oldReviewerID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, models.AssignmentReason, *utils.IfMatch) (*models.PullRequest, error)); ok {
+		return rf(ctx, prID, oldReviewerID, newReviewerID, reason, ifMatch)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.PullRequest); ok {
-		r0 = rf(ctx, prID, oldReviewerID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, models.AssignmentReason, *utils.IfMatch) *models.PullRequest); ok {
+		r0 = rf(ctx, prID, oldReviewerID, newReviewerID, reason, ifMatch)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.PullRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, prID, oldReviewerID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, models.AssignmentReason, *utils.IfMatch) error); ok {
+		r1 = rf(ctx, prID, oldReviewerID, newReviewerID, reason, ifMatch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -301,13 +661,16 @@ type PRInputPort_ReassignReviewer_Call struct {
 //   - ctx context.Context
 //   - prID string
 //   - oldReviewerID string
-func (_e *PRInputPort_Expecter) ReassignReviewer(ctx interface{}, prID interface{}, oldReviewerID interface{}) *PRInputPort_ReassignReviewer_Call {
-	return &PRInputPort_ReassignReviewer_Call{Call: _e.mock.On("ReassignReviewer", ctx, prID, oldReviewerID)}
+//   - newReviewerID string
+//   - reason models.AssignmentReason
+//   - ifMatch *utils.IfMatch
+func (_e *PRInputPort_Expecter) ReassignReviewer(ctx interface{}, prID interface{}, oldReviewerID interface{}, newReviewerID interface{}, reason interface{}, ifMatch interface{}) *PRInputPort_ReassignReviewer_Call {
+	return &PRInputPort_ReassignReviewer_Call{Call: _e.mock.On("ReassignReviewer", ctx, prID, oldReviewerID, newReviewerID, reason, ifMatch)}
 }
 
-func (_c *PRInputPort_ReassignReviewer_Call) Run(run func(ctx context.Context, prID string, oldReviewerID string)) *PRInputPort_ReassignReviewer_Call {
+func (_c *PRInputPort_ReassignReviewer_Call) Run(run func(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason models.AssignmentReason, ifMatch *utils.IfMatch)) *PRInputPort_ReassignReviewer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(models.AssignmentReason), args[5].(*utils.IfMatch))
 	})
 	return _c
 }
@@ -317,7 +680,127 @@ func (_c *PRInputPort_ReassignReviewer_Call) Return(_a0 *models.PullRequest, _a1
 	return _c
 }
 
-func (_c *PRInputPort_ReassignReviewer_Call) RunAndReturn(run func(context.Context, string, string) (*models.PullRequest, error)) *PRInputPort_ReassignReviewer_Call {
+func (_c *PRInputPort_ReassignReviewer_Call) RunAndReturn(run func(context.Context, string, string, string, models.AssignmentReason, *utils.IfMatch) (*models.PullRequest, error)) *PRInputPort_ReassignReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReviewer provides a mock function with given fields: ctx, prID, reviewerID, ifMatch
+func (_m *PRInputPort) RemoveReviewer(ctx context.Context, prID string, reviewerID string, ifMatch *utils.IfMatch) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, prID, reviewerID, ifMatch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReviewer")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *utils.IfMatch) (*models.PullRequest, error)); ok {
+		return rf(ctx, prID, reviewerID, ifMatch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *utils.IfMatch) *models.PullRequest); ok {
+		r0 = rf(ctx, prID, reviewerID, ifMatch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *utils.IfMatch) error); ok {
+		r1 = rf(ctx, prID, reviewerID, ifMatch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRInputPort_RemoveReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReviewer'
+type PRInputPort_RemoveReviewer_Call struct {
+	*mock.Call
+}
+
+// RemoveReviewer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+//   - reviewerID string
+//   - ifMatch *utils.IfMatch
+func (_e *PRInputPort_Expecter) RemoveReviewer(ctx interface{}, prID interface{}, reviewerID interface{}, ifMatch interface{}) *PRInputPort_RemoveReviewer_Call {
+	return &PRInputPort_RemoveReviewer_Call{Call: _e.mock.On("RemoveReviewer", ctx, prID, reviewerID, ifMatch)}
+}
+
+func (_c *PRInputPort_RemoveReviewer_Call) Run(run func(ctx context.Context, prID string, reviewerID string, ifMatch *utils.IfMatch)) *PRInputPort_RemoveReviewer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*utils.IfMatch))
+	})
+	return _c
+}
+
+func (_c *PRInputPort_RemoveReviewer_Call) Return(_a0 *models.PullRequest, _a1 error) *PRInputPort_RemoveReviewer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRInputPort_RemoveReviewer_Call) RunAndReturn(run func(context.Context, string, string, *utils.IfMatch) (*models.PullRequest, error)) *PRInputPort_RemoveReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenPR provides a mock function with given fields: ctx, prID
+func (_m *PRInputPort) ReopenPR(ctx context.Context, prID string) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenPR")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.PullRequest, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.PullRequest); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRInputPort_ReopenPR_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenPR'
+type PRInputPort_ReopenPR_Call struct {
+	*mock.Call
+}
+
+// ReopenPR is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PRInputPort_Expecter) ReopenPR(ctx interface{}, prID interface{}) *PRInputPort_ReopenPR_Call {
+	return &PRInputPort_ReopenPR_Call{Call: _e.mock.On("ReopenPR", ctx, prID)}
+}
+
+func (_c *PRInputPort_ReopenPR_Call) Run(run func(ctx context.Context, prID string)) *PRInputPort_ReopenPR_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PRInputPort_ReopenPR_Call) Return(_a0 *models.PullRequest, _a1 error) *PRInputPort_ReopenPR_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRInputPort_ReopenPR_Call) RunAndReturn(run func(context.Context, string) (*models.PullRequest, error)) *PRInputPort_ReopenPR_Call {
 	_c.Call.Return(run)
 	return _c
 }
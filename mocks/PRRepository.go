@@ -24,6 +24,53 @@ func (_m *PRRepository) EXPECT() *PRRepository_Expecter {
 	return &PRRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddDigestEvents provides a mock function with given fields: ctx, events
+func (_m *PRRepository) AddDigestEvents(ctx context.Context, events []*models.ReviewFeedEvent) error {
+	ret := _m.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDigestEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.ReviewFeedEvent) error); ok {
+		r0 = rf(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PRRepository_AddDigestEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDigestEvents'
+type PRRepository_AddDigestEvents_Call struct {
+	*mock.Call
+}
+
+// AddDigestEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []*models.ReviewFeedEvent
+func (_e *PRRepository_Expecter) AddDigestEvents(ctx interface{}, events interface{}) *PRRepository_AddDigestEvents_Call {
+	return &PRRepository_AddDigestEvents_Call{Call: _e.mock.On("AddDigestEvents", ctx, events)}
+}
+
+func (_c *PRRepository_AddDigestEvents_Call) Run(run func(ctx context.Context, events []*models.ReviewFeedEvent)) *PRRepository_AddDigestEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.ReviewFeedEvent))
+	})
+	return _c
+}
+
+func (_c *PRRepository_AddDigestEvents_Call) Return(_a0 error) *PRRepository_AddDigestEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PRRepository_AddDigestEvents_Call) RunAndReturn(run func(context.Context, []*models.ReviewFeedEvent) error) *PRRepository_AddDigestEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddReviewer provides a mock function with given fields: ctx, prID, reviewerID
 func (_m *PRRepository) AddReviewer(ctx context.Context, prID string, reviewerID string) error {
 	ret := _m.Called(ctx, prID, reviewerID)
@@ -36,59 +83,694 @@ func (_m *PRRepository) AddReviewer(ctx context.Context, prID string, reviewerID
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
 		r0 = rf(ctx, prID, reviewerID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PRRepository_AddReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReviewer'
+type PRRepository_AddReviewer_Call struct {
+	*mock.Call
+}
+
+// AddReviewer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+//   - reviewerID string
+func (_e *PRRepository_Expecter) AddReviewer(ctx interface{}, prID interface{}, reviewerID interface{}) *PRRepository_AddReviewer_Call {
+	return &PRRepository_AddReviewer_Call{Call: _e.mock.On("AddReviewer", ctx, prID, reviewerID)}
+}
+
+func (_c *PRRepository_AddReviewer_Call) Run(run func(ctx context.Context, prID string, reviewerID string)) *PRRepository_AddReviewer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PRRepository_AddReviewer_Call) Return(_a0 error) *PRRepository_AddReviewer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PRRepository_AddReviewer_Call) RunAndReturn(run func(context.Context, string, string) error) *PRRepository_AddReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppendFeedEvents provides a mock function with given fields: ctx, events
+func (_m *PRRepository) AppendFeedEvents(ctx context.Context, events []*models.ReviewFeedEvent) error {
+	ret := _m.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendFeedEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.ReviewFeedEvent) error); ok {
+		r0 = rf(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PRRepository_AppendFeedEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendFeedEvents'
+type PRRepository_AppendFeedEvents_Call struct {
+	*mock.Call
+}
+
+// AppendFeedEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []*models.ReviewFeedEvent
+func (_e *PRRepository_Expecter) AppendFeedEvents(ctx interface{}, events interface{}) *PRRepository_AppendFeedEvents_Call {
+	return &PRRepository_AppendFeedEvents_Call{Call: _e.mock.On("AppendFeedEvents", ctx, events)}
+}
+
+func (_c *PRRepository_AppendFeedEvents_Call) Run(run func(ctx context.Context, events []*models.ReviewFeedEvent)) *PRRepository_AppendFeedEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.ReviewFeedEvent))
+	})
+	return _c
+}
+
+func (_c *PRRepository_AppendFeedEvents_Call) Return(_a0 error) *PRRepository_AppendFeedEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PRRepository_AppendFeedEvents_Call) RunAndReturn(run func(context.Context, []*models.ReviewFeedEvent) error) *PRRepository_AppendFeedEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppendReviewerHistory provides a mock function with given fields: ctx, entry
+func (_m *PRRepository) AppendReviewerHistory(ctx context.Context, entry *models.ReviewerHistoryEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendReviewerHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ReviewerHistoryEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PRRepository_AppendReviewerHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendReviewerHistory'
+type PRRepository_AppendReviewerHistory_Call struct {
+	*mock.Call
+}
+
+// AppendReviewerHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *models.ReviewerHistoryEntry
+func (_e *PRRepository_Expecter) AppendReviewerHistory(ctx interface{}, entry interface{}) *PRRepository_AppendReviewerHistory_Call {
+	return &PRRepository_AppendReviewerHistory_Call{Call: _e.mock.On("AppendReviewerHistory", ctx, entry)}
+}
+
+func (_c *PRRepository_AppendReviewerHistory_Call) Run(run func(ctx context.Context, entry *models.ReviewerHistoryEntry)) *PRRepository_AppendReviewerHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ReviewerHistoryEntry))
+	})
+	return _c
+}
+
+func (_c *PRRepository_AppendReviewerHistory_Call) Return(_a0 error) *PRRepository_AppendReviewerHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PRRepository_AppendReviewerHistory_Call) RunAndReturn(run func(context.Context, *models.ReviewerHistoryEntry) error) *PRRepository_AppendReviewerHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppendReviewerHistoryBatch provides a mock function with given fields: ctx, entries
+func (_m *PRRepository) AppendReviewerHistoryBatch(ctx context.Context, entries []*models.ReviewerHistoryEntry) error {
+	ret := _m.Called(ctx, entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendReviewerHistoryBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.ReviewerHistoryEntry) error); ok {
+		r0 = rf(ctx, entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PRRepository_AppendReviewerHistoryBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendReviewerHistoryBatch'
+type PRRepository_AppendReviewerHistoryBatch_Call struct {
+	*mock.Call
+}
+
+// AppendReviewerHistoryBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entries []*models.ReviewerHistoryEntry
+func (_e *PRRepository_Expecter) AppendReviewerHistoryBatch(ctx interface{}, entries interface{}) *PRRepository_AppendReviewerHistoryBatch_Call {
+	return &PRRepository_AppendReviewerHistoryBatch_Call{Call: _e.mock.On("AppendReviewerHistoryBatch", ctx, entries)}
+}
+
+func (_c *PRRepository_AppendReviewerHistoryBatch_Call) Run(run func(ctx context.Context, entries []*models.ReviewerHistoryEntry)) *PRRepository_AppendReviewerHistoryBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.ReviewerHistoryEntry))
+	})
+	return _c
+}
+
+func (_c *PRRepository_AppendReviewerHistoryBatch_Call) Return(_a0 error) *PRRepository_AppendReviewerHistoryBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PRRepository_AppendReviewerHistoryBatch_Call) RunAndReturn(run func(context.Context, []*models.ReviewerHistoryEntry) error) *PRRepository_AppendReviewerHistoryBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppendSelectionExplanation provides a mock function with given fields: ctx, explanation
+func (_m *PRRepository) AppendSelectionExplanation(ctx context.Context, explanation *models.SelectionExplanation) error {
+	ret := _m.Called(ctx, explanation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendSelectionExplanation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SelectionExplanation) error); ok {
+		r0 = rf(ctx, explanation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PRRepository_AppendSelectionExplanation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendSelectionExplanation'
+type PRRepository_AppendSelectionExplanation_Call struct {
+	*mock.Call
+}
+
+// AppendSelectionExplanation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - explanation *models.SelectionExplanation
+func (_e *PRRepository_Expecter) AppendSelectionExplanation(ctx interface{}, explanation interface{}) *PRRepository_AppendSelectionExplanation_Call {
+	return &PRRepository_AppendSelectionExplanation_Call{Call: _e.mock.On("AppendSelectionExplanation", ctx, explanation)}
+}
+
+func (_c *PRRepository_AppendSelectionExplanation_Call) Run(run func(ctx context.Context, explanation *models.SelectionExplanation)) *PRRepository_AppendSelectionExplanation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.SelectionExplanation))
+	})
+	return _c
+}
+
+func (_c *PRRepository_AppendSelectionExplanation_Call) Return(_a0 error) *PRRepository_AppendSelectionExplanation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PRRepository_AppendSelectionExplanation_Call) RunAndReturn(run func(context.Context, *models.SelectionExplanation) error) *PRRepository_AppendSelectionExplanation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimDigestDay provides a mock function with given fields: ctx, day
+func (_m *PRRepository) ClaimDigestDay(ctx context.Context, day string) (bool, error) {
+	ret := _m.Called(ctx, day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDigestDay")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, day)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, day)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, day)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRRepository_ClaimDigestDay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDigestDay'
+type PRRepository_ClaimDigestDay_Call struct {
+	*mock.Call
+}
+
+// ClaimDigestDay is a helper method to define mock.On call
+//   - ctx context.Context
+//   - day string
+func (_e *PRRepository_Expecter) ClaimDigestDay(ctx interface{}, day interface{}) *PRRepository_ClaimDigestDay_Call {
+	return &PRRepository_ClaimDigestDay_Call{Call: _e.mock.On("ClaimDigestDay", ctx, day)}
+}
+
+func (_c *PRRepository_ClaimDigestDay_Call) Run(run func(ctx context.Context, day string)) *PRRepository_ClaimDigestDay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PRRepository_ClaimDigestDay_Call) Return(_a0 bool, _a1 error) *PRRepository_ClaimDigestDay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRRepository_ClaimDigestDay_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *PRRepository_ClaimDigestDay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountOpenReviewsByReviewers provides a mock function with given fields: ctx, reviewerIDs
+func (_m *PRRepository) CountOpenReviewsByReviewers(ctx context.Context, reviewerIDs []string) (map[string]int, error) {
+	ret := _m.Called(ctx, reviewerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOpenReviewsByReviewers")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]int, error)); ok {
+		return rf(ctx, reviewerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]int); ok {
+		r0 = rf(ctx, reviewerIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, reviewerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRRepository_CountOpenReviewsByReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOpenReviewsByReviewers'
+type PRRepository_CountOpenReviewsByReviewers_Call struct {
+	*mock.Call
+}
+
+// CountOpenReviewsByReviewers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reviewerIDs []string
+func (_e *PRRepository_Expecter) CountOpenReviewsByReviewers(ctx interface{}, reviewerIDs interface{}) *PRRepository_CountOpenReviewsByReviewers_Call {
+	return &PRRepository_CountOpenReviewsByReviewers_Call{Call: _e.mock.On("CountOpenReviewsByReviewers", ctx, reviewerIDs)}
+}
+
+func (_c *PRRepository_CountOpenReviewsByReviewers_Call) Run(run func(ctx context.Context, reviewerIDs []string)) *PRRepository_CountOpenReviewsByReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *PRRepository_CountOpenReviewsByReviewers_Call) Return(_a0 map[string]int, _a1 error) *PRRepository_CountOpenReviewsByReviewers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRRepository_CountOpenReviewsByReviewers_Call) RunAndReturn(run func(context.Context, []string) (map[string]int, error)) *PRRepository_CountOpenReviewsByReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReviewersByPRID provides a mock function with given fields: ctx, prID
+func (_m *PRRepository) CountReviewersByPRID(ctx context.Context, prID string) (int, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReviewersByPRID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRRepository_CountReviewersByPRID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReviewersByPRID'
+type PRRepository_CountReviewersByPRID_Call struct {
+	*mock.Call
+}
+
+// CountReviewersByPRID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PRRepository_Expecter) CountReviewersByPRID(ctx interface{}, prID interface{}) *PRRepository_CountReviewersByPRID_Call {
+	return &PRRepository_CountReviewersByPRID_Call{Call: _e.mock.On("CountReviewersByPRID", ctx, prID)}
+}
+
+func (_c *PRRepository_CountReviewersByPRID_Call) Run(run func(ctx context.Context, prID string)) *PRRepository_CountReviewersByPRID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PRRepository_CountReviewersByPRID_Call) Return(_a0 int, _a1 error) *PRRepository_CountReviewersByPRID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRRepository_CountReviewersByPRID_Call) RunAndReturn(run func(context.Context, string) (int, error)) *PRRepository_CountReviewersByPRID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePR provides a mock function with given fields: ctx, _a1
+func (_m *PRRepository) CreatePR(ctx context.Context, _a1 *models.PullRequest) error {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePR")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PullRequest) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PRRepository_CreatePR_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePR'
+type PRRepository_CreatePR_Call struct {
+	*mock.Call
+}
+
+// CreatePR is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *models.PullRequest
+func (_e *PRRepository_Expecter) CreatePR(ctx interface{}, _a1 interface{}) *PRRepository_CreatePR_Call {
+	return &PRRepository_CreatePR_Call{Call: _e.mock.On("CreatePR", ctx, _a1)}
+}
+
+func (_c *PRRepository_CreatePR_Call) Run(run func(ctx context.Context, _a1 *models.PullRequest)) *PRRepository_CreatePR_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.PullRequest))
+	})
+	return _c
+}
+
+func (_c *PRRepository_CreatePR_Call) Return(_a0 error) *PRRepository_CreatePR_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PRRepository_CreatePR_Call) RunAndReturn(run func(context.Context, *models.PullRequest) error) *PRRepository_CreatePR_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePRs provides a mock function with given fields: ctx, prs
+func (_m *PRRepository) CreatePRs(ctx context.Context, prs []*models.PullRequest) (map[string]struct{}, error) {
+	ret := _m.Called(ctx, prs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePRs")
+	}
+
+	var r0 map[string]struct{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.PullRequest) (map[string]struct{}, error)); ok {
+		return rf(ctx, prs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.PullRequest) map[string]struct{}); ok {
+		r0 = rf(ctx, prs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]struct{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*models.PullRequest) error); ok {
+		r1 = rf(ctx, prs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRRepository_CreatePRs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePRs'
+type PRRepository_CreatePRs_Call struct {
+	*mock.Call
+}
+
+// CreatePRs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prs []*models.PullRequest
+func (_e *PRRepository_Expecter) CreatePRs(ctx interface{}, prs interface{}) *PRRepository_CreatePRs_Call {
+	return &PRRepository_CreatePRs_Call{Call: _e.mock.On("CreatePRs", ctx, prs)}
+}
+
+func (_c *PRRepository_CreatePRs_Call) Run(run func(ctx context.Context, prs []*models.PullRequest)) *PRRepository_CreatePRs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.PullRequest))
+	})
+	return _c
+}
+
+func (_c *PRRepository_CreatePRs_Call) Return(_a0 map[string]struct{}, _a1 error) *PRRepository_CreatePRs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRRepository_CreatePRs_Call) RunAndReturn(run func(context.Context, []*models.PullRequest) (map[string]struct{}, error)) *PRRepository_CreatePRs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDigestEvents provides a mock function with given fields: ctx, userID, throughID
+func (_m *PRRepository) DeleteDigestEvents(ctx context.Context, userID string, throughID int64) error {
+	ret := _m.Called(ctx, userID, throughID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDigestEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, userID, throughID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PRRepository_DeleteDigestEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDigestEvents'
+type PRRepository_DeleteDigestEvents_Call struct {
+	*mock.Call
+}
+
+// DeleteDigestEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - throughID int64
+func (_e *PRRepository_Expecter) DeleteDigestEvents(ctx interface{}, userID interface{}, throughID interface{}) *PRRepository_DeleteDigestEvents_Call {
+	return &PRRepository_DeleteDigestEvents_Call{Call: _e.mock.On("DeleteDigestEvents", ctx, userID, throughID)}
+}
+
+func (_c *PRRepository_DeleteDigestEvents_Call) Run(run func(ctx context.Context, userID string, throughID int64)) *PRRepository_DeleteDigestEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *PRRepository_DeleteDigestEvents_Call) Return(_a0 error) *PRRepository_DeleteDigestEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PRRepository_DeleteDigestEvents_Call) RunAndReturn(run func(context.Context, string, int64) error) *PRRepository_DeleteDigestEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistingPRIDs provides a mock function with given fields: ctx, ids
+func (_m *PRRepository) ExistingPRIDs(ctx context.Context, ids []string) (map[string]struct{}, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistingPRIDs")
+	}
+
+	var r0 map[string]struct{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]struct{}, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]struct{}); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]struct{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRRepository_ExistingPRIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistingPRIDs'
+type PRRepository_ExistingPRIDs_Call struct {
+	*mock.Call
+}
+
+// ExistingPRIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *PRRepository_Expecter) ExistingPRIDs(ctx interface{}, ids interface{}) *PRRepository_ExistingPRIDs_Call {
+	return &PRRepository_ExistingPRIDs_Call{Call: _e.mock.On("ExistingPRIDs", ctx, ids)}
+}
+
+func (_c *PRRepository_ExistingPRIDs_Call) Run(run func(ctx context.Context, ids []string)) *PRRepository_ExistingPRIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *PRRepository_ExistingPRIDs_Call) Return(_a0 map[string]struct{}, _a1 error) *PRRepository_ExistingPRIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRRepository_ExistingPRIDs_Call) RunAndReturn(run func(context.Context, []string) (map[string]struct{}, error)) *PRRepository_ExistingPRIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPRByID provides a mock function with given fields: ctx, id
+func (_m *PRRepository) GetPRByID(ctx context.Context, id string) (*models.PullRequest, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPRByID")
+	}
+
+	var r0 *models.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.PullRequest, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.PullRequest); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// PRRepository_AddReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReviewer'
-type PRRepository_AddReviewer_Call struct {
+// PRRepository_GetPRByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPRByID'
+type PRRepository_GetPRByID_Call struct {
 	*mock.Call
 }
 
-// AddReviewer is a helper method to define mock.On call
+// GetPRByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - prID string
-//   - reviewerID string
-func (_e *PRRepository_Expecter) AddReviewer(ctx interface{}, prID interface{}, reviewerID interface{}) *PRRepository_AddReviewer_Call {
-	return &PRRepository_AddReviewer_Call{Call: _e.mock.On("AddReviewer", ctx, prID, reviewerID)}
+//   - id string
+func (_e *PRRepository_Expecter) GetPRByID(ctx interface{}, id interface{}) *PRRepository_GetPRByID_Call {
+	return &PRRepository_GetPRByID_Call{Call: _e.mock.On("GetPRByID", ctx, id)}
 }
 
-func (_c *PRRepository_AddReviewer_Call) Run(run func(ctx context.Context, prID string, reviewerID string)) *PRRepository_AddReviewer_Call {
+func (_c *PRRepository_GetPRByID_Call) Run(run func(ctx context.Context, id string)) *PRRepository_GetPRByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *PRRepository_AddReviewer_Call) Return(_a0 error) *PRRepository_AddReviewer_Call {
-	_c.Call.Return(_a0)
+func (_c *PRRepository_GetPRByID_Call) Return(_a0 *models.PullRequest, _a1 error) *PRRepository_GetPRByID_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PRRepository_AddReviewer_Call) RunAndReturn(run func(context.Context, string, string) error) *PRRepository_AddReviewer_Call {
+func (_c *PRRepository_GetPRByID_Call) RunAndReturn(run func(context.Context, string) (*models.PullRequest, error)) *PRRepository_GetPRByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountReviewersByPRID provides a mock function with given fields: ctx, prID
-func (_m *PRRepository) CountReviewersByPRID(ctx context.Context, prID string) (int, error) {
+// GetVCSLink provides a mock function with given fields: ctx, prID
+func (_m *PRRepository) GetVCSLink(ctx context.Context, prID string) (*models.VCSPullRequestLink, error) {
 	ret := _m.Called(ctx, prID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountReviewersByPRID")
+		panic("no return value specified for GetVCSLink")
 	}
 
-	var r0 int
+	var r0 *models.VCSPullRequestLink
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.VCSPullRequestLink, error)); ok {
 		return rf(ctx, prID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.VCSPullRequestLink); ok {
 		r0 = rf(ctx, prID)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VCSPullRequestLink)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
@@ -100,105 +782,116 @@ func (_m *PRRepository) CountReviewersByPRID(ctx context.Context, prID string) (
 	return r0, r1
 }
 
-// PRRepository_CountReviewersByPRID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReviewersByPRID'
-type PRRepository_CountReviewersByPRID_Call struct {
+// PRRepository_GetVCSLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVCSLink'
+type PRRepository_GetVCSLink_Call struct {
 	*mock.Call
 }
 
-// CountReviewersByPRID is a helper method to define mock.On call
+// GetVCSLink is a helper method to define mock.On call
 //   - ctx context.Context
 //   - prID string
-func (_e *PRRepository_Expecter) CountReviewersByPRID(ctx interface{}, prID interface{}) *PRRepository_CountReviewersByPRID_Call {
-	return &PRRepository_CountReviewersByPRID_Call{Call: _e.mock.On("CountReviewersByPRID", ctx, prID)}
+func (_e *PRRepository_Expecter) GetVCSLink(ctx interface{}, prID interface{}) *PRRepository_GetVCSLink_Call {
+	return &PRRepository_GetVCSLink_Call{Call: _e.mock.On("GetVCSLink", ctx, prID)}
 }
 
-func (_c *PRRepository_CountReviewersByPRID_Call) Run(run func(ctx context.Context, prID string)) *PRRepository_CountReviewersByPRID_Call {
+func (_c *PRRepository_GetVCSLink_Call) Run(run func(ctx context.Context, prID string)) *PRRepository_GetVCSLink_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *PRRepository_CountReviewersByPRID_Call) Return(_a0 int, _a1 error) *PRRepository_CountReviewersByPRID_Call {
+func (_c *PRRepository_GetVCSLink_Call) Return(_a0 *models.VCSPullRequestLink, _a1 error) *PRRepository_GetVCSLink_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PRRepository_CountReviewersByPRID_Call) RunAndReturn(run func(context.Context, string) (int, error)) *PRRepository_CountReviewersByPRID_Call {
+func (_c *PRRepository_GetVCSLink_Call) RunAndReturn(run func(context.Context, string) (*models.VCSPullRequestLink, error)) *PRRepository_GetVCSLink_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePR provides a mock function with given fields: ctx, _a1
-func (_m *PRRepository) CreatePR(ctx context.Context, _a1 *models.PullRequest) error {
-	ret := _m.Called(ctx, _a1)
+// ListDigestEvents provides a mock function with given fields: ctx
+func (_m *PRRepository) ListDigestEvents(ctx context.Context) ([]*models.ReviewFeedEvent, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePR")
+		panic("no return value specified for ListDigestEvents")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.PullRequest) error); ok {
-		r0 = rf(ctx, _a1)
+	var r0 []*models.ReviewFeedEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.ReviewFeedEvent, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.ReviewFeedEvent); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ReviewFeedEvent)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// PRRepository_CreatePR_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePR'
-type PRRepository_CreatePR_Call struct {
+// PRRepository_ListDigestEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDigestEvents'
+type PRRepository_ListDigestEvents_Call struct {
 	*mock.Call
 }
 
-// CreatePR is a helper method to define mock.On call
+// ListDigestEvents is a helper method to define mock.On call
 //   - ctx context.Context
-//   - _a1 *models.PullRequest
-func (_e *PRRepository_Expecter) CreatePR(ctx interface{}, _a1 interface{}) *PRRepository_CreatePR_Call {
-	return &PRRepository_CreatePR_Call{Call: _e.mock.On("CreatePR", ctx, _a1)}
+func (_e *PRRepository_Expecter) ListDigestEvents(ctx interface{}) *PRRepository_ListDigestEvents_Call {
+	return &PRRepository_ListDigestEvents_Call{Call: _e.mock.On("ListDigestEvents", ctx)}
 }
 
-func (_c *PRRepository_CreatePR_Call) Run(run func(ctx context.Context, _a1 *models.PullRequest)) *PRRepository_CreatePR_Call {
+func (_c *PRRepository_ListDigestEvents_Call) Run(run func(ctx context.Context)) *PRRepository_ListDigestEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.PullRequest))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *PRRepository_CreatePR_Call) Return(_a0 error) *PRRepository_CreatePR_Call {
-	_c.Call.Return(_a0)
+func (_c *PRRepository_ListDigestEvents_Call) Return(_a0 []*models.ReviewFeedEvent, _a1 error) *PRRepository_ListDigestEvents_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PRRepository_CreatePR_Call) RunAndReturn(run func(context.Context, *models.PullRequest) error) *PRRepository_CreatePR_Call {
+func (_c *PRRepository_ListDigestEvents_Call) RunAndReturn(run func(context.Context) ([]*models.ReviewFeedEvent, error)) *PRRepository_ListDigestEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPRByID provides a mock function with given fields: ctx, id
-func (_m *PRRepository) GetPRByID(ctx context.Context, id string) (*models.PullRequest, error) {
-	ret := _m.Called(ctx, id)
+// ListFeedEvents provides a mock function with given fields: ctx, userID, afterID, limit
+func (_m *PRRepository) ListFeedEvents(ctx context.Context, userID string, afterID int64, limit int) ([]*models.ReviewFeedEvent, error) {
+	ret := _m.Called(ctx, userID, afterID, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPRByID")
+		panic("no return value specified for ListFeedEvents")
 	}
 
-	var r0 *models.PullRequest
+	var r0 []*models.ReviewFeedEvent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.PullRequest, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int) ([]*models.ReviewFeedEvent, error)); ok {
+		return rf(ctx, userID, afterID, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.PullRequest); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int) []*models.ReviewFeedEvent); ok {
+		r0 = rf(ctx, userID, afterID, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.PullRequest)
+			r0 = ret.Get(0).([]*models.ReviewFeedEvent)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int) error); ok {
+		r1 = rf(ctx, userID, afterID, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -206,31 +899,99 @@ func (_m *PRRepository) GetPRByID(ctx context.Context, id string) (*models.PullR
 	return r0, r1
 }
 
-// PRRepository_GetPRByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPRByID'
-type PRRepository_GetPRByID_Call struct {
+// PRRepository_ListFeedEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFeedEvents'
+type PRRepository_ListFeedEvents_Call struct {
 	*mock.Call
 }
 
-// GetPRByID is a helper method to define mock.On call
+// ListFeedEvents is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *PRRepository_Expecter) GetPRByID(ctx interface{}, id interface{}) *PRRepository_GetPRByID_Call {
-	return &PRRepository_GetPRByID_Call{Call: _e.mock.On("GetPRByID", ctx, id)}
+//   - userID string
+//   - afterID int64
+//   - limit int
+func (_e *PRRepository_Expecter) ListFeedEvents(ctx interface{}, userID interface{}, afterID interface{}, limit interface{}) *PRRepository_ListFeedEvents_Call {
+	return &PRRepository_ListFeedEvents_Call{Call: _e.mock.On("ListFeedEvents", ctx, userID, afterID, limit)}
 }
 
-func (_c *PRRepository_GetPRByID_Call) Run(run func(ctx context.Context, id string)) *PRRepository_GetPRByID_Call {
+func (_c *PRRepository_ListFeedEvents_Call) Run(run func(ctx context.Context, userID string, afterID int64, limit int)) *PRRepository_ListFeedEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int))
 	})
 	return _c
 }
 
-func (_c *PRRepository_GetPRByID_Call) Return(_a0 *models.PullRequest, _a1 error) *PRRepository_GetPRByID_Call {
+func (_c *PRRepository_ListFeedEvents_Call) Return(_a0 []*models.ReviewFeedEvent, _a1 error) *PRRepository_ListFeedEvents_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PRRepository_GetPRByID_Call) RunAndReturn(run func(context.Context, string) (*models.PullRequest, error)) *PRRepository_GetPRByID_Call {
+func (_c *PRRepository_ListFeedEvents_Call) RunAndReturn(run func(context.Context, string, int64, int) ([]*models.ReviewFeedEvent, error)) *PRRepository_ListFeedEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPRs provides a mock function with given fields: ctx, filter
+func (_m *PRRepository) ListPRs(ctx context.Context, filter models.PRFilter) ([]*models.PullRequest, int, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPRs")
+	}
+
+	var r0 []*models.PullRequest
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.PRFilter) ([]*models.PullRequest, int, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.PRFilter) []*models.PullRequest); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.PRFilter) int); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.PRFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PRRepository_ListPRs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPRs'
+type PRRepository_ListPRs_Call struct {
+	*mock.Call
+}
+
+// ListPRs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.PRFilter
+func (_e *PRRepository_Expecter) ListPRs(ctx interface{}, filter interface{}) *PRRepository_ListPRs_Call {
+	return &PRRepository_ListPRs_Call{Call: _e.mock.On("ListPRs", ctx, filter)}
+}
+
+func (_c *PRRepository_ListPRs_Call) Run(run func(ctx context.Context, filter models.PRFilter)) *PRRepository_ListPRs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.PRFilter))
+	})
+	return _c
+}
+
+func (_c *PRRepository_ListPRs_Call) Return(_a0 []*models.PullRequest, _a1 int, _a2 error) *PRRepository_ListPRs_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *PRRepository_ListPRs_Call) RunAndReturn(run func(context.Context, models.PRFilter) ([]*models.PullRequest, int, error)) *PRRepository_ListPRs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -295,6 +1056,124 @@ func (_c *PRRepository_ListPRsByReviewer_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListReviewerHistory provides a mock function with given fields: ctx, prID
+func (_m *PRRepository) ListReviewerHistory(ctx context.Context, prID string) ([]*models.ReviewerHistoryEntry, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReviewerHistory")
+	}
+
+	var r0 []*models.ReviewerHistoryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.ReviewerHistoryEntry, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.ReviewerHistoryEntry); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ReviewerHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRRepository_ListReviewerHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReviewerHistory'
+type PRRepository_ListReviewerHistory_Call struct {
+	*mock.Call
+}
+
+// ListReviewerHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PRRepository_Expecter) ListReviewerHistory(ctx interface{}, prID interface{}) *PRRepository_ListReviewerHistory_Call {
+	return &PRRepository_ListReviewerHistory_Call{Call: _e.mock.On("ListReviewerHistory", ctx, prID)}
+}
+
+func (_c *PRRepository_ListReviewerHistory_Call) Run(run func(ctx context.Context, prID string)) *PRRepository_ListReviewerHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PRRepository_ListReviewerHistory_Call) Return(_a0 []*models.ReviewerHistoryEntry, _a1 error) *PRRepository_ListReviewerHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRRepository_ListReviewerHistory_Call) RunAndReturn(run func(context.Context, string) ([]*models.ReviewerHistoryEntry, error)) *PRRepository_ListReviewerHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSelectionExplanations provides a mock function with given fields: ctx, prID
+func (_m *PRRepository) ListSelectionExplanations(ctx context.Context, prID string) ([]*models.SelectionExplanation, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSelectionExplanations")
+	}
+
+	var r0 []*models.SelectionExplanation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.SelectionExplanation, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.SelectionExplanation); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SelectionExplanation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PRRepository_ListSelectionExplanations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSelectionExplanations'
+type PRRepository_ListSelectionExplanations_Call struct {
+	*mock.Call
+}
+
+// ListSelectionExplanations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prID string
+func (_e *PRRepository_Expecter) ListSelectionExplanations(ctx interface{}, prID interface{}) *PRRepository_ListSelectionExplanations_Call {
+	return &PRRepository_ListSelectionExplanations_Call{Call: _e.mock.On("ListSelectionExplanations", ctx, prID)}
+}
+
+func (_c *PRRepository_ListSelectionExplanations_Call) Run(run func(ctx context.Context, prID string)) *PRRepository_ListSelectionExplanations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PRRepository_ListSelectionExplanations_Call) Return(_a0 []*models.SelectionExplanation, _a1 error) *PRRepository_ListSelectionExplanations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PRRepository_ListSelectionExplanations_Call) RunAndReturn(run func(context.Context, string) ([]*models.SelectionExplanation, error)) *PRRepository_ListSelectionExplanations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LockPRByID provides a mock function with given fields: ctx, id
 func (_m *PRRepository) LockPRByID(ctx context.Context, id string) (*models.PullRequest, error) {
 	ret := _m.Called(ctx, id)
@@ -402,6 +1281,53 @@ func (_c *PRRepository_RemoveReviewer_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// SetVCSLink provides a mock function with given fields: ctx, link
+func (_m *PRRepository) SetVCSLink(ctx context.Context, link *models.VCSPullRequestLink) error {
+	ret := _m.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetVCSLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.VCSPullRequestLink) error); ok {
+		r0 = rf(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PRRepository_SetVCSLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVCSLink'
+type PRRepository_SetVCSLink_Call struct {
+	*mock.Call
+}
+
+// SetVCSLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *models.VCSPullRequestLink
+func (_e *PRRepository_Expecter) SetVCSLink(ctx interface{}, link interface{}) *PRRepository_SetVCSLink_Call {
+	return &PRRepository_SetVCSLink_Call{Call: _e.mock.On("SetVCSLink", ctx, link)}
+}
+
+func (_c *PRRepository_SetVCSLink_Call) Run(run func(ctx context.Context, link *models.VCSPullRequestLink)) *PRRepository_SetVCSLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.VCSPullRequestLink))
+	})
+	return _c
+}
+
+func (_c *PRRepository_SetVCSLink_Call) Return(_a0 error) *PRRepository_SetVCSLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PRRepository_SetVCSLink_Call) RunAndReturn(run func(context.Context, *models.VCSPullRequestLink) error) *PRRepository_SetVCSLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStatus provides a mock function with given fields: ctx, prID, status, mergedAt
 func (_m *PRRepository) UpdateStatus(ctx context.Context, prID string, status models.PRStatus, mergedAt *time.Time) error {
 	ret := _m.Called(ctx, prID, status, mergedAt)
@@ -5,10 +5,9 @@ package mocks
 import (
 	context "context"
 
+	pgx "github.com/jackc/pgx/v5"
 	pgconn "github.com/jackc/pgx/v5/pgconn"
 	mock "github.com/stretchr/testify/mock"
-
-	pgx "github.com/jackc/pgx/v5"
 )
 
 // Querier is an autogenerated mock type for the Querier type
@@ -24,6 +23,65 @@ func (_m *Querier) EXPECT() *Querier_Expecter {
 	return &Querier_Expecter{mock: &_m.Mock}
 }
 
+// CopyFrom provides a mock function with given fields: ctx, tableName, columnNames, rowSrc
+func (_m *Querier) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	ret := _m.Called(ctx, tableName, columnNames, rowSrc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyFrom")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) (int64, error)); ok {
+		return rf(ctx, tableName, columnNames, rowSrc)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) int64); ok {
+		r0 = rf(ctx, tableName, columnNames, rowSrc)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) error); ok {
+		r1 = rf(ctx, tableName, columnNames, rowSrc)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_CopyFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFrom'
+type Querier_CopyFrom_Call struct {
+	*mock.Call
+}
+
+// CopyFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tableName pgx.Identifier
+//   - columnNames []string
+//   - rowSrc pgx.CopyFromSource
+func (_e *Querier_Expecter) CopyFrom(ctx interface{}, tableName interface{}, columnNames interface{}, rowSrc interface{}) *Querier_CopyFrom_Call {
+	return &Querier_CopyFrom_Call{Call: _e.mock.On("CopyFrom", ctx, tableName, columnNames, rowSrc)}
+}
+
+func (_c *Querier_CopyFrom_Call) Run(run func(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource)) *Querier_CopyFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Identifier), args[2].([]string), args[3].(pgx.CopyFromSource))
+	})
+	return _c
+}
+
+func (_c *Querier_CopyFrom_Call) Return(_a0 int64, _a1 error) *Querier_CopyFrom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_CopyFrom_Call) RunAndReturn(run func(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) (int64, error)) *Querier_CopyFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Exec provides a mock function with given fields: ctx, sql, arguments
 func (_m *Querier) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
 	var _ca []interface{}
@@ -222,6 +280,55 @@ func (_c *Querier_QueryRow_Call) RunAndReturn(run func(context.Context, string,
 	return _c
 }
 
+// SendBatch provides a mock function with given fields: ctx, b
+func (_m *Querier) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	ret := _m.Called(ctx, b)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendBatch")
+	}
+
+	var r0 pgx.BatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, *pgx.Batch) pgx.BatchResults); ok {
+		r0 = rf(ctx, b)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pgx.BatchResults)
+		}
+	}
+
+	return r0
+}
+
+// Querier_SendBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendBatch'
+type Querier_SendBatch_Call struct {
+	*mock.Call
+}
+
+// SendBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - b *pgx.Batch
+func (_e *Querier_Expecter) SendBatch(ctx interface{}, b interface{}) *Querier_SendBatch_Call {
+	return &Querier_SendBatch_Call{Call: _e.mock.On("SendBatch", ctx, b)}
+}
+
+func (_c *Querier_SendBatch_Call) Run(run func(ctx context.Context, b *pgx.Batch)) *Querier_SendBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pgx.Batch))
+	})
+	return _c
+}
+
+func (_c *Querier_SendBatch_Call) Return(_a0 pgx.BatchResults) *Querier_SendBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Querier_SendBatch_Call) RunAndReturn(run func(context.Context, *pgx.Batch) pgx.BatchResults) *Querier_SendBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewQuerier creates a new instance of Querier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQuerier(t interface {
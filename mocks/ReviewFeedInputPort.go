@@ -0,0 +1,159 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "avito-test-pr-service/internal/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReviewFeedInputPort is an autogenerated mock type for the ReviewFeedInputPort type
+type ReviewFeedInputPort struct {
+	mock.Mock
+}
+
+type ReviewFeedInputPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReviewFeedInputPort) EXPECT() *ReviewFeedInputPort_Expecter {
+	return &ReviewFeedInputPort_Expecter{mock: &_m.Mock}
+}
+
+// ListEvents provides a mock function with given fields: ctx, userID, afterID, limit
+func (_m *ReviewFeedInputPort) ListEvents(ctx context.Context, userID string, afterID int64, limit int) ([]*models.ReviewFeedEvent, error) {
+	ret := _m.Called(ctx, userID, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEvents")
+	}
+
+	var r0 []*models.ReviewFeedEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int) ([]*models.ReviewFeedEvent, error)); ok {
+		return rf(ctx, userID, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int) []*models.ReviewFeedEvent); ok {
+		r0 = rf(ctx, userID, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ReviewFeedEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int) error); ok {
+		r1 = rf(ctx, userID, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewFeedInputPort_ListEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEvents'
+type ReviewFeedInputPort_ListEvents_Call struct {
+	*mock.Call
+}
+
+// ListEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - afterID int64
+//   - limit int
+func (_e *ReviewFeedInputPort_Expecter) ListEvents(ctx interface{}, userID interface{}, afterID interface{}, limit interface{}) *ReviewFeedInputPort_ListEvents_Call {
+	return &ReviewFeedInputPort_ListEvents_Call{Call: _e.mock.On("ListEvents", ctx, userID, afterID, limit)}
+}
+
+func (_c *ReviewFeedInputPort_ListEvents_Call) Run(run func(ctx context.Context, userID string, afterID int64, limit int)) *ReviewFeedInputPort_ListEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ReviewFeedInputPort_ListEvents_Call) Return(_a0 []*models.ReviewFeedEvent, _a1 error) *ReviewFeedInputPort_ListEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReviewFeedInputPort_ListEvents_Call) RunAndReturn(run func(context.Context, string, int64, int) ([]*models.ReviewFeedEvent, error)) *ReviewFeedInputPort_ListEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: userID
+func (_m *ReviewFeedInputPort) Subscribe(userID string) (<-chan *models.ReviewFeedEvent, func()) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan *models.ReviewFeedEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(string) (<-chan *models.ReviewFeedEvent, func())); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) <-chan *models.ReviewFeedEvent); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *models.ReviewFeedEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) func()); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// ReviewFeedInputPort_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type ReviewFeedInputPort_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - userID string
+func (_e *ReviewFeedInputPort_Expecter) Subscribe(userID interface{}) *ReviewFeedInputPort_Subscribe_Call {
+	return &ReviewFeedInputPort_Subscribe_Call{Call: _e.mock.On("Subscribe", userID)}
+}
+
+func (_c *ReviewFeedInputPort_Subscribe_Call) Run(run func(userID string)) *ReviewFeedInputPort_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ReviewFeedInputPort_Subscribe_Call) Return(_a0 <-chan *models.ReviewFeedEvent, _a1 func()) *ReviewFeedInputPort_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReviewFeedInputPort_Subscribe_Call) RunAndReturn(run func(string) (<-chan *models.ReviewFeedEvent, func())) *ReviewFeedInputPort_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReviewFeedInputPort creates a new instance of ReviewFeedInputPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewFeedInputPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewFeedInputPort {
+	mock := &ReviewFeedInputPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
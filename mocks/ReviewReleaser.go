@@ -0,0 +1,110 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "avito-test-pr-service/internal/domain/models"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+)
+
+// ReviewReleaser is an autogenerated mock type for the ReviewReleaser type
+type ReviewReleaser struct {
+	mock.Mock
+}
+
+type ReviewReleaser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReviewReleaser) EXPECT() *ReviewReleaser_Expecter {
+	return &ReviewReleaser_Expecter{mock: &_m.Mock}
+}
+
+// ReleaseTeamReviews provides a mock function with given fields: ctx, tx, reviewerID, authorIDs, reason
+func (_m *ReviewReleaser) ReleaseTeamReviews(ctx context.Context, tx uow.Transaction, reviewerID string, authorIDs []string, reason models.AssignmentReason) ([]models.ReviewRelease, func(), error) {
+	ret := _m.Called(ctx, tx, reviewerID, authorIDs, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseTeamReviews")
+	}
+
+	var r0 []models.ReviewRelease
+	var r1 func()
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uow.Transaction, string, []string, models.AssignmentReason) ([]models.ReviewRelease, func(), error)); ok {
+		return rf(ctx, tx, reviewerID, authorIDs, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uow.Transaction, string, []string, models.AssignmentReason) []models.ReviewRelease); ok {
+		r0 = rf(ctx, tx, reviewerID, authorIDs, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReviewRelease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uow.Transaction, string, []string, models.AssignmentReason) func()); ok {
+		r1 = rf(ctx, tx, reviewerID, authorIDs, reason)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uow.Transaction, string, []string, models.AssignmentReason) error); ok {
+		r2 = rf(ctx, tx, reviewerID, authorIDs, reason)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReviewReleaser_ReleaseTeamReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseTeamReviews'
+type ReviewReleaser_ReleaseTeamReviews_Call struct {
+	*mock.Call
+}
+
+// ReleaseTeamReviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx uow.Transaction
+//   - reviewerID string
+//   - authorIDs []string
+//   - reason models.AssignmentReason
+func (_e *ReviewReleaser_Expecter) ReleaseTeamReviews(ctx interface{}, tx interface{}, reviewerID interface{}, authorIDs interface{}, reason interface{}) *ReviewReleaser_ReleaseTeamReviews_Call {
+	return &ReviewReleaser_ReleaseTeamReviews_Call{Call: _e.mock.On("ReleaseTeamReviews", ctx, tx, reviewerID, authorIDs, reason)}
+}
+
+func (_c *ReviewReleaser_ReleaseTeamReviews_Call) Run(run func(ctx context.Context, tx uow.Transaction, reviewerID string, authorIDs []string, reason models.AssignmentReason)) *ReviewReleaser_ReleaseTeamReviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uow.Transaction), args[2].(string), args[3].([]string), args[4].(models.AssignmentReason))
+	})
+	return _c
+}
+
+func (_c *ReviewReleaser_ReleaseTeamReviews_Call) Return(releases []models.ReviewRelease, publish func(), err error) *ReviewReleaser_ReleaseTeamReviews_Call {
+	_c.Call.Return(releases, publish, err)
+	return _c
+}
+
+func (_c *ReviewReleaser_ReleaseTeamReviews_Call) RunAndReturn(run func(context.Context, uow.Transaction, string, []string, models.AssignmentReason) ([]models.ReviewRelease, func(), error)) *ReviewReleaser_ReleaseTeamReviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReviewReleaser creates a new instance of ReviewReleaser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewReleaser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewReleaser {
+	mock := &ReviewReleaser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,133 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "avito-test-pr-service/internal/domain/models"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReviewRequester is an autogenerated mock type for the ReviewRequester type
+type ReviewRequester struct {
+	mock.Mock
+}
+
+type ReviewRequester_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReviewRequester) EXPECT() *ReviewRequester_Expecter {
+	return &ReviewRequester_Expecter{mock: &_m.Mock}
+}
+
+// RemoveReviewers provides a mock function with given fields: ctx, link, logins
+func (_m *ReviewRequester) RemoveReviewers(ctx context.Context, link *models.VCSPullRequestLink, logins []string) error {
+	ret := _m.Called(ctx, link, logins)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.VCSPullRequestLink, []string) error); ok {
+		r0 = rf(ctx, link, logins)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReviewRequester_RemoveReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReviewers'
+type ReviewRequester_RemoveReviewers_Call struct {
+	*mock.Call
+}
+
+// RemoveReviewers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *models.VCSPullRequestLink
+//   - logins []string
+func (_e *ReviewRequester_Expecter) RemoveReviewers(ctx interface{}, link interface{}, logins interface{}) *ReviewRequester_RemoveReviewers_Call {
+	return &ReviewRequester_RemoveReviewers_Call{Call: _e.mock.On("RemoveReviewers", ctx, link, logins)}
+}
+
+func (_c *ReviewRequester_RemoveReviewers_Call) Run(run func(ctx context.Context, link *models.VCSPullRequestLink, logins []string)) *ReviewRequester_RemoveReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.VCSPullRequestLink), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *ReviewRequester_RemoveReviewers_Call) Return(_a0 error) *ReviewRequester_RemoveReviewers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReviewRequester_RemoveReviewers_Call) RunAndReturn(run func(context.Context, *models.VCSPullRequestLink, []string) error) *ReviewRequester_RemoveReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestReviewers provides a mock function with given fields: ctx, link, logins
+func (_m *ReviewRequester) RequestReviewers(ctx context.Context, link *models.VCSPullRequestLink, logins []string) error {
+	ret := _m.Called(ctx, link, logins)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.VCSPullRequestLink, []string) error); ok {
+		r0 = rf(ctx, link, logins)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReviewRequester_RequestReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestReviewers'
+type ReviewRequester_RequestReviewers_Call struct {
+	*mock.Call
+}
+
+// RequestReviewers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *models.VCSPullRequestLink
+//   - logins []string
+func (_e *ReviewRequester_Expecter) RequestReviewers(ctx interface{}, link interface{}, logins interface{}) *ReviewRequester_RequestReviewers_Call {
+	return &ReviewRequester_RequestReviewers_Call{Call: _e.mock.On("RequestReviewers", ctx, link, logins)}
+}
+
+func (_c *ReviewRequester_RequestReviewers_Call) Run(run func(ctx context.Context, link *models.VCSPullRequestLink, logins []string)) *ReviewRequester_RequestReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.VCSPullRequestLink), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *ReviewRequester_RequestReviewers_Call) Return(_a0 error) *ReviewRequester_RequestReviewers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReviewRequester_RequestReviewers_Call) RunAndReturn(run func(context.Context, *models.VCSPullRequestLink, []string) error) *ReviewRequester_RequestReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReviewRequester creates a new instance of ReviewRequester. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewRequester(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewRequester {
+	mock := &ReviewRequester{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
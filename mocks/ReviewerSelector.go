@@ -2,7 +2,13 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	services "avito-test-pr-service/internal/domain/services"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // ReviewerSelector is an autogenerated mock type for the ReviewerSelector type
 type ReviewerSelector struct {
@@ -17,24 +23,34 @@ func (_m *ReviewerSelector) EXPECT() *ReviewerSelector_Expecter {
 	return &ReviewerSelector_Expecter{mock: &_m.Mock}
 }
 
-// Select provides a mock function with given fields: candidates, count
-func (_m *ReviewerSelector) Select(candidates []string, count int) []string {
-	ret := _m.Called(candidates, count)
+// Select provides a mock function with given fields: ctx, tx, req
+func (_m *ReviewerSelector) Select(ctx context.Context, tx uow.Transaction, req services.SelectionRequest) (*services.Selection, error) {
+	ret := _m.Called(ctx, tx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Select")
 	}
 
-	var r0 []string
-	if rf, ok := ret.Get(0).(func([]string, int) []string); ok {
-		r0 = rf(candidates, count)
+	var r0 *services.Selection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uow.Transaction, services.SelectionRequest) (*services.Selection, error)); ok {
+		return rf(ctx, tx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uow.Transaction, services.SelectionRequest) *services.Selection); ok {
+		r0 = rf(ctx, tx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).(*services.Selection)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uow.Transaction, services.SelectionRequest) error); ok {
+		r1 = rf(ctx, tx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // ReviewerSelector_Select_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Select'
@@ -43,25 +59,26 @@ type ReviewerSelector_Select_Call struct {
 }
 
 // Select is a helper method to define mock.On call
-//   - candidates []string
-//   - count int
-func (_e *ReviewerSelector_Expecter) Select(candidates interface{}, count interface{}) *ReviewerSelector_Select_Call {
-	return &ReviewerSelector_Select_Call{Call: _e.mock.On("Select", candidates, count)}
+//   - ctx context.Context
+//   - tx uow.Transaction
+//   - req services.SelectionRequest
+func (_e *ReviewerSelector_Expecter) Select(ctx interface{}, tx interface{}, req interface{}) *ReviewerSelector_Select_Call {
+	return &ReviewerSelector_Select_Call{Call: _e.mock.On("Select", ctx, tx, req)}
 }
 
-func (_c *ReviewerSelector_Select_Call) Run(run func(candidates []string, count int)) *ReviewerSelector_Select_Call {
+func (_c *ReviewerSelector_Select_Call) Run(run func(ctx context.Context, tx uow.Transaction, req services.SelectionRequest)) *ReviewerSelector_Select_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]string), args[1].(int))
+		run(args[0].(context.Context), args[1].(uow.Transaction), args[2].(services.SelectionRequest))
 	})
 	return _c
 }
 
-func (_c *ReviewerSelector_Select_Call) Return(_a0 []string) *ReviewerSelector_Select_Call {
-	_c.Call.Return(_a0)
+func (_c *ReviewerSelector_Select_Call) Return(_a0 *services.Selection, _a1 error) *ReviewerSelector_Select_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ReviewerSelector_Select_Call) RunAndReturn(run func([]string, int) []string) *ReviewerSelector_Select_Call {
+func (_c *ReviewerSelector_Select_Call) RunAndReturn(run func(context.Context, uow.Transaction, services.SelectionRequest) (*services.Selection, error)) *ReviewerSelector_Select_Call {
 	_c.Call.Return(run)
 	return _c
 }
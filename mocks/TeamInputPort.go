@@ -5,11 +5,12 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	models "avito-test-pr-service/internal/domain/models"
+	utils "avito-test-pr-service/internal/utils"
+	json "encoding/json"
 
 	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // TeamInputPort is an autogenerated mock type for the TeamInputPort type
@@ -201,6 +202,182 @@ func (_c *TeamInputPort_CreateTeamWithMembers_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ExportRoster provides a mock function with given fields: ctx
+func (_m *TeamInputPort) ExportRoster(ctx context.Context) (*models.Roster, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportRoster")
+	}
+
+	var r0 *models.Roster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.Roster, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.Roster); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Roster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamInputPort_ExportRoster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportRoster'
+type TeamInputPort_ExportRoster_Call struct {
+	*mock.Call
+}
+
+// ExportRoster is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TeamInputPort_Expecter) ExportRoster(ctx interface{}) *TeamInputPort_ExportRoster_Call {
+	return &TeamInputPort_ExportRoster_Call{Call: _e.mock.On("ExportRoster", ctx)}
+}
+
+func (_c *TeamInputPort_ExportRoster_Call) Run(run func(ctx context.Context)) *TeamInputPort_ExportRoster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TeamInputPort_ExportRoster_Call) Return(_a0 *models.Roster, _a1 error) *TeamInputPort_ExportRoster_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamInputPort_ExportRoster_Call) RunAndReturn(run func(context.Context) (*models.Roster, error)) *TeamInputPort_ExportRoster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCodeOwners provides a mock function with given fields: ctx, teamName
+func (_m *TeamInputPort) GetCodeOwners(ctx context.Context, teamName string) ([]models.CodeOwnerRule, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCodeOwners")
+	}
+
+	var r0 []models.CodeOwnerRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.CodeOwnerRule, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.CodeOwnerRule); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CodeOwnerRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamInputPort_GetCodeOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCodeOwners'
+type TeamInputPort_GetCodeOwners_Call struct {
+	*mock.Call
+}
+
+// GetCodeOwners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *TeamInputPort_Expecter) GetCodeOwners(ctx interface{}, teamName interface{}) *TeamInputPort_GetCodeOwners_Call {
+	return &TeamInputPort_GetCodeOwners_Call{Call: _e.mock.On("GetCodeOwners", ctx, teamName)}
+}
+
+func (_c *TeamInputPort_GetCodeOwners_Call) Run(run func(ctx context.Context, teamName string)) *TeamInputPort_GetCodeOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamInputPort_GetCodeOwners_Call) Return(_a0 []models.CodeOwnerRule, _a1 error) *TeamInputPort_GetCodeOwners_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamInputPort_GetCodeOwners_Call) RunAndReturn(run func(context.Context, string) ([]models.CodeOwnerRule, error)) *TeamInputPort_GetCodeOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReviewerConstraints provides a mock function with given fields: ctx, teamName
+func (_m *TeamInputPort) GetReviewerConstraints(ctx context.Context, teamName string) ([]models.ReviewerConstraint, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewerConstraints")
+	}
+
+	var r0 []models.ReviewerConstraint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.ReviewerConstraint, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.ReviewerConstraint); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReviewerConstraint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamInputPort_GetReviewerConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReviewerConstraints'
+type TeamInputPort_GetReviewerConstraints_Call struct {
+	*mock.Call
+}
+
+// GetReviewerConstraints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *TeamInputPort_Expecter) GetReviewerConstraints(ctx interface{}, teamName interface{}) *TeamInputPort_GetReviewerConstraints_Call {
+	return &TeamInputPort_GetReviewerConstraints_Call{Call: _e.mock.On("GetReviewerConstraints", ctx, teamName)}
+}
+
+func (_c *TeamInputPort_GetReviewerConstraints_Call) Run(run func(ctx context.Context, teamName string)) *TeamInputPort_GetReviewerConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamInputPort_GetReviewerConstraints_Call) Return(_a0 []models.ReviewerConstraint, _a1 error) *TeamInputPort_GetReviewerConstraints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamInputPort_GetReviewerConstraints_Call) RunAndReturn(run func(context.Context, string) ([]models.ReviewerConstraint, error)) *TeamInputPort_GetReviewerConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTeam provides a mock function with given fields: ctx, id
 func (_m *TeamInputPort) GetTeam(ctx context.Context, id uuid.UUID) (*models.Team, error) {
 	ret := _m.Called(ctx, id)
@@ -319,6 +496,66 @@ func (_c *TeamInputPort_GetTeamByName_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ImportRoster provides a mock function with given fields: ctx, roster, dryRun
+func (_m *TeamInputPort) ImportRoster(ctx context.Context, roster *models.Roster, dryRun bool) (*models.RosterDiff, error) {
+	ret := _m.Called(ctx, roster, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportRoster")
+	}
+
+	var r0 *models.RosterDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Roster, bool) (*models.RosterDiff, error)); ok {
+		return rf(ctx, roster, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Roster, bool) *models.RosterDiff); ok {
+		r0 = rf(ctx, roster, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RosterDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Roster, bool) error); ok {
+		r1 = rf(ctx, roster, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamInputPort_ImportRoster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportRoster'
+type TeamInputPort_ImportRoster_Call struct {
+	*mock.Call
+}
+
+// ImportRoster is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roster *models.Roster
+//   - dryRun bool
+func (_e *TeamInputPort_Expecter) ImportRoster(ctx interface{}, roster interface{}, dryRun interface{}) *TeamInputPort_ImportRoster_Call {
+	return &TeamInputPort_ImportRoster_Call{Call: _e.mock.On("ImportRoster", ctx, roster, dryRun)}
+}
+
+func (_c *TeamInputPort_ImportRoster_Call) Run(run func(ctx context.Context, roster *models.Roster, dryRun bool)) *TeamInputPort_ImportRoster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Roster), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *TeamInputPort_ImportRoster_Call) Return(_a0 *models.RosterDiff, _a1 error) *TeamInputPort_ImportRoster_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamInputPort_ImportRoster_Call) RunAndReturn(run func(context.Context, *models.Roster, bool) (*models.RosterDiff, error)) *TeamInputPort_ImportRoster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListTeams provides a mock function with given fields: ctx
 func (_m *TeamInputPort) ListTeams(ctx context.Context) ([]*models.Team, error) {
 	ret := _m.Called(ctx)
@@ -425,6 +662,266 @@ func (_c *TeamInputPort_RemoveMember_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// SetCodeOwners provides a mock function with given fields: ctx, teamName, content, ifMatch
+func (_m *TeamInputPort) SetCodeOwners(ctx context.Context, teamName string, content string, ifMatch *utils.IfMatch) ([]models.CodeOwnerRule, int64, error) {
+	ret := _m.Called(ctx, teamName, content, ifMatch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCodeOwners")
+	}
+
+	var r0 []models.CodeOwnerRule
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *utils.IfMatch) ([]models.CodeOwnerRule, int64, error)); ok {
+		return rf(ctx, teamName, content, ifMatch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *utils.IfMatch) []models.CodeOwnerRule); ok {
+		r0 = rf(ctx, teamName, content, ifMatch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CodeOwnerRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *utils.IfMatch) int64); ok {
+		r1 = rf(ctx, teamName, content, ifMatch)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, *utils.IfMatch) error); ok {
+		r2 = rf(ctx, teamName, content, ifMatch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// TeamInputPort_SetCodeOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCodeOwners'
+type TeamInputPort_SetCodeOwners_Call struct {
+	*mock.Call
+}
+
+// SetCodeOwners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - content string
+//   - ifMatch *utils.IfMatch
+func (_e *TeamInputPort_Expecter) SetCodeOwners(ctx interface{}, teamName interface{}, content interface{}, ifMatch interface{}) *TeamInputPort_SetCodeOwners_Call {
+	return &TeamInputPort_SetCodeOwners_Call{Call: _e.mock.On("SetCodeOwners", ctx, teamName, content, ifMatch)}
+}
+
+func (_c *TeamInputPort_SetCodeOwners_Call) Run(run func(ctx context.Context, teamName string, content string, ifMatch *utils.IfMatch)) *TeamInputPort_SetCodeOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*utils.IfMatch))
+	})
+	return _c
+}
+
+func (_c *TeamInputPort_SetCodeOwners_Call) Return(_a0 []models.CodeOwnerRule, _a1 int64, _a2 error) *TeamInputPort_SetCodeOwners_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *TeamInputPort_SetCodeOwners_Call) RunAndReturn(run func(context.Context, string, string, *utils.IfMatch) ([]models.CodeOwnerRule, int64, error)) *TeamInputPort_SetCodeOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetReviewerConstraints provides a mock function with given fields: ctx, teamName, constraints, ifMatch
+func (_m *TeamInputPort) SetReviewerConstraints(ctx context.Context, teamName string, constraints []models.ReviewerConstraint, ifMatch *utils.IfMatch) ([]models.ReviewerConstraint, int64, error) {
+	ret := _m.Called(ctx, teamName, constraints, ifMatch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReviewerConstraints")
+	}
+
+	var r0 []models.ReviewerConstraint
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []models.ReviewerConstraint, *utils.IfMatch) ([]models.ReviewerConstraint, int64, error)); ok {
+		return rf(ctx, teamName, constraints, ifMatch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []models.ReviewerConstraint, *utils.IfMatch) []models.ReviewerConstraint); ok {
+		r0 = rf(ctx, teamName, constraints, ifMatch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReviewerConstraint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []models.ReviewerConstraint, *utils.IfMatch) int64); ok {
+		r1 = rf(ctx, teamName, constraints, ifMatch)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, []models.ReviewerConstraint, *utils.IfMatch) error); ok {
+		r2 = rf(ctx, teamName, constraints, ifMatch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// TeamInputPort_SetReviewerConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReviewerConstraints'
+type TeamInputPort_SetReviewerConstraints_Call struct {
+	*mock.Call
+}
+
+// SetReviewerConstraints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - constraints []models.ReviewerConstraint
+//   - ifMatch *utils.IfMatch
+func (_e *TeamInputPort_Expecter) SetReviewerConstraints(ctx interface{}, teamName interface{}, constraints interface{}, ifMatch interface{}) *TeamInputPort_SetReviewerConstraints_Call {
+	return &TeamInputPort_SetReviewerConstraints_Call{Call: _e.mock.On("SetReviewerConstraints", ctx, teamName, constraints, ifMatch)}
+}
+
+func (_c *TeamInputPort_SetReviewerConstraints_Call) Run(run func(ctx context.Context, teamName string, constraints []models.ReviewerConstraint, ifMatch *utils.IfMatch)) *TeamInputPort_SetReviewerConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]models.ReviewerConstraint), args[3].(*utils.IfMatch))
+	})
+	return _c
+}
+
+func (_c *TeamInputPort_SetReviewerConstraints_Call) Return(_a0 []models.ReviewerConstraint, _a1 int64, _a2 error) *TeamInputPort_SetReviewerConstraints_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *TeamInputPort_SetReviewerConstraints_Call) RunAndReturn(run func(context.Context, string, []models.ReviewerConstraint, *utils.IfMatch) ([]models.ReviewerConstraint, int64, error)) *TeamInputPort_SetReviewerConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSelectionStrategy provides a mock function with given fields: ctx, teamName, strategy, params, ifMatch
+func (_m *TeamInputPort) SetSelectionStrategy(ctx context.Context, teamName string, strategy string, params json.RawMessage, ifMatch *utils.IfMatch) (*models.Team, error) {
+	ret := _m.Called(ctx, teamName, strategy, params, ifMatch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSelectionStrategy")
+	}
+
+	var r0 *models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, json.RawMessage, *utils.IfMatch) (*models.Team, error)); ok {
+		return rf(ctx, teamName, strategy, params, ifMatch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, json.RawMessage, *utils.IfMatch) *models.Team); ok {
+		r0 = rf(ctx, teamName, strategy, params, ifMatch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, json.RawMessage, *utils.IfMatch) error); ok {
+		r1 = rf(ctx, teamName, strategy, params, ifMatch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamInputPort_SetSelectionStrategy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSelectionStrategy'
+type TeamInputPort_SetSelectionStrategy_Call struct {
+	*mock.Call
+}
+
+// SetSelectionStrategy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+//   - strategy string
+//   - params json.RawMessage
+//   - ifMatch *utils.IfMatch
+func (_e *TeamInputPort_Expecter) SetSelectionStrategy(ctx interface{}, teamName interface{}, strategy interface{}, params interface{}, ifMatch interface{}) *TeamInputPort_SetSelectionStrategy_Call {
+	return &TeamInputPort_SetSelectionStrategy_Call{Call: _e.mock.On("SetSelectionStrategy", ctx, teamName, strategy, params, ifMatch)}
+}
+
+func (_c *TeamInputPort_SetSelectionStrategy_Call) Run(run func(ctx context.Context, teamName string, strategy string, params json.RawMessage, ifMatch *utils.IfMatch)) *TeamInputPort_SetSelectionStrategy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(json.RawMessage), args[4].(*utils.IfMatch))
+	})
+	return _c
+}
+
+func (_c *TeamInputPort_SetSelectionStrategy_Call) Return(_a0 *models.Team, _a1 error) *TeamInputPort_SetSelectionStrategy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamInputPort_SetSelectionStrategy_Call) RunAndReturn(run func(context.Context, string, string, json.RawMessage, *utils.IfMatch) (*models.Team, error)) *TeamInputPort_SetSelectionStrategy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncTeam provides a mock function with given fields: ctx, name, members, dryRun, ifMatch
+func (_m *TeamInputPort) SyncTeam(ctx context.Context, name string, members []models.RosterMember, dryRun bool, ifMatch *utils.IfMatch) (*models.TeamSync, error) {
+	ret := _m.Called(ctx, name, members, dryRun, ifMatch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncTeam")
+	}
+
+	var r0 *models.TeamSync
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []models.RosterMember, bool, *utils.IfMatch) (*models.TeamSync, error)); ok {
+		return rf(ctx, name, members, dryRun, ifMatch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []models.RosterMember, bool, *utils.IfMatch) *models.TeamSync); ok {
+		r0 = rf(ctx, name, members, dryRun, ifMatch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TeamSync)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []models.RosterMember, bool, *utils.IfMatch) error); ok {
+		r1 = rf(ctx, name, members, dryRun, ifMatch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamInputPort_SyncTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncTeam'
+type TeamInputPort_SyncTeam_Call struct {
+	*mock.Call
+}
+
+// SyncTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - members []models.RosterMember
+//   - dryRun bool
+//   - ifMatch *utils.IfMatch
+func (_e *TeamInputPort_Expecter) SyncTeam(ctx interface{}, name interface{}, members interface{}, dryRun interface{}, ifMatch interface{}) *TeamInputPort_SyncTeam_Call {
+	return &TeamInputPort_SyncTeam_Call{Call: _e.mock.On("SyncTeam", ctx, name, members, dryRun, ifMatch)}
+}
+
+func (_c *TeamInputPort_SyncTeam_Call) Run(run func(ctx context.Context, name string, members []models.RosterMember, dryRun bool, ifMatch *utils.IfMatch)) *TeamInputPort_SyncTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]models.RosterMember), args[3].(bool), args[4].(*utils.IfMatch))
+	})
+	return _c
+}
+
+func (_c *TeamInputPort_SyncTeam_Call) Return(_a0 *models.TeamSync, _a1 error) *TeamInputPort_SyncTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamInputPort_SyncTeam_Call) RunAndReturn(run func(context.Context, string, []models.RosterMember, bool, *utils.IfMatch) (*models.TeamSync, error)) *TeamInputPort_SyncTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTeamInputPort creates a new instance of TeamInputPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTeamInputPort(t interface {
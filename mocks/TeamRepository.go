@@ -5,6 +5,7 @@ package mocks
 import (
 	models "avito-test-pr-service/internal/domain/models"
 	context "context"
+	json "encoding/json"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -237,6 +238,124 @@ func (_c *TeamRepository_GetTeamByName_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListCodeOwners provides a mock function with given fields: ctx, teamID
+func (_m *TeamRepository) ListCodeOwners(ctx context.Context, teamID uuid.UUID) ([]models.CodeOwnerRule, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCodeOwners")
+	}
+
+	var r0 []models.CodeOwnerRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.CodeOwnerRule, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.CodeOwnerRule); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CodeOwnerRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamRepository_ListCodeOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCodeOwners'
+type TeamRepository_ListCodeOwners_Call struct {
+	*mock.Call
+}
+
+// ListCodeOwners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID uuid.UUID
+func (_e *TeamRepository_Expecter) ListCodeOwners(ctx interface{}, teamID interface{}) *TeamRepository_ListCodeOwners_Call {
+	return &TeamRepository_ListCodeOwners_Call{Call: _e.mock.On("ListCodeOwners", ctx, teamID)}
+}
+
+func (_c *TeamRepository_ListCodeOwners_Call) Run(run func(ctx context.Context, teamID uuid.UUID)) *TeamRepository_ListCodeOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_ListCodeOwners_Call) Return(_a0 []models.CodeOwnerRule, _a1 error) *TeamRepository_ListCodeOwners_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamRepository_ListCodeOwners_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.CodeOwnerRule, error)) *TeamRepository_ListCodeOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReviewerConstraints provides a mock function with given fields: ctx, teamID
+func (_m *TeamRepository) ListReviewerConstraints(ctx context.Context, teamID uuid.UUID) ([]models.ReviewerConstraint, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReviewerConstraints")
+	}
+
+	var r0 []models.ReviewerConstraint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ReviewerConstraint, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ReviewerConstraint); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReviewerConstraint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamRepository_ListReviewerConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReviewerConstraints'
+type TeamRepository_ListReviewerConstraints_Call struct {
+	*mock.Call
+}
+
+// ListReviewerConstraints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID uuid.UUID
+func (_e *TeamRepository_Expecter) ListReviewerConstraints(ctx interface{}, teamID interface{}) *TeamRepository_ListReviewerConstraints_Call {
+	return &TeamRepository_ListReviewerConstraints_Call{Call: _e.mock.On("ListReviewerConstraints", ctx, teamID)}
+}
+
+func (_c *TeamRepository_ListReviewerConstraints_Call) Run(run func(ctx context.Context, teamID uuid.UUID)) *TeamRepository_ListReviewerConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_ListReviewerConstraints_Call) Return(_a0 []models.ReviewerConstraint, _a1 error) *TeamRepository_ListReviewerConstraints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamRepository_ListReviewerConstraints_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ReviewerConstraint, error)) *TeamRepository_ListReviewerConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListTeams provides a mock function with given fields: ctx
 func (_m *TeamRepository) ListTeams(ctx context.Context) ([]*models.Team, error) {
 	ret := _m.Called(ctx)
@@ -295,6 +414,122 @@ func (_c *TeamRepository_ListTeams_Call) RunAndReturn(run func(context.Context)
 	return _c
 }
 
+// LockRotationCursor provides a mock function with given fields: ctx, teamID
+func (_m *TeamRepository) LockRotationCursor(ctx context.Context, teamID uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockRotationCursor")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (string, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamRepository_LockRotationCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockRotationCursor'
+type TeamRepository_LockRotationCursor_Call struct {
+	*mock.Call
+}
+
+// LockRotationCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID uuid.UUID
+func (_e *TeamRepository_Expecter) LockRotationCursor(ctx interface{}, teamID interface{}) *TeamRepository_LockRotationCursor_Call {
+	return &TeamRepository_LockRotationCursor_Call{Call: _e.mock.On("LockRotationCursor", ctx, teamID)}
+}
+
+func (_c *TeamRepository_LockRotationCursor_Call) Run(run func(ctx context.Context, teamID uuid.UUID)) *TeamRepository_LockRotationCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_LockRotationCursor_Call) Return(_a0 string, _a1 error) *TeamRepository_LockRotationCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamRepository_LockRotationCursor_Call) RunAndReturn(run func(context.Context, uuid.UUID) (string, error)) *TeamRepository_LockRotationCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockTeamByName provides a mock function with given fields: ctx, name
+func (_m *TeamRepository) LockTeamByName(ctx context.Context, name string) (*models.Team, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockTeamByName")
+	}
+
+	var r0 *models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Team, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Team); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamRepository_LockTeamByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockTeamByName'
+type TeamRepository_LockTeamByName_Call struct {
+	*mock.Call
+}
+
+// LockTeamByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *TeamRepository_Expecter) LockTeamByName(ctx interface{}, name interface{}) *TeamRepository_LockTeamByName_Call {
+	return &TeamRepository_LockTeamByName_Call{Call: _e.mock.On("LockTeamByName", ctx, name)}
+}
+
+func (_c *TeamRepository_LockTeamByName_Call) Run(run func(ctx context.Context, name string)) *TeamRepository_LockTeamByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_LockTeamByName_Call) Return(_a0 *models.Team, _a1 error) *TeamRepository_LockTeamByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamRepository_LockTeamByName_Call) RunAndReturn(run func(context.Context, string) (*models.Team, error)) *TeamRepository_LockTeamByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveMember provides a mock function with given fields: ctx, teamID, userID
 func (_m *TeamRepository) RemoveMember(ctx context.Context, teamID uuid.UUID, userID string) error {
 	ret := _m.Called(ctx, teamID, userID)
@@ -343,6 +578,199 @@ func (_c *TeamRepository_RemoveMember_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ReplaceCodeOwners provides a mock function with given fields: ctx, teamID, rules
+func (_m *TeamRepository) ReplaceCodeOwners(ctx context.Context, teamID uuid.UUID, rules []models.CodeOwnerRule) error {
+	ret := _m.Called(ctx, teamID, rules)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceCodeOwners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.CodeOwnerRule) error); ok {
+		r0 = rf(ctx, teamID, rules)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamRepository_ReplaceCodeOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceCodeOwners'
+type TeamRepository_ReplaceCodeOwners_Call struct {
+	*mock.Call
+}
+
+// ReplaceCodeOwners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID uuid.UUID
+//   - rules []models.CodeOwnerRule
+func (_e *TeamRepository_Expecter) ReplaceCodeOwners(ctx interface{}, teamID interface{}, rules interface{}) *TeamRepository_ReplaceCodeOwners_Call {
+	return &TeamRepository_ReplaceCodeOwners_Call{Call: _e.mock.On("ReplaceCodeOwners", ctx, teamID, rules)}
+}
+
+func (_c *TeamRepository_ReplaceCodeOwners_Call) Run(run func(ctx context.Context, teamID uuid.UUID, rules []models.CodeOwnerRule)) *TeamRepository_ReplaceCodeOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.CodeOwnerRule))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_ReplaceCodeOwners_Call) Return(_a0 error) *TeamRepository_ReplaceCodeOwners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamRepository_ReplaceCodeOwners_Call) RunAndReturn(run func(context.Context, uuid.UUID, []models.CodeOwnerRule) error) *TeamRepository_ReplaceCodeOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceReviewerConstraints provides a mock function with given fields: ctx, teamID, constraints
+func (_m *TeamRepository) ReplaceReviewerConstraints(ctx context.Context, teamID uuid.UUID, constraints []models.ReviewerConstraint) error {
+	ret := _m.Called(ctx, teamID, constraints)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceReviewerConstraints")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.ReviewerConstraint) error); ok {
+		r0 = rf(ctx, teamID, constraints)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamRepository_ReplaceReviewerConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceReviewerConstraints'
+type TeamRepository_ReplaceReviewerConstraints_Call struct {
+	*mock.Call
+}
+
+// ReplaceReviewerConstraints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID uuid.UUID
+//   - constraints []models.ReviewerConstraint
+func (_e *TeamRepository_Expecter) ReplaceReviewerConstraints(ctx interface{}, teamID interface{}, constraints interface{}) *TeamRepository_ReplaceReviewerConstraints_Call {
+	return &TeamRepository_ReplaceReviewerConstraints_Call{Call: _e.mock.On("ReplaceReviewerConstraints", ctx, teamID, constraints)}
+}
+
+func (_c *TeamRepository_ReplaceReviewerConstraints_Call) Run(run func(ctx context.Context, teamID uuid.UUID, constraints []models.ReviewerConstraint)) *TeamRepository_ReplaceReviewerConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.ReviewerConstraint))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_ReplaceReviewerConstraints_Call) Return(_a0 error) *TeamRepository_ReplaceReviewerConstraints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamRepository_ReplaceReviewerConstraints_Call) RunAndReturn(run func(context.Context, uuid.UUID, []models.ReviewerConstraint) error) *TeamRepository_ReplaceReviewerConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRotationCursor provides a mock function with given fields: ctx, teamID, userID
+func (_m *TeamRepository) SetRotationCursor(ctx context.Context, teamID uuid.UUID, userID string) error {
+	ret := _m.Called(ctx, teamID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRotationCursor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, teamID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamRepository_SetRotationCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRotationCursor'
+type TeamRepository_SetRotationCursor_Call struct {
+	*mock.Call
+}
+
+// SetRotationCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID uuid.UUID
+//   - userID string
+func (_e *TeamRepository_Expecter) SetRotationCursor(ctx interface{}, teamID interface{}, userID interface{}) *TeamRepository_SetRotationCursor_Call {
+	return &TeamRepository_SetRotationCursor_Call{Call: _e.mock.On("SetRotationCursor", ctx, teamID, userID)}
+}
+
+func (_c *TeamRepository_SetRotationCursor_Call) Run(run func(ctx context.Context, teamID uuid.UUID, userID string)) *TeamRepository_SetRotationCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_SetRotationCursor_Call) Return(_a0 error) *TeamRepository_SetRotationCursor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamRepository_SetRotationCursor_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *TeamRepository_SetRotationCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSelectionStrategy provides a mock function with given fields: ctx, teamID, strategy, params
+func (_m *TeamRepository) UpdateSelectionStrategy(ctx context.Context, teamID uuid.UUID, strategy string, params json.RawMessage) error {
+	ret := _m.Called(ctx, teamID, strategy, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSelectionStrategy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, json.RawMessage) error); ok {
+		r0 = rf(ctx, teamID, strategy, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamRepository_UpdateSelectionStrategy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSelectionStrategy'
+type TeamRepository_UpdateSelectionStrategy_Call struct {
+	*mock.Call
+}
+
+// UpdateSelectionStrategy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID uuid.UUID
+//   - strategy string
+//   - params json.RawMessage
+func (_e *TeamRepository_Expecter) UpdateSelectionStrategy(ctx interface{}, teamID interface{}, strategy interface{}, params interface{}) *TeamRepository_UpdateSelectionStrategy_Call {
+	return &TeamRepository_UpdateSelectionStrategy_Call{Call: _e.mock.On("UpdateSelectionStrategy", ctx, teamID, strategy, params)}
+}
+
+func (_c *TeamRepository_UpdateSelectionStrategy_Call) Run(run func(ctx context.Context, teamID uuid.UUID, strategy string, params json.RawMessage)) *TeamRepository_UpdateSelectionStrategy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(json.RawMessage))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_UpdateSelectionStrategy_Call) Return(_a0 error) *TeamRepository_UpdateSelectionStrategy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamRepository_UpdateSelectionStrategy_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, json.RawMessage) error) *TeamRepository_UpdateSelectionStrategy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTeamRepository creates a new instance of TeamRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTeamRepository(t interface {
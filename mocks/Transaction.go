@@ -3,13 +3,13 @@
 package mocks
 
 import (
+	audit "avito-test-pr-service/internal/domain/ports/output/audit"
 	pr "avito-test-pr-service/internal/domain/ports/output/pr"
+	team "avito-test-pr-service/internal/domain/ports/output/team"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
 
-	team "avito-test-pr-service/internal/domain/ports/output/team"
-
 	user "avito-test-pr-service/internal/domain/ports/output/user"
 )
 
@@ -26,6 +26,53 @@ func (_m *Transaction) EXPECT() *Transaction_Expecter {
 	return &Transaction_Expecter{mock: &_m.Mock}
 }
 
+// AuditRepository provides a mock function with no fields
+func (_m *Transaction) AuditRepository() audit.AuditRepository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditRepository")
+	}
+
+	var r0 audit.AuditRepository
+	if rf, ok := ret.Get(0).(func() audit.AuditRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(audit.AuditRepository)
+		}
+	}
+
+	return r0
+}
+
+// Transaction_AuditRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditRepository'
+type Transaction_AuditRepository_Call struct {
+	*mock.Call
+}
+
+// AuditRepository is a helper method to define mock.On call
+func (_e *Transaction_Expecter) AuditRepository() *Transaction_AuditRepository_Call {
+	return &Transaction_AuditRepository_Call{Call: _e.mock.On("AuditRepository")}
+}
+
+func (_c *Transaction_AuditRepository_Call) Run(run func()) *Transaction_AuditRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Transaction_AuditRepository_Call) Return(_a0 audit.AuditRepository) *Transaction_AuditRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transaction_AuditRepository_Call) RunAndReturn(run func() audit.AuditRepository) *Transaction_AuditRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Commit provides a mock function with given fields: ctx
 func (_m *Transaction) Commit(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -80,6 +80,112 @@ func (_c *UnitOfWork_Begin_Call) RunAndReturn(run func(context.Context) (uow.Tra
 	return _c
 }
 
+// BeginRead provides a mock function with given fields: ctx
+func (_m *UnitOfWork) BeginRead(ctx context.Context) (uow.Transaction, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginRead")
+	}
+
+	var r0 uow.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uow.Transaction, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uow.Transaction); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uow.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnitOfWork_BeginRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginRead'
+type UnitOfWork_BeginRead_Call struct {
+	*mock.Call
+}
+
+// BeginRead is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UnitOfWork_Expecter) BeginRead(ctx interface{}) *UnitOfWork_BeginRead_Call {
+	return &UnitOfWork_BeginRead_Call{Call: _e.mock.On("BeginRead", ctx)}
+}
+
+func (_c *UnitOfWork_BeginRead_Call) Run(run func(ctx context.Context)) *UnitOfWork_BeginRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_BeginRead_Call) Return(_a0 uow.Transaction, _a1 error) *UnitOfWork_BeginRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UnitOfWork_BeginRead_Call) RunAndReturn(run func(context.Context) (uow.Transaction, error)) *UnitOfWork_BeginRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Do provides a mock function with given fields: ctx, opts, fn
+func (_m *UnitOfWork) Do(ctx context.Context, opts uow.TxOptions, fn func(uow.Transaction) error) error {
+	ret := _m.Called(ctx, opts, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Do")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uow.TxOptions, func(uow.Transaction) error) error); ok {
+		r0 = rf(ctx, opts, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnitOfWork_Do_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Do'
+type UnitOfWork_Do_Call struct {
+	*mock.Call
+}
+
+// Do is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts uow.TxOptions
+//   - fn func(uow.Transaction) error
+func (_e *UnitOfWork_Expecter) Do(ctx interface{}, opts interface{}, fn interface{}) *UnitOfWork_Do_Call {
+	return &UnitOfWork_Do_Call{Call: _e.mock.On("Do", ctx, opts, fn)}
+}
+
+func (_c *UnitOfWork_Do_Call) Run(run func(ctx context.Context, opts uow.TxOptions, fn func(uow.Transaction) error)) *UnitOfWork_Do_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uow.TxOptions), args[2].(func(uow.Transaction) error))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_Do_Call) Return(_a0 error) *UnitOfWork_Do_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_Do_Call) RunAndReturn(run func(context.Context, uow.TxOptions, func(uow.Transaction) error) error) *UnitOfWork_Do_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUnitOfWork creates a new instance of UnitOfWork. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUnitOfWork(t interface {
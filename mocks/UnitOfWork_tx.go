@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	uow "avito-test-pr-service/internal/domain/ports/output/uow"
+	context "context"
+)
+
+// RunInTx выполняет fn переданного Do с транзакцией tx так же, как настоящий UnitOfWork без повторов:
+// после fn вызывается Commit, а при ошибке fn или Commit и для read-only транзакции — Rollback.
+func (_c *UnitOfWork_Do_Call) RunInTx(tx *Transaction) *UnitOfWork_Do_Call {
+	return _c.RunAndReturn(func(ctx context.Context, opts uow.TxOptions, fn func(uow.Transaction) error) error {
+		if err := fn(tx); err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+		if opts.ReadOnly {
+			return tx.Rollback(ctx)
+		}
+		if err := tx.Commit(ctx); err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+		return nil
+	})
+}
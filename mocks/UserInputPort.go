@@ -5,9 +5,9 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	models "avito-test-pr-service/internal/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
 )
 
 // UserInputPort is an autogenerated mock type for the UserInputPort type
@@ -317,6 +317,185 @@ func (_c *UserInputPort_ListUsers_Call) RunAndReturn(run func(context.Context) (
 	return _c
 }
 
+// ListUsersByIDs provides a mock function with given fields: ctx, ids
+func (_m *UserInputPort) ListUsersByIDs(ctx context.Context, ids []string) ([]*models.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsersByIDs")
+	}
+
+	var r0 []*models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*models.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*models.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserInputPort_ListUsersByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersByIDs'
+type UserInputPort_ListUsersByIDs_Call struct {
+	*mock.Call
+}
+
+// ListUsersByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *UserInputPort_Expecter) ListUsersByIDs(ctx interface{}, ids interface{}) *UserInputPort_ListUsersByIDs_Call {
+	return &UserInputPort_ListUsersByIDs_Call{Call: _e.mock.On("ListUsersByIDs", ctx, ids)}
+}
+
+func (_c *UserInputPort_ListUsersByIDs_Call) Run(run func(ctx context.Context, ids []string)) *UserInputPort_ListUsersByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *UserInputPort_ListUsersByIDs_Call) Return(_a0 []*models.User, _a1 error) *UserInputPort_ListUsersByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserInputPort_ListUsersByIDs_Call) RunAndReturn(run func(context.Context, []string) ([]*models.User, error)) *UserInputPort_ListUsersByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserEmail provides a mock function with given fields: ctx, id, email
+func (_m *UserInputPort) SetUserEmail(ctx context.Context, id string, email string) (*models.User, error) {
+	ret := _m.Called(ctx, id, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserEmail")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.User, error)); ok {
+		return rf(ctx, id, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.User); ok {
+		r0 = rf(ctx, id, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserInputPort_SetUserEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserEmail'
+type UserInputPort_SetUserEmail_Call struct {
+	*mock.Call
+}
+
+// SetUserEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - email string
+func (_e *UserInputPort_Expecter) SetUserEmail(ctx interface{}, id interface{}, email interface{}) *UserInputPort_SetUserEmail_Call {
+	return &UserInputPort_SetUserEmail_Call{Call: _e.mock.On("SetUserEmail", ctx, id, email)}
+}
+
+func (_c *UserInputPort_SetUserEmail_Call) Run(run func(ctx context.Context, id string, email string)) *UserInputPort_SetUserEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserInputPort_SetUserEmail_Call) Return(_a0 *models.User, _a1 error) *UserInputPort_SetUserEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserInputPort_SetUserEmail_Call) RunAndReturn(run func(context.Context, string, string) (*models.User, error)) *UserInputPort_SetUserEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserTags provides a mock function with given fields: ctx, id, tags
+func (_m *UserInputPort) SetUserTags(ctx context.Context, id string, tags []string) (*models.User, error) {
+	ret := _m.Called(ctx, id, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserTags")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (*models.User, error)); ok {
+		return rf(ctx, id, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *models.User); ok {
+		r0 = rf(ctx, id, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, id, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserInputPort_SetUserTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserTags'
+type UserInputPort_SetUserTags_Call struct {
+	*mock.Call
+}
+
+// SetUserTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - tags []string
+func (_e *UserInputPort_Expecter) SetUserTags(ctx interface{}, id interface{}, tags interface{}) *UserInputPort_SetUserTags_Call {
+	return &UserInputPort_SetUserTags_Call{Call: _e.mock.On("SetUserTags", ctx, id, tags)}
+}
+
+func (_c *UserInputPort_SetUserTags_Call) Run(run func(ctx context.Context, id string, tags []string)) *UserInputPort_SetUserTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *UserInputPort_SetUserTags_Call) Return(_a0 *models.User, _a1 error) *UserInputPort_SetUserTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserInputPort_SetUserTags_Call) RunAndReturn(run func(context.Context, string, []string) (*models.User, error)) *UserInputPort_SetUserTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUserActive provides a mock function with given fields: ctx, id, isActive
 func (_m *UserInputPort) UpdateUserActive(ctx context.Context, id string, isActive bool) error {
 	ret := _m.Called(ctx, id, isActive)
@@ -71,6 +71,65 @@ func (_c *UserRepository_CreateUser_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetNotificationPreferences provides a mock function with given fields: ctx, userIDs
+func (_m *UserRepository) GetNotificationPreferences(ctx context.Context, userIDs []string) (map[string]*models.NotificationPreferences, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationPreferences")
+	}
+
+	var r0 map[string]*models.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]*models.NotificationPreferences, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]*models.NotificationPreferences); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*models.NotificationPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationPreferences'
+type UserRepository_GetNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// GetNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []string
+func (_e *UserRepository_Expecter) GetNotificationPreferences(ctx interface{}, userIDs interface{}) *UserRepository_GetNotificationPreferences_Call {
+	return &UserRepository_GetNotificationPreferences_Call{Call: _e.mock.On("GetNotificationPreferences", ctx, userIDs)}
+}
+
+func (_c *UserRepository_GetNotificationPreferences_Call) Run(run func(ctx context.Context, userIDs []string)) *UserRepository_GetNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetNotificationPreferences_Call) Return(_a0 map[string]*models.NotificationPreferences, _a1 error) *UserRepository_GetNotificationPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetNotificationPreferences_Call) RunAndReturn(run func(context.Context, []string) (map[string]*models.NotificationPreferences, error)) *UserRepository_GetNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTeamIDByUserID provides a mock function with given fields: ctx, userID
 func (_m *UserRepository) GetTeamIDByUserID(ctx context.Context, userID string) (uuid.UUID, error) {
 	ret := _m.Called(ctx, userID)
@@ -189,6 +248,126 @@ func (_c *UserRepository_GetUserByID_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetVCSIdentity provides a mock function with given fields: ctx, provider, login
+func (_m *UserRepository) GetVCSIdentity(ctx context.Context, provider models.VCSProvider, login string) (*models.VCSIdentity, error) {
+	ret := _m.Called(ctx, provider, login)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVCSIdentity")
+	}
+
+	var r0 *models.VCSIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.VCSProvider, string) (*models.VCSIdentity, error)); ok {
+		return rf(ctx, provider, login)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.VCSProvider, string) *models.VCSIdentity); ok {
+		r0 = rf(ctx, provider, login)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VCSIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.VCSProvider, string) error); ok {
+		r1 = rf(ctx, provider, login)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetVCSIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVCSIdentity'
+type UserRepository_GetVCSIdentity_Call struct {
+	*mock.Call
+}
+
+// GetVCSIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider models.VCSProvider
+//   - login string
+func (_e *UserRepository_Expecter) GetVCSIdentity(ctx interface{}, provider interface{}, login interface{}) *UserRepository_GetVCSIdentity_Call {
+	return &UserRepository_GetVCSIdentity_Call{Call: _e.mock.On("GetVCSIdentity", ctx, provider, login)}
+}
+
+func (_c *UserRepository_GetVCSIdentity_Call) Run(run func(ctx context.Context, provider models.VCSProvider, login string)) *UserRepository_GetVCSIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.VCSProvider), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetVCSIdentity_Call) Return(_a0 *models.VCSIdentity, _a1 error) *UserRepository_GetVCSIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetVCSIdentity_Call) RunAndReturn(run func(context.Context, models.VCSProvider, string) (*models.VCSIdentity, error)) *UserRepository_GetVCSIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVCSLogins provides a mock function with given fields: ctx, provider, userIDs
+func (_m *UserRepository) GetVCSLogins(ctx context.Context, provider models.VCSProvider, userIDs []string) (map[string]string, error) {
+	ret := _m.Called(ctx, provider, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVCSLogins")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.VCSProvider, []string) (map[string]string, error)); ok {
+		return rf(ctx, provider, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.VCSProvider, []string) map[string]string); ok {
+		r0 = rf(ctx, provider, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.VCSProvider, []string) error); ok {
+		r1 = rf(ctx, provider, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetVCSLogins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVCSLogins'
+type UserRepository_GetVCSLogins_Call struct {
+	*mock.Call
+}
+
+// GetVCSLogins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider models.VCSProvider
+//   - userIDs []string
+func (_e *UserRepository_Expecter) GetVCSLogins(ctx interface{}, provider interface{}, userIDs interface{}) *UserRepository_GetVCSLogins_Call {
+	return &UserRepository_GetVCSLogins_Call{Call: _e.mock.On("GetVCSLogins", ctx, provider, userIDs)}
+}
+
+func (_c *UserRepository_GetVCSLogins_Call) Run(run func(ctx context.Context, provider models.VCSProvider, userIDs []string)) *UserRepository_GetVCSLogins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.VCSProvider), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetVCSLogins_Call) Return(_a0 map[string]string, _a1 error) *UserRepository_GetVCSLogins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetVCSLogins_Call) RunAndReturn(run func(context.Context, models.VCSProvider, []string) (map[string]string, error)) *UserRepository_GetVCSLogins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListActiveMembersByTeamID provides a mock function with given fields: ctx, teamID
 func (_m *UserRepository) ListActiveMembersByTeamID(ctx context.Context, teamID uuid.UUID) ([]string, error) {
 	ret := _m.Called(ctx, teamID)
@@ -248,6 +427,64 @@ func (_c *UserRepository_ListActiveMembersByTeamID_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// ListEmailDigestRecipients provides a mock function with given fields: ctx
+func (_m *UserRepository) ListEmailDigestRecipients(ctx context.Context) ([]*models.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmailDigestRecipients")
+	}
+
+	var r0 []*models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_ListEmailDigestRecipients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmailDigestRecipients'
+type UserRepository_ListEmailDigestRecipients_Call struct {
+	*mock.Call
+}
+
+// ListEmailDigestRecipients is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserRepository_Expecter) ListEmailDigestRecipients(ctx interface{}) *UserRepository_ListEmailDigestRecipients_Call {
+	return &UserRepository_ListEmailDigestRecipients_Call{Call: _e.mock.On("ListEmailDigestRecipients", ctx)}
+}
+
+func (_c *UserRepository_ListEmailDigestRecipients_Call) Run(run func(ctx context.Context)) *UserRepository_ListEmailDigestRecipients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserRepository_ListEmailDigestRecipients_Call) Return(_a0 []*models.User, _a1 error) *UserRepository_ListEmailDigestRecipients_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_ListEmailDigestRecipients_Call) RunAndReturn(run func(context.Context) ([]*models.User, error)) *UserRepository_ListEmailDigestRecipients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListMembersByTeamID provides a mock function with given fields: ctx, teamID
 func (_m *UserRepository) ListMembersByTeamID(ctx context.Context, teamID uuid.UUID) ([]*models.User, error) {
 	ret := _m.Called(ctx, teamID)
@@ -365,6 +602,314 @@ func (_c *UserRepository_ListUsers_Call) RunAndReturn(run func(context.Context)
 	return _c
 }
 
+// ListUsersByIDs provides a mock function with given fields: ctx, ids
+func (_m *UserRepository) ListUsersByIDs(ctx context.Context, ids []string) ([]*models.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsersByIDs")
+	}
+
+	var r0 []*models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*models.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*models.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_ListUsersByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersByIDs'
+type UserRepository_ListUsersByIDs_Call struct {
+	*mock.Call
+}
+
+// ListUsersByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *UserRepository_Expecter) ListUsersByIDs(ctx interface{}, ids interface{}) *UserRepository_ListUsersByIDs_Call {
+	return &UserRepository_ListUsersByIDs_Call{Call: _e.mock.On("ListUsersByIDs", ctx, ids)}
+}
+
+func (_c *UserRepository_ListUsersByIDs_Call) Run(run func(ctx context.Context, ids []string)) *UserRepository_ListUsersByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_ListUsersByIDs_Call) Return(_a0 []*models.User, _a1 error) *UserRepository_ListUsersByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_ListUsersByIDs_Call) RunAndReturn(run func(context.Context, []string) ([]*models.User, error)) *UserRepository_ListUsersByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListVCSIdentities provides a mock function with given fields: ctx, provider
+func (_m *UserRepository) ListVCSIdentities(ctx context.Context, provider models.VCSProvider) ([]*models.VCSIdentity, error) {
+	ret := _m.Called(ctx, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListVCSIdentities")
+	}
+
+	var r0 []*models.VCSIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.VCSProvider) ([]*models.VCSIdentity, error)); ok {
+		return rf(ctx, provider)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.VCSProvider) []*models.VCSIdentity); ok {
+		r0 = rf(ctx, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.VCSIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.VCSProvider) error); ok {
+		r1 = rf(ctx, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_ListVCSIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListVCSIdentities'
+type UserRepository_ListVCSIdentities_Call struct {
+	*mock.Call
+}
+
+// ListVCSIdentities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider models.VCSProvider
+func (_e *UserRepository_Expecter) ListVCSIdentities(ctx interface{}, provider interface{}) *UserRepository_ListVCSIdentities_Call {
+	return &UserRepository_ListVCSIdentities_Call{Call: _e.mock.On("ListVCSIdentities", ctx, provider)}
+}
+
+func (_c *UserRepository_ListVCSIdentities_Call) Run(run func(ctx context.Context, provider models.VCSProvider)) *UserRepository_ListVCSIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.VCSProvider))
+	})
+	return _c
+}
+
+func (_c *UserRepository_ListVCSIdentities_Call) Return(_a0 []*models.VCSIdentity, _a1 error) *UserRepository_ListVCSIdentities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_ListVCSIdentities_Call) RunAndReturn(run func(context.Context, models.VCSProvider) ([]*models.VCSIdentity, error)) *UserRepository_ListVCSIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetNotificationPreferences provides a mock function with given fields: ctx, prefs
+func (_m *UserRepository) SetNotificationPreferences(ctx context.Context, prefs *models.NotificationPreferences) error {
+	ret := _m.Called(ctx, prefs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNotificationPreferences")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.NotificationPreferences) error); ok {
+		r0 = rf(ctx, prefs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_SetNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetNotificationPreferences'
+type UserRepository_SetNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// SetNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefs *models.NotificationPreferences
+func (_e *UserRepository_Expecter) SetNotificationPreferences(ctx interface{}, prefs interface{}) *UserRepository_SetNotificationPreferences_Call {
+	return &UserRepository_SetNotificationPreferences_Call{Call: _e.mock.On("SetNotificationPreferences", ctx, prefs)}
+}
+
+func (_c *UserRepository_SetNotificationPreferences_Call) Run(run func(ctx context.Context, prefs *models.NotificationPreferences)) *UserRepository_SetNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.NotificationPreferences))
+	})
+	return _c
+}
+
+func (_c *UserRepository_SetNotificationPreferences_Call) Return(_a0 error) *UserRepository_SetNotificationPreferences_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_SetNotificationPreferences_Call) RunAndReturn(run func(context.Context, *models.NotificationPreferences) error) *UserRepository_SetNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserEmail provides a mock function with given fields: ctx, id, email
+func (_m *UserRepository) SetUserEmail(ctx context.Context, id string, email string) error {
+	ret := _m.Called(ctx, id, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_SetUserEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserEmail'
+type UserRepository_SetUserEmail_Call struct {
+	*mock.Call
+}
+
+// SetUserEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - email string
+func (_e *UserRepository_Expecter) SetUserEmail(ctx interface{}, id interface{}, email interface{}) *UserRepository_SetUserEmail_Call {
+	return &UserRepository_SetUserEmail_Call{Call: _e.mock.On("SetUserEmail", ctx, id, email)}
+}
+
+func (_c *UserRepository_SetUserEmail_Call) Run(run func(ctx context.Context, id string, email string)) *UserRepository_SetUserEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_SetUserEmail_Call) Return(_a0 error) *UserRepository_SetUserEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_SetUserEmail_Call) RunAndReturn(run func(context.Context, string, string) error) *UserRepository_SetUserEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserTags provides a mock function with given fields: ctx, userID, tags
+func (_m *UserRepository) SetUserTags(ctx context.Context, userID string, tags []string) error {
+	ret := _m.Called(ctx, userID, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, userID, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_SetUserTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserTags'
+type UserRepository_SetUserTags_Call struct {
+	*mock.Call
+}
+
+// SetUserTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - tags []string
+func (_e *UserRepository_Expecter) SetUserTags(ctx interface{}, userID interface{}, tags interface{}) *UserRepository_SetUserTags_Call {
+	return &UserRepository_SetUserTags_Call{Call: _e.mock.On("SetUserTags", ctx, userID, tags)}
+}
+
+func (_c *UserRepository_SetUserTags_Call) Run(run func(ctx context.Context, userID string, tags []string)) *UserRepository_SetUserTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_SetUserTags_Call) Return(_a0 error) *UserRepository_SetUserTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_SetUserTags_Call) RunAndReturn(run func(context.Context, string, []string) error) *UserRepository_SetUserTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetVCSIdentity provides a mock function with given fields: ctx, identity
+func (_m *UserRepository) SetVCSIdentity(ctx context.Context, identity *models.VCSIdentity) error {
+	ret := _m.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetVCSIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.VCSIdentity) error); ok {
+		r0 = rf(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_SetVCSIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVCSIdentity'
+type UserRepository_SetVCSIdentity_Call struct {
+	*mock.Call
+}
+
+// SetVCSIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity *models.VCSIdentity
+func (_e *UserRepository_Expecter) SetVCSIdentity(ctx interface{}, identity interface{}) *UserRepository_SetVCSIdentity_Call {
+	return &UserRepository_SetVCSIdentity_Call{Call: _e.mock.On("SetVCSIdentity", ctx, identity)}
+}
+
+func (_c *UserRepository_SetVCSIdentity_Call) Run(run func(ctx context.Context, identity *models.VCSIdentity)) *UserRepository_SetVCSIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.VCSIdentity))
+	})
+	return _c
+}
+
+func (_c *UserRepository_SetVCSIdentity_Call) Return(_a0 error) *UserRepository_SetVCSIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_SetVCSIdentity_Call) RunAndReturn(run func(context.Context, *models.VCSIdentity) error) *UserRepository_SetVCSIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUserActive provides a mock function with given fields: ctx, id, isActive
 func (_m *UserRepository) UpdateUserActive(ctx context.Context, id string, isActive bool) error {
 	ret := _m.Called(ctx, id, isActive)
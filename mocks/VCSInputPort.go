@@ -0,0 +1,215 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "avito-test-pr-service/internal/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// VCSInputPort is an autogenerated mock type for the VCSInputPort type
+type VCSInputPort struct {
+	mock.Mock
+}
+
+type VCSInputPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VCSInputPort) EXPECT() *VCSInputPort_Expecter {
+	return &VCSInputPort_Expecter{mock: &_m.Mock}
+}
+
+// HandlePullRequestEvent provides a mock function with given fields: ctx, event
+func (_m *VCSInputPort) HandlePullRequestEvent(ctx context.Context, event *models.VCSPullRequestEvent) (*models.VCSEventResult, error) {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandlePullRequestEvent")
+	}
+
+	var r0 *models.VCSEventResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.VCSPullRequestEvent) (*models.VCSEventResult, error)); ok {
+		return rf(ctx, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.VCSPullRequestEvent) *models.VCSEventResult); ok {
+		r0 = rf(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VCSEventResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.VCSPullRequestEvent) error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VCSInputPort_HandlePullRequestEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandlePullRequestEvent'
+type VCSInputPort_HandlePullRequestEvent_Call struct {
+	*mock.Call
+}
+
+// HandlePullRequestEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *models.VCSPullRequestEvent
+func (_e *VCSInputPort_Expecter) HandlePullRequestEvent(ctx interface{}, event interface{}) *VCSInputPort_HandlePullRequestEvent_Call {
+	return &VCSInputPort_HandlePullRequestEvent_Call{Call: _e.mock.On("HandlePullRequestEvent", ctx, event)}
+}
+
+func (_c *VCSInputPort_HandlePullRequestEvent_Call) Run(run func(ctx context.Context, event *models.VCSPullRequestEvent)) *VCSInputPort_HandlePullRequestEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.VCSPullRequestEvent))
+	})
+	return _c
+}
+
+func (_c *VCSInputPort_HandlePullRequestEvent_Call) Return(_a0 *models.VCSEventResult, _a1 error) *VCSInputPort_HandlePullRequestEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VCSInputPort_HandlePullRequestEvent_Call) RunAndReturn(run func(context.Context, *models.VCSPullRequestEvent) (*models.VCSEventResult, error)) *VCSInputPort_HandlePullRequestEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIdentities provides a mock function with given fields: ctx, provider
+func (_m *VCSInputPort) ListIdentities(ctx context.Context, provider models.VCSProvider) ([]*models.VCSIdentity, error) {
+	ret := _m.Called(ctx, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIdentities")
+	}
+
+	var r0 []*models.VCSIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.VCSProvider) ([]*models.VCSIdentity, error)); ok {
+		return rf(ctx, provider)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.VCSProvider) []*models.VCSIdentity); ok {
+		r0 = rf(ctx, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.VCSIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.VCSProvider) error); ok {
+		r1 = rf(ctx, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VCSInputPort_ListIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdentities'
+type VCSInputPort_ListIdentities_Call struct {
+	*mock.Call
+}
+
+// ListIdentities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider models.VCSProvider
+func (_e *VCSInputPort_Expecter) ListIdentities(ctx interface{}, provider interface{}) *VCSInputPort_ListIdentities_Call {
+	return &VCSInputPort_ListIdentities_Call{Call: _e.mock.On("ListIdentities", ctx, provider)}
+}
+
+func (_c *VCSInputPort_ListIdentities_Call) Run(run func(ctx context.Context, provider models.VCSProvider)) *VCSInputPort_ListIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.VCSProvider))
+	})
+	return _c
+}
+
+func (_c *VCSInputPort_ListIdentities_Call) Return(_a0 []*models.VCSIdentity, _a1 error) *VCSInputPort_ListIdentities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VCSInputPort_ListIdentities_Call) RunAndReturn(run func(context.Context, models.VCSProvider) ([]*models.VCSIdentity, error)) *VCSInputPort_ListIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetIdentities provides a mock function with given fields: ctx, identities
+func (_m *VCSInputPort) SetIdentities(ctx context.Context, identities []*models.VCSIdentity) ([]*models.VCSIdentity, error) {
+	ret := _m.Called(ctx, identities)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetIdentities")
+	}
+
+	var r0 []*models.VCSIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.VCSIdentity) ([]*models.VCSIdentity, error)); ok {
+		return rf(ctx, identities)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.VCSIdentity) []*models.VCSIdentity); ok {
+		r0 = rf(ctx, identities)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.VCSIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*models.VCSIdentity) error); ok {
+		r1 = rf(ctx, identities)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VCSInputPort_SetIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetIdentities'
+type VCSInputPort_SetIdentities_Call struct {
+	*mock.Call
+}
+
+// SetIdentities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identities []*models.VCSIdentity
+func (_e *VCSInputPort_Expecter) SetIdentities(ctx interface{}, identities interface{}) *VCSInputPort_SetIdentities_Call {
+	return &VCSInputPort_SetIdentities_Call{Call: _e.mock.On("SetIdentities", ctx, identities)}
+}
+
+func (_c *VCSInputPort_SetIdentities_Call) Run(run func(ctx context.Context, identities []*models.VCSIdentity)) *VCSInputPort_SetIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.VCSIdentity))
+	})
+	return _c
+}
+
+func (_c *VCSInputPort_SetIdentities_Call) Return(_a0 []*models.VCSIdentity, _a1 error) *VCSInputPort_SetIdentities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VCSInputPort_SetIdentities_Call) RunAndReturn(run func(context.Context, []*models.VCSIdentity) ([]*models.VCSIdentity, error)) *VCSInputPort_SetIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewVCSInputPort creates a new instance of VCSInputPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVCSInputPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VCSInputPort {
+	mock := &VCSInputPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
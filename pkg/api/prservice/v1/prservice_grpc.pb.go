@@ -0,0 +1,1662 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/proto/prservice/v1/prservice.proto
+
+// gRPC API сервиса назначения ревьюверов. Методы повторяют входные порты
+// PRInputPort, TeamInputPort и UserInputPort; ошибки домена возвращаются статусами gRPC
+// с google.rpc.ErrorInfo, reason которого совпадает с кодом ошибки HTTP API (PR_MERGED, NOT_FOUND и т.д.).
+// Инициатор изменения передаётся в метаданных x-actor-id и попадает в журнал аудита.
+
+package prservicev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PullRequestService_CreatePR_FullMethodName           = "/prservice.v1.PullRequestService/CreatePR"
+	PullRequestService_BatchCreatePRs_FullMethodName     = "/prservice.v1.PullRequestService/BatchCreatePRs"
+	PullRequestService_ReassignReviewer_FullMethodName   = "/prservice.v1.PullRequestService/ReassignReviewer"
+	PullRequestService_AddReviewer_FullMethodName        = "/prservice.v1.PullRequestService/AddReviewer"
+	PullRequestService_RemoveReviewer_FullMethodName     = "/prservice.v1.PullRequestService/RemoveReviewer"
+	PullRequestService_MergePR_FullMethodName            = "/prservice.v1.PullRequestService/MergePR"
+	PullRequestService_ClosePR_FullMethodName            = "/prservice.v1.PullRequestService/ClosePR"
+	PullRequestService_ReopenPR_FullMethodName           = "/prservice.v1.PullRequestService/ReopenPR"
+	PullRequestService_GetPR_FullMethodName              = "/prservice.v1.PullRequestService/GetPR"
+	PullRequestService_ListPRsByAssignee_FullMethodName  = "/prservice.v1.PullRequestService/ListPRsByAssignee"
+	PullRequestService_GetReviewerHistory_FullMethodName = "/prservice.v1.PullRequestService/GetReviewerHistory"
+	PullRequestService_ExplainSelection_FullMethodName   = "/prservice.v1.PullRequestService/ExplainSelection"
+	PullRequestService_ListPRs_FullMethodName            = "/prservice.v1.PullRequestService/ListPRs"
+)
+
+// PullRequestServiceClient is the client API for PullRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PullRequestServiceClient interface {
+	CreatePR(ctx context.Context, in *CreatePRRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	BatchCreatePRs(ctx context.Context, in *BatchCreatePRsRequest, opts ...grpc.CallOption) (*BatchCreatePRsResponse, error)
+	ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	AddReviewer(ctx context.Context, in *ReviewerChangeRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	RemoveReviewer(ctx context.Context, in *ReviewerChangeRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	MergePR(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	ClosePR(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	ReopenPR(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	GetPR(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	ListPRsByAssignee(ctx context.Context, in *ListPRsByAssigneeRequest, opts ...grpc.CallOption) (*PullRequestList, error)
+	GetReviewerHistory(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*ReviewerHistory, error)
+	ExplainSelection(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*SelectionExplanations, error)
+	ListPRs(ctx context.Context, in *ListPRsRequest, opts ...grpc.CallOption) (*ListPRsResponse, error)
+}
+
+type pullRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPullRequestServiceClient(cc grpc.ClientConnInterface) PullRequestServiceClient {
+	return &pullRequestServiceClient{cc}
+}
+
+func (c *pullRequestServiceClient) CreatePR(ctx context.Context, in *CreatePRRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_CreatePR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) BatchCreatePRs(ctx context.Context, in *BatchCreatePRsRequest, opts ...grpc.CallOption) (*BatchCreatePRsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreatePRsResponse)
+	err := c.cc.Invoke(ctx, PullRequestService_BatchCreatePRs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_ReassignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) AddReviewer(ctx context.Context, in *ReviewerChangeRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_AddReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) RemoveReviewer(ctx context.Context, in *ReviewerChangeRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_RemoveReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) MergePR(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_MergePR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) ClosePR(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_ClosePR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) ReopenPR(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_ReopenPR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) GetPR(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_GetPR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) ListPRsByAssignee(ctx context.Context, in *ListPRsByAssigneeRequest, opts ...grpc.CallOption) (*PullRequestList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestList)
+	err := c.cc.Invoke(ctx, PullRequestService_ListPRsByAssignee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) GetReviewerHistory(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*ReviewerHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewerHistory)
+	err := c.cc.Invoke(ctx, PullRequestService_GetReviewerHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) ExplainSelection(ctx context.Context, in *PullRequestIDRequest, opts ...grpc.CallOption) (*SelectionExplanations, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectionExplanations)
+	err := c.cc.Invoke(ctx, PullRequestService_ExplainSelection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) ListPRs(ctx context.Context, in *ListPRsRequest, opts ...grpc.CallOption) (*ListPRsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPRsResponse)
+	err := c.cc.Invoke(ctx, PullRequestService_ListPRs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PullRequestServiceServer is the server API for PullRequestService service.
+// All implementations must embed UnimplementedPullRequestServiceServer
+// for forward compatibility.
+type PullRequestServiceServer interface {
+	CreatePR(context.Context, *CreatePRRequest) (*PullRequest, error)
+	BatchCreatePRs(context.Context, *BatchCreatePRsRequest) (*BatchCreatePRsResponse, error)
+	ReassignReviewer(context.Context, *ReassignReviewerRequest) (*PullRequest, error)
+	AddReviewer(context.Context, *ReviewerChangeRequest) (*PullRequest, error)
+	RemoveReviewer(context.Context, *ReviewerChangeRequest) (*PullRequest, error)
+	MergePR(context.Context, *PullRequestIDRequest) (*PullRequest, error)
+	ClosePR(context.Context, *PullRequestIDRequest) (*PullRequest, error)
+	ReopenPR(context.Context, *PullRequestIDRequest) (*PullRequest, error)
+	GetPR(context.Context, *PullRequestIDRequest) (*PullRequest, error)
+	ListPRsByAssignee(context.Context, *ListPRsByAssigneeRequest) (*PullRequestList, error)
+	GetReviewerHistory(context.Context, *PullRequestIDRequest) (*ReviewerHistory, error)
+	ExplainSelection(context.Context, *PullRequestIDRequest) (*SelectionExplanations, error)
+	ListPRs(context.Context, *ListPRsRequest) (*ListPRsResponse, error)
+	mustEmbedUnimplementedPullRequestServiceServer()
+}
+
+// UnimplementedPullRequestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPullRequestServiceServer struct{}
+
+func (UnimplementedPullRequestServiceServer) CreatePR(context.Context, *CreatePRRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePR not implemented")
+}
+func (UnimplementedPullRequestServiceServer) BatchCreatePRs(context.Context, *BatchCreatePRsRequest) (*BatchCreatePRsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreatePRs not implemented")
+}
+func (UnimplementedPullRequestServiceServer) ReassignReviewer(context.Context, *ReassignReviewerRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignReviewer not implemented")
+}
+func (UnimplementedPullRequestServiceServer) AddReviewer(context.Context, *ReviewerChangeRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReviewer not implemented")
+}
+func (UnimplementedPullRequestServiceServer) RemoveReviewer(context.Context, *ReviewerChangeRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReviewer not implemented")
+}
+func (UnimplementedPullRequestServiceServer) MergePR(context.Context, *PullRequestIDRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePR not implemented")
+}
+func (UnimplementedPullRequestServiceServer) ClosePR(context.Context, *PullRequestIDRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePR not implemented")
+}
+func (UnimplementedPullRequestServiceServer) ReopenPR(context.Context, *PullRequestIDRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenPR not implemented")
+}
+func (UnimplementedPullRequestServiceServer) GetPR(context.Context, *PullRequestIDRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPR not implemented")
+}
+func (UnimplementedPullRequestServiceServer) ListPRsByAssignee(context.Context, *ListPRsByAssigneeRequest) (*PullRequestList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPRsByAssignee not implemented")
+}
+func (UnimplementedPullRequestServiceServer) GetReviewerHistory(context.Context, *PullRequestIDRequest) (*ReviewerHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewerHistory not implemented")
+}
+func (UnimplementedPullRequestServiceServer) ExplainSelection(context.Context, *PullRequestIDRequest) (*SelectionExplanations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainSelection not implemented")
+}
+func (UnimplementedPullRequestServiceServer) ListPRs(context.Context, *ListPRsRequest) (*ListPRsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPRs not implemented")
+}
+func (UnimplementedPullRequestServiceServer) mustEmbedUnimplementedPullRequestServiceServer() {}
+func (UnimplementedPullRequestServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafePullRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PullRequestServiceServer will
+// result in compilation errors.
+type UnsafePullRequestServiceServer interface {
+	mustEmbedUnimplementedPullRequestServiceServer()
+}
+
+func RegisterPullRequestServiceServer(s grpc.ServiceRegistrar, srv PullRequestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPullRequestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PullRequestService_ServiceDesc, srv)
+}
+
+func _PullRequestService_CreatePR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).CreatePR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_CreatePR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).CreatePR(ctx, req.(*CreatePRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_BatchCreatePRs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreatePRsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).BatchCreatePRs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_BatchCreatePRs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).BatchCreatePRs(ctx, req.(*BatchCreatePRsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_ReassignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).ReassignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_ReassignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).ReassignReviewer(ctx, req.(*ReassignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_AddReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewerChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).AddReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_AddReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).AddReviewer(ctx, req.(*ReviewerChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_RemoveReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewerChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).RemoveReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_RemoveReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).RemoveReviewer(ctx, req.(*ReviewerChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_MergePR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequestIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).MergePR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_MergePR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).MergePR(ctx, req.(*PullRequestIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_ClosePR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequestIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).ClosePR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_ClosePR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).ClosePR(ctx, req.(*PullRequestIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_ReopenPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequestIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).ReopenPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_ReopenPR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).ReopenPR(ctx, req.(*PullRequestIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_GetPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequestIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).GetPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_GetPR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).GetPR(ctx, req.(*PullRequestIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_ListPRsByAssignee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPRsByAssigneeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).ListPRsByAssignee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_ListPRsByAssignee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).ListPRsByAssignee(ctx, req.(*ListPRsByAssigneeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_GetReviewerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequestIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).GetReviewerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_GetReviewerHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).GetReviewerHistory(ctx, req.(*PullRequestIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_ExplainSelection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequestIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).ExplainSelection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_ExplainSelection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).ExplainSelection(ctx, req.(*PullRequestIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_ListPRs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPRsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).ListPRs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_ListPRs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).ListPRs(ctx, req.(*ListPRsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PullRequestService_ServiceDesc is the grpc.ServiceDesc for PullRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PullRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prservice.v1.PullRequestService",
+	HandlerType: (*PullRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePR",
+			Handler:    _PullRequestService_CreatePR_Handler,
+		},
+		{
+			MethodName: "BatchCreatePRs",
+			Handler:    _PullRequestService_BatchCreatePRs_Handler,
+		},
+		{
+			MethodName: "ReassignReviewer",
+			Handler:    _PullRequestService_ReassignReviewer_Handler,
+		},
+		{
+			MethodName: "AddReviewer",
+			Handler:    _PullRequestService_AddReviewer_Handler,
+		},
+		{
+			MethodName: "RemoveReviewer",
+			Handler:    _PullRequestService_RemoveReviewer_Handler,
+		},
+		{
+			MethodName: "MergePR",
+			Handler:    _PullRequestService_MergePR_Handler,
+		},
+		{
+			MethodName: "ClosePR",
+			Handler:    _PullRequestService_ClosePR_Handler,
+		},
+		{
+			MethodName: "ReopenPR",
+			Handler:    _PullRequestService_ReopenPR_Handler,
+		},
+		{
+			MethodName: "GetPR",
+			Handler:    _PullRequestService_GetPR_Handler,
+		},
+		{
+			MethodName: "ListPRsByAssignee",
+			Handler:    _PullRequestService_ListPRsByAssignee_Handler,
+		},
+		{
+			MethodName: "GetReviewerHistory",
+			Handler:    _PullRequestService_GetReviewerHistory_Handler,
+		},
+		{
+			MethodName: "ExplainSelection",
+			Handler:    _PullRequestService_ExplainSelection_Handler,
+		},
+		{
+			MethodName: "ListPRs",
+			Handler:    _PullRequestService_ListPRs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/prservice/v1/prservice.proto",
+}
+
+const (
+	TeamService_CreateTeam_FullMethodName             = "/prservice.v1.TeamService/CreateTeam"
+	TeamService_CreateTeamWithMembers_FullMethodName  = "/prservice.v1.TeamService/CreateTeamWithMembers"
+	TeamService_AddMember_FullMethodName              = "/prservice.v1.TeamService/AddMember"
+	TeamService_RemoveMember_FullMethodName           = "/prservice.v1.TeamService/RemoveMember"
+	TeamService_GetTeam_FullMethodName                = "/prservice.v1.TeamService/GetTeam"
+	TeamService_GetTeamByName_FullMethodName          = "/prservice.v1.TeamService/GetTeamByName"
+	TeamService_ListTeams_FullMethodName              = "/prservice.v1.TeamService/ListTeams"
+	TeamService_SetCodeOwners_FullMethodName          = "/prservice.v1.TeamService/SetCodeOwners"
+	TeamService_GetCodeOwners_FullMethodName          = "/prservice.v1.TeamService/GetCodeOwners"
+	TeamService_SetReviewerConstraints_FullMethodName = "/prservice.v1.TeamService/SetReviewerConstraints"
+	TeamService_GetReviewerConstraints_FullMethodName = "/prservice.v1.TeamService/GetReviewerConstraints"
+	TeamService_SetSelectionStrategy_FullMethodName   = "/prservice.v1.TeamService/SetSelectionStrategy"
+	TeamService_ExportRoster_FullMethodName           = "/prservice.v1.TeamService/ExportRoster"
+	TeamService_ImportRoster_FullMethodName           = "/prservice.v1.TeamService/ImportRoster"
+	TeamService_SyncTeam_FullMethodName               = "/prservice.v1.TeamService/SyncTeam"
+)
+
+// TeamServiceClient is the client API for TeamService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TeamServiceClient interface {
+	CreateTeam(ctx context.Context, in *TeamNameRequest, opts ...grpc.CallOption) (*Team, error)
+	CreateTeamWithMembers(ctx context.Context, in *CreateTeamWithMembersRequest, opts ...grpc.CallOption) (*CreateTeamWithMembersResponse, error)
+	AddMember(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*Empty, error)
+	RemoveMember(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetTeam(ctx context.Context, in *TeamIDRequest, opts ...grpc.CallOption) (*Team, error)
+	GetTeamByName(ctx context.Context, in *TeamNameRequest, opts ...grpc.CallOption) (*Team, error)
+	ListTeams(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TeamList, error)
+	SetCodeOwners(ctx context.Context, in *SetCodeOwnersRequest, opts ...grpc.CallOption) (*CodeOwners, error)
+	GetCodeOwners(ctx context.Context, in *TeamNameRequest, opts ...grpc.CallOption) (*CodeOwners, error)
+	SetReviewerConstraints(ctx context.Context, in *ReviewerConstraints, opts ...grpc.CallOption) (*ReviewerConstraints, error)
+	GetReviewerConstraints(ctx context.Context, in *TeamNameRequest, opts ...grpc.CallOption) (*ReviewerConstraints, error)
+	SetSelectionStrategy(ctx context.Context, in *SetSelectionStrategyRequest, opts ...grpc.CallOption) (*Team, error)
+	ExportRoster(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Roster, error)
+	ImportRoster(ctx context.Context, in *ImportRosterRequest, opts ...grpc.CallOption) (*RosterDiff, error)
+	// Как PUT /team/sync: без dry_run открытые ревью удалённых участников переназначаются или снимаются.
+	SyncTeam(ctx context.Context, in *SyncTeamRequest, opts ...grpc.CallOption) (*SyncTeamResponse, error)
+}
+
+type teamServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTeamServiceClient(cc grpc.ClientConnInterface) TeamServiceClient {
+	return &teamServiceClient{cc}
+}
+
+func (c *teamServiceClient) CreateTeam(ctx context.Context, in *TeamNameRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) CreateTeamWithMembers(ctx context.Context, in *CreateTeamWithMembersRequest, opts ...grpc.CallOption) (*CreateTeamWithMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTeamWithMembersResponse)
+	err := c.cc.Invoke(ctx, TeamService_CreateTeamWithMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) AddMember(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TeamService_AddMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) RemoveMember(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TeamService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) GetTeam(ctx context.Context, in *TeamIDRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) GetTeamByName(ctx context.Context, in *TeamNameRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_GetTeamByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) ListTeams(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TeamList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamList)
+	err := c.cc.Invoke(ctx, TeamService_ListTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetCodeOwners(ctx context.Context, in *SetCodeOwnersRequest, opts ...grpc.CallOption) (*CodeOwners, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CodeOwners)
+	err := c.cc.Invoke(ctx, TeamService_SetCodeOwners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) GetCodeOwners(ctx context.Context, in *TeamNameRequest, opts ...grpc.CallOption) (*CodeOwners, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CodeOwners)
+	err := c.cc.Invoke(ctx, TeamService_GetCodeOwners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetReviewerConstraints(ctx context.Context, in *ReviewerConstraints, opts ...grpc.CallOption) (*ReviewerConstraints, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewerConstraints)
+	err := c.cc.Invoke(ctx, TeamService_SetReviewerConstraints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) GetReviewerConstraints(ctx context.Context, in *TeamNameRequest, opts ...grpc.CallOption) (*ReviewerConstraints, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewerConstraints)
+	err := c.cc.Invoke(ctx, TeamService_GetReviewerConstraints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetSelectionStrategy(ctx context.Context, in *SetSelectionStrategyRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_SetSelectionStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) ExportRoster(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Roster, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Roster)
+	err := c.cc.Invoke(ctx, TeamService_ExportRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) ImportRoster(ctx context.Context, in *ImportRosterRequest, opts ...grpc.CallOption) (*RosterDiff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RosterDiff)
+	err := c.cc.Invoke(ctx, TeamService_ImportRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SyncTeam(ctx context.Context, in *SyncTeamRequest, opts ...grpc.CallOption) (*SyncTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncTeamResponse)
+	err := c.cc.Invoke(ctx, TeamService_SyncTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TeamServiceServer is the server API for TeamService service.
+// All implementations must embed UnimplementedTeamServiceServer
+// for forward compatibility.
+type TeamServiceServer interface {
+	CreateTeam(context.Context, *TeamNameRequest) (*Team, error)
+	CreateTeamWithMembers(context.Context, *CreateTeamWithMembersRequest) (*CreateTeamWithMembersResponse, error)
+	AddMember(context.Context, *MemberRequest) (*Empty, error)
+	RemoveMember(context.Context, *MemberRequest) (*Empty, error)
+	GetTeam(context.Context, *TeamIDRequest) (*Team, error)
+	GetTeamByName(context.Context, *TeamNameRequest) (*Team, error)
+	ListTeams(context.Context, *Empty) (*TeamList, error)
+	SetCodeOwners(context.Context, *SetCodeOwnersRequest) (*CodeOwners, error)
+	GetCodeOwners(context.Context, *TeamNameRequest) (*CodeOwners, error)
+	SetReviewerConstraints(context.Context, *ReviewerConstraints) (*ReviewerConstraints, error)
+	GetReviewerConstraints(context.Context, *TeamNameRequest) (*ReviewerConstraints, error)
+	SetSelectionStrategy(context.Context, *SetSelectionStrategyRequest) (*Team, error)
+	ExportRoster(context.Context, *Empty) (*Roster, error)
+	ImportRoster(context.Context, *ImportRosterRequest) (*RosterDiff, error)
+	// Как PUT /team/sync: без dry_run открытые ревью удалённых участников переназначаются или снимаются.
+	SyncTeam(context.Context, *SyncTeamRequest) (*SyncTeamResponse, error)
+	mustEmbedUnimplementedTeamServiceServer()
+}
+
+// UnimplementedTeamServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTeamServiceServer struct{}
+
+func (UnimplementedTeamServiceServer) CreateTeam(context.Context, *TeamNameRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedTeamServiceServer) CreateTeamWithMembers(context.Context, *CreateTeamWithMembersRequest) (*CreateTeamWithMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamWithMembers not implemented")
+}
+func (UnimplementedTeamServiceServer) AddMember(context.Context, *MemberRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+}
+func (UnimplementedTeamServiceServer) RemoveMember(context.Context, *MemberRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedTeamServiceServer) GetTeam(context.Context, *TeamIDRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedTeamServiceServer) GetTeamByName(context.Context, *TeamNameRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamByName not implemented")
+}
+func (UnimplementedTeamServiceServer) ListTeams(context.Context, *Empty) (*TeamList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedTeamServiceServer) SetCodeOwners(context.Context, *SetCodeOwnersRequest) (*CodeOwners, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCodeOwners not implemented")
+}
+func (UnimplementedTeamServiceServer) GetCodeOwners(context.Context, *TeamNameRequest) (*CodeOwners, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCodeOwners not implemented")
+}
+func (UnimplementedTeamServiceServer) SetReviewerConstraints(context.Context, *ReviewerConstraints) (*ReviewerConstraints, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReviewerConstraints not implemented")
+}
+func (UnimplementedTeamServiceServer) GetReviewerConstraints(context.Context, *TeamNameRequest) (*ReviewerConstraints, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewerConstraints not implemented")
+}
+func (UnimplementedTeamServiceServer) SetSelectionStrategy(context.Context, *SetSelectionStrategyRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSelectionStrategy not implemented")
+}
+func (UnimplementedTeamServiceServer) ExportRoster(context.Context, *Empty) (*Roster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRoster not implemented")
+}
+func (UnimplementedTeamServiceServer) ImportRoster(context.Context, *ImportRosterRequest) (*RosterDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRoster not implemented")
+}
+func (UnimplementedTeamServiceServer) SyncTeam(context.Context, *SyncTeamRequest) (*SyncTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncTeam not implemented")
+}
+func (UnimplementedTeamServiceServer) mustEmbedUnimplementedTeamServiceServer() {}
+func (UnimplementedTeamServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTeamServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TeamServiceServer will
+// result in compilation errors.
+type UnsafeTeamServiceServer interface {
+	mustEmbedUnimplementedTeamServiceServer()
+}
+
+func RegisterTeamServiceServer(s grpc.ServiceRegistrar, srv TeamServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTeamServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TeamService_ServiceDesc, srv)
+}
+
+func _TeamService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).CreateTeam(ctx, req.(*TeamNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_CreateTeamWithMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamWithMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).CreateTeamWithMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_CreateTeamWithMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).CreateTeamWithMembers(ctx, req.(*CreateTeamWithMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).AddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_AddMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).AddMember(ctx, req.(*MemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).RemoveMember(ctx, req.(*MemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).GetTeam(ctx, req.(*TeamIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_GetTeamByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).GetTeamByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_GetTeamByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).GetTeamByName(ctx, req.(*TeamNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).ListTeams(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetCodeOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCodeOwnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetCodeOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetCodeOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetCodeOwners(ctx, req.(*SetCodeOwnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_GetCodeOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).GetCodeOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_GetCodeOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).GetCodeOwners(ctx, req.(*TeamNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetReviewerConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewerConstraints)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetReviewerConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetReviewerConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetReviewerConstraints(ctx, req.(*ReviewerConstraints))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_GetReviewerConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).GetReviewerConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_GetReviewerConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).GetReviewerConstraints(ctx, req.(*TeamNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetSelectionStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSelectionStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetSelectionStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetSelectionStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetSelectionStrategy(ctx, req.(*SetSelectionStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_ExportRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).ExportRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_ExportRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).ExportRoster(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_ImportRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).ImportRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_ImportRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).ImportRoster(ctx, req.(*ImportRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SyncTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SyncTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SyncTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SyncTeam(ctx, req.(*SyncTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TeamService_ServiceDesc is the grpc.ServiceDesc for TeamService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TeamService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prservice.v1.TeamService",
+	HandlerType: (*TeamServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTeam",
+			Handler:    _TeamService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "CreateTeamWithMembers",
+			Handler:    _TeamService_CreateTeamWithMembers_Handler,
+		},
+		{
+			MethodName: "AddMember",
+			Handler:    _TeamService_AddMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _TeamService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _TeamService_GetTeam_Handler,
+		},
+		{
+			MethodName: "GetTeamByName",
+			Handler:    _TeamService_GetTeamByName_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _TeamService_ListTeams_Handler,
+		},
+		{
+			MethodName: "SetCodeOwners",
+			Handler:    _TeamService_SetCodeOwners_Handler,
+		},
+		{
+			MethodName: "GetCodeOwners",
+			Handler:    _TeamService_GetCodeOwners_Handler,
+		},
+		{
+			MethodName: "SetReviewerConstraints",
+			Handler:    _TeamService_SetReviewerConstraints_Handler,
+		},
+		{
+			MethodName: "GetReviewerConstraints",
+			Handler:    _TeamService_GetReviewerConstraints_Handler,
+		},
+		{
+			MethodName: "SetSelectionStrategy",
+			Handler:    _TeamService_SetSelectionStrategy_Handler,
+		},
+		{
+			MethodName: "ExportRoster",
+			Handler:    _TeamService_ExportRoster_Handler,
+		},
+		{
+			MethodName: "ImportRoster",
+			Handler:    _TeamService_ImportRoster_Handler,
+		},
+		{
+			MethodName: "SyncTeam",
+			Handler:    _TeamService_SyncTeam_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/prservice/v1/prservice.proto",
+}
+
+const (
+	UserService_CreateUser_FullMethodName          = "/prservice.v1.UserService/CreateUser"
+	UserService_UpdateUserActive_FullMethodName    = "/prservice.v1.UserService/UpdateUserActive"
+	UserService_UpdateUserName_FullMethodName      = "/prservice.v1.UserService/UpdateUserName"
+	UserService_GetUser_FullMethodName             = "/prservice.v1.UserService/GetUser"
+	UserService_ListUsers_FullMethodName           = "/prservice.v1.UserService/ListUsers"
+	UserService_GetUserTeamName_FullMethodName     = "/prservice.v1.UserService/GetUserTeamName"
+	UserService_ListMembersByTeamID_FullMethodName = "/prservice.v1.UserService/ListMembersByTeamID"
+	UserService_ListUsersByIDs_FullMethodName      = "/prservice.v1.UserService/ListUsersByIDs"
+	UserService_SetUserTags_FullMethodName         = "/prservice.v1.UserService/SetUserTags"
+	UserService_SetUserEmail_FullMethodName        = "/prservice.v1.UserService/SetUserEmail"
+)
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserServiceClient interface {
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	UpdateUserActive(ctx context.Context, in *UpdateUserActiveRequest, opts ...grpc.CallOption) (*Empty, error)
+	UpdateUserName(ctx context.Context, in *UpdateUserNameRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetUser(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*User, error)
+	ListUsers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UserList, error)
+	GetUserTeamName(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*TeamNameResponse, error)
+	ListMembersByTeamID(ctx context.Context, in *TeamIDRequest, opts ...grpc.CallOption) (*UserList, error)
+	ListUsersByIDs(ctx context.Context, in *ListUsersByIDsRequest, opts ...grpc.CallOption) (*UserList, error)
+	SetUserTags(ctx context.Context, in *SetUserTagsRequest, opts ...grpc.CallOption) (*User, error)
+	SetUserEmail(ctx context.Context, in *SetUserEmailRequest, opts ...grpc.CallOption) (*User, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUserActive(ctx context.Context, in *UpdateUserActiveRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, UserService_UpdateUserActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUserName(ctx context.Context, in *UpdateUserNameRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, UserService_UpdateUserName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUser(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUsers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UserList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserList)
+	err := c.cc.Invoke(ctx, UserService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserTeamName(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*TeamNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamNameResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserTeamName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListMembersByTeamID(ctx context.Context, in *TeamIDRequest, opts ...grpc.CallOption) (*UserList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserList)
+	err := c.cc.Invoke(ctx, UserService_ListMembersByTeamID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUsersByIDs(ctx context.Context, in *ListUsersByIDsRequest, opts ...grpc.CallOption) (*UserList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserList)
+	err := c.cc.Invoke(ctx, UserService_ListUsersByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetUserTags(ctx context.Context, in *SetUserTagsRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_SetUserTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetUserEmail(ctx context.Context, in *SetUserEmailRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_SetUserEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility.
+type UserServiceServer interface {
+	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	UpdateUserActive(context.Context, *UpdateUserActiveRequest) (*Empty, error)
+	UpdateUserName(context.Context, *UpdateUserNameRequest) (*Empty, error)
+	GetUser(context.Context, *UserIDRequest) (*User, error)
+	ListUsers(context.Context, *Empty) (*UserList, error)
+	GetUserTeamName(context.Context, *UserIDRequest) (*TeamNameResponse, error)
+	ListMembersByTeamID(context.Context, *TeamIDRequest) (*UserList, error)
+	ListUsersByIDs(context.Context, *ListUsersByIDsRequest) (*UserList, error)
+	SetUserTags(context.Context, *SetUserTagsRequest) (*User, error)
+	SetUserEmail(context.Context, *SetUserEmailRequest) (*User, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserActive(context.Context, *UpdateUserActiveRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserActive not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserName(context.Context, *UpdateUserNameRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserName not implemented")
+}
+func (UnimplementedUserServiceServer) GetUser(context.Context, *UserIDRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(context.Context, *Empty) (*UserList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserTeamName(context.Context, *UserIDRequest) (*TeamNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTeamName not implemented")
+}
+func (UnimplementedUserServiceServer) ListMembersByTeamID(context.Context, *TeamIDRequest) (*UserList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembersByTeamID not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsersByIDs(context.Context, *ListUsersByIDsRequest) (*UserList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsersByIDs not implemented")
+}
+func (UnimplementedUserServiceServer) SetUserTags(context.Context, *SetUserTagsRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserTags not implemented")
+}
+func (UnimplementedUserServiceServer) SetUserEmail(context.Context, *SetUserEmailRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserEmail not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUserActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserActive(ctx, req.(*UpdateUserActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUserName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserName(ctx, req.(*UpdateUserNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUser(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserTeamName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserTeamName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserTeamName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserTeamName(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListMembersByTeamID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListMembersByTeamID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListMembersByTeamID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListMembersByTeamID(ctx, req.(*TeamIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsersByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsersByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsersByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsersByIDs(ctx, req.(*ListUsersByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetUserTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetUserTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetUserTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetUserTags(ctx, req.(*SetUserTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetUserEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetUserEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetUserEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetUserEmail(ctx, req.(*SetUserEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prservice.v1.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUserActive",
+			Handler:    _UserService_UpdateUserActive_Handler,
+		},
+		{
+			MethodName: "UpdateUserName",
+			Handler:    _UserService_UpdateUserName_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _UserService_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserService_ListUsers_Handler,
+		},
+		{
+			MethodName: "GetUserTeamName",
+			Handler:    _UserService_GetUserTeamName_Handler,
+		},
+		{
+			MethodName: "ListMembersByTeamID",
+			Handler:    _UserService_ListMembersByTeamID_Handler,
+		},
+		{
+			MethodName: "ListUsersByIDs",
+			Handler:    _UserService_ListUsersByIDs_Handler,
+		},
+		{
+			MethodName: "SetUserTags",
+			Handler:    _UserService_SetUserTags_Handler,
+		},
+		{
+			MethodName: "SetUserEmail",
+			Handler:    _UserService_SetUserEmail_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/prservice/v1/prservice.proto",
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Форматы выгрузки и загрузки состава команд.
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatCSV  = "csv"
+)
+
+var formatContentTypes = map[string]string{
+	FormatJSON: "application/json",
+	FormatYAML: "application/yaml",
+	FormatCSV:  "text/csv",
+}
+
+type RosterMember struct {
+	UserID   string `json:"user_id" yaml:"user_id"`
+	Username string `json:"username" yaml:"username"`
+	IsActive bool   `json:"is_active" yaml:"is_active"`
+}
+
+// TeamSettings — настройки выбора ревьюверов; при импорте nil оставляет настройки команды без изменений.
+type TeamSettings struct {
+	SelectionStrategy   string               `json:"selection_strategy" yaml:"selection_strategy"`
+	SelectionParams     json.RawMessage      `json:"selection_params,omitempty" yaml:"selection_params,omitempty"`
+	CodeOwners          []CodeOwnerRule      `json:"code_owners" yaml:"code_owners"`
+	ReviewerConstraints []ReviewerConstraint `json:"reviewer_constraints" yaml:"reviewer_constraints"`
+}
+
+type RosterTeam struct {
+	TeamName string         `json:"team_name" yaml:"team_name"`
+	Members  []RosterMember `json:"members" yaml:"members"`
+	Settings *TeamSettings  `json:"settings,omitempty" yaml:"settings,omitempty"`
+}
+
+type Roster struct {
+	Teams []RosterTeam `json:"teams" yaml:"teams"`
+}
+
+type UserRename struct {
+	UserID string `json:"user_id" yaml:"user_id"`
+	From   string `json:"from" yaml:"from"`
+	To     string `json:"to" yaml:"to"`
+}
+
+type Membership struct {
+	TeamName string `json:"team_name" yaml:"team_name"`
+	UserID   string `json:"user_id" yaml:"user_id"`
+}
+
+type SettingsChange struct {
+	TeamName string   `json:"team_name" yaml:"team_name"`
+	Fields   []string `json:"fields" yaml:"fields"`
+}
+
+type RosterDiff struct {
+	CreateTeams       []string         `json:"create_teams" yaml:"create_teams"`
+	CreateUsers       []TeamMember     `json:"create_users" yaml:"create_users"`
+	RenameUsers       []UserRename     `json:"rename_users" yaml:"rename_users"`
+	ActivateUsers     []string         `json:"activate_users" yaml:"activate_users"`
+	DeactivateUsers   []string         `json:"deactivate_users" yaml:"deactivate_users"`
+	AddMemberships    []Membership     `json:"add_memberships" yaml:"add_memberships"`
+	RemoveMemberships []Membership     `json:"remove_memberships" yaml:"remove_memberships"`
+	UpdateSettings    []SettingsChange `json:"update_settings" yaml:"update_settings"`
+}
+
+type ImportResult struct {
+	DryRun bool       `json:"dry_run" yaml:"dry_run"`
+	Diff   RosterDiff `json:"diff" yaml:"diff"`
+}
+
+func (c *Client) ExportRoster(ctx context.Context) (*Roster, error) {
+	var out Roster
+	if err := c.get(ctx, "/admin/export", url.Values{"format": {FormatJSON}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportRosterRaw возвращает выгрузку в формате format (FormatJSON, FormatYAML или FormatCSV) как есть.
+func (c *Client) ExportRosterRaw(ctx context.Context, format string) ([]byte, error) {
+	resp, err := c.send(ctx, http.MethodGet, "/admin/export", url.Values{"format": {format}}, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return io.ReadAll(resp.Body)
+}
+
+func (c *Client) ImportRoster(ctx context.Context, roster Roster, dryRun bool) (*ImportResult, error) {
+	raw, err := json.Marshal(roster)
+	if err != nil {
+		return nil, err
+	}
+	return c.ImportRosterRaw(ctx, FormatJSON, raw, dryRun)
+}
+
+// ImportRosterRaw загружает файл состава в формате format без разбора на стороне клиента.
+func (c *Client) ImportRosterRaw(ctx context.Context, format string, data []byte, dryRun bool) (*ImportResult, error) {
+	query := url.Values{"format": {format}}
+	if dryRun {
+		query.Set("dry_run", strconv.FormatBool(dryRun))
+	}
+	contentType := formatContentTypes[format]
+	resp, err := c.send(ctx, http.MethodPost, "/admin/import", query, contentType, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	var out ImportResult
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type AuditEntry struct {
+	ID         int64           `json:"id" yaml:"id"`
+	Actor      string          `json:"actor" yaml:"actor"`
+	RequestID  string          `json:"request_id" yaml:"request_id"`
+	Action     string          `json:"action" yaml:"action"`
+	EntityType string          `json:"entity_type" yaml:"entity_type"`
+	EntityID   string          `json:"entity_id" yaml:"entity_id"`
+	Before     json.RawMessage `json:"before,omitempty" yaml:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty" yaml:"after,omitempty"`
+	CreatedAt  time.Time       `json:"created_at" yaml:"created_at"`
+}
+
+type AuditPage struct {
+	Entries []AuditEntry `json:"entries" yaml:"entries"`
+}
+
+// AuditFilter — фильтры /audit; нулевые значения не передаются, To не включается в выборку.
+type AuditFilter struct {
+	EntityType string
+	EntityID   string
+	Actor      string
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+func (c *Client) ListAudit(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	query := url.Values{}
+	for key, value := range map[string]string{
+		"entity_type": filter.EntityType,
+		"entity_id":   filter.EntityID,
+		"actor":       filter.Actor,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	if !filter.From.IsZero() {
+		query.Set("from", filter.From.Format(time.RFC3339))
+	}
+	if !filter.To.IsZero() {
+		query.Set("to", filter.To.Format(time.RFC3339))
+	}
+	if filter.Limit > 0 {
+		query.Set("limit", strconv.Itoa(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		query.Set("offset", strconv.Itoa(filter.Offset))
+	}
+	var out AuditPage
+	if err := c.get(ctx, "/audit", query, &out); err != nil {
+		return nil, err
+	}
+	return out.Entries, nil
+}
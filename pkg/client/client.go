@@ -0,0 +1,211 @@
+// Package client — типизированный Go-клиент HTTP API сервиса назначения ревьюверов.
+// Типы и пути соответствуют docs/openapi.yml; соответствие проверяют контрактные тесты internal/tests/contract.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// ActorHeader — заголовок с идентификатором инициатора изменения, попадает в журнал аудита.
+	ActorHeader = "X-Actor-ID"
+	// IfMatchHeader — заголовок с ожидаемой версией ресурса (ETag) для изменяющих запросов.
+	IfMatchHeader = "If-Match"
+
+	defaultTimeout = 10 * time.Second
+)
+
+// Коды ошибок ErrorResponse.
+const (
+	CodeTeamExists            = "TEAM_EXISTS"
+	CodePRExists              = "PR_EXISTS"
+	CodePRMerged              = "PR_MERGED"
+	CodePRClosed              = "PR_CLOSED"
+	CodeNotAssigned           = "NOT_ASSIGNED"
+	CodeNoCandidate           = "NO_CANDIDATE"
+	CodeTooManyReviewers      = "TOO_MANY_REVIEWERS"
+	CodeAlreadyAssigned       = "ALREADY_ASSIGNED"
+	CodeNotEligible           = "NOT_ELIGIBLE"
+	CodeConstraintUnsatisfied = "CONSTRAINT_UNSATISFIED"
+	CodeNotFound              = "NOT_FOUND"
+	CodeBadRequest            = "BAD_REQUEST"
+	CodePreconditionFailed    = "PRECONDITION_FAILED"
+)
+
+type Client struct {
+	baseURL    string
+	token      string
+	actor      string
+	httpClient *http.Client
+}
+
+type Option func(*Client)
+
+// WithToken передаёт токен в заголовке Authorization: Bearer (для развёртываний за авторизующим прокси).
+func WithToken(token string) Option { return func(c *Client) { c.token = token } }
+
+// WithActor задаёт значение заголовка X-Actor-ID для изменяющих запросов.
+func WithActor(actor string) Option { return func(c *Client) { c.actor = actor } }
+
+func WithHTTPClient(hc *http.Client) Option { return func(c *Client) { c.httpClient = hc } }
+
+type ifMatchKey struct{}
+
+// WithIfMatch возвращает контекст, с которым изменяющий запрос выполнится, только если версия ресурса
+// на сервере всё ещё равна etag (из поля ETag ранее полученного PR или команды); иначе — 412 CodePreconditionFailed.
+func WithIfMatch(ctx context.Context, etag string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, etag)
+}
+
+// etagged — ответ, которому нужен ETag из заголовков.
+type etagged interface {
+	setETag(etag string)
+}
+
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: defaultTimeout},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// ErrorDetail — тело ошибки API; также используется в результатах пакетного создания PR.
+type ErrorDetail struct {
+	Code    string `json:"code" yaml:"code"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// APIError — ответ сервиса с кодом ошибки из ErrorResponse.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsCode сообщает, является ли err ошибкой API с кодом code (например, CodeNotFound или CodeTeamExists).
+func IsCode(err error, code string) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	return c.do(ctx, http.MethodGet, path, query, nil, out)
+}
+
+func (c *Client) post(ctx context.Context, path string, body, out any) error {
+	return c.do(ctx, http.MethodPost, path, nil, body, out)
+}
+
+func (c *Client) put(ctx context.Context, path string, query url.Values, body, out any) error {
+	return c.do(ctx, http.MethodPut, path, query, body, out)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	var (
+		reader      io.Reader
+		contentType string
+	)
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader, contentType = bytes.NewReader(raw), "application/json"
+	}
+	resp, err := c.send(ctx, method, path, query, contentType, reader)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s %s response: %w", method, path, err)
+	}
+	if e, ok := out.(etagged); ok {
+		e.setETag(resp.Header.Get("ETag"))
+	}
+	return nil
+}
+
+// send выполняет запрос и превращает ответы 4xx/5xx в *APIError; тело успешного ответа закрывает вызывающий.
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, path, query, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	return c.roundTrip(c.httpClient, req)
+}
+
+func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values, contentType string, body io.Reader) (*http.Request, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if c.actor != "" {
+		req.Header.Set(ActorHeader, c.actor)
+	}
+	if etag, _ := ctx.Value(ifMatchKey{}).(string); etag != "" {
+		req.Header.Set(IfMatchHeader, etag)
+	}
+	return req, nil
+}
+
+func (c *Client) roundTrip(hc *http.Client, req *http.Request) (*http.Response, error) {
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer func() { _ = resp.Body.Close() }()
+		return nil, decodeError(resp)
+	}
+	return resp, nil
+}
+
+func decodeError(resp *http.Response) error {
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	var body struct {
+		Error ErrorDetail `json:"error"`
+	}
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	if err := json.Unmarshal(raw, &body); err == nil && body.Error.Code != "" {
+		apiErr.Code, apiErr.Message = body.Error.Code, body.Error.Message
+	} else {
+		apiErr.Message = strings.TrimSpace(string(raw))
+	}
+	return apiErr
+}
+
+func (c *Client) Ping(ctx context.Context) error {
+	return c.get(ctx, "/ping", nil, nil)
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_HeadersAndDecode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.Equal(t, "admin", r.Header.Get(ActorHeader))
+		switch r.URL.Path {
+		case "/pullRequest/create":
+			require.Equal(t, http.MethodPost, r.Method)
+			var req CreatePRRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			_ = json.NewEncoder(w).Encode(map[string]any{"pr": map[string]any{
+				"pull_request_id": req.PullRequestID, "pull_request_name": req.PullRequestName,
+				"author_id": req.AuthorID, "status": StatusOpen, "assigned_reviewers": []string{"u2"},
+			}})
+		case "/pullRequest/list":
+			require.Equal(t, "OPEN", r.URL.Query().Get("status"))
+			require.Equal(t, "50", r.URL.Query().Get("limit"))
+			require.Empty(t, r.URL.Query().Get("offset"))
+			_, _ = w.Write([]byte(`{"pull_requests":[],"total":0,"limit":50,"offset":0}`))
+		case "/team/sync":
+			require.Equal(t, http.MethodPut, r.Method)
+			require.Equal(t, "true", r.URL.Query().Get("dry_run"))
+			_, _ = w.Write([]byte(`{"dry_run":true,"team":{"team_name":"core","members":[]},"diff":{"create_teams":[]},"releases":[]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL+"/", WithToken("secret"), WithActor("admin"))
+	ctx := context.Background()
+
+	pr, err := c.CreatePR(ctx, CreatePRRequest{PullRequestID: "pr-1", PullRequestName: "Fix", AuthorID: "u1"})
+	require.NoError(t, err)
+	require.Equal(t, "pr-1", pr.PullRequestID)
+	require.Equal(t, []string{"u2"}, pr.AssignedReviewers)
+
+	page, err := c.ListPRs(ctx, ListPRsParams{Status: StatusOpen, Limit: 50})
+	require.NoError(t, err)
+	require.Equal(t, 50, page.Limit)
+
+	res, err := c.SyncTeam(ctx, Team{TeamName: "core"}, true)
+	require.NoError(t, err)
+	require.True(t, res.DryRun)
+	require.Equal(t, "core", res.Team.TeamName)
+}
+
+func TestClient_ETagAndIfMatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pullRequest/get":
+			require.Empty(t, r.Header.Get(IfMatchHeader))
+			w.Header().Set("ETag", `"3"`)
+			_, _ = w.Write([]byte(`{"pr":{"pull_request_id":"pr-1","pull_request_name":"Fix","author_id":"u1","status":"OPEN","assigned_reviewers":[]}}`))
+		case "/pullRequest/merge":
+			if r.Header.Get(IfMatchHeader) != `"3"` {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_, _ = w.Write([]byte(`{"error":{"code":"PRECONDITION_FAILED","message":"stale"}}`))
+				return
+			}
+			w.Header().Set("ETag", `"4"`)
+			_, _ = w.Write([]byte(`{"pr":{"pull_request_id":"pr-1","pull_request_name":"Fix","author_id":"u1","status":"MERGED","assigned_reviewers":[]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+	ctx := context.Background()
+	pr, err := c.GetPR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, `"3"`, pr.ETag)
+
+	_, err = c.MergePR(WithIfMatch(ctx, `"2"`), "pr-1")
+	require.True(t, IsCode(err, CodePreconditionFailed))
+
+	merged, err := c.MergePR(WithIfMatch(ctx, pr.ETag), "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, `"4"`, merged.ETag)
+}
+
+func TestClient_APIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/team/get" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NOT_FOUND","message":"team not found"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("upstream unavailable\n"))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+	_, err := c.GetTeam(context.Background(), "missing")
+	require.True(t, IsCode(err, "NOT_FOUND"))
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "team not found", apiErr.Message)
+
+	err = c.Ping(context.Background())
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	require.Empty(t, apiErr.Code)
+	require.Equal(t, "upstream unavailable", apiErr.Message)
+}
+
+func TestClient_RosterRaw(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/export":
+			require.Equal(t, FormatCSV, r.URL.Query().Get("format"))
+			w.Header().Set("Content-Type", "text/csv")
+			_, _ = w.Write([]byte("team_name,user_id,username,is_active\nbackend,u1,Alice,true\n"))
+		case "/admin/import":
+			require.Equal(t, "text/csv", r.Header.Get("Content-Type"))
+			require.Equal(t, "true", r.URL.Query().Get("dry_run"))
+			raw, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Contains(t, string(raw), "backend,u1,Alice,true")
+			_, _ = w.Write([]byte(`{"dry_run":true,"diff":{"create_teams":["backend"]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+	ctx := context.Background()
+
+	data, err := c.ExportRosterRaw(ctx, FormatCSV)
+	require.NoError(t, err)
+
+	res, err := c.ImportRosterRaw(ctx, FormatCSV, data, true)
+	require.NoError(t, err)
+	require.True(t, res.DryRun)
+	require.Equal(t, []string{"backend"}, res.Diff.CreateTeams)
+}
+
+func TestClient_ReviewFeed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/users/reviewFeed", r.URL.Path)
+		require.Equal(t, "u2", r.URL.Query().Get("user_id"))
+		require.Equal(t, "41", r.Header.Get("Last-Event-ID"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = io.WriteString(w, "retry: 3000\n\n: ping\n\n"+
+			"id: 42\nevent: assigned\ndata: {\"event_id\":42,\"type\":\"assigned\",\"user_id\":\"u2\",\"pull_request_id\":\"pr-1\",\"pull_request_name\":\"Fix\",\"reason\":\"initial\",\"at\":\"2025-01-10T12:00:00Z\"}\n\n"+
+			"id: 43\nevent: merged\ndata: {\"event_id\":43,\"type\":\"merged\",\"user_id\":\"u2\",\"pull_request_id\":\"pr-1\",\"pull_request_name\":\"Fix\",\"at\":\"2025-01-10T13:00:00Z\"}\n\n")
+	}))
+	defer srv.Close()
+
+	var got []ReviewFeedEvent
+	err := New(srv.URL).ReviewFeed(context.Background(), "u2", 41, func(e ReviewFeedEvent) error {
+		got = append(got, e)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, int64(42), got[0].EventID)
+	require.Equal(t, FeedAssigned, got[0].Type)
+	require.Equal(t, "initial", got[0].Reason)
+	require.Equal(t, FeedMerged, got[1].Type)
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	ProviderGitHub = "github"
+	ProviderGitLab = "gitlab"
+)
+
+// VCSIdentity связывает логин в VCS с пользователем; сервис хранит логины в нижнем регистре.
+type VCSIdentity struct {
+	Provider string `json:"provider" yaml:"provider"`
+	Login    string `json:"login" yaml:"login"`
+	UserID   string `json:"user_id" yaml:"user_id"`
+}
+
+type VCSIdentities struct {
+	Identities []VCSIdentity `json:"identities" yaml:"identities"`
+}
+
+func (c *Client) SetVCSIdentities(ctx context.Context, identities []VCSIdentity) ([]VCSIdentity, error) {
+	var out VCSIdentities
+	if err := c.post(ctx, "/integrations/identities", VCSIdentities{Identities: identities}, &out); err != nil {
+		return nil, err
+	}
+	return out.Identities, nil
+}
+
+// ListVCSIdentities возвращает сопоставления; пустой provider — по всем VCS.
+func (c *Client) ListVCSIdentities(ctx context.Context, provider string) ([]VCSIdentity, error) {
+	q := url.Values{}
+	if provider != "" {
+		q.Set("provider", provider)
+	}
+	var out VCSIdentities
+	if err := c.get(ctx, "/integrations/identities", q, &out); err != nil {
+		return nil, err
+	}
+	return out.Identities, nil
+}
@@ -0,0 +1,266 @@
+package client
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	StatusOpen   = "OPEN"
+	StatusMerged = "MERGED"
+	StatusClosed = "CLOSED"
+)
+
+// Причины назначения и снятия ревьюверов (ReassignRequest.Reason, история и объяснения выбора).
+const (
+	ReasonInitial        = "initial"
+	ReasonManualReassign = "manual_reassign"
+	ReasonDeactivation   = "deactivation"
+	ReasonSLAEscalation  = "sla_escalation"
+	ReasonTeamRemoval    = "team_removal"
+	ReasonManual         = "manual"
+)
+
+type Reviewer struct {
+	UserID   string `json:"user_id" yaml:"user_id"`
+	Username string `json:"username" yaml:"username"`
+	IsActive bool   `json:"is_active" yaml:"is_active"`
+}
+
+type PullRequest struct {
+	PullRequestID     string     `json:"pull_request_id" yaml:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name" yaml:"pull_request_name"`
+	AuthorID          string     `json:"author_id" yaml:"author_id"`
+	Status            string     `json:"status" yaml:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers" yaml:"assigned_reviewers"`
+	CreatedAt         *time.Time `json:"createdAt,omitempty" yaml:"created_at,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt,omitempty" yaml:"merged_at,omitempty"`
+	ChangedFiles      []string   `json:"changed_files,omitempty" yaml:"changed_files,omitempty"`
+	Labels            []string   `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Reviewers         []Reviewer `json:"reviewers,omitempty" yaml:"reviewers,omitempty"`
+	// ETag — версия PR из заголовка ответа; передаётся в WithIfMatch. Заполняется у GetPR, MergePR,
+	// ReassignPR, AddReviewer и RemoveReviewer.
+	ETag string `json:"-" yaml:"-"`
+}
+
+type PRResponse struct {
+	PR PullRequest `json:"pr" yaml:"pr"`
+}
+
+func (r *PRResponse) setETag(etag string) { r.PR.ETag = etag }
+
+type CreatePRRequest struct {
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	ChangedFiles    []string `json:"changed_files,omitempty"`
+	Labels          []string `json:"labels,omitempty"`
+}
+
+// BatchCreateItem — элемент пакетного создания. Reviewers == nil — ревьюверы выбираются автоматически,
+// пустой срез — PR без ревьюверов.
+type BatchCreateItem struct {
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	ChangedFiles    []string  `json:"changed_files,omitempty"`
+	Labels          []string  `json:"labels,omitempty"`
+	Reviewers       *[]string `json:"reviewers,omitempty"`
+}
+
+const (
+	BatchStatusCreated = "created"
+	BatchStatusExists  = "exists"
+	BatchStatusError   = "error"
+)
+
+type BatchResult struct {
+	PullRequestID string       `json:"pull_request_id" yaml:"pull_request_id"`
+	Status        string       `json:"status" yaml:"status"`
+	PR            *PullRequest `json:"pr,omitempty" yaml:"pr,omitempty"`
+	Error         *ErrorDetail `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+type BatchCreateResult struct {
+	Results []BatchResult `json:"results" yaml:"results"`
+	Created int           `json:"created" yaml:"created"`
+	Exists  int           `json:"exists" yaml:"exists"`
+	Failed  int           `json:"failed" yaml:"failed"`
+}
+
+type ReassignRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldUserID     string `json:"old_user_id"`
+	// NewUserID — явный выбор замены; пусто — замену выбирает стратегия команды.
+	NewUserID string `json:"new_user_id,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+type ReassignResult struct {
+	PR         PullRequest `json:"pr" yaml:"pr"`
+	ReplacedBy string      `json:"replaced_by" yaml:"replaced_by"`
+}
+
+func (r *ReassignResult) setETag(etag string) { r.PR.ETag = etag }
+
+// ListPRsParams — фильтры /pullRequest/list; нулевые значения не передаются.
+type ListPRsParams struct {
+	AuthorID   string
+	TeamName   string
+	Status     string
+	ReviewerID string
+	Limit      int
+	Offset     int
+}
+
+type PRPage struct {
+	PullRequests []PullRequest `json:"pull_requests" yaml:"pull_requests"`
+	Total        int           `json:"total" yaml:"total"`
+	Limit        int           `json:"limit" yaml:"limit"`
+	Offset       int           `json:"offset" yaml:"offset"`
+}
+
+type HistoryEntry struct {
+	ReviewerID string    `json:"reviewer_id" yaml:"reviewer_id"`
+	Event      string    `json:"event" yaml:"event"`
+	Reason     string    `json:"reason" yaml:"reason"`
+	Actor      string    `json:"actor,omitempty" yaml:"actor,omitempty"`
+	At         time.Time `json:"at" yaml:"at"`
+}
+
+type ReviewerHistory struct {
+	PullRequestID string         `json:"pull_request_id" yaml:"pull_request_id"`
+	History       []HistoryEntry `json:"history" yaml:"history"`
+}
+
+type ExcludedCandidate struct {
+	UserID string `json:"user_id" yaml:"user_id"`
+	Reason string `json:"reason" yaml:"reason"`
+}
+
+type CandidateScore struct {
+	UserID   string             `json:"user_id" yaml:"user_id"`
+	Score    float64            `json:"score" yaml:"score"`
+	Factors  map[string]float64 `json:"factors,omitempty" yaml:"factors,omitempty"`
+	Selected bool               `json:"selected" yaml:"selected"`
+}
+
+type SelectionExplanation struct {
+	PullRequestID string              `json:"pull_request_id" yaml:"pull_request_id"`
+	Reason        string              `json:"reason" yaml:"reason"`
+	Strategy      string              `json:"strategy" yaml:"strategy"`
+	Slots         int                 `json:"slots" yaml:"slots"`
+	Pool          []string            `json:"pool" yaml:"pool"`
+	Excluded      []ExcludedCandidate `json:"excluded" yaml:"excluded"`
+	Scores        []CandidateScore    `json:"scores" yaml:"scores"`
+	Selected      []string            `json:"selected" yaml:"selected"`
+	CreatedAt     time.Time           `json:"created_at" yaml:"created_at"`
+}
+
+type Explanation struct {
+	PullRequestID string                 `json:"pull_request_id" yaml:"pull_request_id"`
+	Selections    []SelectionExplanation `json:"selections" yaml:"selections"`
+}
+
+func (c *Client) CreatePR(ctx context.Context, req CreatePRRequest) (*PullRequest, error) {
+	return c.postPR(ctx, "/pullRequest/create", req)
+}
+
+// BatchCreatePRs создаёт до 1000 PR за запрос; ошибки отдельных элементов возвращаются в BatchResult.Error.
+func (c *Client) BatchCreatePRs(ctx context.Context, items []BatchCreateItem) (*BatchCreateResult, error) {
+	body := struct {
+		PullRequests []BatchCreateItem `json:"pull_requests"`
+	}{items}
+	var out BatchCreateResult
+	if err := c.post(ctx, "/pullRequest/batchCreate", body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) MergePR(ctx context.Context, prID string) (*PullRequest, error) {
+	body := struct {
+		PullRequestID string `json:"pull_request_id"`
+	}{prID}
+	return c.postPR(ctx, "/pullRequest/merge", body)
+}
+
+func (c *Client) GetPR(ctx context.Context, prID string) (*PullRequest, error) {
+	var out PRResponse
+	if err := c.get(ctx, "/pullRequest/get", url.Values{"pull_request_id": {prID}}, &out); err != nil {
+		return nil, err
+	}
+	return &out.PR, nil
+}
+
+func (c *Client) ReassignPR(ctx context.Context, req ReassignRequest) (*ReassignResult, error) {
+	var out ReassignResult
+	if err := c.post(ctx, "/pullRequest/reassign", req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) AddReviewer(ctx context.Context, prID, userID string) (*PullRequest, error) {
+	return c.postPR(ctx, "/pullRequest/addReviewer", reviewerChange{prID, userID})
+}
+
+func (c *Client) RemoveReviewer(ctx context.Context, prID, userID string) (*PullRequest, error) {
+	return c.postPR(ctx, "/pullRequest/removeReviewer", reviewerChange{prID, userID})
+}
+
+func (c *Client) GetHistory(ctx context.Context, prID string) (*ReviewerHistory, error) {
+	var out ReviewerHistory
+	if err := c.get(ctx, "/pullRequest/history", url.Values{"pull_request_id": {prID}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) ExplainSelection(ctx context.Context, prID string) (*Explanation, error) {
+	var out Explanation
+	if err := c.get(ctx, "/pullRequest/explain", url.Values{"pull_request_id": {prID}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) ListPRs(ctx context.Context, params ListPRsParams) (*PRPage, error) {
+	query := url.Values{}
+	for key, value := range map[string]string{
+		"author_id":   params.AuthorID,
+		"team_name":   params.TeamName,
+		"status":      params.Status,
+		"reviewer_id": params.ReviewerID,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	if params.Limit > 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Offset > 0 {
+		query.Set("offset", strconv.Itoa(params.Offset))
+	}
+	var out PRPage
+	if err := c.get(ctx, "/pullRequest/list", query, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type reviewerChange struct {
+	PullRequestID string `json:"pull_request_id"`
+	UserID        string `json:"user_id"`
+}
+
+func (c *Client) postPR(ctx context.Context, path string, body any) (*PullRequest, error) {
+	var out PRResponse
+	if err := c.post(ctx, path, body, &out); err != nil {
+		return nil, err
+	}
+	return &out.PR, nil
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Типы событий ленты ревьювера.
+const (
+	FeedAssigned   = "assigned"
+	FeedUnassigned = "unassigned"
+	FeedMerged     = "merged"
+)
+
+type ReviewFeedEvent struct {
+	EventID         int64     `json:"event_id" yaml:"event_id"`
+	Type            string    `json:"type" yaml:"type"`
+	UserID          string    `json:"user_id" yaml:"user_id"`
+	PullRequestID   string    `json:"pull_request_id" yaml:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name" yaml:"pull_request_name"`
+	Reason          string    `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Actor           string    `json:"actor,omitempty" yaml:"actor,omitempty"`
+	At              time.Time `json:"at" yaml:"at"`
+}
+
+// ReviewFeed подписывается на ленту /users/reviewFeed и вызывает fn для каждого события с номером больше
+// lastEventID. Возвращает nil, когда сервер закрыл поток, — тогда стоит переподключиться с EventID
+// последнего обработанного события; ошибку fn или контекста возвращает как есть.
+// Таймаут HTTP-клиента к потоку не применяется, время жизни ограничивает ctx.
+func (c *Client) ReviewFeed(ctx context.Context, userID string, lastEventID int64, fn func(ReviewFeedEvent) error) error {
+	req, err := c.newRequest(ctx, http.MethodGet, "/users/reviewFeed", url.Values{"user_id": {userID}}, "", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID > 0 {
+		req.Header.Set("Last-Event-ID", strconv.FormatInt(lastEventID, 10))
+	}
+	hc := *c.httpClient
+	hc.Timeout = 0
+	resp, err := c.roundTrip(&hc, req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	sc := bufio.NewScanner(resp.Body)
+	var data strings.Builder
+	for sc.Scan() {
+		line := sc.Text()
+		if line != "" {
+			// Из полей нужен только data: тип и номер события дублируются в JSON.
+			if v, ok := strings.CutPrefix(line, "data:"); ok {
+				data.WriteString(strings.TrimPrefix(v, " "))
+			}
+			continue
+		}
+		if data.Len() == 0 {
+			continue
+		}
+		var e ReviewFeedEvent
+		if err := json.Unmarshal([]byte(data.String()), &e); err != nil {
+			return fmt.Errorf("decode review feed event: %w", err)
+		}
+		data.Reset()
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return sc.Err()
+}
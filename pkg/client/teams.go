@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+type TeamMember struct {
+	UserID   string `json:"user_id" yaml:"user_id"`
+	Username string `json:"username" yaml:"username"`
+	IsActive bool   `json:"is_active" yaml:"is_active"`
+}
+
+type Team struct {
+	TeamName string       `json:"team_name" yaml:"team_name"`
+	Members  []TeamMember `json:"members" yaml:"members"`
+	// ETag — версия команды из ответа GetTeam; передаётся в WithIfMatch при изменении состава или настроек.
+	ETag string `json:"-" yaml:"-"`
+}
+
+func (t *Team) setETag(etag string) { t.ETag = etag }
+
+type TeamResponse struct {
+	Team Team `json:"team" yaml:"team"`
+}
+
+type TeamSummary struct {
+	TeamName          string `json:"team_name" yaml:"team_name"`
+	SelectionStrategy string `json:"selection_strategy" yaml:"selection_strategy"`
+}
+
+type TeamListResponse struct {
+	Teams []TeamSummary `json:"teams" yaml:"teams"`
+}
+
+type ReviewRelease struct {
+	PullRequestID string `json:"pull_request_id" yaml:"pull_request_id"`
+	UserID        string `json:"user_id" yaml:"user_id"`
+	Outcome       string `json:"outcome" yaml:"outcome"`
+	ReplacedBy    string `json:"replaced_by,omitempty" yaml:"replaced_by,omitempty"`
+}
+
+type SyncResult struct {
+	DryRun   bool            `json:"dry_run" yaml:"dry_run"`
+	Team     Team            `json:"team" yaml:"team"`
+	Diff     RosterDiff      `json:"diff" yaml:"diff"`
+	Releases []ReviewRelease `json:"releases" yaml:"releases"`
+}
+
+type CodeOwnerRule struct {
+	Pattern string   `json:"pattern" yaml:"pattern"`
+	Owners  []string `json:"owners" yaml:"owners"`
+}
+
+type CodeOwners struct {
+	TeamName string          `json:"team_name" yaml:"team_name"`
+	Rules    []CodeOwnerRule `json:"rules" yaml:"rules"`
+	// ETag — версия команды из заголовка ответа SetCodeOwners.
+	ETag string `json:"-" yaml:"-"`
+}
+
+func (c *CodeOwners) setETag(etag string) { c.ETag = etag }
+
+type SelectionStrategy struct {
+	TeamName string          `json:"team_name" yaml:"team_name"`
+	Strategy string          `json:"strategy" yaml:"strategy"`
+	Params   json.RawMessage `json:"params,omitempty" yaml:"params,omitempty"`
+	// ETag — версия команды из заголовка ответа.
+	ETag string `json:"-" yaml:"-"`
+}
+
+func (s *SelectionStrategy) setETag(etag string) { s.ETag = etag }
+
+const (
+	ConstraintMustIncludeOneOf = "must_include_one_of"
+	ConstraintNeverPair        = "never_pair"
+	ConstraintPreferPair       = "prefer_pair"
+)
+
+type ReviewerConstraint struct {
+	Kind        string   `json:"kind" yaml:"kind"`
+	AuthorIDs   []string `json:"author_ids,omitempty" yaml:"author_ids,omitempty"`
+	ReviewerIDs []string `json:"reviewer_ids" yaml:"reviewer_ids"`
+}
+
+type ReviewerConstraints struct {
+	TeamName    string               `json:"team_name" yaml:"team_name"`
+	Constraints []ReviewerConstraint `json:"constraints" yaml:"constraints"`
+	// ETag — версия команды из заголовка ответа SetReviewerConstraints.
+	ETag string `json:"-" yaml:"-"`
+}
+
+func (rc *ReviewerConstraints) setETag(etag string) { rc.ETag = etag }
+
+// AddTeam создаёт команду; повтор того же состава идемпотентен, другой состав даёт CodeTeamExists.
+func (c *Client) AddTeam(ctx context.Context, team Team) (*Team, error) {
+	var out TeamResponse
+	if err := c.post(ctx, "/team/add", team, &out); err != nil {
+		return nil, err
+	}
+	return &out.Team, nil
+}
+
+func (c *Client) GetTeam(ctx context.Context, name string) (*Team, error) {
+	var out Team
+	if err := c.get(ctx, "/team/get", url.Values{"team_name": {name}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) ListTeams(ctx context.Context) ([]TeamSummary, error) {
+	var out TeamListResponse
+	if err := c.get(ctx, "/team/list", nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Teams, nil
+}
+
+// SyncTeam приводит состав команды к team.Members; при dryRun возвращает только diff.
+func (c *Client) SyncTeam(ctx context.Context, team Team, dryRun bool) (*SyncResult, error) {
+	var query url.Values
+	if dryRun {
+		query = url.Values{"dry_run": {"true"}}
+	}
+	var out SyncResult
+	if err := c.put(ctx, "/team/sync", query, team, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetCodeOwners заменяет правила команды содержимым файла в формате CODEOWNERS.
+func (c *Client) SetCodeOwners(ctx context.Context, teamName, content string) (*CodeOwners, error) {
+	body := struct {
+		TeamName string `json:"team_name"`
+		Content  string `json:"content"`
+	}{teamName, content}
+	var out CodeOwners
+	if err := c.post(ctx, "/team/codeOwners", body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetCodeOwners(ctx context.Context, teamName string) (*CodeOwners, error) {
+	var out CodeOwners
+	if err := c.get(ctx, "/team/codeOwners", url.Values{"team_name": {teamName}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetSelectionStrategy задаёт стратегию выбора ревьюверов; пустой strategy — стратегия по умолчанию.
+func (c *Client) SetSelectionStrategy(ctx context.Context, s SelectionStrategy) (*SelectionStrategy, error) {
+	var out SelectionStrategy
+	if err := c.post(ctx, "/team/selectionStrategy", s, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetSelectionStrategy(ctx context.Context, teamName string) (*SelectionStrategy, error) {
+	var out SelectionStrategy
+	if err := c.get(ctx, "/team/selectionStrategy", url.Values{"team_name": {teamName}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetReviewerConstraints заменяет ограничения команды; пустой список очищает их.
+func (c *Client) SetReviewerConstraints(ctx context.Context, rc ReviewerConstraints) (*ReviewerConstraints, error) {
+	if rc.Constraints == nil {
+		rc.Constraints = []ReviewerConstraint{}
+	}
+	var out ReviewerConstraints
+	if err := c.post(ctx, "/team/reviewerConstraints", rc, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetReviewerConstraints(ctx context.Context, teamName string) (*ReviewerConstraints, error) {
+	var out ReviewerConstraints
+	if err := c.get(ctx, "/team/reviewerConstraints", url.Values{"team_name": {teamName}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
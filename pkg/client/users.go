@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+type User struct {
+	UserID   string `json:"user_id" yaml:"user_id"`
+	Username string `json:"username" yaml:"username"`
+	TeamName string `json:"team_name,omitempty" yaml:"team_name,omitempty"`
+	IsActive bool   `json:"is_active" yaml:"is_active"`
+}
+
+type UserResponse struct {
+	User User `json:"user" yaml:"user"`
+}
+
+type UserListResponse struct {
+	Users []User `json:"users" yaml:"users"`
+}
+
+type UserTags struct {
+	UserID string   `json:"user_id" yaml:"user_id"`
+	Tags   []string `json:"tags" yaml:"tags"`
+}
+
+type UserEmail struct {
+	UserID string `json:"user_id" yaml:"user_id"`
+	Email  string `json:"email" yaml:"email"`
+}
+
+// NotificationPreferences — настройки уведомлений в чат и на почту. Delivery и EmailDelivery: immediate,
+// digest или off; Kinds: assigned, reassigned_away, merged, sla_breached.
+type NotificationPreferences struct {
+	UserID        string   `json:"user_id" yaml:"user_id"`
+	ChatHandle    string   `json:"chat_handle" yaml:"chat_handle"`
+	Delivery      string   `json:"delivery" yaml:"delivery"`
+	EmailDelivery string   `json:"email_delivery" yaml:"email_delivery"`
+	Kinds         []string `json:"kinds" yaml:"kinds"`
+}
+
+type PullRequestShort struct {
+	PullRequestID   string `json:"pull_request_id" yaml:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name" yaml:"pull_request_name"`
+	AuthorID        string `json:"author_id" yaml:"author_id"`
+	Status          string `json:"status" yaml:"status"`
+}
+
+type UserReviews struct {
+	UserID       string             `json:"user_id" yaml:"user_id"`
+	PullRequests []PullRequestShort `json:"pull_requests" yaml:"pull_requests"`
+}
+
+func (c *Client) SetUserActive(ctx context.Context, userID string, active bool) (*User, error) {
+	body := struct {
+		UserID   string `json:"user_id"`
+		IsActive bool   `json:"is_active"`
+	}{userID, active}
+	var out UserResponse
+	if err := c.post(ctx, "/users/setIsActive", body, &out); err != nil {
+		return nil, err
+	}
+	return &out.User, nil
+}
+
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	var out UserListResponse
+	if err := c.get(ctx, "/users/list", nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Users, nil
+}
+
+// SetUserTags заменяет теги навыков; сервис приводит их к нижнему регистру и убирает дубликаты.
+func (c *Client) SetUserTags(ctx context.Context, userID string, tags []string) (*UserTags, error) {
+	if tags == nil {
+		tags = []string{}
+	}
+	var out UserTags
+	if err := c.post(ctx, "/users/tags", UserTags{UserID: userID, Tags: tags}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetUserTags(ctx context.Context, userID string) (*UserTags, error) {
+	var out UserTags
+	if err := c.get(ctx, "/users/tags", url.Values{"user_id": {userID}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetUserEmail задаёт адрес для уведомлений на почту; пустой email его удаляет.
+func (c *Client) SetUserEmail(ctx context.Context, userID, email string) (*UserEmail, error) {
+	var out UserEmail
+	if err := c.post(ctx, "/users/email", UserEmail{UserID: userID, Email: email}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetUserEmail(ctx context.Context, userID string) (*UserEmail, error) {
+	var out UserEmail
+	if err := c.get(ctx, "/users/email", url.Values{"user_id": {userID}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetNotificationPreferences заменяет настройки уведомлений; пустые Delivery и EmailDelivery — immediate,
+// пустой Kinds — все виды.
+func (c *Client) SetNotificationPreferences(ctx context.Context, prefs NotificationPreferences) (*NotificationPreferences, error) {
+	var out NotificationPreferences
+	if err := c.post(ctx, "/users/notifications", prefs, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) GetNotificationPreferences(ctx context.Context, userID string) (*NotificationPreferences, error) {
+	var out NotificationPreferences
+	if err := c.get(ctx, "/users/notifications", url.Values{"user_id": {userID}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserReviews возвращает PR, на которые пользователь назначен ревьювером.
+func (c *Client) GetUserReviews(ctx context.Context, userID string) (*UserReviews, error) {
+	var out UserReviews
+	if err := c.get(ctx, "/users/getReview", url.Values{"user_id": {userID}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}